@@ -17,10 +17,13 @@ type AdminController struct {
 }
 
 func (s AdminController) HandleUserBanPost(g *gin.Context) {
-	req := pb.AdminBanUserPostRequest{
-		Id:      g.Param("id"),
-		XUserId: g.GetString("userId"),
+	req := pb.AdminBanUserPostRequest{}
+	if err := lib.GetBody(g, &req); err != nil {
+		lib.BadRequest(g, err)
+		return
 	}
+	req.Id = g.Param("id")
+	req.XUserId = g.GetString("userId")
 	res, err := s.S.BanUser(lib.ParseGinContext(g), &req)
 	if err != nil {
 		lib.BadRequest(g, err)
@@ -30,10 +33,13 @@ func (s AdminController) HandleUserBanPost(g *gin.Context) {
 }
 
 func (s AdminController) HandleBusinessBanPost(g *gin.Context) {
-	req := pb.AdminBusinessBanPostRequest{
-		Id:      g.Param("id"),
-		XUserId: g.GetString("userId"),
+	req := pb.AdminBusinessBanPostRequest{}
+	if err := lib.GetBody(g, &req); err != nil {
+		lib.BadRequest(g, err)
+		return
 	}
+	req.Id = g.Param("id")
+	req.XUserId = g.GetString("userId")
 	res, err := s.S.BanBusiness(lib.ParseGinContext(g), &req)
 	if err != nil {
 		lib.BadRequest(g, err)
@@ -55,6 +61,37 @@ func (s AdminController) HandleBusinessDeletePost(g *gin.Context) {
 	lib.Success(g, res)
 }
 
+func (s AdminController) HandleDisputesGet(g *gin.Context) {
+	req := pb.AdminDisputesGetRequest{
+		XUserId: g.GetString("userId"),
+		Status:  c.DISPUTE_STATUS(lib.ParseInt32Val(g.DefaultQuery("status", "0"))),
+		Limit:   g.DefaultQuery("limit", "5"),
+		Offset:  g.DefaultQuery("offset", "0"),
+	}
+	res, err := s.S.ListDisputes(lib.ParseGinContext(g), &req)
+	if err != nil {
+		lib.BadRequest(g, err)
+		return
+	}
+	lib.Success(g, res)
+}
+
+func (s AdminController) HandleDisputeReviewPost(g *gin.Context) {
+	req := pb.AdminDisputeReviewPostRequest{}
+	if err := lib.GetBody(g, &req); err != nil {
+		lib.BadRequest(g, err)
+		return
+	}
+	req.Id = g.Param("id")
+	req.XUserId = g.GetString("userId")
+	res, err := s.S.ReviewDispute(lib.ParseGinContext(g), &req)
+	if err != nil {
+		lib.BadRequest(g, err)
+		return
+	}
+	lib.Success(g, res)
+}
+
 func (s AdminController) HandleBusinessesGet(g *gin.Context) {
 	req := pb.AdminBusinessesGetRequest{
 		XUserId: g.GetString("userId"),
@@ -194,6 +231,21 @@ func (s AdminController) HandleCategoriesPostEdit(g *gin.Context) {
 	lib.Success(g, res)
 }
 
+func (s AdminController) HandleCategoryQuestionsPost(g *gin.Context) {
+	req := pb.AdminCategoryQuestionsPostRequest{}
+	if err := lib.GetBody(g, &req); err != nil {
+		lib.BadRequest(g, err)
+		return
+	}
+	req.XUserId = g.GetString("userId")
+	res, err := s.S.SetCategoryQuestions(lib.ParseGinContext(g), &req)
+	if err != nil {
+		lib.BadRequest(g, err)
+		return
+	}
+	lib.Success(g, res)
+}
+
 func (s AdminController) HandleGroupsGet(g *gin.Context) {
 	req := pb.AdminGroupGetRequest{
 		Limit:      g.DefaultQuery("limit", "5"),
@@ -319,3 +371,76 @@ func (s AdminController) HandleAdvertiseManagementDeletePost(g *gin.Context) {
 	}
 	lib.Success(g, res)
 }
+
+func (s AdminController) HandleUserActivityGet(g *gin.Context) {
+	req := pb.AdminUserActivityGetRequest{
+		Id:      g.Param("id"),
+		XUserId: g.GetString("userId"),
+		Limit:   g.DefaultQuery("limit", "10"),
+		Offset:  g.DefaultQuery("offset", "0"),
+	}
+	res, err := s.S.ListUserActivity(lib.ParseGinContext(g), &req)
+	if err != nil {
+		lib.BadRequest(g, err)
+		return
+	}
+	lib.Success(g, res)
+}
+
+func (s AdminController) HandleUserImpersonatePost(g *gin.Context) {
+	req := pb.AdminUserImpersonatePostRequest{}
+	if err := lib.GetBody(g, &req); err != nil {
+		lib.BadRequest(g, err)
+		return
+	}
+	req.XUserId = g.GetString("userId")
+	req.Id = g.Param("id")
+
+	res, err := s.S.Impersonate(lib.ParseGinContext(g), &req)
+	if err != nil {
+		lib.BadRequest(g, err)
+		return
+	}
+	lib.Success(g, res)
+}
+
+func (s AdminController) HandlePermissionsGet(g *gin.Context) {
+	req := pb.AdminPermissionsGetRequest{
+		XUserId: g.GetString("userId"),
+	}
+	res, err := s.S.ListPermissions(lib.ParseGinContext(g), &req)
+	if err != nil {
+		lib.BadRequest(g, err)
+		return
+	}
+	lib.Success(g, res)
+}
+
+func (s AdminController) HandleRolesGet(g *gin.Context) {
+	req := pb.AdminRolesGetRequest{
+		XUserId: g.GetString("userId"),
+	}
+	res, err := s.S.ListRoles(lib.ParseGinContext(g), &req)
+	if err != nil {
+		lib.BadRequest(g, err)
+		return
+	}
+	lib.Success(g, res)
+}
+
+func (s AdminController) HandleRolePermissionsPut(g *gin.Context) {
+	req := pb.AdminRolePermissionsPutRequest{}
+	if err := lib.GetBody(g, &req); err != nil {
+		lib.BadRequest(g, err)
+		return
+	}
+	req.XUserId = g.GetString("userId")
+	req.Role = g.Param("role")
+
+	res, err := s.S.SetRolePermissions(lib.ParseGinContext(g), &req)
+	if err != nil {
+		lib.BadRequest(g, err)
+		return
+	}
+	lib.Success(g, res)
+}
@@ -2,10 +2,13 @@ package api
 
 import (
 	"context"
+	"strings"
+	"time"
 
 	"github.com/aqaurius6666/apiservice/src/internal/db/advertise_package"
 	"github.com/aqaurius6666/apiservice/src/internal/db/business"
 	"github.com/aqaurius6666/apiservice/src/internal/db/category"
+	"github.com/aqaurius6666/apiservice/src/internal/db/dispute"
 	"github.com/aqaurius6666/apiservice/src/internal/db/group"
 	"github.com/aqaurius6666/apiservice/src/internal/db/user"
 	"github.com/aqaurius6666/apiservice/src/internal/lib"
@@ -14,15 +17,18 @@ import (
 	"github.com/aqaurius6666/apiservice/src/internal/var/c"
 	"github.com/aqaurius6666/apiservice/src/internal/var/e"
 	"github.com/aqaurius6666/apiservice/src/pb"
+	"github.com/aqaurius6666/apiservice/src/services/authservice"
 	"github.com/aqaurius6666/go-utils/database"
 	"github.com/aqaurius6666/go-utils/utils"
 	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
 	"go.opentelemetry.io/otel"
 	"golang.org/x/xerrors"
 )
 
 type AdminService struct {
-	Model model.Server
+	Model  model.Server
+	Logger *logrus.Logger
 }
 
 func (s *AdminService) DeleteUser(ctx context.Context, req *pb.AdminUsersDeletePostRequest) (*pb.AdminUsersDeletePostResponse_Data, error) {
@@ -105,21 +111,29 @@ func (s *AdminService) BanBusiness(ctx context.Context, req *pb.AdminBusinessBan
 		lib.RecordError(span, err, ctx)
 		return nil, err
 	}
-	_, _, err = s.Model.InactiveBusiness(ctx, req.Id)
+	sus, err := parseSuspension(req.Reason, req.Note, req.ExpiresAt, req.XUserId)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	sus, _, err = s.Model.InactiveBusiness(ctx, req.Id, sus)
 	if err != nil {
 		err = xerrors.Errorf("%w", err)
 		lib.RecordError(span, err, ctx)
 
 		return nil, err
 	}
-	err = s.Model.UpdateOrdersStatusByUser(ctx, req.Id, c.ORDER_STATUS_CANCELED)
+	err = s.Model.UpdateOrdersStatusByUser(ctx, req.Id, c.ORDER_STATUS_CANCELED, suspensionActor(req.XUserId, sus))
 	if err != nil {
 		err = xerrors.Errorf("%w", err)
 		lib.RecordError(span, err, ctx)
 		return nil, err
 	}
 
-	return &pb.AdminBusinessBanPostResponse_Data{}, nil
+	return &pb.AdminBusinessBanPostResponse_Data{
+		Suspension: suspensionToPb(sus),
+	}, nil
 }
 
 func (s *AdminService) UnbanUser(ctx context.Context, req *pb.AdminUsersUnbanPostRequest) (*pb.AdminUsersUnbanPostResponse_Data, error) {
@@ -140,7 +154,7 @@ func (s *AdminService) UnbanUser(ctx context.Context, req *pb.AdminUsersUnbanPos
 		lib.RecordError(span, err, ctx)
 		return nil, err
 	}
-	_, _, err = s.Model.ActiveUser(ctx, req.Id)
+	_, err = s.Model.ActiveUser(ctx, req.Id, req.XUserId)
 	if err != nil {
 		return nil, xerrors.Errorf("%w", err)
 	}
@@ -168,7 +182,7 @@ func (s *AdminService) UnbanBusiness(ctx context.Context, req *pb.AdminBusinesse
 		lib.RecordError(span, err, ctx)
 		return nil, err
 	}
-	_, _, err = s.Model.ActiveBusiness(ctx, req.Id)
+	_, err = s.Model.ActiveBusiness(ctx, req.Id, req.XUserId)
 	if err != nil {
 		err = xerrors.Errorf("%w", err)
 		lib.RecordError(span, err, ctx)
@@ -199,20 +213,70 @@ func (s *AdminService) BanUser(ctx context.Context, req *pb.AdminBanUserPostRequ
 		lib.RecordError(span, err, ctx)
 		return nil, err
 	}
-	_, _, err = s.Model.InactiveUser(ctx, req.Id)
+	sus, err := parseSuspension(req.Reason, req.Note, req.ExpiresAt, req.XUserId)
 	if err != nil {
 		err = xerrors.Errorf("%w", err)
 		lib.RecordError(span, err, ctx)
 		return nil, err
 	}
-	err = s.Model.UpdateOrdersStatusByUser(ctx, req.Id, c.ORDER_STATUS_CANCELED)
+	sus, _, err = s.Model.InactiveUser(ctx, req.Id, sus)
 	if err != nil {
 		err = xerrors.Errorf("%w", err)
 		lib.RecordError(span, err, ctx)
 		return nil, err
 	}
+	err = s.Model.UpdateOrdersStatusByUser(ctx, req.Id, c.ORDER_STATUS_CANCELED, suspensionActor(req.XUserId, sus))
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+
+	return &pb.AdminBanUserPostResponse_Data{
+		Suspension: suspensionToPb(sus),
+	}, nil
+}
+
+// suspensionActor is the admin canceling the orders of a user it suspends.
+func suspensionActor(adminId string, sus *authservice.Suspension) model.OrderActor {
+	return model.OrderActor{
+		Id:       adminId,
+		Role:     c.ROLE_ADMIN,
+		Reason:   c.ORDER_REASON_SUSPENDED,
+		Metadata: map[string]string{"suspensionReason": sus.Reason.String()},
+	}
+}
+
+// parseSuspension builds the suspension an admin issues from a ban request.
+// An empty reason is recorded as OTHER.
+func parseSuspension(reason, note string, expiresAt int64, adminId string) (*authservice.Suspension, error) {
+	r := c.SUSPENSION_REASON_OTHER
+	if reason != "" {
+		v, ok := c.SUSPENSION_REASON_value[strings.ToUpper(reason)]
+		if !ok {
+			return nil, e.ErrBodyInvalid
+		}
+		r = c.SUSPENSION_REASON(v)
+	}
+	if expiresAt < 0 {
+		return nil, e.ErrBodyInvalid
+	}
+	return &authservice.Suspension{
+		Reason:    r,
+		Note:      note,
+		ExpiresAt: expiresAt,
+		IssuedBy:  adminId,
+	}, nil
+}
 
-	return &pb.AdminBanUserPostResponse_Data{}, nil
+func suspensionToPb(sus *authservice.Suspension) *pb.Suspension {
+	return &pb.Suspension{
+		Reason:    sus.Reason,
+		Note:      sus.Note,
+		ExpiresAt: sus.ExpiresAt,
+		IssuedBy:  sus.IssuedBy,
+		CreatedAt: sus.CreatedAt,
+	}
 }
 
 func (s *AdminService) ListBusinesses(ctx context.Context, req *pb.AdminBusinessesGetRequest) (*pb.AdminBusinessesGetResponse_Data, error) {
@@ -480,6 +544,16 @@ func (s *AdminService) EditCategory(ctx context.Context, req *pb.AdminCategoryPo
 	if err != nil {
 		return nil, xerrors.Errorf("%w", err)
 	}
+	// zero keeps the order expiry of the category
+	if req.OrderExpiryHours < 0 || time.Duration(req.OrderExpiryHours)*time.Hour > model.MAX_ORDER_EXPIRY {
+		err = xerrors.Errorf("%w", e.ErrInvalidOrderExpiry)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	var expiry *int32
+	if req.OrderExpiryHours > 0 {
+		expiry = utils.Int32Ptr(req.OrderExpiryHours)
+	}
 
 	err = s.Model.EditCategory(ctx, &category.Search{
 		Category: category.Category{
@@ -488,8 +562,9 @@ func (s *AdminService) EditCategory(ctx context.Context, req *pb.AdminCategoryPo
 			},
 		},
 	}, &category.Category{
-		ImageUrl: utils.SafeStrPtr(req.Url),
-		Name:     utils.SafeStrPtr(req.Name),
+		ImageUrl:    utils.SafeStrPtr(req.Url),
+		Name:        utils.SafeStrPtr(req.Name),
+		OrderExpiry: expiry,
 	})
 	if err != nil {
 		err = xerrors.Errorf("%w", err)
@@ -500,6 +575,120 @@ func (s *AdminService) EditCategory(ctx context.Context, req *pb.AdminCategoryPo
 	return &pb.AdminCategoryPostEditResponese_Data{}, nil
 }
 
+func (s *AdminService) SetCategoryQuestions(ctx context.Context, req *pb.AdminCategoryQuestionsPostRequest) (*pb.AdminCategoryQuestionsPostResponse_Data, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.SetCategoryQuestions))
+	defer span.End()
+
+	if f, ok := validate.RequiredFields(req, "XUserId", "CategoryId"); !ok {
+		err := xerrors.Errorf("%w", e.ErrMissingField(f))
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+
+	questions := make([]model.CategoryQuestion, 0, len(req.Questions))
+	for _, q := range req.Questions {
+		questions = append(questions, model.CategoryQuestion{
+			Key:      strings.TrimSpace(q.Key),
+			Label:    strings.TrimSpace(q.Label),
+			Type:     q.Type,
+			Required: q.Required,
+			Options:  q.Options,
+		})
+	}
+	err := s.Model.SetCategoryQuestions(ctx, req.CategoryId, questions)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	cate, err := s.Model.GetCategoryById(ctx, req.CategoryId)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+
+	return &pb.AdminCategoryQuestionsPostResponse_Data{
+		Category: s.Model.ConvertCategoryToProto(cate),
+	}, nil
+}
+
+// ListDisputes is the review queue of connection-fee disputes in a status,
+// oldest first.
+func (s *AdminService) ListDisputes(ctx context.Context, req *pb.AdminDisputesGetRequest) (*pb.AdminDisputesGetResponse_Data, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.ListDisputes))
+	defer span.End()
+
+	if f, ok := validate.RequiredFields(req, "XUserId"); !ok {
+		err := xerrors.Errorf("%w", e.ErrMissingField(f))
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	limit := lib.ParseInt32Val(req.Limit)
+	offset := lib.ParseInt32Val(req.Offset)
+
+	search := &dispute.Search{Dispute: dispute.Dispute{Status: utils.Int32Ptr(int32(req.Status))}}
+	total, err := s.Model.TotalDisputes(ctx, search)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	search.DefaultSearchModel = database.DefaultSearchModel{Skip: int(offset), Limit: int(limit)}
+	ds, err := s.Model.ListDisputes(ctx, search)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	upbs := s.Model.ConvertDisputeToProtos(ds)
+	if err := s.Model.PresignDisputeAttachments(ctx, ds, upbs); err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	return &pb.AdminDisputesGetResponse_Data{
+		Result:     upbs,
+		Pagination: lib.Pagination(offset, limit, total),
+	}, nil
+}
+
+// ReviewDispute approves or denies a dispute under review and tells the
+// handyman.
+func (s *AdminService) ReviewDispute(ctx context.Context, req *pb.AdminDisputeReviewPostRequest) (*pb.AdminDisputeReviewPostResponse_Data, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.ReviewDispute))
+	defer span.End()
+
+	if f, ok := validate.RequiredFields(req, "XUserId", "Id"); !ok {
+		err := xerrors.Errorf("%w", e.ErrMissingField(f))
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+
+	d, err := s.Model.GetDisputeById(ctx, req.Id)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	d, err = s.Model.ReviewDispute(ctx, d, req.XUserId, req.Approve, strings.TrimSpace(req.Note))
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	go func(handymanId, orderId string, status c.DISPUTE_STATUS, resolution c.DISPUTE_RESOLUTION) {
+		err := s.Model.SendDisputeReviewedNotification(context.TODO(), handymanId, orderId, status, resolution)
+		if err != nil {
+			s.Logger.Error(err)
+		}
+	}(d.BusinessId.String(), d.OrderId.String(), c.DISPUTE_STATUS(utils.Int32Val(d.Status)), c.DISPUTE_RESOLUTION(utils.Int32Val(d.Resolution)))
+
+	return &pb.AdminDisputeReviewPostResponse_Data{
+		Dispute: s.Model.ConvertDisputeToProto(d),
+	}, nil
+}
+
 func (s *AdminService) AddGroup(ctx context.Context, req *pb.AdminGroupPostRequest) (*pb.AdminGroupPostResponse_Data, error) {
 	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.AddGroup))
 	defer span.End()
@@ -868,3 +1057,133 @@ func (s *AdminService) DeleteAdvertisePackage(ctx context.Context, req *pb.Admin
 
 	return &pb.AdminAdvertiseManagementDeletePostResponse_Data{}, nil
 }
+
+func (s *AdminService) ListUserActivity(ctx context.Context, req *pb.AdminUserActivityGetRequest) (*pb.AdminUserActivityGetResponse_Data, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.ListUserActivity))
+	defer span.End()
+
+	if f, ok := validate.RequiredFields(req, "Id", "XUserId"); !ok {
+		err := xerrors.Errorf("%w", e.ErrMissingField(f))
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	limit := lib.ParseInt32Val(req.Limit)
+	offset := lib.ParseInt32Val(req.Offset)
+	events, total, err := s.Model.ListSecurityEvents(ctx, req.Id, limit, offset)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	return &pb.AdminUserActivityGetResponse_Data{
+		Result:     s.Model.ConvertSecurityEventsToProtos(events),
+		Pagination: lib.Pagination(offset, limit, total),
+	}, nil
+}
+
+func (s *AdminService) Impersonate(ctx context.Context, req *pb.AdminUserImpersonatePostRequest) (*pb.AdminUserImpersonatePostResponse_Data, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.Impersonate))
+	defer span.End()
+
+	if f, ok := validate.RequiredFields(req, "Id", "XUserId", "Reason"); !ok {
+		err := xerrors.Errorf("%w", e.ErrMissingField(f))
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	token, exp, sessionId, err := s.Model.Impersonate(ctx, req.XUserId, req.Id, req.Reason)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	return &pb.AdminUserImpersonatePostResponse_Data{
+		AccessToken: token,
+		ExpiresAt:   exp,
+		SessionId:   sessionId,
+	}, nil
+}
+
+func (s *AdminService) ListPermissions(ctx context.Context, req *pb.AdminPermissionsGetRequest) (*pb.AdminPermissionsGetResponse_Data, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.ListPermissions))
+	defer span.End()
+
+	if f, ok := validate.RequiredFields(req, "XUserId"); !ok {
+		err := xerrors.Errorf("%w", e.ErrMissingField(f))
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	perms, err := s.Model.ListPermissions(ctx)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	result := make([]*pb.Permission, 0, len(perms))
+	for _, p := range perms {
+		result = append(result, &pb.Permission{
+			Code:        p.Code,
+			Description: p.Description,
+		})
+	}
+	return &pb.AdminPermissionsGetResponse_Data{
+		Result: result,
+	}, nil
+}
+
+func (s *AdminService) ListRoles(ctx context.Context, req *pb.AdminRolesGetRequest) (*pb.AdminRolesGetResponse_Data, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.ListRoles))
+	defer span.End()
+
+	if f, ok := validate.RequiredFields(req, "XUserId"); !ok {
+		err := xerrors.Errorf("%w", e.ErrMissingField(f))
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	roles, err := s.Model.ListRoles(ctx)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	result := make([]*pb.Role, 0, len(roles))
+	for _, r := range roles {
+		result = append(result, &pb.Role{
+			Role:        r.Role,
+			Name:        r.Name,
+			Permissions: r.Permissions,
+		})
+	}
+	return &pb.AdminRolesGetResponse_Data{
+		Result: result,
+	}, nil
+}
+
+func (s *AdminService) SetRolePermissions(ctx context.Context, req *pb.AdminRolePermissionsPutRequest) (*pb.AdminRolePermissionsPutResponse_Data, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.SetRolePermissions))
+	defer span.End()
+
+	if f, ok := validate.RequiredFields(req, "XUserId", "Role"); !ok {
+		err := xerrors.Errorf("%w", e.ErrMissingField(f))
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	role, ok := c.ROLE_value[strings.ToUpper(req.Role)]
+	if !ok {
+		err := xerrors.Errorf("%w", e.ErrBodyInvalid)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	perms, err := s.Model.SetRolePermissions(ctx, c.ROLE(role), req.Permissions)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	return &pb.AdminRolePermissionsPutResponse_Data{
+		Result: &pb.Role{
+			Role:        c.ROLE(role),
+			Name:        strings.ToLower(req.Role),
+			Permissions: perms,
+		},
+	}, nil
+}
@@ -63,7 +63,7 @@ func (s *ApiServer) RegisterEndpoint() {
 	api.POST("/web-hook", s.Index.HandleWebHookPost)
 	api.GET("/stripe/key", s.Index.HandleStripeKeyGet)
 	api.POST("/stripe/setup", s.Mid.CheckAuth, s.Index.HandleStripeSetupPost)
-	api.GET("/stripe/payment-method", s.Mid.CheckAuth, s.Index.HandlePaymentMethodGet)
+	api.GET("/stripe/payment-method", s.Mid.CheckAuth, s.Mid.Member(c.BUSINESS_ROLE_OWNER), s.Index.HandlePaymentMethodGet)
 	api.GET("/", s.Index.HandleIndexGet)
 	api.GET("/random", s.Index.HandleRandomGet)
 	api.GET("/statistics", s.Index.HandleStatisticGet)
@@ -84,6 +84,18 @@ func (s *ApiServer) RegisterEndpoint() {
 	authGroup.POST("/forgot/reset", s.Auth.HandleForgotResetPost)
 	authGroup.POST("/ping", s.Mid.CheckAuth, s.Auth.HandlePingPost)
 	authGroup.PUT("/change-mail-and-pass", s.Mid.CheckAuth, s.Auth.HandleMailPassPut)
+	authGroup.POST("/logout-all", s.Mid.CheckAuth, s.Auth.HandleLogoutAllPost)
+	authGroup.POST("/totp/enroll", s.Mid.CheckAuth, s.Auth.HandleTotpEnrollPost)
+	authGroup.POST("/totp/verify", s.Mid.CheckAuth, s.Auth.HandleTotpVerifyPost)
+	authGroup.POST("/totp/disable", s.Mid.CheckAuth, s.Auth.HandleTotpDisablePost)
+	authGroup.POST("/phone", s.Mid.CheckAuth, s.Auth.HandlePhonePost)
+	authGroup.POST("/token", s.Auth.HandleTokenPost)
+	authGroup.POST("/token/refresh", s.Auth.HandleTokenRefreshPost)
+	authGroup.GET("/activity", s.Mid.CheckAuth, s.Auth.HandleActivityGet)
+	authGroup.POST("/delete", s.Mid.CheckAuth, s.Auth.HandleDeletePost)
+	authGroup.GET("/api-keys", s.Mid.CheckAuth, s.Auth.HandleApiKeysGet)
+	authGroup.POST("/api-keys", s.Mid.CheckAuth, s.Auth.HandleApiKeysPost)
+	authGroup.POST("/api-keys/:id/revoke", s.Mid.CheckAuth, s.Auth.HandleApiKeysRevokePost)
 
 	userGroup := api.Group("/users")
 	userGroup.POST("", s.Users.HandleUserPost)
@@ -92,31 +104,35 @@ func (s *ApiServer) RegisterEndpoint() {
 	userGroup.GET("/state", s.Users.HandleStateGet)
 
 	businessGroup := api.Group("/businesses")
-	businessGroup.GET("/payment-summary", s.Mid.CheckAuth, s.Mid.Only(c.ROLE_HANDYMAN), s.Business.TransactionsGet)
-	businessGroup.GET("/payment-summary-export", s.Mid.CheckAuth, s.Mid.Only(c.ROLE_HANDYMAN), s.Business.TransactionsExport)
+	businessGroup.GET("/payment-summary", s.Mid.CheckAuth, s.Mid.Only(c.ROLE_HANDYMAN), s.Mid.Member(c.BUSINESS_ROLE_OWNER, c.BUSINESS_ROLE_MANAGER), s.Business.TransactionsGet)
+	businessGroup.GET("/payment-summary-export", s.Mid.CheckAuth, s.Mid.Only(c.ROLE_HANDYMAN), s.Mid.Member(c.BUSINESS_ROLE_OWNER, c.BUSINESS_ROLE_MANAGER), s.Business.TransactionsExport)
 	businessGroup.POST("", s.Business.HandlePost)
 	businessGroup.GET("", s.Business.HandleGet)
 	businessGroup.GET("/:id/rating", s.Business.HandleRatingGet)
 	businessGroup.GET("/:id/feedbacks", s.Business.HandleFeedbackGet)
 	businessGroup.GET("/:id/services", s.Business.HandleServicesGet)
 	businessGroup.GET("/:id/free-contact", s.Mid.CheckAuth, s.Mid.Only(c.ROLE_HANDYMAN), s.Business.HandleFreeContactGet)
-	businessGroup.PUT("/:id/services", s.Mid.CheckAuth, s.Mid.Only(c.ROLE_HANDYMAN), s.Business.HandleServicesPut)
+	businessGroup.PUT("/:id/services", s.Mid.Scope(c.SCOPE_BUSINESSES_WRITE), s.Mid.CheckAuth, s.Mid.Only(c.ROLE_HANDYMAN), s.Mid.Member(c.BUSINESS_ROLE_OWNER, c.BUSINESS_ROLE_MANAGER), s.Business.HandleServicesPut)
 	businessGroup.PUT("/:id/verify-refcode", s.Mid.CheckAuth, s.Mid.Only(c.ROLE_HANDYMAN), s.Business.HandleVerifyRefCodePut)
-	businessGroup.PUT("/:id", s.Mid.CheckAuth, s.Business.HandlePut)
+	businessGroup.PUT("/:id", s.Mid.Scope(c.SCOPE_BUSINESSES_WRITE), s.Mid.CheckAuth, s.Mid.Member(c.BUSINESS_ROLE_OWNER, c.BUSINESS_ROLE_MANAGER), s.Business.HandlePut)
 	businessGroup.GET("/interest", s.Business.HandleInterestGet)
 	businessGroup.GET("/near", s.Mid.CheckAuth, s.Business.HandleNearGet)
 	businessGroup.GET("/invitation-code", s.Mid.CheckAuth, s.Mid.Only(c.ROLE_HANDYMAN), s.Business.HandleInvitationCodeGet)
-	businessGroup.GET("/payment-method", s.Mid.CheckAuth, s.Business.HandlePaymentMethodGet)
-	businessGroup.POST("/payment-method", s.Mid.CheckAuth, s.Business.HandlePaymentMethodPost)
-	businessGroup.POST("/payment-method/setup", s.Mid.CheckAuth, s.Business.HandlePaymentMethodSetupPost)
-	businessGroup.POST("/payment-method/delete", s.Mid.CheckAuth, s.Business.HandlePaymentMethodDeletePost)
+	businessGroup.GET("/payment-method", s.Mid.CheckAuth, s.Mid.Member(c.BUSINESS_ROLE_OWNER), s.Business.HandlePaymentMethodGet)
+	businessGroup.POST("/payment-method", s.Mid.CheckAuth, s.Mid.Member(c.BUSINESS_ROLE_OWNER), s.Business.HandlePaymentMethodPost)
+	businessGroup.POST("/payment-method/setup", s.Mid.CheckAuth, s.Mid.Member(c.BUSINESS_ROLE_OWNER), s.Business.HandlePaymentMethodSetupPost)
+	businessGroup.POST("/payment-method/delete", s.Mid.CheckAuth, s.Mid.Member(c.BUSINESS_ROLE_OWNER), s.Business.HandlePaymentMethodDeletePost)
+	businessGroup.GET("/members", s.Mid.CheckAuth, s.Mid.Only(c.ROLE_HANDYMAN), s.Business.HandleMembersGet)
+	businessGroup.POST("/members/invite", s.Mid.CheckAuth, s.Mid.Only(c.ROLE_HANDYMAN), s.Mid.Member(c.BUSINESS_ROLE_OWNER, c.BUSINESS_ROLE_MANAGER), s.Business.HandleMembersInvitePost)
+	businessGroup.POST("/members/accept", s.Mid.CheckAuth, s.Mid.Only(c.ROLE_CUSTOMER), s.Business.HandleMembersAcceptPost)
+	businessGroup.POST("/members/:id/delete", s.Mid.CheckAuth, s.Mid.Only(c.ROLE_HANDYMAN), s.Business.HandleMembersDeletePost)
 	businessGroup.GET("/:id", s.Business.HandleGetById)
 	businessGroup.GET("/promote", s.Mid.CheckAuth, s.Mid.Only(c.ROLE_HANDYMAN), s.Business.HandleAdvertiseGet)
 	businessGroup.GET("/promote/:id", s.Mid.CheckAuth, s.Mid.Only(c.ROLE_HANDYMAN), s.Business.HandleAdvertiseDetailGet)
 	businessGroup.GET("/promote/order", s.Mid.CheckAuth, s.Mid.Only(c.ROLE_HANDYMAN), s.Business.HandleAdvertiseOrdersGet)
-	businessGroup.POST("/buy-promote/setup", s.Mid.CheckAuth, s.Mid.Only(c.ROLE_HANDYMAN), s.Business.HandleBuyAdvertiseSetupPost)
-	businessGroup.POST("/buy-promote", s.Mid.CheckAuth, s.Mid.Only(c.ROLE_HANDYMAN), s.Business.HandleBuyAdvertisePost)
-	businessGroup.POST("/buy-promote/validate", s.Mid.CheckAuth, s.Mid.Only(c.ROLE_HANDYMAN), s.Business.HandleValidateBuyAdvertisePost)
+	businessGroup.POST("/buy-promote/setup", s.Mid.CheckAuth, s.Mid.Only(c.ROLE_HANDYMAN), s.Mid.Member(c.BUSINESS_ROLE_OWNER), s.Business.HandleBuyAdvertiseSetupPost)
+	businessGroup.POST("/buy-promote", s.Mid.CheckAuth, s.Mid.Only(c.ROLE_HANDYMAN), s.Mid.Member(c.BUSINESS_ROLE_OWNER), s.Business.HandleBuyAdvertisePost)
+	businessGroup.POST("/buy-promote/validate", s.Mid.CheckAuth, s.Mid.Only(c.ROLE_HANDYMAN), s.Mid.Member(c.BUSINESS_ROLE_OWNER), s.Business.HandleValidateBuyAdvertisePost)
 
 	contactGroup := api.Group("/contacts")
 	contactGroup.GET("/states", s.Contact.HandleListStates)
@@ -125,41 +141,59 @@ func (s *ApiServer) RegisterEndpoint() {
 	contactGroup.PUT("/:id", s.Mid.CheckAuth, s.Contact.HandlePut)
 
 	adminGroup := api.Group("/admin")
-	adminGroup.GET("/categories", s.Mid.CheckAuth, s.Mid.OnlyAdmin(), s.Admin.HandleCategoriesGet)
-	adminGroup.POST("/categories", s.Mid.CheckAuth, s.Mid.OnlyAdmin(), s.Admin.HandleCategoriesPost)
-	adminGroup.POST("/categories/delete", s.Mid.CheckAuth, s.Mid.OnlyAdmin(), s.Admin.HandleCategoriesPostDelete)
-	adminGroup.POST("/categories/edit", s.Mid.CheckAuth, s.Mid.OnlyAdmin(), s.Admin.HandleCategoriesPostEdit)
-	adminGroup.GET("/groups", s.Mid.CheckAuth, s.Mid.OnlyAdmin(), s.Admin.HandleGroupsGet)
-	adminGroup.POST("/groups", s.Mid.CheckAuth, s.Mid.OnlyAdmin(), s.Admin.HandleGroupsPost)
-	adminGroup.PUT("/groups/:id", s.Mid.CheckAuth, s.Mid.OnlyAdmin(), s.Admin.HandleGroupsPut)
-	adminGroup.POST("/users/:id/ban", s.Mid.CheckAuth, s.Mid.OnlyAdmin(), s.Admin.HandleUserBanPost)
-	adminGroup.POST("/users/:id/unban", s.Mid.CheckAuth, s.Mid.OnlyAdmin(), s.Admin.HandleUserUnbanPost)
-	adminGroup.POST("/users/:id/delete", s.Mid.CheckAuth, s.Mid.OnlyAdmin(), s.Admin.HandleUserDeletePost)
-	adminGroup.GET("/businesses", s.Mid.CheckAuth, s.Mid.OnlyAdmin(), s.Admin.HandleBusinessesGet)
-	adminGroup.GET("/users", s.Mid.CheckAuth, s.Mid.OnlyAdmin(), s.Admin.HandleUsersGet)
-	adminGroup.POST("/businesses/:id/ban", s.Mid.CheckAuth, s.Mid.OnlyAdmin(), s.Admin.HandleBusinessBanPost)
-	adminGroup.POST("/businesses/:id/unban", s.Mid.CheckAuth, s.Mid.OnlyAdmin(), s.Admin.HandleBusinessUnbanPost)
-	adminGroup.POST("/businesses/:id/delete", s.Mid.CheckAuth, s.Mid.OnlyAdmin(), s.Admin.HandleBusinessDeletePost)
-	adminGroup.POST("/promote-management", s.Mid.CheckAuth, s.Mid.OnlyAdmin(), s.Admin.HandleAdvertiseManagementPost)
-	adminGroup.GET("/promote-management", s.Mid.CheckAuth, s.Mid.OnlyAdmin(), s.Admin.HandleAdvertiseManagementGet)
-	adminGroup.PUT("/promote-management/:id", s.Mid.CheckAuth, s.Mid.OnlyAdmin(), s.Admin.HandleAdvertiseManagementPut)
-	adminGroup.POST("/promote-management/:id/delete", s.Mid.CheckAuth, s.Mid.OnlyAdmin(), s.Admin.HandleAdvertiseManagementDeletePost)
+	adminGroup.GET("/categories", s.Mid.CheckAuth, s.Mid.Require(c.PERM_CONTENT_MODERATE), s.Admin.HandleCategoriesGet)
+	adminGroup.POST("/categories", s.Mid.CheckAuth, s.Mid.Require(c.PERM_CONTENT_MODERATE), s.Admin.HandleCategoriesPost)
+	adminGroup.POST("/categories/delete", s.Mid.CheckAuth, s.Mid.Require(c.PERM_CONTENT_MODERATE), s.Admin.HandleCategoriesPostDelete)
+	adminGroup.POST("/categories/edit", s.Mid.CheckAuth, s.Mid.Require(c.PERM_CONTENT_MODERATE), s.Admin.HandleCategoriesPostEdit)
+	adminGroup.POST("/categories/questions", s.Mid.CheckAuth, s.Mid.Require(c.PERM_CONTENT_MODERATE), s.Admin.HandleCategoryQuestionsPost)
+	adminGroup.GET("/groups", s.Mid.CheckAuth, s.Mid.Require(c.PERM_CONTENT_MODERATE), s.Admin.HandleGroupsGet)
+	// groups set the connection fee charged to handymen
+	adminGroup.POST("/groups", s.Mid.CheckAuth, s.Mid.Require(c.PERM_FEES_WRITE), s.Admin.HandleGroupsPost)
+	adminGroup.PUT("/groups/:id", s.Mid.CheckAuth, s.Mid.Require(c.PERM_FEES_WRITE), s.Admin.HandleGroupsPut)
+	adminGroup.POST("/users/:id/ban", s.Mid.CheckAuth, s.Mid.Require(c.PERM_USERS_WRITE), s.Admin.HandleUserBanPost)
+	adminGroup.POST("/users/:id/unban", s.Mid.CheckAuth, s.Mid.Require(c.PERM_USERS_WRITE), s.Admin.HandleUserUnbanPost)
+	adminGroup.POST("/users/:id/delete", s.Mid.CheckAuth, s.Mid.Require(c.PERM_USERS_WRITE), s.Admin.HandleUserDeletePost)
+	adminGroup.GET("/users/:id/activity", s.Mid.CheckAuth, s.Mid.Require(c.PERM_USERS_READ), s.Admin.HandleUserActivityGet)
+	adminGroup.POST("/users/:id/impersonate", s.Mid.CheckAuth, s.Mid.Require(c.PERM_USERS_IMPERSONATE), s.Admin.HandleUserImpersonatePost)
+	adminGroup.GET("/businesses", s.Mid.CheckAuth, s.Mid.Require(c.PERM_USERS_READ), s.Admin.HandleBusinessesGet)
+	adminGroup.GET("/users", s.Mid.CheckAuth, s.Mid.Require(c.PERM_USERS_READ), s.Admin.HandleUsersGet)
+	adminGroup.POST("/businesses/:id/ban", s.Mid.CheckAuth, s.Mid.Require(c.PERM_USERS_WRITE), s.Admin.HandleBusinessBanPost)
+	adminGroup.POST("/businesses/:id/unban", s.Mid.CheckAuth, s.Mid.Require(c.PERM_USERS_WRITE), s.Admin.HandleBusinessUnbanPost)
+	adminGroup.POST("/businesses/:id/delete", s.Mid.CheckAuth, s.Mid.Require(c.PERM_USERS_WRITE), s.Admin.HandleBusinessDeletePost)
+	adminGroup.POST("/promote-management", s.Mid.CheckAuth, s.Mid.Require(c.PERM_FEES_WRITE), s.Admin.HandleAdvertiseManagementPost)
+	adminGroup.GET("/promote-management", s.Mid.CheckAuth, s.Mid.Require(c.PERM_FEES_WRITE), s.Admin.HandleAdvertiseManagementGet)
+	adminGroup.PUT("/promote-management/:id", s.Mid.CheckAuth, s.Mid.Require(c.PERM_FEES_WRITE), s.Admin.HandleAdvertiseManagementPut)
+	adminGroup.POST("/promote-management/:id/delete", s.Mid.CheckAuth, s.Mid.Require(c.PERM_FEES_WRITE), s.Admin.HandleAdvertiseManagementDeletePost)
+	adminGroup.GET("/disputes", s.Mid.CheckAuth, s.Mid.Require(c.PERM_TRANSACTIONS_READ), s.Admin.HandleDisputesGet)
+	adminGroup.POST("/disputes/:id/review", s.Mid.CheckAuth, s.Mid.Require(c.PERM_REFUNDS_WRITE), s.Admin.HandleDisputeReviewPost)
+	adminGroup.GET("/permissions", s.Mid.CheckAuth, s.Mid.Require(c.PERM_ROLES_MANAGE), s.Admin.HandlePermissionsGet)
+	adminGroup.GET("/roles", s.Mid.CheckAuth, s.Mid.Require(c.PERM_ROLES_MANAGE), s.Admin.HandleRolesGet)
+	adminGroup.PUT("/roles/:role/permissions", s.Mid.CheckAuth, s.Mid.Require(c.PERM_ROLES_MANAGE), s.Admin.HandleRolePermissionsPut)
 
 	categoryGroup := api.Group("/categories")
 	categoryGroup.GET("", s.Category.HandleGet)
 	categoryGroup.GET("/:id", s.Category.HandleByIdGet)
 
 	orderGroup := api.Group("/orders")
-	orderGroup.GET("/projects", s.Mid.CheckAuth, s.Mid.Only(c.ROLE_CUSTOMER), s.Order.HandleProjectsGet)
+	orderGroup.GET("/projects", s.Mid.Scope(c.SCOPE_ORDERS_READ), s.Mid.CheckAuth, s.Mid.Only(c.ROLE_CUSTOMER), s.Order.HandleProjectsGet)
 	orderGroup.GET("/already-ordered", s.Mid.CheckAuth, s.Mid.Only(c.ROLE_CUSTOMER), s.Order.HandleBusinessesAlreadyOrderedGet)
 	orderGroup.POST("/projects/cancel", s.Mid.CheckAuth, s.Mid.Only(c.ROLE_CUSTOMER), s.Order.HandleProjectCancelPost)
 	orderGroup.POST("", s.Mid.CheckAuth, s.Mid.Only(c.ROLE_CUSTOMER), s.Order.HandlePost)
-	orderGroup.POST("/connect", s.Mid.CheckAuth, s.Mid.Only(c.ROLE_HANDYMAN), s.Order.HandleConnectPost)
-	orderGroup.POST("/connect-all", s.Mid.CheckAuth, s.Mid.Only(c.ROLE_HANDYMAN), s.Order.HandleConnectAllPost)
-	orderGroup.POST("/reject", s.Mid.CheckAuth, s.Mid.Only(c.ROLE_HANDYMAN), s.Order.HandleRejectPost) // must be pending or connnected
-	orderGroup.POST("/cancel", s.Mid.CheckAuth, s.Mid.Only(c.ROLE_CUSTOMER), s.Order.HandleCancelPost) // must be pending
-	orderGroup.POST("/complete", s.Mid.CheckAuth, s.Mid.Only(c.ROLE_CUSTOMER, c.ROLE_HANDYMAN), s.Order.HandleCompletePost) // must be connected
-	orderGroup.GET("", s.Mid.CheckAuth, s.Order.HandleGet)
+	orderGroup.POST("/connect", s.Mid.Scope(c.SCOPE_ORDERS_WRITE), s.Mid.CheckAuth, s.Mid.Only(c.ROLE_HANDYMAN), s.Order.HandleConnectPost)
+	orderGroup.POST("/connect-all", s.Mid.Scope(c.SCOPE_ORDERS_WRITE), s.Mid.CheckAuth, s.Mid.Only(c.ROLE_HANDYMAN), s.Order.HandleConnectAllPost)
+	orderGroup.POST("/reject", s.Mid.Scope(c.SCOPE_ORDERS_WRITE), s.Mid.CheckAuth, s.Mid.Only(c.ROLE_HANDYMAN), s.Order.HandleRejectPost)
+	orderGroup.POST("/cancel", s.Mid.CheckAuth, s.Mid.Only(c.ROLE_CUSTOMER), s.Order.HandleCancelPost)
+	orderGroup.POST("/complete", s.Mid.Scope(c.SCOPE_ORDERS_WRITE), s.Mid.CheckAuth, s.Mid.Only(c.ROLE_CUSTOMER, c.ROLE_HANDYMAN), s.Order.HandleCompletePost)
+	orderGroup.POST("/complete/dispute", s.Mid.Scope(c.SCOPE_ORDERS_WRITE), s.Mid.CheckAuth, s.Mid.Only(c.ROLE_CUSTOMER, c.ROLE_HANDYMAN), s.Order.HandleCompleteDisputePost)
+	orderGroup.POST("/slot/propose", s.Mid.Scope(c.SCOPE_ORDERS_WRITE), s.Mid.CheckAuth, s.Mid.Only(c.ROLE_HANDYMAN), s.Order.HandleSlotProposePost)
+	orderGroup.POST("/slot/respond", s.Mid.CheckAuth, s.Mid.Only(c.ROLE_CUSTOMER), s.Order.HandleSlotRespondPost)
+	orderGroup.POST("/quote", s.Mid.Scope(c.SCOPE_ORDERS_WRITE), s.Mid.CheckAuth, s.Mid.Only(c.ROLE_HANDYMAN), s.Order.HandleQuotePost)
+	orderGroup.GET("/quotes", s.Mid.Scope(c.SCOPE_ORDERS_READ), s.Mid.CheckAuth, s.Order.HandleQuotesGet)
+	orderGroup.POST("/quote/accept", s.Mid.CheckAuth, s.Mid.Only(c.ROLE_CUSTOMER), s.Order.HandleQuoteAcceptPost)
+	orderGroup.POST("/dispute", s.Mid.Scope(c.SCOPE_ORDERS_WRITE), s.Mid.CheckAuth, s.Mid.Only(c.ROLE_HANDYMAN), s.Mid.Member(c.BUSINESS_ROLE_OWNER, c.BUSINESS_ROLE_MANAGER), s.Order.HandleDisputePost)
+	orderGroup.GET("/disputes", s.Mid.Scope(c.SCOPE_ORDERS_READ), s.Mid.CheckAuth, s.Mid.Only(c.ROLE_HANDYMAN), s.Mid.Member(c.BUSINESS_ROLE_OWNER, c.BUSINESS_ROLE_MANAGER), s.Order.HandleDisputesGet)
+	orderGroup.GET("", s.Mid.Scope(c.SCOPE_ORDERS_READ), s.Mid.CheckAuth, s.Order.HandleGet)
+	orderGroup.GET("/:id/timeline", s.Mid.AllowImpersonation, s.Mid.Scope(c.SCOPE_ORDERS_READ), s.Mid.CheckAuth, s.Order.HandleTimelineGet)
 
 	feedbackGroup := api.Group("/feedbacks")
 	feedbackGroup.POST("", s.Mid.CheckAuth, s.Mid.Only(c.ROLE_CUSTOMER), s.Feedback.HandlePost)
@@ -167,6 +201,6 @@ func (s *ApiServer) RegisterEndpoint() {
 	feedbackGroup.PUT("/:id", s.Mid.CheckAuth, s.Mid.Only(c.ROLE_CUSTOMER), s.Feedback.HandlePut)
 
 	chatGroup := api.Group("/chatservice")
-	chatGroup.POST("/conversations", s.Mid.CheckAuth, s.Chat.HandleConversationsGet)
+	chatGroup.POST("/conversations", s.Mid.AllowImpersonation, s.Mid.Scope(c.SCOPE_CONVERSATIONS_READ), s.Mid.CheckAuth, s.Chat.HandleConversationsGet)
 
 }
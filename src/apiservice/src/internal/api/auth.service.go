@@ -10,6 +10,7 @@ import (
 	"github.com/aqaurius6666/apiservice/src/internal/var/c"
 	"github.com/aqaurius6666/apiservice/src/internal/var/e"
 	"github.com/aqaurius6666/apiservice/src/pb"
+	"github.com/aqaurius6666/apiservice/src/services/authservice"
 	"github.com/aqaurius6666/go-utils/utils"
 	"go.opentelemetry.io/otel"
 	"golang.org/x/xerrors"
@@ -47,7 +48,7 @@ func (s AuthService) GetCredential(ctx context.Context, req *pb.AuthCredentialRe
 		lib.RecordError(span, err, ctx)
 		return nil, err
 	}
-	id, pub, priv, isDef, err := s.Model.GetCredential(ctx, req.Identifier)
+	id, pub, priv, isDef, err := s.Model.GetCredential(ctx, req.Identifier, req.Totp)
 	if err != nil {
 		err = xerrors.Errorf("%w", err)
 		lib.RecordError(span, err, ctx)
@@ -175,11 +176,12 @@ func (s AuthService) Ping(ctx context.Context, req *pb.AuthPingRequest) (*pb.Aut
 			Image:   utils.StrVal(usr.AvatarUrl),
 			User:    s.Model.ConvertUserToProto(usr),
 		}, nil
-	case c.ROLE_ADMIN:
+	case c.ROLE_ADMIN, c.ROLE_SUPPORT, c.ROLE_FINANCE, c.ROLE_MODERATOR:
 		return &pb.AuthPingResponse_Data{
-			Id:      req.XUserId,
-			Role:    req.XRole,
-			Process: c.REGISTRATION_PROCESS_DONE,
+			Id:          req.XUserId,
+			Role:        req.XRole,
+			Process:     c.REGISTRATION_PROCESS_DONE,
+			Permissions: req.XPermissions,
 		}, nil
 	default:
 		return nil, nil
@@ -208,17 +210,21 @@ func (s AuthService) ForgotPassword(ctx context.Context, req *pb.AuthForgotPostR
 	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.ForgotPassword))
 	defer span.End()
 
-	if f, ok := validate.RequiredFields(req, "Mail"); !ok {
-		err := xerrors.Errorf("%w", e.ErrMissingField(f))
+	identifier := req.Mail
+	if req.Phone != "" {
+		identifier = req.Phone
+	}
+	if identifier == "" {
+		err := xerrors.Errorf("%w", e.ErrMissingField("Mail"))
 		lib.RecordError(span, err, ctx)
 		return nil, err
 	}
-	id, _, _, _, err := s.Model.GetCredential(ctx, req.Mail)
-	if err != nil {
+	existed, err := s.Model.CheckCredential(ctx, identifier)
+	if err != nil || !existed {
 		return &pb.AuthForgotPostResponse_Data{}, nil
 	}
 
-	otpId, err := s.Model.ForgotPassword(ctx, req.Mail)
+	id, otpId, err := s.Model.ForgotPassword(ctx, req.Mail, req.Phone)
 	if err != nil {
 		err = xerrors.Errorf("%w", err)
 		lib.RecordError(span, err, ctx)
@@ -268,3 +274,258 @@ func (s AuthService) ChangeMailAndPass(ctx context.Context, req *pb.AuthChangeMa
 
 	return &pb.AuthChangeMailAndPassPostResponse_Data{}, nil
 }
+
+func (s AuthService) LogoutAll(ctx context.Context, req *pb.AuthLogoutAllPostRequest) (*pb.AuthLogoutAllPostResponse_Data, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.LogoutAll))
+	defer span.End()
+
+	if f, ok := validate.RequiredFields(req, "XUserId"); !ok {
+		err := xerrors.Errorf("%w", e.ErrMissingField(f))
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	notBefore, err := s.Model.LogoutAll(ctx, req.XUserId)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	return &pb.AuthLogoutAllPostResponse_Data{
+		NotBefore: notBefore,
+	}, nil
+}
+
+func (s AuthService) TotpEnroll(ctx context.Context, req *pb.AuthTotpEnrollPostRequest) (*pb.AuthTotpEnrollPostResponse_Data, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.TotpEnroll))
+	defer span.End()
+
+	if f, ok := validate.RequiredFields(req, "XUserId"); !ok {
+		err := xerrors.Errorf("%w", e.ErrMissingField(f))
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	secret, uri, err := s.Model.EnrollTotp(ctx, req.XUserId)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	return &pb.AuthTotpEnrollPostResponse_Data{
+		Secret: secret,
+		Uri:    uri,
+	}, nil
+}
+
+func (s AuthService) TotpVerify(ctx context.Context, req *pb.AuthTotpVerifyPostRequest) (*pb.AuthTotpVerifyPostResponse_Data, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.TotpVerify))
+	defer span.End()
+
+	if f, ok := validate.RequiredFields(req, "XUserId", "Code"); !ok {
+		err := xerrors.Errorf("%w", e.ErrMissingField(f))
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	codes, err := s.Model.ConfirmTotp(ctx, req.XUserId, req.Code)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	return &pb.AuthTotpVerifyPostResponse_Data{
+		RecoveryCodes: codes,
+	}, nil
+}
+
+func (s AuthService) TotpDisable(ctx context.Context, req *pb.AuthTotpDisablePostRequest) (*pb.AuthTotpDisablePostResponse_Data, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.TotpDisable))
+	defer span.End()
+
+	if f, ok := validate.RequiredFields(req, "XUserId", "Code"); !ok {
+		err := xerrors.Errorf("%w", e.ErrMissingField(f))
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	if err := s.Model.DisableTotp(ctx, req.XUserId, req.Code); err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	return &pb.AuthTotpDisablePostResponse_Data{}, nil
+}
+
+func (s AuthService) VerifyPhone(ctx context.Context, req *pb.AuthPhonePostRequest) (*pb.AuthPhonePostResponse_Data, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.VerifyPhone))
+	defer span.End()
+
+	if f, ok := validate.RequiredFields(req, "XUserId", "Phone"); !ok {
+		err := xerrors.Errorf("%w", e.ErrMissingField(f))
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	if err := req.Validate(); err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	otpId, err := s.Model.VerifyPhone(ctx, req.XUserId, req.Phone)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	return &pb.AuthPhonePostResponse_Data{
+		OtpId: otpId,
+	}, nil
+}
+
+func (s AuthService) IssueToken(ctx context.Context, req *pb.AuthTokenPostRequest) (*pb.AuthTokenPostResponse_Data, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.IssueToken))
+	defer span.End()
+
+	if f, ok := validate.RequiredFields(req, "XCertificate", "XMethod"); !ok {
+		err := xerrors.Errorf("%w", e.ErrMissingField(f))
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	token, err := s.Model.IssueToken(ctx, []byte(req.XCertificate), req.XRawBody, req.XMethod)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	return &pb.AuthTokenPostResponse_Data{
+		AccessToken:      token.AccessToken,
+		ExpiresAt:        token.ExpiresAt,
+		RefreshToken:     token.RefreshToken,
+		RefreshExpiresAt: token.RefreshExpiresAt,
+	}, nil
+}
+
+func (s AuthService) RefreshToken(ctx context.Context, req *pb.AuthTokenRefreshPostRequest) (*pb.AuthTokenRefreshPostResponse_Data, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.RefreshToken))
+	defer span.End()
+
+	if f, ok := validate.RequiredFields(req, "RefreshToken", "XCertificate", "XMethod"); !ok {
+		err := xerrors.Errorf("%w", e.ErrMissingField(f))
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	token, err := s.Model.RefreshToken(ctx, req.RefreshToken, []byte(req.XCertificate), req.XRawBody, req.XMethod)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	return &pb.AuthTokenRefreshPostResponse_Data{
+		AccessToken:      token.AccessToken,
+		ExpiresAt:        token.ExpiresAt,
+		RefreshToken:     token.RefreshToken,
+		RefreshExpiresAt: token.RefreshExpiresAt,
+	}, nil
+}
+
+func (s AuthService) ListActivity(ctx context.Context, req *pb.AuthActivityGetRequest) (*pb.AuthActivityGetResponse_Data, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.ListActivity))
+	defer span.End()
+
+	if f, ok := validate.RequiredFields(req, "XUserId"); !ok {
+		err := xerrors.Errorf("%w", e.ErrMissingField(f))
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	limit := lib.ParseInt32Val(req.Limit)
+	offset := lib.ParseInt32Val(req.Offset)
+	events, total, err := s.Model.ListSecurityEvents(ctx, req.XUserId, limit, offset)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	return &pb.AuthActivityGetResponse_Data{
+		Result:     s.Model.ConvertSecurityEventsToProtos(events),
+		Pagination: lib.Pagination(offset, limit, total),
+	}, nil
+}
+
+func (s AuthService) DeleteAccount(ctx context.Context, req *pb.AuthDeletePostRequest) (*pb.AuthDeletePostResponse_Data, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.DeleteAccount))
+	defer span.End()
+
+	if f, ok := validate.RequiredFields(req, "XUserId"); !ok {
+		err := xerrors.Errorf("%w", e.ErrMissingField(f))
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	otpId, err := s.Model.DeleteAccount(ctx, req.XUserId)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	return &pb.AuthDeletePostResponse_Data{
+		OtpId: otpId,
+	}, nil
+}
+
+func (s AuthService) ListApiKeys(ctx context.Context, req *pb.AuthApiKeysGetRequest) (*pb.AuthApiKeysGetResponse_Data, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.ListApiKeys))
+	defer span.End()
+
+	if f, ok := validate.RequiredFields(req, "XUserId"); !ok {
+		err := xerrors.Errorf("%w", e.ErrMissingField(f))
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	keys, err := s.Model.ListApiKeys(ctx, req.XUserId)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	return &pb.AuthApiKeysGetResponse_Data{
+		Result: s.Model.ConvertApiKeysToProtos(keys),
+	}, nil
+}
+
+func (s AuthService) CreateApiKey(ctx context.Context, req *pb.AuthApiKeysPostRequest) (*pb.AuthApiKeysPostResponse_Data, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.CreateApiKey))
+	defer span.End()
+
+	if f, ok := validate.RequiredFields(req, "XUserId", "Name", "Scopes"); !ok {
+		err := xerrors.Errorf("%w", e.ErrMissingField(f))
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	k, key, err := s.Model.CreateApiKey(ctx, req.XUserId, &authservice.ApiKey{
+		Name:      req.Name,
+		Scopes:    req.Scopes,
+		ExpiresAt: req.ExpiresAt,
+		RateLimit: req.RateLimit,
+	}, req.ForBusiness)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	return &pb.AuthApiKeysPostResponse_Data{
+		ApiKey: s.Model.ConvertApiKeyToProto(k),
+		Key:    key,
+	}, nil
+}
+
+func (s AuthService) RevokeApiKey(ctx context.Context, req *pb.AuthApiKeysRevokePostRequest) (*pb.AuthApiKeysRevokePostResponse_Data, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.RevokeApiKey))
+	defer span.End()
+
+	if f, ok := validate.RequiredFields(req, "XUserId", "Id"); !ok {
+		err := xerrors.Errorf("%w", e.ErrMissingField(f))
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	if err := s.Model.RevokeApiKey(ctx, req.XUserId, req.Id); err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	return &pb.AuthApiKeysRevokePostResponse_Data{}, nil
+}
@@ -97,7 +97,7 @@ func (s *BusinessController) HandleGet(g *gin.Context) {
 
 func (s *BusinessController) HandleNearGet(g *gin.Context) {
 	req := pb.BusinessNearGetRequest{
-		XUserId: g.GetString("userId"),
+		XUserId: lib.GetActingId(g),
 	}
 
 	res, err := s.S.GetNear(lib.ParseGinContext(g), &req)
@@ -125,7 +125,7 @@ func (s *BusinessController) HandlePut(g *gin.Context) {
 		lib.BadRequest(g, err)
 		return
 	}
-	req.XUserId = g.GetString("userId")
+	req.XUserId = lib.GetActingId(g)
 	req.Id = g.Param("id")
 	res, err := s.S.Update(lib.ParseGinContext(g), &req)
 	if err != nil {
@@ -137,7 +137,7 @@ func (s *BusinessController) HandlePut(g *gin.Context) {
 
 func (s *BusinessController) HandlePaymentMethodGet(g *gin.Context) {
 	req := pb.BusinessPaymentMethodGetRequest{}
-	req.XUserId = g.GetString("userId")
+	req.XUserId = lib.GetActingId(g)
 	res, err := s.S.GetPaymentMethod(lib.ParseGinContext(g), &req)
 	if err != nil {
 		lib.BadRequest(g, err)
@@ -152,7 +152,7 @@ func (s *BusinessController) HandlePaymentMethodPost(g *gin.Context) {
 		lib.BadRequest(g, err)
 		return
 	}
-	req.XUserId = g.GetString("userId")
+	req.XUserId = lib.GetActingId(g)
 	res, err := s.S.PostPaymentMethod(lib.ParseGinContext(g), &req)
 	if err != nil {
 		lib.BadRequest(g, err)
@@ -163,7 +163,7 @@ func (s *BusinessController) HandlePaymentMethodPost(g *gin.Context) {
 
 func (s *BusinessController) HandlePaymentMethodSetupPost(g *gin.Context) {
 	req := pb.BusinessPaymentMethodSetupPostRequest{}
-	req.XUserId = g.GetString("userId")
+	req.XUserId = lib.GetActingId(g)
 	res, err := s.S.SetupPaymentMethod(lib.ParseGinContext(g), &req)
 	if err != nil {
 		lib.BadRequest(g, err)
@@ -174,7 +174,7 @@ func (s *BusinessController) HandlePaymentMethodSetupPost(g *gin.Context) {
 
 func (s *BusinessController) HandlePaymentMethodDeletePost(g *gin.Context) {
 	req := pb.BusinessPaymentMethodDeletePostRequest{}
-	req.XUserId = g.GetString("userId")
+	req.XUserId = lib.GetActingId(g)
 	res, err := s.S.DeletePaymentMethod(lib.ParseGinContext(g), &req)
 	if err != nil {
 		lib.BadRequest(g, err)
@@ -202,7 +202,7 @@ func (s *BusinessController) HandleServicesPut(g *gin.Context) {
 		return
 	}
 	req.Id = g.Param("id")
-	req.XUserId = g.GetString("userId")
+	req.XUserId = lib.GetActingId(g)
 	res, err := s.S.UpdateServices(lib.ParseGinContext(g), &req)
 	if err != nil {
 		lib.BadRequest(g, err)
@@ -222,7 +222,7 @@ func (s *BusinessController) TransactionsGet(g *gin.Context) {
 		Offset: g.DefaultQuery("offset", "0"),
 	}
 
-	req.XUserId = g.GetString("userId")
+	req.XUserId = lib.GetActingId(g)
 
 	res, err := s.S.ListTransactions(lib.ParseGinContext(g), &req)
 	if err != nil {
@@ -243,7 +243,7 @@ func (s *BusinessController) TransactionsExport(g *gin.Context) {
 		Offset: g.DefaultQuery("offset", "0"),
 	}
 
-	req.XUserId = g.GetString("userId")
+	req.XUserId = lib.GetActingId(g)
 
 	res, len, err := s.S.TransactionsExport(lib.ParseGinContext(g), &req)
 	if err != nil {
@@ -263,7 +263,7 @@ func (s BusinessController) HandleAdvertiseGet(g *gin.Context) {
 		Offset: g.DefaultQuery("offset", "0"),
 	}
 
-	req.XUserId = g.GetString("userId")
+	req.XUserId = lib.GetActingId(g)
 
 	res, err := s.S.GetAdvertisePackages(lib.ParseGinContext(g), &req)
 
@@ -283,7 +283,7 @@ func (s BusinessController) HandleAdvertiseDetailGet(g *gin.Context) {
 		Offset: g.DefaultQuery("offset", "0"),
 	}
 
-	req.XUserId = g.GetString("userId")
+	req.XUserId = lib.GetActingId(g)
 
 	res, err := s.S.GetAdvertiseDetail(lib.ParseGinContext(g), &req)
 
@@ -301,7 +301,7 @@ func (s BusinessController) HandleAdvertiseOrdersGet(g *gin.Context) {
 		Offset: g.DefaultQuery("offset", "0"),
 	}
 
-	req.XUserId = g.GetString("userId")
+	req.XUserId = lib.GetActingId(g)
 
 	res, err := s.S.GetAdvertiseOrder(lib.ParseGinContext(g), &req)
 
@@ -315,7 +315,7 @@ func (s BusinessController) HandleAdvertiseOrdersGet(g *gin.Context) {
 func (s BusinessController) HandleInvitationCodeGet(g *gin.Context) {
 	req := pb.BusinessInvitationCodeGetRequest{}
 
-	req.XUserId = g.GetString("userId")
+	req.XUserId = lib.GetActingId(g)
 
 	res, err := s.S.GetInvitationCode(lib.ParseGinContext(g), &req)
 
@@ -346,7 +346,7 @@ func (s *BusinessController) HandleValidateBuyAdvertisePost(g *gin.Context) {
 		lib.BadRequest(g, err)
 		return
 	}
-	req.XUserId = g.GetString("userId")
+	req.XUserId = lib.GetActingId(g)
 	res, err := s.S.ValidateBuyAdvertise(lib.ParseGinContext(g), &req)
 	if err != nil {
 		lib.BadRequest(g, err)
@@ -361,7 +361,7 @@ func (s *BusinessController) HandleBuyAdvertiseSetupPost(g *gin.Context) {
 		lib.BadRequest(g, err)
 		return
 	}
-	req.XUserId = g.GetString("userId")
+	req.XUserId = lib.GetActingId(g)
 
 	res, err := s.S.BuyAdvertiseSetup(lib.ParseGinContext(g), &req)
 	if err != nil {
@@ -377,7 +377,7 @@ func (s *BusinessController) HandleBuyAdvertisePost(g *gin.Context) {
 		lib.BadRequest(g, err)
 		return
 	}
-	req.XUserId = g.GetString("userId")
+	req.XUserId = lib.GetActingId(g)
 	res, err := s.S.BuyAdvertise(lib.ParseGinContext(g), &req)
 	if err != nil {
 		lib.BadRequest(g, err)
@@ -400,3 +400,57 @@ func (s *BusinessController) HandleVerifyRefCodePut(g *gin.Context) {
 	}
 	lib.Success(g, res)
 }
+
+func (s *BusinessController) HandleMembersGet(g *gin.Context) {
+	req := pb.BusinessMembersGetRequest{}
+	req.XUserId = lib.GetActingId(g)
+	res, err := s.S.ListMembers(lib.ParseGinContext(g), &req)
+	if err != nil {
+		lib.BadRequest(g, err)
+		return
+	}
+	lib.Success(g, res)
+}
+
+func (s *BusinessController) HandleMembersInvitePost(g *gin.Context) {
+	req := pb.BusinessMembersInvitePostRequest{}
+	if err := lib.GetBody(g, &req); err != nil {
+		lib.BadRequest(g, err)
+		return
+	}
+	req.XUserId = g.GetString("userId")
+	req.XBusinessId = lib.GetActingId(g)
+	res, err := s.S.InviteMember(lib.ParseGinContext(g), &req)
+	if err != nil {
+		lib.BadRequest(g, err)
+		return
+	}
+	lib.Success(g, res)
+}
+
+func (s *BusinessController) HandleMembersAcceptPost(g *gin.Context) {
+	req := pb.BusinessMembersAcceptPostRequest{}
+	if err := lib.GetBody(g, &req); err != nil {
+		lib.BadRequest(g, err)
+		return
+	}
+	req.XUserId = g.GetString("userId")
+	res, err := s.S.AcceptInvitation(lib.ParseGinContext(g), &req)
+	if err != nil {
+		lib.BadRequest(g, err)
+		return
+	}
+	lib.Success(g, res)
+}
+
+func (s *BusinessController) HandleMembersDeletePost(g *gin.Context) {
+	req := pb.BusinessMembersDeletePostRequest{}
+	req.XUserId = g.GetString("userId")
+	req.Id = g.Param("id")
+	res, err := s.S.RemoveMember(lib.ParseGinContext(g), &req)
+	if err != nil {
+		lib.BadRequest(g, err)
+		return
+	}
+	lib.Success(g, res)
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/aqaurius6666/apiservice/src/internal/var/c"
 	"github.com/aqaurius6666/apiservice/src/internal/var/e"
 	"github.com/aqaurius6666/apiservice/src/pb"
+	"github.com/aqaurius6666/apiservice/src/services/authservice"
 	"github.com/aqaurius6666/go-utils/database"
 	"github.com/aqaurius6666/go-utils/utils"
 	"github.com/google/uuid"
@@ -238,7 +239,7 @@ func (s BusinessService) CreateBusiness(ctx context.Context, req *pb.BusinessPos
 		return nil, err
 	}
 
-	otpId, err := s.Model.Register(ctx, req.Mail, req.Phone, req.PublicKey, req.EncryptedPrivateKey, c.ROLE_HANDYMAN, req.RefCode)
+	otpId, err := s.Model.Register(ctx, req.Mail, req.Phone, req.PublicKey, req.EncryptedPrivateKey, c.ROLE_HANDYMAN, req.RefCode, req.Channel)
 	if err != nil {
 		err = xerrors.Errorf("%w", err)
 		lib.RecordError(span, err, ctx)
@@ -1158,3 +1159,97 @@ func (s *BusinessService) VerifyRefCode(ctx context.Context, req *pb.BusinessVer
 
 	return &pb.BusinessVerifyRefCodePutResponse_Data{}, nil
 }
+
+func (s *BusinessService) ListMembers(ctx context.Context, req *pb.BusinessMembersGetRequest) (*pb.BusinessMembersGetResponse_Data, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.ListMembers))
+	defer span.End()
+	if f, ok := validate.RequiredFields(req, "XUserId"); !ok {
+		err := xerrors.Errorf("%w", e.ErrMissingField(f))
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	members, err := s.Model.ListMembers(ctx, req.XUserId)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	result := make([]*pb.Member, 0, len(members))
+	for _, m := range members {
+		result = append(result, memberToPb(m))
+	}
+	return &pb.BusinessMembersGetResponse_Data{
+		Result: result,
+	}, nil
+}
+
+func (s *BusinessService) InviteMember(ctx context.Context, req *pb.BusinessMembersInvitePostRequest) (*pb.BusinessMembersInvitePostResponse_Data, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.InviteMember))
+	defer span.End()
+	if f, ok := validate.RequiredFields(req, "XUserId", "XBusinessId", "Mail"); !ok {
+		err := xerrors.Errorf("%w", e.ErrMissingField(f))
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	if req.Role != c.BUSINESS_ROLE_MANAGER && req.Role != c.BUSINESS_ROLE_STAFF {
+		err := xerrors.Errorf("%w", e.ErrBodyInvalid)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	member, err := s.Model.InviteMember(ctx, req.XUserId, req.XBusinessId, req.Mail, req.Role)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	return &pb.BusinessMembersInvitePostResponse_Data{
+		Member: memberToPb(member),
+	}, nil
+}
+
+func (s *BusinessService) AcceptInvitation(ctx context.Context, req *pb.BusinessMembersAcceptPostRequest) (*pb.BusinessMembersAcceptPostResponse_Data, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.AcceptInvitation))
+	defer span.End()
+	if f, ok := validate.RequiredFields(req, "XUserId", "OtpId", "Otp"); !ok {
+		err := xerrors.Errorf("%w", e.ErrMissingField(f))
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	membership, err := s.Model.AcceptInvitation(ctx, req.XUserId, req.OtpId, req.Otp)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	return &pb.BusinessMembersAcceptPostResponse_Data{
+		BusinessId: membership.BusinessId,
+		Role:       membership.Role,
+	}, nil
+}
+
+func (s *BusinessService) RemoveMember(ctx context.Context, req *pb.BusinessMembersDeletePostRequest) (*pb.BusinessMembersDeletePostResponse_Data, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.RemoveMember))
+	defer span.End()
+	if f, ok := validate.RequiredFields(req, "XUserId", "Id"); !ok {
+		err := xerrors.Errorf("%w", e.ErrMissingField(f))
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	if err := s.Model.RemoveMember(ctx, req.XUserId, req.Id); err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	return &pb.BusinessMembersDeletePostResponse_Data{}, nil
+}
+
+func memberToPb(m *authservice.Member) *pb.Member {
+	return &pb.Member{
+		Id:        m.Id,
+		UserId:    m.UserId,
+		Mail:      m.Mail,
+		Role:      m.Role,
+		Accepted:  m.Accepted,
+		CreatedAt: m.CreatedAt,
+	}
+}
@@ -20,7 +20,7 @@ func (s *ChatController) HandleConversationsGet(g *gin.Context) {
 		lib.BadRequest(g, err)
 		return
 	}
-	req.XUserId = g.GetString("userId")
+	req.XUserId = lib.GetActingId(g)
 	res, err := s.S.GetConversations(lib.ParseGinContext(g), &req)
 	if err != nil {
 		lib.BadRequest(g, err)
@@ -45,7 +45,7 @@ func (s *IndexController) HandleStripeKeyGet(g *gin.Context) {
 
 func (s *IndexController) HandlePaymentMethodGet(g *gin.Context) {
 	req := pb.StripePaymentMethodGetRequest{}
-	req.XUserId = g.GetString("userId")
+	req.XUserId = lib.GetActingId(g)
 	res, err := s.S.GetPaymentMethodInfo(lib.ParseGinContext(g), &req)
 	if err != nil {
 		lib.BadRequest(g, err)
@@ -76,7 +76,7 @@ func (s *IndexController) HandleSubscribePost(g *gin.Context) {
 		lib.BadRequest(g, err)
 		return
 	}
-	req.XUserId = g.GetString("userId")
+	req.XUserId = lib.GetActingId(g)
 	res, err := s.S.UnsubscribeNotification(lib.ParseGinContext(g), &req)
 	if err != nil {
 		lib.BadRequest(g, err)
@@ -91,7 +91,7 @@ func (s *IndexController) HandleUnsubscribePost(g *gin.Context) {
 		lib.BadRequest(g, err)
 		return
 	}
-	req.XUserId = g.GetString("userId")
+	req.XUserId = lib.GetActingId(g)
 	res, err := s.S.SubscribeNotification(lib.ParseGinContext(g), &req)
 	if err != nil {
 		lib.BadRequest(g, err)
@@ -41,7 +41,7 @@ func (s *IndexService) GetUploadUrl(ctx context.Context, req *pb.UploadUrlPostRe
 		lib.RecordError(span, err, ctx)
 		return nil, err
 	}
-	url, form, err := s.Model.GetUploadUrl(ctx, req.Filename, req.ContentLength, req.XUserId)
+	url, form, key, err := s.Model.GetUploadUrl(ctx, req.Filename, req.ContentLength, req.XUserId, req.Purpose)
 	if err != nil {
 		err = xerrors.Errorf("%w", err)
 		lib.RecordError(span, err, ctx)
@@ -51,6 +51,7 @@ func (s *IndexService) GetUploadUrl(ctx context.Context, req *pb.UploadUrlPostRe
 	return gin.H{
 		"url":  url,
 		"form": form,
+		"key":  key,
 	}, nil
 }
 
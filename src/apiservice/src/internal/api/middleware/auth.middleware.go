@@ -1,19 +1,28 @@
 package middleware
 
 import (
+	"context"
 	"net/http"
+	"strings"
 
 	"github.com/aqaurius6666/apiservice/src/internal/lib"
+	"github.com/aqaurius6666/apiservice/src/internal/lib/jwt"
 	"github.com/aqaurius6666/apiservice/src/internal/lib/unleash"
 	"github.com/aqaurius6666/apiservice/src/internal/var/c"
 	"github.com/aqaurius6666/apiservice/src/internal/var/e"
 	"github.com/gin-gonic/gin"
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/xerrors"
 )
 
+const BEARER_PREFIX = "Bearer "
+
 type Auth interface {
 	CheckAuth(g *gin.Context)
+	AllowImpersonation(g *gin.Context)
+	Scope(scope string) gin.HandlerFunc
 }
 
 func (s *MiddlewareV1) CheckAuth(g *gin.Context) {
@@ -34,11 +43,35 @@ func (s *MiddlewareV1) checkAuth(g *gin.Context) {
 	var method string
 	var err error
 	method = g.Request.Method
+	if key := g.GetHeader(c.API_KEY_HEADER); key != "" {
+		s.apiKey(ctx, g, key)
+		return
+	}
 	auth = []byte(g.GetHeader("Authorization"))
 	if len(auth) == 0 {
 		lib.Unauthorized(g, xerrors.Errorf("%w", e.ErrMissingCertificate))
 		return
 	}
+	// Bearer tokens are verified here, everything else is a signed
+	// certificate that only authservice can check.
+	if token := strings.TrimPrefix(string(auth), BEARER_PREFIX); token != string(auth) {
+		claims, err := s.Keys.Verify(ctx, token)
+		if err != nil {
+			lib.Unauthorized(g, xerrors.Errorf("%w", err))
+			return
+		}
+		if claims.Actor != nil {
+			s.impersonate(ctx, g, claims)
+			return
+		}
+		g.Set("userId", claims.Subject)
+		g.Set("role", c.ROLE(claims.Role))
+		g.Set("totpEnrollRequired", claims.TotpEnrollRequired)
+		g.Set("permissions", claims.Permissions)
+		setMembership(g, claims.BusinessId, c.BUSINESS_ROLE(claims.BusinessRole))
+		g.Next()
+		return
+	}
 	if method != http.MethodGet {
 		rawBody, ok := g.Get("rawBody")
 		if !ok {
@@ -47,13 +80,106 @@ func (s *MiddlewareV1) checkAuth(g *gin.Context) {
 		}
 		body = rawBody.([]byte)
 	}
-	id, role, err := s.Auth.CheckAuth(ctx, auth, body, method)
+	id, role, totpEnrollRequired, permissions, membership, err := s.Auth.CheckAuth(ctx, auth, body, method)
+	if err != nil {
+		lib.Unauthorized(g, xerrors.Errorf("%w", err))
+		return
+	}
+	g.Set("userId", id)
+	g.Set("role", role)
+	g.Set("totpEnrollRequired", totpEnrollRequired)
+	g.Set("permissions", permissions)
+	if membership != nil {
+		setMembership(g, membership.BusinessId, membership.Role)
+	}
+	g.Next()
+}
+
+// setMembership records the business the caller acts for. Members of a
+// business act as its handyman, so they are given the handyman role and
+// lib.GetActingId returns the business instead of their own id.
+func setMembership(g *gin.Context, businessId string, role c.BUSINESS_ROLE) {
+	if businessId == "" {
+		return
+	}
+	g.Set("businessId", businessId)
+	g.Set("businessRole", role)
+	g.Set("role", c.ROLE_HANDYMAN)
+}
+
+// impersonate serves a request made with an impersonation token. Read only
+// tokens are refused on anything but GET unless the route allows
+// impersonation, and every request, refused or not, is tagged with the admin
+// in traces and logs and added to the audit trail of the session.
+func (s *MiddlewareV1) impersonate(ctx context.Context, g *gin.Context, claims *jwt.Claims) {
+	adminId := claims.Actor.Subject
+	trace.SpanFromContext(g.Request.Context()).SetAttributes(
+		attribute.String("auth.impersonator_id", adminId),
+		attribute.String("auth.impersonation_id", claims.ID),
+	)
+	g.Set("impersonatorId", adminId)
+	g.Set("impersonationId", claims.ID)
+	defer func() {
+		if err := s.Auth.RecordImpersonationAction(ctx, claims.ID, adminId, claims.Subject, g.Request.Method, g.Request.URL.Path, int32(g.Writer.Status())); err != nil {
+			s.Logger.WithField("impersonatorId", adminId).Error(err)
+		}
+	}()
+	if claims.ReadOnly && g.Request.Method != http.MethodGet && !g.GetBool("impersonationAllowed") {
+		lib.Unauthorized(g, xerrors.Errorf("%w", e.ErrReadOnlyToken))
+		return
+	}
+	g.Set("userId", claims.Subject)
+	g.Set("role", c.ROLE(claims.Role))
+	g.Set("permissions", claims.Permissions)
+	setMembership(g, claims.BusinessId, c.BUSINESS_ROLE(claims.BusinessRole))
+	g.Next()
+}
+
+// apiKey serves a request made with an api key. Keys are only accepted on
+// routes marked with Scope, and only if they were given that scope.
+func (s *MiddlewareV1) apiKey(ctx context.Context, g *gin.Context, key string) {
+	scope := g.GetString("apiKeyScope")
+	if scope == "" {
+		lib.Unauthorized(g, xerrors.Errorf("%w", e.ErrApiKeyNotAllowed))
+		return
+	}
+	id, role, scopes, membership, err := s.Auth.CheckApiKey(ctx, key)
 	if err != nil {
 		lib.Unauthorized(g, xerrors.Errorf("%w", err))
 		return
 	}
+	granted := false
+	for _, sc := range scopes {
+		if sc == scope {
+			granted = true
+		}
+	}
+	if !granted {
+		lib.Unauthorized(g, xerrors.Errorf("%w", e.ErrNoPermission))
+		return
+	}
 	g.Set("userId", id)
 	g.Set("role", role)
+	if membership != nil {
+		setMembership(g, membership.BusinessId, membership.Role)
+	}
+	g.Next()
+}
+
+// Scope lets api keys with scope through a route. It has to come before
+// CheckAuth.
+func (s *MiddlewareV1) Scope(scope string) gin.HandlerFunc {
+	return func(g *gin.Context) {
+		g.Set("apiKeyScope", scope)
+		g.Next()
+	}
+}
+
+// AllowImpersonation lets read only impersonation tokens through a route
+// that is not a GET, for requests that change nothing or were judged safe
+// for support to make on behalf of a user. It has to come before CheckAuth.
+func (s *MiddlewareV1) AllowImpersonation(g *gin.Context) {
+	g.Set("impersonationAllowed", true)
 	g.Next()
 }
 
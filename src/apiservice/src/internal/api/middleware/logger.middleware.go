@@ -28,12 +28,19 @@ func (l *MiddlewareV1) LoggerMid() gin.HandlerFunc {
 			"path":    reqUri,
 		})
 
+		impersonatorId, impersonating := c.Get("impersonatorId")
+		if impersonating {
+			reqLogger = reqLogger.WithFields(logrus.Fields{
+				"impersonatorId":  impersonatorId,
+				"impersonationId": c.GetString("impersonationId"),
+			})
+		}
 		if body, ok := c.Get("body"); ok && unleash.IsEnabled("apiservice.debug.log-body") {
 			reqLogger = reqLogger.WithField("body", string(body.(json.RawMessage)))
 		}
 		if err, ok := c.Get("error"); ok && unleash.IsEnabled("apiservice.debug.log-error") {
 			reqLogger.Errorf("%+v", err)
-		} else if unleash.IsEnabled("apiservice.debug.log-request") {
+		} else if impersonating || unleash.IsEnabled("apiservice.debug.log-request") {
 			reqLogger.Info()
 		}
 	}
@@ -10,6 +10,8 @@ import (
 type Role interface {
 	OnlyAdmin() gin.HandlerFunc
 	Only(...c.ROLE) gin.HandlerFunc
+	Require(...string) gin.HandlerFunc
+	Member(...c.BUSINESS_ROLE) gin.HandlerFunc
 }
 
 func (l *MiddlewareV1) OnlyAdmin() gin.HandlerFunc {
@@ -19,6 +21,10 @@ func (l *MiddlewareV1) OnlyAdmin() gin.HandlerFunc {
 			lib.Unauthorized(g, e.ErrNoPermission)
 			return
 		}
+		if g.GetBool("totpEnrollRequired") {
+			lib.Unauthorized(g, e.ErrTotpEnrollRequired)
+			return
+		}
 		g.Next()
 	}
 }
@@ -37,3 +43,40 @@ func (l *MiddlewareV1) Only(ro ...c.ROLE) gin.HandlerFunc {
 		g.Next()
 	}
 }
+
+// Require lets the request through when the caller holds every given
+// permission. Admins hold all permissions.
+func (l *MiddlewareV1) Require(perms ...string) gin.HandlerFunc {
+	return func(g *gin.Context) {
+		for _, p := range perms {
+			if !lib.HasPermission(g, p) {
+				lib.Unauthorized(g, e.ErrNoPermission)
+				return
+			}
+		}
+		if g.GetBool("totpEnrollRequired") {
+			lib.Unauthorized(g, e.ErrTotpEnrollRequired)
+			return
+		}
+		g.Next()
+	}
+}
+
+// Member lets the request through when the caller acts for a business with
+// one of the given roles.
+func (l *MiddlewareV1) Member(ro ...c.BUSINESS_ROLE) gin.HandlerFunc {
+	return func(g *gin.Context) {
+		r, ok := lib.GetBusinessRole(g)
+		if !ok {
+			lib.Unauthorized(g, e.ErrNoPermission)
+			return
+		}
+		for _, ros := range ro {
+			if r == ros {
+				g.Next()
+				return
+			}
+		}
+		lib.Unauthorized(g, e.ErrNoPermission)
+	}
+}
@@ -22,7 +22,7 @@ func (s OrderController) HandlePost(g *gin.Context) {
 		lib.BadRequest(g, err)
 		return
 	}
-	req.XUserId = g.GetString("userId")
+	req.XUserId = lib.GetActingId(g)
 	res, err := s.S.CreateOrder(lib.ParseGinContext(g), &req)
 	if err != nil {
 		lib.BadRequest(g, err)
@@ -37,12 +37,15 @@ func (s *OrderController) HandleGet(g *gin.Context) {
 		q = 0
 	}
 	req := pb.OrdersGetRequest{
-		XUserId:   g.GetString("userId"),
-		Offset:    g.DefaultQuery("offset", "0"),
-		Limit:     g.DefaultQuery("limit", "5"),
-		Status:    c.ORDER_STATUS(q),
-		ServiceId: g.DefaultQuery("serviceId", ""),
-		Zipcode:   g.Query("zipcode"),
+		XUserId:       lib.GetActingId(g),
+		Offset:        g.DefaultQuery("offset", "0"),
+		Limit:         g.DefaultQuery("limit", "5"),
+		Status:        c.ORDER_STATUS(q),
+		ServiceId:     g.DefaultQuery("serviceId", ""),
+		Zipcode:       g.Query("zipcode"),
+		Urgency:       g.Query("urgency"),
+		ScheduledFrom: lib.ParseInt64Val(g.Query("scheduledFrom")),
+		ScheduledTo:   lib.ParseInt64Val(g.Query("scheduledTo")),
 	}
 	res, err := s.S.ListOrders(lib.ParseGinContext(g), &req)
 	if err != nil {
@@ -59,7 +62,8 @@ func (s *OrderController) HandleConnectPost(g *gin.Context) {
 		lib.BadRequest(g, err)
 		return
 	}
-	req.XUserId = g.GetString("userId")
+	req.XUserId = lib.GetActingId(g)
+	req.XActorId = g.GetString("userId")
 	req.XRole = lib.MustGetRole(g)
 
 	res, err := s.S.UpdateOrderConnectedStatus(lib.ParseGinContext(g), &req)
@@ -77,7 +81,8 @@ func (s *OrderController) HandleConnectAllPost(g *gin.Context) {
 		lib.BadRequest(g, err)
 		return
 	}
-	req.XUserId = g.GetString("userId")
+	req.XUserId = lib.GetActingId(g)
+	req.XActorId = g.GetString("userId")
 	req.XRole = lib.MustGetRole(g)
 
 	res, err := s.S.UpdateAllOrderConnectedStatus(lib.ParseGinContext(g), &req)
@@ -95,7 +100,8 @@ func (s *OrderController) HandleCompletePost(g *gin.Context) {
 		lib.BadRequest(g, err)
 		return
 	}
-	req.XUserId = g.GetString("userId")
+	req.XUserId = lib.GetActingId(g)
+	req.XActorId = g.GetString("userId")
 	req.XRole = lib.MustGetRole(g)
 
 	res, err := s.S.UpdateOrderCompletedStatus(lib.ParseGinContext(g), &req)
@@ -106,6 +112,25 @@ func (s *OrderController) HandleCompletePost(g *gin.Context) {
 	lib.Success(g, res)
 }
 
+func (s *OrderController) HandleCompleteDisputePost(g *gin.Context) {
+	req := pb.UpdateOrderStatusPostRequest{}
+
+	if err := lib.GetBody(g, &req); err != nil {
+		lib.BadRequest(g, err)
+		return
+	}
+	req.XUserId = lib.GetActingId(g)
+	req.XActorId = g.GetString("userId")
+	req.XRole = lib.MustGetRole(g)
+
+	res, err := s.S.DisputeOrderCompletion(lib.ParseGinContext(g), &req)
+	if err != nil {
+		lib.BadRequest(g, err)
+		return
+	}
+	lib.Success(g, res)
+}
+
 func (s *OrderController) HandleCancelPost(g *gin.Context) {
 	req := pb.UpdateOrderStatusPostRequest{}
 
@@ -113,7 +138,8 @@ func (s *OrderController) HandleCancelPost(g *gin.Context) {
 		lib.BadRequest(g, err)
 		return
 	}
-	req.XUserId = g.GetString("userId")
+	req.XUserId = lib.GetActingId(g)
+	req.XActorId = g.GetString("userId")
 	req.XRole = lib.MustGetRole(g)
 
 	res, err := s.S.UpdateOrderCancelledStatus(lib.ParseGinContext(g), &req)
@@ -131,7 +157,8 @@ func (s *OrderController) HandleRejectPost(g *gin.Context) {
 		lib.BadRequest(g, err)
 		return
 	}
-	req.XUserId = g.GetString("userId")
+	req.XUserId = lib.GetActingId(g)
+	req.XActorId = g.GetString("userId")
 	req.XRole = lib.MustGetRole(g)
 
 	res, err := s.S.UpdateOrderRejectedStatus(lib.ParseGinContext(g), &req)
@@ -142,12 +169,151 @@ func (s *OrderController) HandleRejectPost(g *gin.Context) {
 	lib.Success(g, res)
 }
 
+func (s *OrderController) HandleSlotProposePost(g *gin.Context) {
+	req := pb.OrderSlotProposePostRequest{}
+
+	if err := lib.GetBody(g, &req); err != nil {
+		lib.BadRequest(g, err)
+		return
+	}
+	req.XUserId = lib.GetActingId(g)
+	req.XActorId = g.GetString("userId")
+
+	res, err := s.S.ProposeOrderSlot(lib.ParseGinContext(g), &req)
+	if err != nil {
+		lib.BadRequest(g, err)
+		return
+	}
+	lib.Success(g, res)
+}
+
+func (s *OrderController) HandleSlotRespondPost(g *gin.Context) {
+	req := pb.OrderSlotRespondPostRequest{}
+
+	if err := lib.GetBody(g, &req); err != nil {
+		lib.BadRequest(g, err)
+		return
+	}
+	req.XUserId = lib.GetActingId(g)
+	req.XActorId = g.GetString("userId")
+
+	res, err := s.S.AnswerOrderSlot(lib.ParseGinContext(g), &req)
+	if err != nil {
+		lib.BadRequest(g, err)
+		return
+	}
+	lib.Success(g, res)
+}
+
+func (s *OrderController) HandleQuotePost(g *gin.Context) {
+	req := pb.OrderQuotePostRequest{}
+
+	if err := lib.GetBody(g, &req); err != nil {
+		lib.BadRequest(g, err)
+		return
+	}
+	req.XUserId = lib.GetActingId(g)
+	req.XActorId = g.GetString("userId")
+
+	res, err := s.S.SubmitQuote(lib.ParseGinContext(g), &req)
+	if err != nil {
+		lib.BadRequest(g, err)
+		return
+	}
+	lib.Success(g, res)
+}
+
+func (s *OrderController) HandleQuotesGet(g *gin.Context) {
+	req := pb.OrderQuotesGetRequest{
+		XUserId:   lib.GetActingId(g),
+		XActorId:  g.GetString("userId"),
+		OrderId:   g.Query("orderId"),
+		ServiceId: g.Query("serviceId"),
+		Zipcode:   g.Query("zipcode"),
+	}
+
+	res, err := s.S.ListQuotes(lib.ParseGinContext(g), &req)
+	if err != nil {
+		lib.BadRequest(g, err)
+		return
+	}
+	lib.Success(g, res)
+}
+
+func (s *OrderController) HandleQuoteAcceptPost(g *gin.Context) {
+	req := pb.OrderQuoteAcceptPostRequest{}
+
+	if err := lib.GetBody(g, &req); err != nil {
+		lib.BadRequest(g, err)
+		return
+	}
+	req.XUserId = lib.GetActingId(g)
+	req.XActorId = g.GetString("userId")
+	req.XRole = lib.MustGetRole(g)
+
+	res, err := s.S.AcceptQuote(lib.ParseGinContext(g), &req)
+	if err != nil {
+		lib.BadRequest(g, err)
+		return
+	}
+	lib.Success(g, res)
+}
+
+func (s *OrderController) HandleDisputePost(g *gin.Context) {
+	req := pb.OrderDisputePostRequest{}
+
+	if err := lib.GetBody(g, &req); err != nil {
+		lib.BadRequest(g, err)
+		return
+	}
+	req.XUserId = lib.GetActingId(g)
+	req.XUploaderId = g.GetString("userId")
+
+	res, err := s.S.FileDispute(lib.ParseGinContext(g), &req)
+	if err != nil {
+		lib.BadRequest(g, err)
+		return
+	}
+	lib.Success(g, res)
+}
+
+func (s *OrderController) HandleDisputesGet(g *gin.Context) {
+	req := pb.OrderDisputesGetRequest{
+		XUserId: lib.GetActingId(g),
+		Limit:   g.DefaultQuery("limit", "5"),
+		Offset:  g.DefaultQuery("offset", "0"),
+	}
+
+	res, err := s.S.ListDisputes(lib.ParseGinContext(g), &req)
+	if err != nil {
+		lib.BadRequest(g, err)
+		return
+	}
+	lib.Success(g, res)
+}
+
+func (s *OrderController) HandleTimelineGet(g *gin.Context) {
+	req := pb.OrdersTimelineGetRequest{
+		XUserId:  lib.GetActingId(g),
+		XActorId: g.GetString("userId"),
+		Id:       g.Param("id"),
+		XStaff:   lib.HasPermission(g, c.PERM_ORDERS_READ),
+	}
+
+	res, err := s.S.GetOrderTimeline(lib.ParseGinContext(g), &req)
+	if err != nil {
+		lib.BadRequest(g, err)
+		return
+	}
+	lib.Success(g, res)
+}
+
 func (s *OrderController) HandleProjectsGet(g *gin.Context) {
 	req := pb.UserProjectsGetRequest{
 		Offset: g.DefaultQuery("offset", "0"),
 		Limit:  g.DefaultQuery("limit", "15"),
 	}
-	req.XUserId = g.GetString("userId")
+	req.XUserId = lib.GetActingId(g)
 
 	res, err := s.S.GetProjects(lib.ParseGinContext(g), &req)
 	if err != nil {
@@ -164,7 +330,7 @@ func (s *OrderController) HandleProjectCancelPost(g *gin.Context) {
 		lib.BadRequest(g, err)
 		return
 	}
-	req.XUserId = g.GetString("userId")
+	req.XUserId = lib.GetActingId(g)
 	res, err := s.S.CancelProject(lib.ParseGinContext(g), &req)
 	if err != nil {
 		lib.BadRequest(g, err)
@@ -179,7 +345,7 @@ func (s *OrderController) HandleBusinessesAlreadyOrderedGet(g *gin.Context) {
 		CategoryId: g.Query("categoryId"),
 		Zipcode:    g.Query("zipcode"),
 	}
-	req.XUserId = g.GetString("userId")
+	req.XUserId = lib.GetActingId(g)
 
 	res, err := s.S.BusinessesAlreadyOrdered(g, &req)
 	if err != nil {
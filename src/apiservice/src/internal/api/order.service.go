@@ -3,10 +3,14 @@ package api
 import (
 	"context"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/aqaurius6666/apiservice/src/internal/db/business"
+	"github.com/aqaurius6666/apiservice/src/internal/db/dispute"
 	"github.com/aqaurius6666/apiservice/src/internal/db/group"
 	"github.com/aqaurius6666/apiservice/src/internal/db/order"
+	"github.com/aqaurius6666/apiservice/src/internal/db/quote"
 	"github.com/aqaurius6666/apiservice/src/internal/lib"
 	"github.com/aqaurius6666/apiservice/src/internal/lib/unleash"
 	"github.com/aqaurius6666/apiservice/src/internal/lib/validate"
@@ -16,6 +20,7 @@ import (
 	"github.com/aqaurius6666/apiservice/src/pb"
 	"github.com/aqaurius6666/go-utils/database"
 	"github.com/aqaurius6666/go-utils/utils"
+	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
 	"go.opentelemetry.io/otel"
 	"golang.org/x/xerrors"
@@ -31,7 +36,7 @@ func (s OrderService) CreateOrder(ctx context.Context, req *pb.OrdersPostRequest
 	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.CreateOrder))
 	defer span.End()
 
-	if f, ok := validate.RequiredFields(req, "XUserId", "CategoryId", "Zipcode", "BusinessIds"); !ok {
+	if f, ok := validate.RequiredFields(req, "XUserId", "CategoryId", "Zipcode"); !ok {
 		err := xerrors.Errorf("%w", e.ErrMissingField(f))
 		lib.RecordError(span, err, ctx)
 		return nil, err
@@ -59,7 +64,41 @@ func (s OrderService) CreateOrder(ctx context.Context, req *pb.OrdersPostRequest
 
 	customerName := utils.StrVal(usr.FirstName) + " " + utils.StrVal(usr.LastName)
 
-	if int(*currOrder)+len(req.BusinessIds) > maxOrder {
+	windows := make([]model.TimeWindow, 0, len(req.TimeWindows))
+	for _, w := range req.TimeWindows {
+		windows = append(windows, model.TimeWindow{Start: w.Start, End: w.End})
+	}
+	schedule, err := model.NewOrderSchedule(req.Urgency, req.PreferredDate, windows)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+
+	// customers asking for quotes get the best businesses left to order
+	var matches []model.LeadMatch
+	bids := req.BusinessIds
+	if len(bids) == 0 {
+		n := maxOrder - int(*currOrder)
+		if n > model.LEAD_MATCH_COUNT {
+			n = model.LEAD_MATCH_COUNT
+		}
+		if n <= 0 {
+			err = xerrors.Errorf("%w", e.ErrExceedMaxOrders)
+			lib.RecordError(span, err, ctx)
+			return nil, err
+		}
+		matches, err = s.Model.MatchBusinesses(ctx, req.XUserId, req.CategoryId, req.Zipcode, n)
+		if err != nil {
+			err = xerrors.Errorf("%w", err)
+			lib.RecordError(span, err, ctx)
+			return nil, err
+		}
+		for _, m := range matches {
+			bids = append(bids, m.BusinessId.String())
+		}
+	}
+	if int(*currOrder)+len(bids) > maxOrder {
 		err = xerrors.Errorf("%w", e.ErrExceedMaxOrders)
 		lib.RecordError(span, err, ctx)
 		return nil, err
@@ -70,22 +109,52 @@ func (s OrderService) CreateOrder(ctx context.Context, req *pb.OrdersPostRequest
 		lib.RecordError(span, err)
 		return nil, err
 	}
-	for _, bid := range req.BusinessIds {
-		_, err := s.Model.CreateOrderV2(ctx, req.XUserId, bid, req.CategoryId, &req.Zipcode, usr.Phone, nil, &customerName)
+	answers := make(map[string][]string, len(req.Answers))
+	for _, a := range req.Answers {
+		if _, ok := answers[a.Key]; ok {
+			err = xerrors.Errorf("%w", e.ErrInvalidAnswer(a.Key))
+			lib.RecordError(span, err, ctx)
+			return nil, err
+		}
+		answers[a.Key] = a.Values
+	}
+	details, err := model.AnswerCategoryQuestions(model.CategoryQuestions(cate), answers)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	summary := model.JobSummary(details)
+	attachments, err := s.Model.CheckOrderAttachments(ctx, req.XUserId, req.Attachments)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	res := &pb.OrdersPostResponse_Data{}
+	for i, bid := range bids {
+		var reason *string
+		if matches != nil {
+			reason = utils.StrPtr(matches[i].Reason)
+		}
+		ord, err := s.Model.CreateOrderV2(ctx, req.XUserId, bid, req.CategoryId, &req.Zipcode, usr.Phone, utils.SafeStrPtr(summary), &customerName, schedule, details, attachments, reason)
 		if err != nil {
 			err = xerrors.Errorf("%w", err)
 			lib.RecordError(span, err, ctx)
 			return nil, err
 		}
 		go func(handymanId, customerName, categoryName, zipcode string) {
-			err := s.Model.SendRequestedNotification(context.TODO(), handymanId, customerName, categoryName, zipcode)
+			err := s.Model.SendRequestedNotification(context.TODO(), handymanId, customerName, categoryName, zipcode, summary)
 			if err != nil {
 				s.Logger.Error(err)
 			}
 		}(bid, customerName, *cate.Name, req.Zipcode)
 
+		if matches != nil {
+			res.Matches = append(res.Matches, s.Model.ConvertLeadMatchToProto(matches[i], ord.ID.String()))
+		}
 	}
-	return &pb.OrdersPostResponse_Data{}, nil
+	return res, nil
 }
 
 func (s OrderService) ListOrders(ctx context.Context, req *pb.OrdersGetRequest) (*pb.OrdersGetResponse_Data, error) {
@@ -99,13 +168,26 @@ func (s OrderService) ListOrders(ctx context.Context, req *pb.OrdersGetRequest)
 	}
 	limit := lib.ParseInt32Val(req.Limit)
 	offset := lib.ParseInt32Val(req.Offset)
+	var urgency *int32
+	if req.Urgency != "" {
+		v, ok := c.ORDER_URGENCY_value[strings.ToUpper(req.Urgency)]
+		if !ok {
+			err := xerrors.Errorf("%w", e.ErrInvalidSchedule)
+			lib.RecordError(span, err, ctx)
+			return nil, err
+		}
+		urgency = &v
+	}
 	total, err := s.Model.TotalOrders(ctx, &order.Search{
 		Order: order.Order{
 			Status:          utils.Int32Ptr(int32(req.Status)),
 			CustomerZipcode: utils.SafeStrPtr(req.Zipcode),
+			Urgency:         urgency,
 		},
-		UserId:     lib.ParseUUID(req.XUserId),
-		CategoryId: lib.ParseUUID(req.ServiceId),
+		UserId:        lib.ParseUUID(req.XUserId),
+		CategoryId:    lib.ParseUUID(req.ServiceId),
+		ScheduledFrom: lib.SafeInt64Ptr(req.ScheduledFrom),
+		ScheduledTo:   lib.SafeInt64Ptr(req.ScheduledTo),
 	})
 	if err != nil {
 		err = xerrors.Errorf("%w", err)
@@ -131,18 +213,27 @@ func (s OrderService) ListOrders(ctx context.Context, req *pb.OrdersGetRequest)
 		Order: order.Order{
 			Status:          utils.Int32Ptr(int32(req.Status)),
 			CustomerZipcode: utils.SafeStrPtr(req.Zipcode),
+			Urgency:         urgency,
 		},
-		UserId:     lib.ParseUUID(req.XUserId),
-		CategoryId: lib.ParseUUID(req.ServiceId),
+		UserId:        lib.ParseUUID(req.XUserId),
+		CategoryId:    lib.ParseUUID(req.ServiceId),
+		ScheduledFrom: lib.SafeInt64Ptr(req.ScheduledFrom),
+		ScheduledTo:   lib.SafeInt64Ptr(req.ScheduledTo),
 	})
 	if err != nil {
 		err = xerrors.Errorf("%w", err)
 		lib.RecordError(span, err, ctx)
 		return nil, err
 	}
+	result := s.Model.ConvertOrderToProtos(orders)
+	if err := s.Model.PresignOrderAttachments(ctx, req.XUserId, orders, result); err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
 	return &pb.OrdersGetResponse_Data{
 		Pagination: lib.Pagination(offset, limit, total),
-		Result:     s.Model.ConvertOrderToProtos(orders),
+		Result:     result,
 	}, nil
 }
 
@@ -150,7 +241,7 @@ func (s OrderService) UpdateOrderConnectedStatus(ctx context.Context, req *pb.Up
 	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.UpdateOrderConnectedStatus))
 	defer span.End()
 
-	if f, ok := validate.RequiredFields(req, "XUserId", "OrderId"); !ok {
+	if f, ok := validate.RequiredFields(req, "XUserId", "XActorId", "OrderId"); !ok {
 		err := xerrors.Errorf("%w", e.ErrMissingField(f))
 		lib.RecordError(span, err, ctx)
 		return nil, err
@@ -163,88 +254,73 @@ func (s OrderService) UpdateOrderConnectedStatus(ctx context.Context, req *pb.Up
 		return nil, err
 	}
 
-	if !s.Model.CheckPermissionUpdateOrder(req.XUserId, ord.BusinessId) {
+	if !s.checkBusinessActor(ctx, req.XUserId, req.XActorId, ord.BusinessId) {
 		err = xerrors.Errorf("%w", e.ErrNoPermission)
 		lib.RecordError(span, err, ctx)
 		return nil, err
 	}
 
-	if *ord.Status != int32(c.ORDER_STATUS_PENDING) {
-		err = xerrors.Errorf("%w", e.ErrInvalidOrderStatus)
+	actor := model.OrderActor{Id: req.XActorId, Role: req.XRole}
+	if err := s.Model.CheckOrderTransition(ord, c.ORDER_STATUS_CONNECTED, actor); err != nil {
+		err = xerrors.Errorf("%w", err)
 		lib.RecordError(span, err, ctx)
 		return nil, err
 	}
 
-	convId, err := s.Model.NewConversation(ctx, ord.BusinessId, ord.CustomerId, ord.ID)
-	if err != nil {
+	if err := s.connectOrder(ctx, ord, nil, actor); err != nil {
 		err = xerrors.Errorf("%w", err)
 		lib.RecordError(span, err, ctx)
 		return nil, err
 	}
 
+	return &pb.UpdateOrderStatusPostResponse_Data{}, nil
+}
+
+// connectOrder connects ord for actor, accepting q if set, and charges the
+// business the fee of the group of the category if any. Only then, so that of
+// concurrent connects only the one that moved ord goes on, it opens the
+// conversation of the business and the customer and notifies the customer.
+func (s OrderService) connectOrder(ctx context.Context, ord *order.Order, q *quote.Quote, actor model.OrderActor) error {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.connectOrder))
+	defer span.End()
+
 	sv, err := s.Model.GetServiceById(ctx, ord.ServiceId)
 	if err != nil {
 		err = xerrors.Errorf("%w", err)
 		lib.RecordError(span, err, ctx)
-		return nil, err
+		return err
 	}
 
-	gr, err := s.Model.SelectGroup(ctx, &group.Search{CategoryId: sv.CategoryId})
-	if err != nil {
-		// Category not in group
-		if err = s.Model.UpdateOrderById(ctx, ord.ID, &order.Order{
-			ConversationId: convId,
-			Status:         utils.Int32Ptr(int32(c.ORDER_STATUS_CONNECTED)),
-		}); err != nil {
-			err = xerrors.Errorf("%w", err)
-			lib.RecordError(span, err)
-			return nil, err
-		}
-
-		return &pb.UpdateOrderStatusPostResponse_Data{}, nil
+	var fee *float32
+	// Category not in group is not charged
+	if gr, err := s.Model.SelectGroup(ctx, &group.Search{CategoryId: sv.CategoryId}); err == nil {
+		fee = gr.Fee
 	}
 
-	bus, err := s.Model.GetBusinessById(ctx, req.XUserId)
+	bus, err := s.Model.GetBusinessById(ctx, ord.BusinessId)
 	if err != nil {
 		err = xerrors.Errorf("%w", err)
 		lib.RecordError(span, err, ctx)
-		return nil, err
+		return err
 	}
 
-	if utils.Int32Val(bus.FreeContact) > 0 {
-		err = s.Model.InsertTransaction(ctx, ord.ID, req.XUserId, *gr.Fee, true)
-		if err != nil {
-			err = xerrors.Errorf("%w", err)
-			lib.RecordError(span, err, ctx)
-			return nil, err
-		}
-
-		freeContact := utils.Int32Val(bus.FreeContact) - 1
-		err = s.Model.UpdateBusiness(ctx, req.XUserId, &business.Business{
-			FreeContact: &freeContact,
-		})
-		if err != nil {
-			err = xerrors.Errorf("%w", err)
-			lib.RecordError(span, err, ctx)
-			return nil, err
-		}
-	} else {
-		err = s.Model.InsertTransaction(ctx, ord.ID, req.XUserId, *gr.Fee, false)
-		if err != nil {
-			err = xerrors.Errorf("%w", err)
-			lib.RecordError(span, err, ctx)
-			return nil, err
-		}
+	if err := s.Model.ConnectOrder(ctx, ord.ID, fee, q, actor); err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return err
 	}
 
-	err = s.Model.UpdateOrderById(ctx, ord.ID, &order.Order{
-		ConversationId: convId,
-		Status:         utils.Int32Ptr(int32(c.ORDER_STATUS_CONNECTED)),
-	})
+	convId, err := s.Model.NewConversation(ctx, ord.BusinessId, ord.CustomerId, ord.ID)
 	if err != nil {
 		err = xerrors.Errorf("%w", err)
 		lib.RecordError(span, err, ctx)
-		return nil, err
+		return err
+	}
+	err = s.Model.UpdateOrderById(ctx, ord.ID, &order.Order{ConversationId: convId})
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return err
 	}
 
 	go func(customerId, businessName string) {
@@ -254,14 +330,14 @@ func (s OrderService) UpdateOrderConnectedStatus(ctx context.Context, req *pb.Up
 		}
 	}(ord.CustomerId.String(), *bus.Name)
 
-	return &pb.UpdateOrderStatusPostResponse_Data{}, nil
+	return nil
 }
 
 func (s OrderService) UpdateAllOrderConnectedStatus(ctx context.Context, req *pb.UpdateAllOrderStatusPostRequest) (*pb.UpdateAllOrderStatusPostResponse, error) {
 	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.UpdateAllOrderConnectedStatus))
 	defer span.End()
 
-	if f, ok := validate.RequiredFields(req, "XUserId"); !ok {
+	if f, ok := validate.RequiredFields(req, "XUserId", "XActorId"); !ok {
 		err := xerrors.Errorf("%w", e.ErrMissingField(f))
 		lib.RecordError(span, err, ctx)
 		return nil, err
@@ -271,6 +347,11 @@ func (s OrderService) UpdateAllOrderConnectedStatus(ctx context.Context, req *pb
 	if err != nil {
 		return nil, xerrors.Errorf("%w", err)
 	}
+	if !s.checkBusinessActor(ctx, req.XUserId, req.XActorId, bid) {
+		err = xerrors.Errorf("%w", e.ErrNoPermission)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
 
 	orders, err := s.Model.ListOrders(ctx, &order.Search{
 		Order: order.Order{
@@ -287,82 +368,18 @@ func (s OrderService) UpdateAllOrderConnectedStatus(ctx context.Context, req *pb
 		return nil, err
 	}
 
+	actor := model.OrderActor{Id: req.XActorId, Role: req.XRole}
 	for _, ord := range orders {
-		if !s.Model.CheckPermissionUpdateOrder(req.XUserId, ord.BusinessId) {
-			err = xerrors.Errorf("%w", e.ErrNoPermission)
-			lib.RecordError(span, err, ctx)
-			return nil, err
-		}
-
-		convId, err := s.Model.NewConversation(ctx, ord.BusinessId, ord.CustomerId, ord.ID)
-		if err != nil {
-			err = xerrors.Errorf("%w", err)
-			lib.RecordError(span, err, ctx)
-			return nil, err
-		}
-
-		sv, err := s.Model.GetServiceById(ctx, ord.ServiceId)
-		if err != nil {
-			err = xerrors.Errorf("%w", err)
-			lib.RecordError(span, err, ctx)
-			return nil, err
-		}
-
-		gr, err := s.Model.SelectGroup(ctx, &group.Search{CategoryId: sv.CategoryId})
-		if err != nil {
-			err = xerrors.Errorf("%w", err)
-			lib.RecordError(span, err, ctx)
-			return nil, err
-		}
-
-		bus, err := s.Model.GetBusinessById(ctx, req.XUserId)
-		if err != nil {
+		if err := s.Model.CheckOrderTransition(ord, c.ORDER_STATUS_CONNECTED, actor); err != nil {
 			err = xerrors.Errorf("%w", err)
 			lib.RecordError(span, err, ctx)
 			return nil, err
 		}
-
-		if utils.Int32Val(bus.FreeContact) > 0 {
-			err = s.Model.InsertTransaction(ctx, ord.ID, req.XUserId, *gr.Fee, true)
-			if err != nil {
-				err = xerrors.Errorf("%w", err)
-				lib.RecordError(span, err, ctx)
-				return nil, err
-			}
-
-			freeContact := utils.Int32Val(bus.FreeContact) - 1
-			err = s.Model.UpdateBusiness(ctx, req.XUserId, &business.Business{
-				FreeContact: &freeContact,
-			})
-			if err != nil {
-				err = xerrors.Errorf("%w", err)
-				lib.RecordError(span, err, ctx)
-				return nil, err
-			}
-		} else {
-			err = s.Model.InsertTransaction(ctx, ord.ID, req.XUserId, *gr.Fee, false)
-			if err != nil {
-				err = xerrors.Errorf("%w", err)
-				lib.RecordError(span, err, ctx)
-				return nil, err
-			}
-		}
-
-		err = s.Model.UpdateOrderById(ctx, ord.ID, &order.Order{
-			ConversationId: convId,
-			Status:         utils.Int32Ptr(int32(c.ORDER_STATUS_CONNECTED)),
-		})
-		if err != nil {
+		if err := s.connectOrder(ctx, ord, nil, actor); err != nil {
 			err = xerrors.Errorf("%w", err)
 			lib.RecordError(span, err, ctx)
 			return nil, err
 		}
-		go func(customerId, businessName string) {
-			err := s.Model.SendConnectNotification(context.TODO(), customerId, businessName, convId.String())
-			if err != nil {
-				s.Logger.Error(err)
-			}
-		}(ord.CustomerId.String(), *bus.Name)
 	}
 
 	return &pb.UpdateAllOrderStatusPostResponse{}, nil
@@ -372,7 +389,7 @@ func (s OrderService) UpdateOrderRejectedStatus(ctx context.Context, req *pb.Upd
 	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.UpdateOrderConnectedStatus))
 	defer span.End()
 
-	if f, ok := validate.RequiredFields(req, "XUserId", "OrderId"); !ok {
+	if f, ok := validate.RequiredFields(req, "XUserId", "XActorId", "OrderId"); !ok {
 		err := xerrors.Errorf("%w", e.ErrMissingField(f))
 		lib.RecordError(span, err, ctx)
 		return nil, err
@@ -384,32 +401,40 @@ func (s OrderService) UpdateOrderRejectedStatus(ctx context.Context, req *pb.Upd
 		lib.RecordError(span, err)
 		return nil, err
 	}
-	order, err := s.Model.GetOrderById(ctx, req.OrderId)
+	ord, err := s.Model.GetOrderById(ctx, req.OrderId)
 	if err != nil {
 		err = xerrors.Errorf("%w", err)
 		lib.RecordError(span, err, ctx)
 		return nil, err
 	}
 
-	if !s.Model.CheckPermissionUpdateOrder(req.XUserId, order.BusinessId) {
+	if !s.checkBusinessActor(ctx, req.XUserId, req.XActorId, ord.BusinessId) {
 		err = xerrors.Errorf("%w", e.ErrNoPermission)
 		lib.RecordError(span, err, ctx)
 		return nil, err
 	}
 
-	if !(*order.Status == int32(c.ORDER_STATUS_PENDING) || *order.Status == int32(c.ORDER_STATUS_CONNECTED)) {
-		err = xerrors.Errorf("%w", e.ErrInvalidOrderStatus)
+	actor, err := orderActor(req.XActorId, req.XRole, req.Reason, req.Note)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	if err := s.Model.CheckOrderTransition(ord, c.ORDER_STATUS_REJECTED, actor); err != nil {
+		err = xerrors.Errorf("%w", err)
 		lib.RecordError(span, err, ctx)
 		return nil, err
 	}
 
-	err = s.Model.UpdateOrderStatusById(ctx, req.OrderId, c.ORDER_STATUS_REJECTED)
+	err = s.Model.TransitionOrder(ctx, req.OrderId, &order.Order{
+		Status: utils.Int32Ptr(int32(c.ORDER_STATUS_REJECTED)),
+	}, actor)
 	if err != nil {
 		err = xerrors.Errorf("%w", err)
 		lib.RecordError(span, err, ctx)
 		return nil, err
 	}
-	err = s.Model.CloseConversation(ctx, order.ConversationId)
+	err = s.Model.CloseConversation(ctx, ord.ConversationId)
 	if err != nil {
 		err = xerrors.Errorf("%w", err)
 		lib.RecordError(span, err, ctx)
@@ -420,7 +445,7 @@ func (s OrderService) UpdateOrderRejectedStatus(ctx context.Context, req *pb.Upd
 		if err != nil {
 			s.Logger.Error(err)
 		}
-	}(order.CustomerId.String(), *bus.Name, bus.ID.String())
+	}(ord.CustomerId.String(), *bus.Name, bus.ID.String())
 
 	return &pb.UpdateOrderStatusPostResponse_Data{}, nil
 }
@@ -429,48 +454,64 @@ func (s OrderService) UpdateOrderCompletedStatus(ctx context.Context, req *pb.Up
 	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.UpdateOrderConnectedStatus))
 	defer span.End()
 
-	if f, ok := validate.RequiredFields(req, "XUserId", "OrderId"); !ok {
+	if f, ok := validate.RequiredFields(req, "XUserId", "XActorId", "OrderId"); !ok {
 		err := xerrors.Errorf("%w", e.ErrMissingField(f))
 		lib.RecordError(span, err, ctx)
 		return nil, err
 	}
 
-	order, err := s.Model.GetOrderById(ctx, req.OrderId)
+	ord, err := s.Model.GetOrderById(ctx, req.OrderId)
 	if err != nil {
 		err = xerrors.Errorf("%w", err)
 		lib.RecordError(span, err, ctx)
 		return nil, err
 	}
 
-	if req.XRole == c.ROLE_HANDYMAN && !s.Model.CheckPermissionUpdateOrder(req.XUserId, order.BusinessId) {
+	if req.XRole == c.ROLE_HANDYMAN && !s.checkBusinessActor(ctx, req.XUserId, req.XActorId, ord.BusinessId) {
 		err = xerrors.Errorf("%w", e.ErrNoPermission)
 		lib.RecordError(span, err, ctx)
 		return nil, err
-	} else if req.XRole == c.ROLE_CUSTOMER && !s.Model.CheckPermissionUpdateOrder(req.XUserId, order.CustomerId) {
+	} else if req.XRole == c.ROLE_CUSTOMER && !s.Model.CheckPermissionUpdateOrder(ctx, req.XActorId, ord.CustomerId) {
 		err = xerrors.Errorf("%w", e.ErrNoPermission)
 		lib.RecordError(span, err, ctx)
 		return nil, err
 	}
 
-	bus, err := s.Model.GetBusinessById(ctx, order.BusinessId)
+	bus, err := s.Model.GetBusinessById(ctx, ord.BusinessId)
 	if err != nil {
 		err = xerrors.Errorf("%w", err)
 		lib.RecordError(span, err)
 		return nil, err
 	}
-	if *order.Status != int32(c.ORDER_STATUS_CONNECTED) {
-		err = xerrors.Errorf("%w", e.ErrInvalidOrderStatus)
+	actor, err := orderActor(req.XActorId, req.XRole, req.Reason, req.Note)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
 		lib.RecordError(span, err, ctx)
 		return nil, err
 	}
 
-	err = s.Model.UpdateOrderStatusById(ctx, req.OrderId, c.ORDER_STATUS_COMPLETED)
-	if err != nil {
+	// the first side to call marks the job done, the other confirms it
+	if utils.Int32Val(ord.Status) != int32(c.ORDER_STATUS_AWAITING_CONFIRMATION) {
+		if err := s.Model.MarkOrderDone(ctx, ord, actor); err != nil {
+			err = xerrors.Errorf("%w", err)
+			lib.RecordError(span, err, ctx)
+			return nil, err
+		}
+		go func(userId, requesterName, orderId string) {
+			err := s.Model.SendCompletionRequestedNotification(context.TODO(), userId, requesterName, orderId)
+			if err != nil {
+				s.Logger.Error(err)
+			}
+		}(completionCounterpart(ord, bus, req.XRole))
+		return &pb.UpdateOrderStatusPostResponse_Data{}, nil
+	}
+
+	if err := s.Model.ConfirmOrderCompletion(ctx, ord, actor); err != nil {
 		err = xerrors.Errorf("%w", err)
 		lib.RecordError(span, err, ctx)
 		return nil, err
 	}
-	err = s.Model.CloseConversation(ctx, order.ConversationId)
+	err = s.Model.CloseConversation(ctx, ord.ConversationId)
 	if err != nil {
 		err = xerrors.Errorf("%w", err)
 		lib.RecordError(span, err, ctx)
@@ -481,7 +522,63 @@ func (s OrderService) UpdateOrderCompletedStatus(ctx context.Context, req *pb.Up
 		if err != nil {
 			s.Logger.Error(err)
 		}
-	}(order.CustomerId.String(), *bus.Name, bus.ID.String())
+	}(ord.CustomerId.String(), *bus.Name, bus.ID.String())
+
+	return &pb.UpdateOrderStatusPostResponse_Data{}, nil
+}
+
+// DisputeOrderCompletion sends a job the other side marked done back to
+// connected. The note tells what is left to do.
+func (s OrderService) DisputeOrderCompletion(ctx context.Context, req *pb.UpdateOrderStatusPostRequest) (*pb.UpdateOrderStatusPostResponse_Data, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.DisputeOrderCompletion))
+	defer span.End()
+
+	if f, ok := validate.RequiredFields(req, "XUserId", "XActorId", "OrderId", "Note"); !ok {
+		err := xerrors.Errorf("%w", e.ErrMissingField(f))
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+
+	ord, err := s.Model.GetOrderById(ctx, req.OrderId)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+
+	if req.XRole == c.ROLE_HANDYMAN && !s.checkBusinessActor(ctx, req.XUserId, req.XActorId, ord.BusinessId) {
+		err = xerrors.Errorf("%w", e.ErrNoPermission)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	} else if req.XRole == c.ROLE_CUSTOMER && !s.Model.CheckPermissionUpdateOrder(ctx, req.XActorId, ord.CustomerId) {
+		err = xerrors.Errorf("%w", e.ErrNoPermission)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+
+	bus, err := s.Model.GetBusinessById(ctx, ord.BusinessId)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	actor, err := orderActor(req.XActorId, req.XRole, "", strings.TrimSpace(req.Note))
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	if err := s.Model.DisputeOrderCompletion(ctx, ord, actor); err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	go func(userId, disputerName, orderId string) {
+		err := s.Model.SendCompletionDisputedNotification(context.TODO(), userId, disputerName, orderId)
+		if err != nil {
+			s.Logger.Error(err)
+		}
+	}(completionCounterpart(ord, bus, req.XRole))
 
 	return &pb.UpdateOrderStatusPostResponse_Data{}, nil
 }
@@ -490,39 +587,47 @@ func (s OrderService) UpdateOrderCancelledStatus(ctx context.Context, req *pb.Up
 	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.UpdateOrderConnectedStatus))
 	defer span.End()
 
-	if f, ok := validate.RequiredFields(req, "XUserId", "OrderId"); !ok {
+	if f, ok := validate.RequiredFields(req, "XUserId", "XActorId", "OrderId"); !ok {
 		err := xerrors.Errorf("%w", e.ErrMissingField(f))
 		lib.RecordError(span, err, ctx)
 		return nil, err
 	}
 
-	order, err := s.Model.GetOrderById(ctx, req.OrderId)
+	ord, err := s.Model.GetOrderById(ctx, req.OrderId)
 	if err != nil {
 		err = xerrors.Errorf("%w", err)
 		lib.RecordError(span, err, ctx)
 		return nil, err
 	}
 
-	if !s.Model.CheckPermissionUpdateOrder(req.XUserId, order.CustomerId) {
+	if !s.Model.CheckPermissionUpdateOrder(ctx, req.XActorId, ord.CustomerId) {
 		err = xerrors.Errorf("%w", e.ErrNoPermission)
 		lib.RecordError(span, err, ctx)
 		return nil, err
 	}
 
-	if *order.Status != int32(c.ORDER_STATUS_PENDING) {
-		err = xerrors.Errorf("%w", e.ErrInvalidOrderStatus)
+	actor, err := orderActor(req.XActorId, req.XRole, req.Reason, req.Note)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	if err := s.Model.CheckOrderTransition(ord, c.ORDER_STATUS_CANCELED, actor); err != nil {
+		err = xerrors.Errorf("%w", err)
 		lib.RecordError(span, err, ctx)
 		return nil, err
 	}
 
-	err = s.Model.UpdateOrderStatusById(ctx, req.OrderId, c.ORDER_STATUS_CANCELED)
+	err = s.Model.TransitionOrder(ctx, req.OrderId, &order.Order{
+		Status: utils.Int32Ptr(int32(c.ORDER_STATUS_CANCELED)),
+	}, actor)
 	if err != nil {
 		err = xerrors.Errorf("%w", err)
 		lib.RecordError(span, err, ctx)
 		return nil, err
 	}
 
-	service, err := s.Model.GetServiceById(ctx, order.ServiceId)
+	service, err := s.Model.GetServiceById(ctx, ord.ServiceId)
 	if err != nil {
 		err = xerrors.Errorf("%w", err)
 		lib.RecordError(span, err)
@@ -533,7 +638,7 @@ func (s OrderService) UpdateOrderCancelledStatus(ctx context.Context, req *pb.Up
 		if err != nil {
 			s.Logger.Error(err)
 		}
-	}(order.BusinessId.String(), *order.CustomerName, *service.Category.Name, *order.CustomerZipcode)
+	}(ord.BusinessId.String(), *ord.CustomerName, *service.Category.Name, *ord.CustomerZipcode)
 
 	return &pb.UpdateOrderStatusPostResponse_Data{}, nil
 }
@@ -614,13 +719,19 @@ func (s OrderService) CancelProject(ctx context.Context, req *pb.CancelProjectPo
 		return nil, err
 	}
 
-	err = s.Model.CancelProject(ctx, &order.Search{
+	actor, err := orderActor(req.XUserId, c.ROLE_CUSTOMER, req.Reason, req.Note)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	_, err = s.Model.CancelProject(ctx, &order.Search{
 		Order: order.Order{
 			CustomerZipcode: &req.Zipcode,
 			ServiceId:       sid,
 			CustomerId:      uid,
 		},
-	})
+	}, actor)
 	if err != nil {
 		err = xerrors.Errorf("%w", err)
 		lib.RecordError(span, err, ctx)
@@ -630,6 +741,468 @@ func (s OrderService) CancelProject(ctx context.Context, req *pb.CancelProjectPo
 	return &pb.CancelProjectPostResponse_Data{}, nil
 }
 
+// ProposeOrderSlot lets the business of an open order offer the customer
+// another slot than the one asked for.
+func (s OrderService) ProposeOrderSlot(ctx context.Context, req *pb.OrderSlotProposePostRequest) (*pb.OrderSlotProposePostResponse_Data, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.ProposeOrderSlot))
+	defer span.End()
+
+	if f, ok := validate.RequiredFields(req, "XUserId", "XActorId", "OrderId"); !ok {
+		err := xerrors.Errorf("%w", e.ErrMissingField(f))
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	if req.Slot == nil {
+		err := xerrors.Errorf("%w", e.ErrMissingField("Slot"))
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+
+	ord, err := s.Model.GetOrderById(ctx, req.OrderId)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	if !s.checkBusinessActor(ctx, req.XUserId, req.XActorId, ord.BusinessId) {
+		err = xerrors.Errorf("%w", e.ErrNoPermission)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	if !isOpenOrder(ord) {
+		err = xerrors.Errorf("%w", e.ErrInvalidOrderStatus)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	bus, err := s.Model.GetBusinessById(ctx, ord.BusinessId)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+
+	err = s.Model.ProposeOrderSlot(ctx, ord.ID, model.TimeWindow{Start: req.Slot.Start, End: req.Slot.End})
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	go func(customerId, businessName, orderId string) {
+		err := s.Model.SendSlotProposedNotification(context.TODO(), customerId, businessName, orderId)
+		if err != nil {
+			s.Logger.Error(err)
+		}
+	}(ord.CustomerId.String(), utils.StrVal(bus.Name), ord.ID.String())
+
+	return &pb.OrderSlotProposePostResponse_Data{}, nil
+}
+
+// AnswerOrderSlot lets the customer of an open order accept or decline the
+// slot its business proposed.
+func (s OrderService) AnswerOrderSlot(ctx context.Context, req *pb.OrderSlotRespondPostRequest) (*pb.OrderSlotRespondPostResponse_Data, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.AnswerOrderSlot))
+	defer span.End()
+
+	if f, ok := validate.RequiredFields(req, "XActorId", "OrderId"); !ok {
+		err := xerrors.Errorf("%w", e.ErrMissingField(f))
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+
+	ord, err := s.Model.GetOrderById(ctx, req.OrderId)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	if !s.Model.CheckPermissionUpdateOrder(ctx, req.XActorId, ord.CustomerId) {
+		err = xerrors.Errorf("%w", e.ErrNoPermission)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	if !isOpenOrder(ord) {
+		err = xerrors.Errorf("%w", e.ErrInvalidOrderStatus)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+
+	err = s.Model.AnswerOrderSlot(ctx, ord, req.Accept)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	go func(handymanId, customerName, orderId string, accepted bool) {
+		err := s.Model.SendSlotAnsweredNotification(context.TODO(), handymanId, customerName, orderId, accepted)
+		if err != nil {
+			s.Logger.Error(err)
+		}
+	}(ord.BusinessId.String(), utils.StrVal(ord.CustomerName), ord.ID.String(), req.Accept)
+
+	return &pb.OrderSlotRespondPostResponse_Data{}, nil
+}
+
+// SubmitQuote lets the business of a pending order quote its job, replacing
+// its open quote if any.
+func (s OrderService) SubmitQuote(ctx context.Context, req *pb.OrderQuotePostRequest) (*pb.OrderQuotePostResponse_Data, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.SubmitQuote))
+	defer span.End()
+
+	if f, ok := validate.RequiredFields(req, "XUserId", "XActorId", "OrderId"); !ok {
+		err := xerrors.Errorf("%w", e.ErrMissingField(f))
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+
+	ord, err := s.Model.GetOrderById(ctx, req.OrderId)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	if !s.checkBusinessActor(ctx, req.XUserId, req.XActorId, ord.BusinessId) {
+		err = xerrors.Errorf("%w", e.ErrNoPermission)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	if utils.Int32Val(ord.Status) != int32(c.ORDER_STATUS_PENDING) {
+		err = xerrors.Errorf("%w", e.ErrInvalidOrderStatus)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	bus, err := s.Model.GetBusinessById(ctx, ord.BusinessId)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+
+	q, err := s.Model.SubmitQuote(ctx, ord, model.QuoteOffer{
+		PriceMin:   req.PriceMin,
+		PriceMax:   req.PriceMax,
+		VisitFee:   req.VisitFee,
+		Message:    strings.TrimSpace(req.Message),
+		ValidUntil: req.ValidUntil,
+	})
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	go func(customerId, businessName, orderId string) {
+		err := s.Model.SendQuoteSubmittedNotification(context.TODO(), customerId, businessName, orderId)
+		if err != nil {
+			s.Logger.Error(err)
+		}
+	}(ord.CustomerId.String(), utils.StrVal(bus.Name), ord.ID.String())
+
+	q.BusinessName, q.BusinessLogo = bus.Name, bus.LogoUrl
+	return &pb.OrderQuotePostResponse_Data{
+		Quote: s.Model.ConvertQuoteToProto(q),
+	}, nil
+}
+
+// ListQuotes lists the quotes of an order to its customer and its business,
+// or the quotes of a project to its customer to compare them.
+func (s OrderService) ListQuotes(ctx context.Context, req *pb.OrderQuotesGetRequest) (*pb.OrderQuotesGetResponse_Data, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.ListQuotes))
+	defer span.End()
+
+	if f, ok := validate.RequiredFields(req, "XUserId", "XActorId"); !ok {
+		err := xerrors.Errorf("%w", e.ErrMissingField(f))
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+
+	search := &quote.Search{}
+	if req.OrderId != "" {
+		ord, err := s.Model.GetOrderById(ctx, req.OrderId)
+		if err != nil {
+			err = xerrors.Errorf("%w", err)
+			lib.RecordError(span, err, ctx)
+			return nil, err
+		}
+		if !s.Model.CheckPermissionUpdateOrder(ctx, req.XActorId, ord.CustomerId) && !s.checkBusinessActor(ctx, req.XUserId, req.XActorId, ord.BusinessId) {
+			err = xerrors.Errorf("%w", e.ErrNoPermission)
+			lib.RecordError(span, err, ctx)
+			return nil, err
+		}
+		search.OrderId = ord.ID
+	} else {
+		if f, ok := validate.RequiredFields(req, "ServiceId", "Zipcode"); !ok {
+			err := xerrors.Errorf("%w", e.ErrMissingField(f))
+			lib.RecordError(span, err, ctx)
+			return nil, err
+		}
+		uid, err := lib.ToUUID(req.XActorId)
+		if err != nil {
+			err = xerrors.Errorf("%w", err)
+			lib.RecordError(span, err, ctx)
+			return nil, err
+		}
+		cid, err := lib.ToUUID(req.ServiceId)
+		if err != nil {
+			err = xerrors.Errorf("%w", err)
+			lib.RecordError(span, err, ctx)
+			return nil, err
+		}
+		search.CustomerId = uid
+		search.CategoryId = cid
+		search.CustomerZipcode = &req.Zipcode
+	}
+
+	quotes, err := s.Model.ListQuotes(ctx, search)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	return &pb.OrderQuotesGetResponse_Data{
+		Result: s.Model.ConvertQuoteToProtos(quotes),
+	}, nil
+}
+
+// AcceptQuote lets the customer hire the business of an open quote: its order
+// is connected, charging the business as connecting does, and the other
+// pending orders of the project are canceled.
+func (s OrderService) AcceptQuote(ctx context.Context, req *pb.OrderQuoteAcceptPostRequest) (*pb.OrderQuoteAcceptPostResponse_Data, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.AcceptQuote))
+	defer span.End()
+
+	if f, ok := validate.RequiredFields(req, "XActorId", "QuoteId"); !ok {
+		err := xerrors.Errorf("%w", e.ErrMissingField(f))
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+
+	q, err := s.Model.GetQuoteById(ctx, req.QuoteId)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	if !s.Model.CheckPermissionUpdateOrder(ctx, req.XActorId, q.CustomerId) {
+		err = xerrors.Errorf("%w", e.ErrNoPermission)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	if !model.IsOpen(q, time.Now()) {
+		err = xerrors.Errorf("%w", e.ErrQuoteNotOpen)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	ord, err := s.Model.GetOrderById(ctx, q.OrderId)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+
+	metadata := map[string]string{"quoteId": q.ID.String()}
+	actor := model.OrderActor{Id: req.XActorId, Role: c.ROLE_CUSTOMER, Reason: c.ORDER_REASON_QUOTE_ACCEPTED, Metadata: metadata}
+	if err := s.Model.CheckOrderTransition(ord, c.ORDER_STATUS_CONNECTED, actor); err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	if err := s.connectOrder(ctx, ord, q, actor); err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	canceled, err := s.Model.CancelProject(ctx, &order.Search{
+		Order: order.Order{
+			CustomerZipcode: q.CustomerZipcode,
+			ServiceId:       q.CategoryId,
+			CustomerId:      q.CustomerId,
+		},
+	}, model.OrderActor{Id: req.XActorId, Role: c.ROLE_CUSTOMER, Reason: c.ORDER_REASON_HIRED_ELSEWHERE, Metadata: metadata})
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+
+	go func(customerName, orderId string) {
+		err := s.Model.SendQuoteAnsweredNotification(context.TODO(), ord.BusinessId.String(), customerName, orderId, true)
+		if err != nil {
+			s.Logger.Error(err)
+		}
+		for _, o := range canceled {
+			err := s.Model.SendQuoteAnsweredNotification(context.TODO(), o.BusinessId.String(), customerName, o.ID.String(), false)
+			if err != nil {
+				s.Logger.Error(err)
+			}
+		}
+	}(utils.StrVal(ord.CustomerName), ord.ID.String())
+
+	return &pb.OrderQuoteAcceptPostResponse_Data{}, nil
+}
+
+// FileDispute lets the handyman of a connected order dispute its connection
+// fee. Admins review it before the fee is billed or refunded.
+func (s OrderService) FileDispute(ctx context.Context, req *pb.OrderDisputePostRequest) (*pb.OrderDisputePostResponse_Data, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.FileDispute))
+	defer span.End()
+
+	if f, ok := validate.RequiredFields(req, "XUserId", "XUploaderId", "OrderId", "Note"); !ok {
+		err := xerrors.Errorf("%w", e.ErrMissingField(f))
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+
+	ord, err := s.Model.GetOrderById(ctx, req.OrderId)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	if !s.checkBusinessActor(ctx, req.XUserId, req.XUploaderId, ord.BusinessId) {
+		err = xerrors.Errorf("%w", e.ErrNoPermission)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	attachments, err := s.Model.CheckOrderAttachments(ctx, req.XUploaderId, req.Attachments)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+
+	d, err := s.Model.FileDispute(ctx, ord, model.DisputeEvidence{
+		Reason:      req.Reason,
+		Note:        req.Note,
+		Attachments: attachments,
+	})
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	return &pb.OrderDisputePostResponse_Data{
+		Dispute: s.Model.ConvertDisputeToProto(d),
+	}, nil
+}
+
+// ListDisputes lists the disputes of the business of the caller, oldest
+// first.
+func (s OrderService) ListDisputes(ctx context.Context, req *pb.OrderDisputesGetRequest) (*pb.OrderDisputesGetResponse_Data, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.ListDisputes))
+	defer span.End()
+
+	if f, ok := validate.RequiredFields(req, "XUserId"); !ok {
+		err := xerrors.Errorf("%w", e.ErrMissingField(f))
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	bid, err := lib.ToUUID(req.XUserId)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	limit := lib.ParseInt32Val(req.Limit)
+	offset := lib.ParseInt32Val(req.Offset)
+
+	search := &dispute.Search{Dispute: dispute.Dispute{BusinessId: bid}}
+	total, err := s.Model.TotalDisputes(ctx, search)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	search.DefaultSearchModel = database.DefaultSearchModel{Skip: int(offset), Limit: int(limit)}
+	ds, err := s.Model.ListDisputes(ctx, search)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	upbs := s.Model.ConvertDisputeToProtos(ds)
+	if err := s.Model.PresignDisputeAttachments(ctx, ds, upbs); err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	return &pb.OrderDisputesGetResponse_Data{
+		Result:     upbs,
+		Pagination: lib.Pagination(offset, limit, total),
+	}, nil
+}
+
+// isOpenOrder tells whether the job of ord is still to be done.
+func isOpenOrder(ord *order.Order) bool {
+	st := utils.Int32Val(ord.Status)
+	return st == int32(c.ORDER_STATUS_PENDING) || st == int32(c.ORDER_STATUS_CONNECTED)
+}
+
+// GetOrderTimeline lists the status changes of an order, oldest first, to
+// its customer, its business and staff.
+func (s OrderService) GetOrderTimeline(ctx context.Context, req *pb.OrdersTimelineGetRequest) (*pb.OrdersTimelineGetResponse_Data, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.GetOrderTimeline))
+	defer span.End()
+
+	if f, ok := validate.RequiredFields(req, "XUserId", "XActorId", "Id"); !ok {
+		err := xerrors.Errorf("%w", e.ErrMissingField(f))
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+
+	ord, err := s.Model.GetOrderById(ctx, req.Id)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	if !req.XStaff &&
+		!s.Model.CheckPermissionUpdateOrder(ctx, req.XActorId, ord.CustomerId) &&
+		!s.checkBusinessActor(ctx, req.XUserId, req.XActorId, ord.BusinessId) {
+		err = xerrors.Errorf("%w", e.ErrNoPermission)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+
+	evs, err := s.Model.ListOrderEvents(ctx, ord.ID)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	return &pb.OrdersTimelineGetResponse_Data{
+		Result: s.Model.ConvertOrderEventToProtos(evs),
+	}, nil
+}
+
+// checkBusinessActor tells whether actorId, signed in and acting for the
+// business userId, may act on the orders of businessId: userId must be
+// businessId and actorId one of its members.
+func (s OrderService) checkBusinessActor(ctx context.Context, userId, actorId string, businessId uuid.UUID) bool {
+	return userId == businessId.String() && s.Model.CheckPermissionUpdateOrder(ctx, actorId, businessId)
+}
+
+// orderActor is the caller of a status change request giving reason, an
+// ORDER_REASON name. Reasons recorded only by the platform are refused.
+func orderActor(userId string, role c.ROLE, reason, note string) (model.OrderActor, error) {
+	r := c.ORDER_REASON_NO_REASON
+	if reason != "" {
+		v, ok := c.ORDER_REASON_value[strings.ToUpper(reason)]
+		if !ok || c.ORDER_REASON(v) > c.ORDER_REASON_OTHER_REASON {
+			return model.OrderActor{}, e.ErrInvalidOrderReason
+		}
+		r = c.ORDER_REASON(v)
+	}
+	return model.OrderActor{Id: userId, Role: role, Reason: r, Note: note}, nil
+}
+
+// completionCounterpart returns the side of ord to tell about a completion
+// answered by role, with the name role goes by, and the order id.
+func completionCounterpart(ord *order.Order, bus *business.Business, role c.ROLE) (string, string, string) {
+	if role == c.ROLE_CUSTOMER {
+		return ord.BusinessId.String(), utils.StrVal(ord.CustomerName), ord.ID.String()
+	}
+	return ord.CustomerId.String(), utils.StrVal(bus.Name), ord.ID.String()
+}
+
 func (s OrderService) BusinessesAlreadyOrdered(ctx context.Context, req *pb.BusinessesAlreadyOrderedGetRequest) (*pb.BusinessesAlreadyOrderedGetResponse_Data, error) {
 	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.CancelProject))
 	defer span.End()
@@ -34,7 +34,7 @@ func (s UserService) CreateUser(ctx context.Context, req *pb.UserPostRequest) (*
 		return nil, err
 	}
 
-	otpId, err := s.Model.Register(ctx, req.Mail, req.Phone, req.PublicKey, req.EncryptedPrivateKey, c.ROLE_CUSTOMER, "")
+	otpId, err := s.Model.Register(ctx, req.Mail, req.Phone, req.PublicKey, req.EncryptedPrivateKey, c.ROLE_CUSTOMER, "", req.Channel)
 	if err != nil {
 		err = xerrors.Errorf("%w", err)
 		lib.RecordError(span, err, ctx)
@@ -35,6 +35,9 @@ type Business struct {
 	ServiceId      pq.StringArray     `gorm:"type:varchar(64)[];-:migration;->"`
 	StartDate      *int64             `gorm:"-:migration;->"`
 	CountZipcodes  *int64             `gorm:"-:migration;->"`
+	ResponseTime   *float64           `gorm:"-:migration;->"`
+	OpenLeads      *int64             `gorm:"-:migration;->"`
+	Promoted       *bool              `gorm:"-:migration;->"`
 }
 
 type Search struct {
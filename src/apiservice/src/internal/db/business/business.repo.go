@@ -15,4 +15,8 @@ type BusinessRepo interface {
 	ListBusinesssWithRating(context.Context, *Search) ([]*Business, error)
 	GetMapIdName(context.Context, *Search) (map[string]*string, error)
 	GetTotalZipcodes(context.Context, *Search) (*int64, error)
+	// ListLeadCandidates lists the businesses with an active service of the
+	// category serving the zipcode of search, with their rating, response
+	// time, open leads and whether the service is promoted.
+	ListLeadCandidates(context.Context, *Search) ([]*Business, error)
 }
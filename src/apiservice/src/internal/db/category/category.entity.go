@@ -12,6 +12,8 @@ type Category struct {
 	Name          *string   `gorm:"type:varchar(64)"`
 	GroupId       uuid.UUID `gorm:"type:uuid"`
 	ImageUrl      *string   `gorm:"type:varchar(512)"`
+	Questions     *string   `gorm:"type:text"`
+	OrderExpiry   *int32    `gorm:"type:int4"`
 	Fee           *float32  `gorm:"-:migration;->"`
 	TotalProvider *int64    `gorm:"-:migration;->"`
 }
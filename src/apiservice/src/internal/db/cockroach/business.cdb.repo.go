@@ -10,6 +10,7 @@ import (
 	"github.com/aqaurius6666/apiservice/src/internal/lib"
 	"github.com/aqaurius6666/apiservice/src/internal/var/c"
 	"github.com/aqaurius6666/go-utils/database"
+	"github.com/aqaurius6666/go-utils/utils"
 	"github.com/google/uuid"
 	"go.opentelemetry.io/otel"
 	"golang.org/x/xerrors"
@@ -284,3 +285,47 @@ func getSubQueryAgg(db *gorm.DB) *gorm.DB {
 	db = db.Joins(`left join "categories" c on "services"."category_id" = "c"."id" group by "services"."business_id"`)
 	return db
 }
+
+// getSubQueryResponseTime averages, per business, how long it took to connect
+// or reject its orders.
+func getSubQueryResponseTime(db *gorm.DB) *gorm.DB {
+	db = db.Table("order_events")
+	db = db.Joins(`join "orders" on "orders"."id" = "order_events"."order_id" and "orders"."business_id" = "order_events"."actor_id"`)
+	db = db.Where(`"order_events"."old_status" = ?`, c.ORDER_STATUS_PENDING)
+	db = db.Select(`"orders"."business_id", avg("order_events"."created_at" - "orders"."created_at") as "response_time"`)
+	db = db.Group(`"orders"."business_id"`)
+	return db
+}
+
+func getSubQueryOpenLeads(db *gorm.DB) *gorm.DB {
+	db = db.Table("orders")
+	db = db.Where(`"orders"."status" = ?`, c.ORDER_STATUS_PENDING)
+	db = db.Select(`"orders"."business_id", count(*) as "open_leads"`)
+	db = db.Group(`"orders"."business_id"`)
+	return db
+}
+
+func (u *ServerCDBRepo) ListLeadCandidates(ctx context.Context, search *business.Search) ([]*business.Business, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(u.ListLeadCandidates))
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	now := time.Now().UnixMilli()
+	r := make([]*business.Business, 0)
+	db := u.Db
+	if err := applySearchBusiness(db, search).WithContext(ctx).
+		Joins(`join "services" on "services"."business_id" = "businesses"."id" and "services"."status" = ? and "services"."category_id" = ?`, c.SERVICE_STATUS_SERVICE_ACTIVE, search.CategoryId).
+		Joins(`left join (?) as "rating" on "rating"."business_id" = "businesses"."id"`, getSubQueryRating(db, search)).
+		Joins(`left join (?) as "response" on "response"."business_id" = "businesses"."id"`, getSubQueryResponseTime(db)).
+		Joins(`left join (?) as "leads" on "leads"."business_id" = "businesses"."id"`, getSubQueryOpenLeads(db)).
+		Where(`cast(? as varchar) = any("businesses"."zipcodes" :: varchar[])`, utils.StrVal(search.Zipcode)).
+		Select(`"businesses".*, "rating"."rate", "rating"."review", "response"."response_time", coalesce("leads"."open_leads", 0) as "open_leads",
+			exists(select 1 from "advertise_orders" where "advertise_orders"."service_id" = "services"."id" and "advertise_orders"."start_date" < ? and "advertise_orders"."end_date" > ?) as "promoted"`, now, now).
+		Find(&r).Error; err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	return r, nil
+}
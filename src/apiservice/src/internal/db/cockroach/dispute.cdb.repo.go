@@ -0,0 +1,146 @@
+package cockroach
+
+import (
+	"context"
+
+	"github.com/aqaurius6666/apiservice/src/internal/db/dispute"
+	"github.com/aqaurius6666/apiservice/src/internal/lib"
+	"github.com/aqaurius6666/apiservice/src/internal/var/c"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"golang.org/x/xerrors"
+	"gorm.io/gorm"
+)
+
+func applySearchDispute(db *gorm.DB, search *dispute.Search) *gorm.DB {
+	if search.ID != uuid.Nil {
+		db = db.Where(`"disputes"."id" = ?`, search.ID)
+	}
+	if search.OrderId != uuid.Nil {
+		db = db.Where(`"disputes"."order_id" = ?`, search.OrderId)
+	}
+	if search.BusinessId != uuid.Nil {
+		db = db.Where(`"disputes"."business_id" = ?`, search.BusinessId)
+	}
+	if search.Status != nil {
+		db = db.Where(`"disputes"."status" = ?`, *search.Status)
+	}
+	return db
+}
+
+func paginateDispute(db *gorm.DB, search *dispute.Search) *gorm.DB {
+	if search.Skip != 0 {
+		db = db.Offset(search.Skip)
+	}
+	if search.Limit != 0 {
+		db = db.Limit(search.Limit)
+	}
+	return db
+}
+
+func (u *ServerCDBRepo) SelectDispute(ctx context.Context, search *dispute.Search) (*dispute.Dispute, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(u.SelectDispute))
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	r := dispute.Dispute{}
+	if err := applySearchDispute(u.Db, search).WithContext(ctx).First(&r).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, xerrors.Errorf("%w", dispute.ErrNotFound)
+		}
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	return &r, nil
+}
+
+func (u *ServerCDBRepo) InsertDispute(ctx context.Context, value *dispute.Dispute) (*dispute.Dispute, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(u.InsertDispute))
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	if err := u.Db.WithContext(ctx).Create(value).Error; err != nil {
+		err = xerrors.Errorf("%w", dispute.ErrInsertFail)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	return value, nil
+}
+
+func (u *ServerCDBRepo) UpdateDisputes(ctx context.Context, search *dispute.Search, value *dispute.Dispute) error {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(u.UpdateDisputes))
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	if err := applySearchDispute(u.Db.Model(&dispute.Dispute{}), search).WithContext(ctx).Updates(value).Error; err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return err
+	}
+	return nil
+}
+
+func (u *ServerCDBRepo) ListDisputes(ctx context.Context, search *dispute.Search) ([]*dispute.Dispute, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(u.ListDisputes))
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	r := make([]*dispute.Dispute, 0)
+	if err := paginateDispute(applySearchDispute(u.Db, search), search).WithContext(ctx).
+		Joins(`left join businesses "businesses" on "disputes"."business_id" = "businesses"."id"`).
+		Select([]string{`"disputes".*`, `"businesses"."name" as business_name`}).
+		Order(`"disputes"."created_at" asc`).
+		Find(&r).Error; err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	return r, nil
+}
+
+func (u *ServerCDBRepo) TotalDisputes(ctx context.Context, search *dispute.Search) (*int64, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(u.TotalDisputes))
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var r int64
+	if err := applySearchDispute(u.Db.Model(&dispute.Dispute{}), search).WithContext(ctx).Count(&r).Error; err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	return &r, nil
+}
+
+// ReviewDispute records the review of a dispute still under review. It fails
+// with dispute.ErrReviewed when someone reviewed it first.
+func (u *ServerCDBRepo) ReviewDispute(ctx context.Context, value *dispute.Dispute) error {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(u.ReviewDispute))
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	res := u.Db.WithContext(ctx).Model(&dispute.Dispute{}).
+		Where(`"disputes"."id" = ? and "disputes"."status" = ?`, value.ID, int32(c.DISPUTE_STATUS_UNDER_REVIEW)).
+		Updates(&dispute.Dispute{
+			Status:     value.Status,
+			ReviewerId: value.ReviewerId,
+			ReviewNote: value.ReviewNote,
+			ReviewedAt: value.ReviewedAt,
+		})
+	if res.Error != nil {
+		err := xerrors.Errorf("%w", res.Error)
+		lib.RecordError(span, err, ctx)
+		return err
+	}
+	if res.RowsAffected == 0 {
+		return xerrors.Errorf("%w", dispute.ErrReviewed)
+	}
+	return nil
+}
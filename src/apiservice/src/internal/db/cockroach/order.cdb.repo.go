@@ -4,11 +4,14 @@ import (
 	"context"
 	"time"
 
+	"github.com/aqaurius6666/apiservice/src/internal/db/business"
 	"github.com/aqaurius6666/apiservice/src/internal/db/order"
+	"github.com/aqaurius6666/apiservice/src/internal/db/order_event"
+	"github.com/aqaurius6666/apiservice/src/internal/db/quote"
+	"github.com/aqaurius6666/apiservice/src/internal/db/transaction"
 	"github.com/aqaurius6666/apiservice/src/internal/lib"
 	"github.com/aqaurius6666/apiservice/src/internal/var/c"
 	"github.com/aqaurius6666/go-utils/database"
-	"github.com/aqaurius6666/go-utils/utils"
 	"github.com/google/uuid"
 	"go.opentelemetry.io/otel"
 	"golang.org/x/xerrors"
@@ -67,6 +70,24 @@ func applySearchOrder(db *gorm.DB, search *order.Search) *gorm.DB {
 		db = db.Where(`"orders"."id"  = any (? :: uuid[])`, search.OIds)
 	}
 
+	if len(search.Statuses) != 0 {
+		db = db.Where(`"orders"."status" in ?`, search.Statuses)
+	}
+
+	if search.Urgency != nil {
+		db = db.Where(order.Order{
+			Urgency: search.Urgency,
+		})
+	}
+
+	if search.ScheduledFrom != nil {
+		db = db.Where(`"orders"."preferred_date" >= ?`, *search.ScheduledFrom)
+	}
+
+	if search.ScheduledTo != nil {
+		db = db.Where(`"orders"."preferred_date" <= ?`, *search.ScheduledTo)
+	}
+
 	return db
 }
 
@@ -135,40 +156,118 @@ func (u *ServerCDBRepo) UpdateOrder(ctx context.Context, search *order.Search, v
 	return nil
 }
 
-func (u *ServerCDBRepo) UpdateOrderStatusIfExpireTime(ctx context.Context) error {
-	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(u.UpdateOrderStatusIfExpireTime))
+// TransitionOrders moves the orders matching search to the status of value
+// and records an event for each of them in the same transaction. Only orders
+// in one of search.Statuses move, so a concurrent change is never overwritten.
+// It returns the moved orders with their business and old status.
+func (u *ServerCDBRepo) TransitionOrders(ctx context.Context, search *order.Search, value *order.Order, event *order_event.OrderEvent) ([]*order.Order, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(u.TransitionOrders))
 	defer span.End()
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	var now = time.Now().UnixMilli()
-	if err := u.Db.WithContext(ctx).Table("orders").
-		Where(`"orders"."status" = ? AND "orders"."end_date" < ?`, c.ORDER_STATUS_PENDING, now).
-		Updates(&order.Order{
-			Status: utils.Int32Ptr(int32(c.ORDER_STATUS_REJECTED)),
-		}).
-		Error; err != nil {
+	r, err := transitionOrders(u.Db.WithContext(ctx), func(db *gorm.DB) *gorm.DB {
+		return applySearchOrder(db, search)
+	}, value, event)
+	if err != nil {
 		err = xerrors.Errorf("%w", err)
 		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	return r, nil
+}
+
+func transitionOrders(db *gorm.DB, scope func(*gorm.DB) *gorm.DB, value *order.Order, event *order_event.OrderEvent) ([]*order.Order, error) {
+	var r []*order.Order
+	err := db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		r, err = moveOrders(tx, scope, value, event)
 		return err
+	})
+	if err != nil {
+		return nil, err
 	}
-	return nil
+	return r, nil
 }
 
-func (u *ServerCDBRepo) UpdateOrdersCancelledStatusByUser(ctx context.Context, search *order.Search, value *order.Order) error {
-	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(u.UpdateOrdersCancelledStatusByUser))
+// moveOrders is transitionOrders within the transaction tx.
+func moveOrders(tx *gorm.DB, scope func(*gorm.DB) *gorm.DB, value *order.Order, event *order_event.OrderEvent) ([]*order.Order, error) {
+	r := make([]*order.Order, 0)
+	if err := scope(tx.Model(&order.Order{})).
+		Select([]string{`"orders"."id"`, `"orders"."business_id"`, `"orders"."status"`}).
+		Find(&r).Error; err != nil {
+		return nil, err
+	}
+	if len(r) == 0 {
+		return r, nil
+	}
+	ids := make([]uuid.UUID, 0, len(r))
+	events := make([]*order_event.OrderEvent, 0, len(r))
+	for _, o := range r {
+		ids = append(ids, o.ID)
+		ev := *event
+		ev.ID = uuid.New()
+		ev.OrderId = o.ID
+		ev.OldStatus = o.Status
+		ev.NewStatus = value.Status
+		events = append(events, &ev)
+	}
+	if err := tx.Model(&order.Order{}).Where(`"orders"."id" in ?`, ids).Updates(value).Error; err != nil {
+		return nil, err
+	}
+	if err := tx.Create(&events).Error; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ConnectOrder moves the order matching search to the status of value like
+// TransitionOrders and, in the same transaction, accepts the quote accept and
+// charges fee to its business, each if set. The fee is free while the
+// business has free contacts left, and one of them is used. Nothing is
+// charged when no order moved, and nothing is done when the quote cannot be
+// accepted, which fails with quote.ErrNotOpen.
+func (u *ServerCDBRepo) ConnectOrder(ctx context.Context, search *order.Search, value *order.Order, event *order_event.OrderEvent, accept *quote.Quote, fee *transaction.Transaction) ([]*order.Order, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(u.ConnectOrder))
 	defer span.End()
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	if err := applySearchOrder(u.Db, search).WithContext(ctx).
-		Where(`"orders"."status" = ? or "orders"."status" = ?`, c.ORDER_STATUS_PENDING, c.ORDER_STATUS_CONNECTED).
-		Updates(value).Error; err != nil {
+	var r []*order.Order
+	err := u.Db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		r, err = moveOrders(tx, func(db *gorm.DB) *gorm.DB {
+			return applySearchOrder(db, search)
+		}, value, event)
+		if err != nil || len(r) != 1 {
+			return err
+		}
+		if accept != nil {
+			if err := acceptQuote(tx, accept, time.Now().UnixMilli()); err != nil {
+				return err
+			}
+		}
+		if fee == nil {
+			return nil
+		}
+		res := tx.Model(&business.Business{}).
+			Where(`"businesses"."id" = ? and "businesses"."free_contact" > 0`, r[0].BusinessId).
+			Update("free_contact", gorm.Expr(`"free_contact" - 1`))
+		if res.Error != nil {
+			return res.Error
+		}
+		isFree := res.RowsAffected > 0
+		fee.OrderId = r[0].ID
+		fee.BusinessId = r[0].BusinessId
+		fee.IsFree = &isFree
+		return tx.Create(fee).Error
+	})
+	if err != nil {
 		err = xerrors.Errorf("%w", err)
 		lib.RecordError(span, err, ctx)
-		return err
+		return nil, err
 	}
-	return nil
+	return r, nil
 }
 
 func (u *ServerCDBRepo) TotalOrder(ctx context.Context, search *order.Search) (*int64, error) {
@@ -233,25 +332,27 @@ func SubQueryProjects(db *gorm.DB, search *order.Search) *gorm.DB {
 		Joins(`left join categories on "categories"."id" = "services"."category_id"`).
 		Group(`"categories"."id", "orders"."customer_zipcode"`).
 		Order(`"categories"."name" asc`).
-		Where(`"orders"."status" = ? or "orders"."status" = ?`, c.ORDER_STATUS_PENDING, c.ORDER_STATUS_CONNECTED).
+		Where(`"orders"."status" in ?`, []c.ORDER_STATUS{c.ORDER_STATUS_PENDING, c.ORDER_STATUS_CONNECTED, c.ORDER_STATUS_AWAITING_CONFIRMATION}).
 		Select(search.Fields)
 	return db
 }
 
-func (u *ServerCDBRepo) CancelProject(ctx context.Context, search *order.Search, value *order.Order) error {
+func (u *ServerCDBRepo) CancelProject(ctx context.Context, search *order.Search, value *order.Order, event *order_event.OrderEvent) ([]*order.Order, error) {
 	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(u.CancelProject))
 	defer span.End()
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 	subQuery := getSubQueryForCancelProject(u.Db, search)
-	if err := u.Db.WithContext(ctx).Where(`"orders"."id" IN (?)`, subQuery).
-		Updates(value).
-		Error; err != nil {
+	r, err := transitionOrders(u.Db.WithContext(ctx), func(db *gorm.DB) *gorm.DB {
+		return db.Where(`"orders"."id" IN (?)`, subQuery).
+			Where(`"orders"."status" in ?`, search.Statuses)
+	}, value, event)
+	if err != nil {
 		err = xerrors.Errorf("%w", err)
 		lib.RecordError(span, err, ctx)
-		return err
+		return nil, err
 	}
-	return nil
+	return r, nil
 }
 
 func (u *ServerCDBRepo) ListBusinessesAlreadyOrdered(ctx context.Context, search *order.Search) ([]*order.Order, error) {
@@ -263,7 +364,7 @@ func (u *ServerCDBRepo) ListBusinessesAlreadyOrdered(ctx context.Context, search
 	r := make([]*order.Order, 0)
 	if err := applySearchOrder(u.Db, search).
 		WithContext(ctx).
-		Where(`"orders"."status" = ? or "orders"."status" = ?`, c.ORDER_STATUS_PENDING, c.ORDER_STATUS_CONNECTED).
+		Where(`"orders"."status" in ?`, []c.ORDER_STATUS{c.ORDER_STATUS_PENDING, c.ORDER_STATUS_CONNECTED, c.ORDER_STATUS_AWAITING_CONFIRMATION}).
 		Joins(`left join "services" "services" on "orders"."service_id" = "services"."id"`).
 		Joins(`left join "categories" "categories" on "services"."category_id" = "categories"."id"`).
 		Select(search.Fields).
@@ -0,0 +1,59 @@
+package cockroach
+
+import (
+	"context"
+
+	"github.com/aqaurius6666/apiservice/src/internal/db/order_event"
+	"github.com/aqaurius6666/apiservice/src/internal/lib"
+	"github.com/aqaurius6666/apiservice/src/internal/var/c"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"golang.org/x/xerrors"
+	"gorm.io/gorm"
+)
+
+func applySearchOrderEvent(db *gorm.DB, search *order_event.Search) *gorm.DB {
+	if search.OrderId != uuid.Nil {
+		db = db.Where(order_event.OrderEvent{
+			OrderId: search.OrderId,
+		})
+	}
+	if search.Skip != 0 {
+		db = db.Offset(search.Skip)
+	}
+	if search.Limit != 0 {
+		db = db.Limit(search.Limit)
+	}
+	return db
+}
+
+func (u *ServerCDBRepo) ListOrderEvents(ctx context.Context, search *order_event.Search) ([]*order_event.OrderEvent, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(u.ListOrderEvents))
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	r := make([]*order_event.OrderEvent, 0)
+	if err := applySearchOrderEvent(u.Db, search).WithContext(ctx).
+		Order(`"order_events"."created_at" asc`).
+		Find(&r).Error; err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	return r, nil
+}
+
+func (u *ServerCDBRepo) InsertOrderEvent(ctx context.Context, value *order_event.OrderEvent) error {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(u.InsertOrderEvent))
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	if err := u.Db.WithContext(ctx).Create(value).Error; err != nil {
+		err = xerrors.Errorf("%w", order_event.ErrInsertFail)
+		lib.RecordError(span, err, ctx)
+		return err
+	}
+	return nil
+}
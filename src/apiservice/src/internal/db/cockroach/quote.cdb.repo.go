@@ -0,0 +1,134 @@
+package cockroach
+
+import (
+	"context"
+
+	"github.com/aqaurius6666/apiservice/src/internal/db/quote"
+	"github.com/aqaurius6666/apiservice/src/internal/lib"
+	"github.com/aqaurius6666/apiservice/src/internal/var/c"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"golang.org/x/xerrors"
+	"gorm.io/gorm"
+)
+
+func applySearchQuote(db *gorm.DB, search *quote.Search) *gorm.DB {
+	if search.ID != uuid.Nil {
+		db = db.Where(`"quotes"."id" = ?`, search.ID)
+	}
+	if search.OrderId != uuid.Nil {
+		db = db.Where(`"quotes"."order_id" = ?`, search.OrderId)
+	}
+	if search.BusinessId != uuid.Nil {
+		db = db.Where(`"quotes"."business_id" = ?`, search.BusinessId)
+	}
+	if search.CustomerId != uuid.Nil {
+		db = db.Where(`"quotes"."customer_id" = ?`, search.CustomerId)
+	}
+	if search.CategoryId != uuid.Nil {
+		db = db.Where(`"quotes"."category_id" = ?`, search.CategoryId)
+	}
+	if search.CustomerZipcode != nil {
+		db = db.Where(`"quotes"."customer_zipcode" = ?`, *search.CustomerZipcode)
+	}
+	if search.Status != nil {
+		db = db.Where(`"quotes"."status" = ?`, *search.Status)
+	}
+	if search.Skip != 0 {
+		db = db.Offset(search.Skip)
+	}
+	if search.Limit != 0 {
+		db = db.Limit(search.Limit)
+	}
+	return db
+}
+
+func (u *ServerCDBRepo) SelectQuote(ctx context.Context, search *quote.Search) (*quote.Quote, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(u.SelectQuote))
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	r := quote.Quote{}
+	if err := applySearchQuote(u.Db, search).WithContext(ctx).First(&r).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, xerrors.Errorf("%w", quote.ErrNotFound)
+		}
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	return &r, nil
+}
+
+func (u *ServerCDBRepo) InsertQuote(ctx context.Context, value *quote.Quote) (*quote.Quote, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(u.InsertQuote))
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	if err := u.Db.WithContext(ctx).Create(value).Error; err != nil {
+		err = xerrors.Errorf("%w", quote.ErrInsertFail)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	return value, nil
+}
+
+func (u *ServerCDBRepo) UpdateQuotes(ctx context.Context, search *quote.Search, value *quote.Quote) error {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(u.UpdateQuotes))
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	if err := applySearchQuote(u.Db.Model(&quote.Quote{}), search).WithContext(ctx).Updates(value).Error; err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return err
+	}
+	return nil
+}
+
+func (u *ServerCDBRepo) ListQuotes(ctx context.Context, search *quote.Search) ([]*quote.Quote, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(u.ListQuotes))
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	r := make([]*quote.Quote, 0)
+	if err := applySearchQuote(u.Db, search).WithContext(ctx).
+		Joins(`left join businesses "businesses" on "quotes"."business_id" = "businesses"."id"`).
+		Select([]string{`"quotes".*`,
+			`"businesses"."name" as business_name`,
+			`"businesses"."logo_url" as business_logo`,
+		}).
+		Order(`"quotes"."created_at" asc`).
+		Find(&r).Error; err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	return r, nil
+}
+
+// acceptQuote marks the quote won if it is open and valid at now, and every
+// other open quote of its project lost, within the transaction tx. It fails
+// with quote.ErrNotOpen when the quote cannot be accepted anymore.
+func acceptQuote(tx *gorm.DB, value *quote.Quote, now int64) error {
+	open := int32(c.QUOTE_STATUS_OPEN)
+	res := tx.Model(&quote.Quote{}).
+		Where(`"quotes"."id" = ? and "quotes"."status" = ? and "quotes"."valid_until" > ?`, value.ID, open, now).
+		Update("status", int32(c.QUOTE_STATUS_WON))
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return quote.ErrNotOpen
+	}
+	return applySearchQuote(tx.Model(&quote.Quote{}), &quote.Search{Quote: quote.Quote{
+		CustomerId:      value.CustomerId,
+		CategoryId:      value.CategoryId,
+		CustomerZipcode: value.CustomerZipcode,
+		Status:          &open,
+	}}).Update("status", int32(c.QUOTE_STATUS_LOST)).Error
+}
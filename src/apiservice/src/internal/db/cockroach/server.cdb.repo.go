@@ -9,10 +9,13 @@ import (
 	"github.com/aqaurius6666/apiservice/src/internal/db/business"
 	"github.com/aqaurius6666/apiservice/src/internal/db/category"
 	"github.com/aqaurius6666/apiservice/src/internal/db/contact"
+	"github.com/aqaurius6666/apiservice/src/internal/db/dispute"
 	"github.com/aqaurius6666/apiservice/src/internal/db/feedback"
 	"github.com/aqaurius6666/apiservice/src/internal/db/group"
 	"github.com/aqaurius6666/apiservice/src/internal/db/order"
+	"github.com/aqaurius6666/apiservice/src/internal/db/order_event"
 	"github.com/aqaurius6666/apiservice/src/internal/db/payment"
+	"github.com/aqaurius6666/apiservice/src/internal/db/quote"
 	"github.com/aqaurius6666/apiservice/src/internal/db/service"
 	"github.com/aqaurius6666/apiservice/src/internal/db/state"
 	"github.com/aqaurius6666/apiservice/src/internal/db/transaction"
@@ -40,6 +43,9 @@ func InterfacesProvider() cockroach.DBInterfaces {
 		contact.Contact{},
 		feedback.Feedback{},
 		order.Order{},
+		order_event.OrderEvent{},
+		quote.Quote{},
+		dispute.Dispute{},
 		service.Service{},
 		state.State{},
 		user.User{},
@@ -13,6 +13,12 @@ import (
 )
 
 func applySearchTransaction(db *gorm.DB, search *transaction.Search) *gorm.DB {
+	if search.ID != uuid.Nil {
+		db = db.Where(`"transactions"."id" = ?`, search.ID)
+	}
+	if search.OrderId != uuid.Nil {
+		db = db.Where(`"transactions"."order_id" = ?`, search.OrderId)
+	}
 	if search.BusinessId != uuid.Nil {
 		db = db.Where(transaction.Transaction{
 			BusinessId: search.BusinessId,
@@ -100,6 +106,7 @@ func (u *ServerCDBRepo) TotalFee(ctx context.Context, search *transaction.Search
 	left, right := lib.GetTimeRange(*search.Query)
 	if err := applySearchTransaction(u.Db, search).WithContext(ctx).Model(transaction.Transaction{}).Select(`coalesce(sum("transactions"."fee"),0) as "totalFee"`).
 		Joins(`left join orders "orders" on "transactions"."order_id" = "orders"."id"`).
+		Where(`"transactions"."is_waived" is not true`).
 		Where(`"transactions"."created_at" >= ? AND "transactions"."created_at" < ?`, left, right).
 		Scan(&r).
 		Error; err != nil {
@@ -139,3 +146,45 @@ func (u *ServerCDBRepo) UpdateTransaction(ctx context.Context, search *transacti
 	}
 	return nil
 }
+
+func (u *ServerCDBRepo) SelectTransaction(ctx context.Context, search *transaction.Search) (*transaction.Transaction, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(u.SelectTransaction))
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	r := transaction.Transaction{}
+	if err := applySearchTransaction(u.Db, search).WithContext(ctx).First(&r).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, xerrors.Errorf("%w", transaction.ErrNotFound)
+		}
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	return &r, nil
+}
+
+// WaiveTransaction waives the fee of a transaction the payment cronjob has
+// not billed yet. It fails with transaction.ErrBilled once billing started.
+func (u *ServerCDBRepo) WaiveTransaction(ctx context.Context, value *transaction.Transaction) error {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(u.WaiveTransaction))
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	res := u.Db.WithContext(ctx).Model(&transaction.Transaction{}).
+		Where(`"transactions"."id" = ? and "transactions"."payment_intent_id" is null and "transactions"."is_paid" is not true`, value.ID).
+		Update("is_waived", true)
+	if res.Error != nil {
+		err := xerrors.Errorf("%w", res.Error)
+		lib.RecordError(span, err, ctx)
+		return err
+	}
+	if res.RowsAffected == 0 {
+		return xerrors.Errorf("%w", transaction.ErrBilled)
+	}
+	return nil
+}
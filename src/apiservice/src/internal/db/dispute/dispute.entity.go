@@ -0,0 +1,31 @@
+package dispute
+
+import (
+	"github.com/aqaurius6666/go-utils/database"
+	"github.com/google/uuid"
+)
+
+// Dispute is a handyman disputing the connection fee of an order. Fee is
+// copied from the transaction in cents, Attachments are stored as json.
+type Dispute struct {
+	database.BaseModel
+	OrderId       uuid.UUID `gorm:"type:uuid;uniqueIndex"`
+	TransactionId uuid.UUID `gorm:"type:uuid"`
+	BusinessId    uuid.UUID `gorm:"type:uuid;index"`
+	Reason        *int32    `gorm:"type:int8;default:0"`
+	Note          *string   `gorm:"type:varchar(1024)"`
+	Attachments   *string   `gorm:"type:text"`
+	Fee           *int64    `gorm:"type:int8;default:0"`
+	Status        *int32    `gorm:"type:int8;default:0"`
+	Resolution    *int32    `gorm:"type:int8;default:0"`
+	ReviewerId    uuid.UUID `gorm:"type:uuid"`
+	ReviewNote    *string   `gorm:"type:varchar(1024)"`
+	ReviewedAt    *int64    `gorm:"type:bigint"`
+	RefundId      *string   `gorm:"type:varchar(128)"`
+	BusinessName  *string   `gorm:"-:migration;->"`
+}
+
+type Search struct {
+	database.DefaultSearchModel
+	Dispute
+}
@@ -0,0 +1,10 @@
+package dispute
+
+import "golang.org/x/xerrors"
+
+var (
+	prefix        = "dispute"
+	ErrNotFound   = xerrors.Errorf("%s: record not found", prefix)
+	ErrInsertFail = xerrors.Errorf("%s: insert failed", prefix)
+	ErrReviewed   = xerrors.Errorf("%s: already reviewed", prefix)
+)
@@ -0,0 +1,12 @@
+package dispute
+
+import "context"
+
+type DisputeRepo interface {
+	SelectDispute(context.Context, *Search) (*Dispute, error)
+	InsertDispute(context.Context, *Dispute) (*Dispute, error)
+	UpdateDisputes(context.Context, *Search, *Dispute) error
+	ListDisputes(context.Context, *Search) ([]*Dispute, error)
+	TotalDisputes(context.Context, *Search) (*int64, error)
+	ReviewDispute(context.Context, *Dispute) error
+}
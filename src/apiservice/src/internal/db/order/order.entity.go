@@ -3,35 +3,54 @@ package order
 import (
 	"github.com/aqaurius6666/go-utils/database"
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"github.com/ubgo/gormuuid"
 )
 
 type Order struct {
 	database.BaseModel
-	CustomerId      uuid.UUID `gorm:"type:uuid"`
-	BusinessId      uuid.UUID `gorm:"type:uuid"`
-	ConversationId  uuid.UUID `gorm:"type:uuid"`
-	ServiceId       uuid.UUID `gorm:"type:uuid"`
-	StartDate       *int64    `gorm:"type:bigint"`
-	EndDate         *int64    `gorm:"type:bigint"`
-	Status          *int32    `gorm:"type:int8;default:0"`
-	CustomerPhone   *string   `gorm:"type:varchar(16)"`
-	CustomerName    *string   `gorm:"type:varchar(128)"`
-	CustomerZipcode *string   `gorm:"type:varchar(16)"`
-	CustomerMessage *string   `gorm:"type:varchar(256)"`
-	IsReviewed      *bool     `gorm:"type:bool;default:false"`
-	ServiceName     *string   `gorm:"-:migration;->"`
-	NumberOrders    *int64    `gorm:"-:migration;->"`
-	ServiceAvatar   *string   `gorm:"-:migration;->"`
-	CustomerAvatar  *string   `gorm:"-:migration;->"`
-	Fee             *float32  `gorm:"-:migration;->"`
-	CategoryName    *string   `gorm:"-:migration;->"`
-	BusinessName    *string   `gorm:"-:migration;->"`
-	BusinessLogo    *string   `gorm:"-:migration;->"`
-	BusinessBanner  *string   `gorm:"-:migration;->"`
-	CategoryId      *string   `gorm:"-:migration;->"`
-	HandymanMail    *string   `gorm:"-:migration;->"`
-	CustomerMail    *string   `gorm:"-:migration;->"`
+	CustomerId      uuid.UUID     `gorm:"type:uuid"`
+	BusinessId      uuid.UUID     `gorm:"type:uuid"`
+	ConversationId  uuid.UUID     `gorm:"type:uuid"`
+	ServiceId       uuid.UUID     `gorm:"type:uuid"`
+	StartDate       *int64        `gorm:"type:bigint"`
+	EndDate         *int64        `gorm:"type:bigint"`
+	Status          *int32        `gorm:"type:int8;default:0"`
+	CustomerPhone   *string       `gorm:"type:varchar(16)"`
+	CustomerName    *string       `gorm:"type:varchar(128)"`
+	CustomerZipcode *string       `gorm:"type:varchar(16)"`
+	CustomerMessage *string       `gorm:"type:varchar(256)"`
+	IsReviewed      *bool         `gorm:"type:bool;default:false"`
+	Urgency         *int32        `gorm:"type:int8;default:0"`
+	PreferredDate   *int64        `gorm:"type:bigint;index"`
+	WindowStarts    pq.Int64Array `gorm:"type:int8[]"`
+	WindowEnds      pq.Int64Array `gorm:"type:int8[]"`
+	ProposedStart   *int64        `gorm:"type:bigint"`
+	ProposedEnd     *int64        `gorm:"type:bigint"`
+	ProposalStatus  *int32        `gorm:"type:int8"`
+	JobDetails      *string       `gorm:"type:text"`
+	Attachments     *string       `gorm:"type:text"`
+	MatchReason     *string       `gorm:"type:varchar(512)"`
+	ExpiryReminded  *bool         `gorm:"type:bool;default:false"`
+	// CompletionRequestedBy is the ROLE of the side that marked the job done,
+	// which auto-confirms at ConfirmBy.
+	CompletionRequestedBy  *int32   `gorm:"type:int8"`
+	CompletionRequestedAt  *int64   `gorm:"type:bigint"`
+	ConfirmBy              *int64   `gorm:"type:bigint;index"`
+	CompletionConfirmation *int32   `gorm:"type:int8;default:0"`
+	CompletedAt            *int64   `gorm:"type:bigint"`
+	ServiceName            *string  `gorm:"-:migration;->"`
+	NumberOrders           *int64   `gorm:"-:migration;->"`
+	ServiceAvatar          *string  `gorm:"-:migration;->"`
+	CustomerAvatar         *string  `gorm:"-:migration;->"`
+	Fee                    *float32 `gorm:"-:migration;->"`
+	CategoryName           *string  `gorm:"-:migration;->"`
+	BusinessName           *string  `gorm:"-:migration;->"`
+	BusinessLogo           *string  `gorm:"-:migration;->"`
+	BusinessBanner         *string  `gorm:"-:migration;->"`
+	CategoryId             *string  `gorm:"-:migration;->"`
+	HandymanMail           *string  `gorm:"-:migration;->"`
+	CustomerMail           *string  `gorm:"-:migration;->"`
 }
 type Search struct {
 	database.DefaultSearchModel
@@ -40,4 +59,8 @@ type Search struct {
 	CategoryId uuid.UUID
 	Query      *int32
 	OIds       gormuuid.UUIDArray
+	Statuses   []int32
+	// ScheduledFrom and ScheduledTo bound the preferred date.
+	ScheduledFrom *int64
+	ScheduledTo   *int64
 }
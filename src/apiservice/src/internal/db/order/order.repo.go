@@ -1,6 +1,12 @@
 package order
 
-import "context"
+import (
+	"context"
+
+	"github.com/aqaurius6666/apiservice/src/internal/db/order_event"
+	"github.com/aqaurius6666/apiservice/src/internal/db/quote"
+	"github.com/aqaurius6666/apiservice/src/internal/db/transaction"
+)
 
 type OrderRepo interface {
 	SelectOrder(context.Context, *Search) (*Order, error)
@@ -8,10 +14,10 @@ type OrderRepo interface {
 	UpdateOrder(context.Context, *Search, *Order) error
 	TotalOrder(context.Context, *Search) (*int64, error)
 	ListOrders(context.Context, *Search) ([]*Order, error)
-	UpdateOrdersCancelledStatusByUser(context.Context, *Search, *Order) error
+	TransitionOrders(context.Context, *Search, *Order, *order_event.OrderEvent) ([]*Order, error)
+	ConnectOrder(context.Context, *Search, *Order, *order_event.OrderEvent, *quote.Quote, *transaction.Transaction) ([]*Order, error)
 	ListProjects(context.Context, *Search) ([]*Order, error)
 	TotalProjects(context.Context, *Search) (*int64, error)
-	CancelProject(context.Context, *Search, *Order) error
-	UpdateOrderStatusIfExpireTime(ctx context.Context) error
+	CancelProject(context.Context, *Search, *Order, *order_event.OrderEvent) ([]*Order, error)
 	ListBusinessesAlreadyOrdered(context.Context, *Search) ([]*Order, error)
 }
@@ -0,0 +1,26 @@
+package order_event
+
+import (
+	"github.com/aqaurius6666/go-utils/database"
+	"github.com/google/uuid"
+)
+
+// OrderEvent records one status change of an order. Events are only ever
+// inserted. ActorId is nil when the system made the change, OldStatus is nil
+// for placing the order.
+type OrderEvent struct {
+	database.BaseModel
+	OrderId   uuid.UUID `gorm:"type:uuid;index"`
+	ActorId   uuid.UUID `gorm:"type:uuid"`
+	ActorRole *int32    `gorm:"type:int8"`
+	OldStatus *int32    `gorm:"type:int8"`
+	NewStatus *int32    `gorm:"type:int8"`
+	Reason    *int32    `gorm:"type:int8"`
+	Note      *string   `gorm:"type:varchar(256)"`
+	Metadata  *string   `gorm:"type:text"`
+}
+
+type Search struct {
+	database.DefaultSearchModel
+	OrderEvent
+}
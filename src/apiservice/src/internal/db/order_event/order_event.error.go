@@ -0,0 +1,8 @@
+package order_event
+
+import "golang.org/x/xerrors"
+
+var (
+	prefix        = "order_event"
+	ErrInsertFail = xerrors.Errorf("%s: insert failed", prefix)
+)
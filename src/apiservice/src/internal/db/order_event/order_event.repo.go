@@ -0,0 +1,8 @@
+package order_event
+
+import "context"
+
+type OrderEventRepo interface {
+	ListOrderEvents(context.Context, *Search) ([]*OrderEvent, error)
+	InsertOrderEvent(context.Context, *OrderEvent) error
+}
@@ -0,0 +1,31 @@
+package quote
+
+import (
+	"github.com/aqaurius6666/go-utils/database"
+	"github.com/google/uuid"
+)
+
+// Quote is the price a handyman offers for a pending order. CustomerId,
+// CategoryId and CustomerZipcode are copied from the order so the quotes of a
+// project are found without joins.
+type Quote struct {
+	database.BaseModel
+	OrderId         uuid.UUID `gorm:"type:uuid;index"`
+	BusinessId      uuid.UUID `gorm:"type:uuid"`
+	CustomerId      uuid.UUID `gorm:"type:uuid;index"`
+	CategoryId      uuid.UUID `gorm:"type:uuid"`
+	CustomerZipcode *string   `gorm:"type:varchar(16)"`
+	PriceMin        *float32  `gorm:"type:float8"`
+	PriceMax        *float32  `gorm:"type:float8"`
+	VisitFee        *float32  `gorm:"type:float8;default:0"`
+	Message         *string   `gorm:"type:varchar(512)"`
+	ValidUntil      *int64    `gorm:"type:bigint"`
+	Status          *int32    `gorm:"type:int8;default:0"`
+	BusinessName    *string   `gorm:"-:migration;->"`
+	BusinessLogo    *string   `gorm:"-:migration;->"`
+}
+
+type Search struct {
+	database.DefaultSearchModel
+	Quote
+}
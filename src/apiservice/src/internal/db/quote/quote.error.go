@@ -0,0 +1,10 @@
+package quote
+
+import "golang.org/x/xerrors"
+
+var (
+	prefix        = "quote"
+	ErrNotFound   = xerrors.Errorf("%s: record not found", prefix)
+	ErrInsertFail = xerrors.Errorf("%s: insert failed", prefix)
+	ErrNotOpen    = xerrors.Errorf("%s: not open", prefix)
+)
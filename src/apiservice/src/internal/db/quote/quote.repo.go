@@ -0,0 +1,10 @@
+package quote
+
+import "context"
+
+type QuoteRepo interface {
+	SelectQuote(context.Context, *Search) (*Quote, error)
+	InsertQuote(context.Context, *Quote) (*Quote, error)
+	UpdateQuotes(context.Context, *Search, *Quote) error
+	ListQuotes(context.Context, *Search) ([]*Quote, error)
+}
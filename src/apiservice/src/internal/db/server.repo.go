@@ -7,10 +7,13 @@ import (
 	"github.com/aqaurius6666/apiservice/src/internal/db/business"
 	"github.com/aqaurius6666/apiservice/src/internal/db/category"
 	"github.com/aqaurius6666/apiservice/src/internal/db/contact"
+	"github.com/aqaurius6666/apiservice/src/internal/db/dispute"
 	"github.com/aqaurius6666/apiservice/src/internal/db/feedback"
 	"github.com/aqaurius6666/apiservice/src/internal/db/group"
 	"github.com/aqaurius6666/apiservice/src/internal/db/order"
+	"github.com/aqaurius6666/apiservice/src/internal/db/order_event"
 	"github.com/aqaurius6666/apiservice/src/internal/db/payment"
+	"github.com/aqaurius6666/apiservice/src/internal/db/quote"
 	"github.com/aqaurius6666/apiservice/src/internal/db/service"
 	"github.com/aqaurius6666/apiservice/src/internal/db/state"
 	"github.com/aqaurius6666/apiservice/src/internal/db/transaction"
@@ -30,6 +33,9 @@ type ServerRepo interface {
 	feedback.FeedbackRepo
 	category.CategoryRepo
 	order.OrderRepo
+	order_event.OrderEventRepo
+	quote.QuoteRepo
+	dispute.DisputeRepo
 	payment.PaymentRepo
 	group.GroupRepo
 	transaction.TransactionRepo
@@ -13,6 +13,7 @@ type Transaction struct {
 	IsFree           *bool     `gorm:"type:bool;default:false"`
 	IsPaid           *bool     `gorm:"type:bool;default:false"`
 	PaymentIntentId  *string   `gorm:"type:varchar(128)"`
+	IsWaived         *bool     `gorm:"type:bool;default:false"`
 	StartDate        *int64    `gorm:"-:migration;->"`
 	EndDate          *int64    `gorm:"-:migration;->"`
 	ServiceName      *string   `gorm:"-:migration;->"`
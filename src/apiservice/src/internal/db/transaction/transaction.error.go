@@ -6,4 +6,5 @@ var (
 	prefix        = "transaction"
 	ErrNotFound   = xerrors.Errorf("%s: record not found", prefix)
 	ErrInsertFail = xerrors.Errorf("%s: insert failed", prefix)
+	ErrBilled     = xerrors.Errorf("%s: already billed", prefix)
 )
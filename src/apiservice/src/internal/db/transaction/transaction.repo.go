@@ -8,4 +8,6 @@ type TransactionRepo interface {
 	TotalFee(context.Context, *Search) (*int64, error)
 	InsertTransaction(context.Context, *Transaction) error
 	UpdateTransaction(context.Context, *Search, *Transaction) error
+	SelectTransaction(context.Context, *Search) (*Transaction, error)
+	WaiveTransaction(context.Context, *Transaction) error
 }
@@ -26,6 +26,12 @@ import (
 	"go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/xerrors"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	CLIENT_IP_KEY  = "x-client-ip"
+	USER_AGENT_KEY = "x-user-agent"
 )
 
 func SetBody(c *gin.Context) error {
@@ -89,6 +95,43 @@ func MustGetRole(g *gin.Context) c.ROLE {
 	return ro
 }
 
+// GetActingId returns the id of the business the caller acts for, or its
+// own id when it acts for none.
+func GetActingId(g *gin.Context) string {
+	if id := g.GetString("businessId"); id != "" {
+		return id
+	}
+	return g.GetString("userId")
+}
+
+// GetBusinessRole returns the role of the caller in the business it acts
+// for, if any. A handyman owns its own business.
+func GetBusinessRole(g *gin.Context) (c.BUSINESS_ROLE, bool) {
+	r, ok := g.Get("businessRole")
+	if !ok {
+		if ro, _ := g.Get("role"); ro == c.ROLE_HANDYMAN {
+			return c.BUSINESS_ROLE_OWNER, true
+		}
+		return 0, false
+	}
+	ro, ok := r.(c.BUSINESS_ROLE)
+	return ro, ok
+}
+
+// HasPermission reports whether the caller holds perm. Admins hold all
+// permissions.
+func HasPermission(g *gin.Context, perm string) bool {
+	if ro, _ := g.Get("role"); ro == c.ROLE_ADMIN {
+		return true
+	}
+	for _, p := range g.GetStringSlice("permissions") {
+		if p == perm {
+			return true
+		}
+	}
+	return false
+}
+
 func GetContentType(name string) (string, error) {
 	ext := path.Ext(name)
 	typ := mime.TypeByExtension(ext)
@@ -183,6 +226,9 @@ func ParseGinContext(c *gin.Context) context.Context {
 	for k, v := range c.Keys {
 		ctx = context.WithValue(ctx, GinKey(k), v)
 	}
+	// Forward the end user address and agent so upstream services can throttle
+	// per client and keep a record of where requests came from.
+	ctx = metadata.AppendToOutgoingContext(ctx, CLIENT_IP_KEY, c.ClientIP(), USER_AGENT_KEY, c.Request.UserAgent())
 	return ctx
 }
 
@@ -217,6 +263,14 @@ func ParseInt64Ptr(a string) *int64 {
 	return &i64
 }
 
+// SafeInt64Ptr returns nil for 0, which requests use for unset.
+func SafeInt64Ptr(a int64) *int64 {
+	if a == 0 {
+		return nil
+	}
+	return &a
+}
+
 func ParseInt64Val(a string) int64 {
 	if a == "" {
 		return 0
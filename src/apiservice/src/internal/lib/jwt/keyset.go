@@ -0,0 +1,69 @@
+package jwt
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"sync"
+	"time"
+
+	"golang.org/x/xerrors"
+)
+
+// REFETCH_INTERVAL bounds how often an unknown kid makes KeySet fetch the
+// keys again, so tokens with made up kids cannot flood authservice.
+var REFETCH_INTERVAL = 30 * time.Second
+
+// KeySet caches the public keys access tokens are verified with. Keys are
+// fetched on first use and again when a token names a kid that is not
+// cached, which is how a key rotation on authservice gets picked up.
+type KeySet struct {
+	fetch     func(ctx context.Context) ([]JWK, error)
+	mu        sync.Mutex
+	keys      map[string]*ecdsa.PublicKey
+	fetchedAt time.Time
+	now       func() time.Time
+}
+
+func NewKeySet(fetch func(ctx context.Context) ([]JWK, error)) *KeySet {
+	return &KeySet{
+		fetch: fetch,
+		keys:  make(map[string]*ecdsa.PublicKey),
+		now:   time.Now,
+	}
+}
+
+// Verify verifies token with the cached keys.
+func (k *KeySet) Verify(ctx context.Context, token string) (*Claims, error) {
+	return Verify(token, func(kid string) (*ecdsa.PublicKey, error) {
+		return k.Key(ctx, kid)
+	}, k.now())
+}
+
+func (k *KeySet) Key(ctx context.Context, kid string) (*ecdsa.PublicKey, error) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	if pub, ok := k.keys[kid]; ok {
+		return pub, nil
+	}
+	if !k.fetchedAt.IsZero() && k.now().Sub(k.fetchedAt) < REFETCH_INTERVAL {
+		return nil, ErrUnknownKey
+	}
+	jwks, err := k.fetch(ctx)
+	if err != nil {
+		return nil, xerrors.Errorf("%w", err)
+	}
+	k.fetchedAt = k.now()
+	keys := make(map[string]*ecdsa.PublicKey, len(jwks))
+	for _, jwk := range jwks {
+		pub, err := jwk.PublicKey()
+		if err != nil {
+			continue
+		}
+		keys[jwk.Kid] = pub
+	}
+	k.keys = keys
+	if pub, ok := keys[kid]; ok {
+		return pub, nil
+	}
+	return nil, ErrUnknownKey
+}
@@ -0,0 +1,103 @@
+package jwt
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newKey(t *testing.T, kid string) (*ecdsa.PrivateKey, JWK) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.Nil(t, err)
+	x := make([]byte, size)
+	y := make([]byte, size)
+	key.X.FillBytes(x)
+	key.Y.FillBytes(y)
+	return key, JWK{
+		Kty: "EC",
+		Crv: "P-256",
+		X:   encoding.EncodeToString(x),
+		Y:   encoding.EncodeToString(y),
+		Kid: kid,
+		Alg: ALG,
+		Use: "sig",
+	}
+}
+
+func sign(t *testing.T, key *ecdsa.PrivateKey, kid string, claims Claims) string {
+	bHeader, err := json.Marshal(header{Alg: ALG, Typ: "JWT", Kid: kid})
+	assert.Nil(t, err)
+	bClaims, err := json.Marshal(claims)
+	assert.Nil(t, err)
+	input := encoding.EncodeToString(bHeader) + "." + encoding.EncodeToString(bClaims)
+	digest := sha256.Sum256([]byte(input))
+	r, s, err := ecdsa.Sign(rand.Reader, key, digest[:])
+	assert.Nil(t, err)
+	sig := make([]byte, 2*size)
+	r.FillBytes(sig[:size])
+	s.FillBytes(sig[size:])
+	return input + "." + encoding.EncodeToString(sig)
+}
+
+func TestKeySetVerify(t *testing.T) {
+	ctx := context.Background()
+	key, jwk := newKey(t, "a")
+	fetches := 0
+	ks := NewKeySet(func(ctx context.Context) ([]JWK, error) {
+		fetches++
+		return []JWK{jwk}, nil
+	})
+	exp := time.Now().Add(time.Minute).Unix()
+
+	claims, err := ks.Verify(ctx, sign(t, key, "a", Claims{Subject: "user", Role: 1, ExpiresAt: exp}))
+	assert.Nil(t, err)
+	assert.Equal(t, "user", claims.Subject)
+	assert.Equal(t, int32(1), claims.Role)
+
+	_, err = ks.Verify(ctx, sign(t, key, "a", Claims{Subject: "user", ExpiresAt: exp}))
+	assert.Nil(t, err)
+	assert.Equal(t, 1, fetches)
+
+	other, _ := newKey(t, "a")
+	_, err = ks.Verify(ctx, sign(t, other, "a", Claims{Subject: "user", ExpiresAt: exp}))
+	assert.ErrorIs(t, err, ErrInvalidSignature)
+
+	_, err = ks.Verify(ctx, sign(t, key, "a", Claims{Subject: "user", ExpiresAt: time.Now().Unix()}))
+	assert.ErrorIs(t, err, ErrExpired)
+}
+
+func TestKeySetRefetch(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	keyA, jwkA := newKey(t, "a")
+	keyB, jwkB := newKey(t, "b")
+	jwks := []JWK{jwkA}
+	fetches := 0
+	ks := NewKeySet(func(ctx context.Context) ([]JWK, error) {
+		fetches++
+		return jwks, nil
+	})
+	ks.now = func() time.Time { return now }
+	exp := now.Add(time.Hour).Unix()
+
+	_, err := ks.Verify(ctx, sign(t, keyA, "a", Claims{ExpiresAt: exp}))
+	assert.Nil(t, err)
+
+	// authservice rotated to b, but unknown kids are not refetched right away.
+	jwks = []JWK{jwkA, jwkB}
+	_, err = ks.Verify(ctx, sign(t, keyB, "b", Claims{ExpiresAt: exp}))
+	assert.ErrorIs(t, err, ErrUnknownKey)
+	assert.Equal(t, 1, fetches)
+
+	now = now.Add(REFETCH_INTERVAL)
+	_, err = ks.Verify(ctx, sign(t, keyB, "b", Claims{ExpiresAt: exp}))
+	assert.Nil(t, err)
+	assert.Equal(t, 2, fetches)
+}
@@ -0,0 +1,164 @@
+package mtls
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"golang.org/x/xerrors"
+)
+
+const (
+	devCAFile     = "ca.pem"
+	devCAValidity = 10 * 365 * 24 * time.Hour
+	devValidity   = 30 * 24 * time.Hour
+)
+
+// newDevCredentials issues a certificate for identity from the CA in dir,
+// generating the CA on first use. Services started with the same dir trust
+// each other without any setup.
+func newDevCredentials(dir, identity string) (*Credentials, error) {
+	ca, caKey, err := loadOrCreateDevCA(dir)
+	if err != nil {
+		return nil, xerrors.Errorf("%w", err)
+	}
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, xerrors.Errorf("%w", err)
+	}
+	serial, err := newSerial()
+	if err != nil {
+		return nil, xerrors.Errorf("%w", err)
+	}
+	now := time.Now()
+	tmpl := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: identity},
+		// docker compose names containers without the dash of the identity
+		DNSNames:    []string{identity, strings.ReplaceAll(identity, "-", ""), "localhost"},
+		IPAddresses: []net.IP{net.IPv4(127, 0, 0, 1), net.IPv6loopback},
+		NotBefore:   now.Add(-time.Minute),
+		NotAfter:    now.Add(devValidity),
+		KeyUsage:    x509.KeyUsageDigitalSignature,
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca, &key.PublicKey, caKey)
+	if err != nil {
+		return nil, xerrors.Errorf("%w", err)
+	}
+	pool := x509.NewCertPool()
+	pool.AddCert(ca)
+	return &Credentials{
+		cert: tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key},
+		pool: pool,
+	}, nil
+}
+
+func loadOrCreateDevCA(dir string) (*x509.Certificate, *ecdsa.PrivateKey, error) {
+	path := filepath.Join(dir, devCAFile)
+	if raw, err := os.ReadFile(path); err == nil {
+		return parseDevCA(raw)
+	} else if !os.IsNotExist(err) {
+		return nil, nil, xerrors.Errorf("%w", err)
+	}
+	raw, err := newDevCA()
+	if err != nil {
+		return nil, nil, xerrors.Errorf("%w", err)
+	}
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, nil, xerrors.Errorf("%w", err)
+	}
+	// Link the complete file into place so a service starting at the same
+	// time either wins or reads the CA of the one that did.
+	tmp, err := os.CreateTemp(dir, devCAFile)
+	if err != nil {
+		return nil, nil, xerrors.Errorf("%w", err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(raw); err != nil {
+		_ = tmp.Close()
+		return nil, nil, xerrors.Errorf("%w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return nil, nil, xerrors.Errorf("%w", err)
+	}
+	if err := os.Link(tmp.Name(), path); err != nil {
+		if !os.IsExist(err) {
+			return nil, nil, xerrors.Errorf("%w", err)
+		}
+		if raw, err = os.ReadFile(path); err != nil {
+			return nil, nil, xerrors.Errorf("%w", err)
+		}
+	}
+	return parseDevCA(raw)
+}
+
+// newDevCA returns a PEM encoded self-signed CA certificate followed by its
+// private key.
+func newDevCA() ([]byte, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, xerrors.Errorf("%w", err)
+	}
+	serial, err := newSerial()
+	if err != nil {
+		return nil, xerrors.Errorf("%w", err)
+	}
+	now := time.Now()
+	tmpl := &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{CommonName: "dev ca"},
+		NotBefore:             now.Add(-time.Minute),
+		NotAfter:              now.Add(devCAValidity),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		return nil, xerrors.Errorf("%w", err)
+	}
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, xerrors.Errorf("%w", err)
+	}
+	raw := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	return append(raw, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})...), nil
+}
+
+func parseDevCA(raw []byte) (*x509.Certificate, *ecdsa.PrivateKey, error) {
+	var (
+		ca  *x509.Certificate
+		key *ecdsa.PrivateKey
+		err error
+	)
+	for block, rest := pem.Decode(raw); block != nil; block, rest = pem.Decode(rest) {
+		switch block.Type {
+		case "CERTIFICATE":
+			ca, err = x509.ParseCertificate(block.Bytes)
+		case "EC PRIVATE KEY":
+			key, err = x509.ParseECPrivateKey(block.Bytes)
+		}
+		if err != nil {
+			return nil, nil, xerrors.Errorf("%w", err)
+		}
+	}
+	if ca == nil || key == nil {
+		return nil, nil, xerrors.Errorf("%w", ErrInvalidCA)
+	}
+	return ca, key, nil
+}
+
+func newSerial() (*big.Int, error) {
+	return rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+}
@@ -1,13 +1,27 @@
 package lib
 
 import (
+	"math"
 	"net/http"
+	"strconv"
 
+	"github.com/aqaurius6666/apiservice/src/internal/var/e"
 	"github.com/aqaurius6666/go-utils/utils"
 	"github.com/gin-gonic/gin"
+	"golang.org/x/xerrors"
 )
 
 func BadRequest(c *gin.Context, err error) {
+	var throttled *e.TooManyRequestsError
+	if xerrors.As(err, &throttled) {
+		TooManyRequests(c, err, throttled)
+		return
+	}
+	var suspended *e.SuspendedError
+	if xerrors.As(err, &suspended) {
+		Suspended(c, err, suspended)
+		return
+	}
 	c.Set("error", err)
 	err = utils.Unwrap(err)
 	c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
@@ -32,9 +46,40 @@ func Success(c *gin.Context, response interface{}) {
 }
 
 func Unauthorized(c *gin.Context, err error) {
+	var throttled *e.TooManyRequestsError
+	if xerrors.As(err, &throttled) {
+		TooManyRequests(c, err, throttled)
+		return
+	}
+	var suspended *e.SuspendedError
+	if xerrors.As(err, &suspended) {
+		Suspended(c, err, suspended)
+		return
+	}
 	c.Set("error", err)
 	err = utils.Unwrap(err)
 	c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
 		"error": err.Error(),
 	})
 }
+
+func TooManyRequests(c *gin.Context, err error, t *e.TooManyRequestsError) {
+	c.Set("error", err)
+	err = utils.Unwrap(err)
+	retryAfter := int64(math.Ceil(t.RetryAfter.Seconds()))
+	c.Header("Retry-After", strconv.FormatInt(retryAfter, 10))
+	c.AbortWithStatusJSON(http.StatusTooManyRequests, gin.H{
+		"error":      err.Error(),
+		"retryAfter": retryAfter,
+	})
+}
+
+func Suspended(c *gin.Context, err error, s *e.SuspendedError) {
+	c.Set("error", err)
+	err = utils.Unwrap(err)
+	c.AbortWithStatusJSON(http.StatusForbidden, gin.H{
+		"error":     err.Error(),
+		"reason":    s.Reason.String(),
+		"expiresAt": s.ExpiresAt,
+	})
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/aqaurius6666/apiservice/src/internal/db/user"
 	"github.com/aqaurius6666/apiservice/src/internal/lib"
 	"github.com/aqaurius6666/apiservice/src/internal/var/c"
+	"github.com/aqaurius6666/apiservice/src/services/authservice"
 	"github.com/aqaurius6666/go-utils/database"
 	"github.com/aqaurius6666/go-utils/utils"
 	"go.opentelemetry.io/otel"
@@ -19,12 +20,16 @@ var (
 )
 
 type AdminModel interface {
-	InactiveUser(ctx context.Context, id interface{}) (string, c.ROLE, error)
-	InactiveBusiness(ctx context.Context, id interface{}) (string, c.ROLE, error)
-	ActiveUser(ctx context.Context, id interface{}) (string, c.ROLE, error)
-	ActiveBusiness(ctx context.Context, id interface{}) (string, c.ROLE, error)
+	InactiveUser(ctx context.Context, id interface{}, sus *authservice.Suspension) (*authservice.Suspension, c.ROLE, error)
+	InactiveBusiness(ctx context.Context, id interface{}, sus *authservice.Suspension) (*authservice.Suspension, c.ROLE, error)
+	ActiveUser(ctx context.Context, id interface{}, adminId string) (c.ROLE, error)
+	ActiveBusiness(ctx context.Context, id interface{}, adminId string) (c.ROLE, error)
 	DeleteBusiness(ctx context.Context, id interface{}) error
 	DeleteUser(ctx context.Context, id interface{}) error
+	ListPermissions(ctx context.Context) ([]*authservice.Permission, error)
+	ListRoles(ctx context.Context) ([]*authservice.Role, error)
+	SetRolePermissions(ctx context.Context, role c.ROLE, permissions []string) ([]string, error)
+	Impersonate(ctx context.Context, adminId, userId interface{}, reason string) (accessToken string, expiresAt int64, sessionId string, err error)
 }
 
 func (s *ServerModel) DeleteUser(ctx context.Context, id interface{}) error {
@@ -100,7 +105,7 @@ func (s *ServerModel) DeleteBusiness(ctx context.Context, id interface{}) error
 	}
 	return nil
 }
-func (s *ServerModel) InactiveUser(ctx context.Context, id interface{}) (string, c.ROLE, error) {
+func (s *ServerModel) InactiveUser(ctx context.Context, id interface{}, sus *authservice.Suspension) (*authservice.Suspension, c.ROLE, error) {
 	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.InactiveUser))
 	defer span.End()
 
@@ -108,25 +113,25 @@ func (s *ServerModel) InactiveUser(ctx context.Context, id interface{}) (string,
 	if err != nil {
 		err = xerrors.Errorf("%w", err)
 		lib.RecordError(span, err, ctx)
-		return "", 0, err
+		return nil, 0, err
 	}
-	nid, r, err := s.Auth.BanUser(ctx, uid.String(), false)
+	r, sus, err := s.Auth.SuspendUser(ctx, uid.String(), sus)
 	if err != nil {
 		err = xerrors.Errorf("%w", err)
 		lib.RecordError(span, err, ctx)
-		return "", r, err
+		return nil, r, err
 	}
 	if err = s.UpdateUser(ctx, uid, &user.User{
 		Status: utils.Int32Ptr(int32(c.ACCOUNT_STATUS_INACTIVE)),
 	}); err != nil {
 		err = xerrors.Errorf("%w", err)
 		lib.RecordError(span, err, ctx)
-		return "", 0, err
+		return nil, 0, err
 	}
-	return nid, r, nil
+	return sus, r, nil
 }
 
-func (s *ServerModel) InactiveBusiness(ctx context.Context, id interface{}) (string, c.ROLE, error) {
+func (s *ServerModel) InactiveBusiness(ctx context.Context, id interface{}, sus *authservice.Suspension) (*authservice.Suspension, c.ROLE, error) {
 	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.InactiveBusiness))
 	defer span.End()
 
@@ -134,49 +139,49 @@ func (s *ServerModel) InactiveBusiness(ctx context.Context, id interface{}) (str
 	if err != nil {
 		err = xerrors.Errorf("%w", err)
 		lib.RecordError(span, err, ctx)
-		return "", 0, err
+		return nil, 0, err
 	}
-	nid, r, err := s.Auth.BanUser(ctx, uid.String(), false)
+	r, sus, err := s.Auth.SuspendUser(ctx, uid.String(), sus)
 	if err != nil {
 		err = xerrors.Errorf("%w", err)
 		lib.RecordError(span, err, ctx)
-		return "", 0, err
+		return nil, 0, err
 	}
 	if err = s.UpdateBusiness(ctx, uid, &business.Business{
 		Status: utils.Int32Ptr(int32(c.ACCOUNT_STATUS_INACTIVE)),
 	}); err != nil {
 		err = xerrors.Errorf("%w", err)
 		lib.RecordError(span, err, ctx)
-		return "", 0, err
+		return nil, 0, err
 	}
-	return nid, r, nil
+	return sus, r, nil
 }
-func (s *ServerModel) ActiveUser(ctx context.Context, id interface{}) (string, c.ROLE, error) {
+func (s *ServerModel) ActiveUser(ctx context.Context, id interface{}, adminId string) (c.ROLE, error) {
 	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.ActiveUser))
 	defer span.End()
 	uid, err := lib.ToUUID(id)
 	if err != nil {
 		err = xerrors.Errorf("%w", err)
 		lib.RecordError(span, err, ctx)
-		return "", 0, err
+		return 0, err
 	}
-	nid, r, err := s.Auth.BanUser(ctx, uid.String(), true)
+	r, err := s.Auth.LiftSuspension(ctx, uid.String(), adminId)
 	if err != nil {
 		err = xerrors.Errorf("%w", err)
 		lib.RecordError(span, err, ctx)
-		return "", 0, err
+		return 0, err
 	}
 	if err = s.UpdateUser(ctx, uid, &user.User{
 		Status: utils.Int32Ptr(int32(c.ACCOUNT_STATUS_ACTIVE)),
 	}); err != nil {
 		err = xerrors.Errorf("%w", err)
 		lib.RecordError(span, err, ctx)
-		return "", 0, err
+		return 0, err
 	}
-	return nid, r, nil
+	return r, nil
 }
 
-func (s *ServerModel) ActiveBusiness(ctx context.Context, id interface{}) (string, c.ROLE, error) {
+func (s *ServerModel) ActiveBusiness(ctx context.Context, id interface{}, adminId string) (c.ROLE, error) {
 	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.ActiveBusiness))
 	defer span.End()
 
@@ -184,20 +189,86 @@ func (s *ServerModel) ActiveBusiness(ctx context.Context, id interface{}) (strin
 	if err != nil {
 		err = xerrors.Errorf("%w", err)
 		lib.RecordError(span, err, ctx)
-		return "", 0, err
+		return 0, err
 	}
-	nid, r, err := s.Auth.BanUser(ctx, uid.String(), true)
+	r, err := s.Auth.LiftSuspension(ctx, uid.String(), adminId)
 	if err != nil {
 		err = xerrors.Errorf("%w", err)
 		lib.RecordError(span, err, ctx)
-		return "", 0, err
+		return 0, err
 	}
 	if err = s.UpdateBusiness(ctx, uid, &business.Business{
 		Status: utils.Int32Ptr(int32(c.ACCOUNT_STATUS_ACTIVE)),
 	}); err != nil {
 		err = xerrors.Errorf("%w", err)
 		lib.RecordError(span, err, ctx)
-		return "", 0, err
+		return 0, err
 	}
-	return nid, r, nil
+	return r, nil
+}
+
+func (s *ServerModel) ListPermissions(ctx context.Context) ([]*authservice.Permission, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.ListPermissions))
+	defer span.End()
+
+	perms, err := s.Auth.ListPermissions(ctx)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	return perms, nil
+}
+
+func (s *ServerModel) ListRoles(ctx context.Context) ([]*authservice.Role, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.ListRoles))
+	defer span.End()
+
+	roles, err := s.Auth.ListRoles(ctx)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	return roles, nil
+}
+
+func (s *ServerModel) SetRolePermissions(ctx context.Context, role c.ROLE, permissions []string) ([]string, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.SetRolePermissions))
+	defer span.End()
+
+	perms, err := s.Auth.SetRolePermissions(ctx, role, permissions)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	return perms, nil
+}
+
+// Impersonate gets the admin with adminId a read only access token of the
+// user with userId. reason is kept in the audit trail of the session.
+func (s *ServerModel) Impersonate(ctx context.Context, adminId, userId interface{}, reason string) (string, int64, string, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.Impersonate))
+	defer span.End()
+
+	aid, err := lib.ToUUID(adminId)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return "", 0, "", err
+	}
+	uid, err := lib.ToUUID(userId)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return "", 0, "", err
+	}
+	token, exp, sessionId, err := s.Auth.Impersonate(ctx, aid.String(), uid.String(), reason)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return "", 0, "", err
+	}
+	return token, exp, sessionId, nil
 }
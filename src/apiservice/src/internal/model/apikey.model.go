@@ -0,0 +1,112 @@
+package model
+
+import (
+	"context"
+
+	"github.com/aqaurius6666/apiservice/src/internal/lib"
+	"github.com/aqaurius6666/apiservice/src/internal/var/c"
+	"github.com/aqaurius6666/apiservice/src/pb"
+	"github.com/aqaurius6666/apiservice/src/services/authservice"
+	"go.opentelemetry.io/otel"
+	"golang.org/x/xerrors"
+)
+
+var (
+	_ ApiKeyModel = (*ServerModel)(nil)
+)
+
+type ApiKeyModel interface {
+	CreateApiKey(ctx context.Context, userId interface{}, key *authservice.ApiKey, forBusiness bool) (*authservice.ApiKey, string, error)
+	ListApiKeys(ctx context.Context, userId interface{}) ([]*authservice.ApiKey, error)
+	RevokeApiKey(ctx context.Context, userId, id interface{}) error
+
+	ConvertApiKeyToProto(*authservice.ApiKey) *pb.ApiKey
+	ConvertApiKeysToProtos([]*authservice.ApiKey) []*pb.ApiKey
+}
+
+// CreateApiKey returns a new api key of userId and the key itself, which
+// cannot be shown again.
+func (s *ServerModel) CreateApiKey(ctx context.Context, userId interface{}, key *authservice.ApiKey, forBusiness bool) (*authservice.ApiKey, string, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.CreateApiKey))
+	defer span.End()
+
+	uid, err := lib.ToUUID(userId)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, "", err
+	}
+	k, secret, err := s.Auth.CreateApiKey(ctx, uid.String(), key, forBusiness)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, "", err
+	}
+	return k, secret, nil
+}
+
+func (s *ServerModel) ListApiKeys(ctx context.Context, userId interface{}) ([]*authservice.ApiKey, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.ListApiKeys))
+	defer span.End()
+
+	uid, err := lib.ToUUID(userId)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	keys, err := s.Auth.ListApiKeys(ctx, uid.String())
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	return keys, nil
+}
+
+func (s *ServerModel) RevokeApiKey(ctx context.Context, userId, id interface{}) error {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.RevokeApiKey))
+	defer span.End()
+
+	uid, err := lib.ToUUID(userId)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return err
+	}
+	kid, err := lib.ToUUID(id)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return err
+	}
+	if err := s.Auth.RevokeApiKey(ctx, uid.String(), kid.String()); err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return err
+	}
+	return nil
+}
+
+func (s *ServerModel) ConvertApiKeyToProto(k *authservice.ApiKey) *pb.ApiKey {
+	return &pb.ApiKey{
+		Id:         k.Id,
+		Name:       k.Name,
+		Prefix:     k.Prefix,
+		Scopes:     k.Scopes,
+		BusinessId: k.BusinessId,
+		RateLimit:  k.RateLimit,
+		ExpiresAt:  k.ExpiresAt,
+		LastUsedAt: k.LastUsedAt,
+		Revoked:    k.Revoked,
+		CreatedAt:  k.CreatedAt,
+	}
+}
+
+func (s *ServerModel) ConvertApiKeysToProtos(keys []*authservice.ApiKey) []*pb.ApiKey {
+	arr := make([]*pb.ApiKey, 0, len(keys))
+	for _, k := range keys {
+		arr = append(arr, s.ConvertApiKeyToProto(k))
+	}
+	return arr
+}
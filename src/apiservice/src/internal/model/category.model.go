@@ -91,6 +91,10 @@ func (s *ServerModel) ConvertCategoryToProto(u *category.Category) *pb.Category
 	if u.ImageUrl != nil {
 		upb.Image = *u.ImageUrl
 	}
+	if u.OrderExpiry != nil {
+		upb.OrderExpiryHours = *u.OrderExpiry
+	}
+	convertQuestionsToProto(u, upb)
 	return upb
 }
 
@@ -0,0 +1,271 @@
+package model
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/aqaurius6666/apiservice/src/internal/db/category"
+	"github.com/aqaurius6666/apiservice/src/internal/db/order"
+	"github.com/aqaurius6666/apiservice/src/internal/lib"
+	"github.com/aqaurius6666/apiservice/src/internal/var/c"
+	"github.com/aqaurius6666/apiservice/src/internal/var/e"
+	"github.com/aqaurius6666/apiservice/src/pb"
+	"github.com/aqaurius6666/go-utils/database"
+	"github.com/aqaurius6666/go-utils/utils"
+	"go.opentelemetry.io/otel"
+	"golang.org/x/xerrors"
+)
+
+var (
+	_ CategoryQuestionModel = (*ServerModel)(nil)
+)
+
+const (
+	MAX_CATEGORY_QUESTIONS = 30
+	MAX_ANSWER_LENGTH      = 500
+	MAX_ANSWER_PHOTOS      = 10
+	// the summary goes to the customer message of orders, a varchar(256)
+	MAX_JOB_SUMMARY_LENGTH = 256
+)
+
+type CategoryQuestionModel interface {
+	SetCategoryQuestions(ctx context.Context, categoryId interface{}, questions []CategoryQuestion) error
+}
+
+// CategoryQuestion is a question customers answer about the job when they
+// post an order for a category. It is stored as json on the category.
+type CategoryQuestion struct {
+	Key      string          `json:"key"`
+	Label    string          `json:"label"`
+	Type     c.QUESTION_TYPE `json:"type"`
+	Required bool            `json:"required,omitempty"`
+	Options  []string        `json:"options,omitempty"`
+}
+
+// JobDetail is an answered question. Label and Type are copied from the
+// question so orders keep reading the same after the questions change.
+type JobDetail struct {
+	Key    string          `json:"key"`
+	Label  string          `json:"label"`
+	Type   c.QUESTION_TYPE `json:"type"`
+	Values []string        `json:"values"`
+}
+
+// checkCategoryQuestions tells whether questions make a usable form: keys
+// and labels are set, keys are unique and only CHOICE questions have
+// options, at least two of them.
+func checkCategoryQuestions(questions []CategoryQuestion) error {
+	if len(questions) > MAX_CATEGORY_QUESTIONS {
+		return e.ErrInvalidQuestions
+	}
+	keys := make(map[string]bool, len(questions))
+	for _, q := range questions {
+		if q.Key == "" || strings.TrimSpace(q.Label) == "" || keys[q.Key] {
+			return e.ErrInvalidQuestions
+		}
+		keys[q.Key] = true
+		if _, ok := c.QUESTION_TYPE_name[int32(q.Type)]; !ok {
+			return e.ErrInvalidQuestions
+		}
+		if q.Type != c.QUESTION_TYPE_CHOICE {
+			if len(q.Options) != 0 {
+				return e.ErrInvalidQuestions
+			}
+			continue
+		}
+		if len(q.Options) < 2 {
+			return e.ErrInvalidQuestions
+		}
+		options := make(map[string]bool, len(q.Options))
+		for _, o := range q.Options {
+			if strings.TrimSpace(o) == "" || options[o] {
+				return e.ErrInvalidQuestions
+			}
+			options[o] = true
+		}
+	}
+	return nil
+}
+
+// CategoryQuestions returns the questions of u, none when it has no form.
+func CategoryQuestions(u *category.Category) []CategoryQuestion {
+	if u.Questions == nil {
+		return nil
+	}
+	var questions []CategoryQuestion
+	// questions are written by SetCategoryQuestions, a broken value is only dropped
+	_ = json.Unmarshal([]byte(*u.Questions), &questions)
+	return questions
+}
+
+// AnswerCategoryQuestions checks the answers of a customer, by question key,
+// against questions. It fails with e.ErrInvalidAnswer naming the first
+// question answered wrong, or missing when it is required.
+func AnswerCategoryQuestions(questions []CategoryQuestion, answers map[string][]string) ([]JobDetail, error) {
+	asked := make(map[string]bool, len(questions))
+	for _, q := range questions {
+		asked[q.Key] = true
+	}
+	for key := range answers {
+		if !asked[key] {
+			return nil, e.ErrInvalidAnswer(key)
+		}
+	}
+	details := make([]JobDetail, 0, len(answers))
+	for _, q := range questions {
+		values := answers[q.Key]
+		if len(values) == 0 || (len(values) == 1 && strings.TrimSpace(values[0]) == "") {
+			if q.Required {
+				return nil, e.ErrInvalidAnswer(q.Key)
+			}
+			continue
+		}
+		values, ok := q.answer(values)
+		if !ok {
+			return nil, e.ErrInvalidAnswer(q.Key)
+		}
+		details = append(details, JobDetail{Key: q.Key, Label: q.Label, Type: q.Type, Values: values})
+	}
+	return details, nil
+}
+
+// answer checks values answer q, returning them cleaned up.
+func (q CategoryQuestion) answer(values []string) ([]string, bool) {
+	if q.Type == c.QUESTION_TYPE_PHOTOS {
+		if len(values) > MAX_ANSWER_PHOTOS {
+			return nil, false
+		}
+		for _, v := range values {
+			u, err := url.Parse(v)
+			if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+				return nil, false
+			}
+		}
+		return values, true
+	}
+	if len(values) != 1 {
+		return nil, false
+	}
+	v := strings.TrimSpace(values[0])
+	switch q.Type {
+	case c.QUESTION_TYPE_TEXT:
+		return []string{v}, utf8.RuneCountInString(v) <= MAX_ANSWER_LENGTH
+	case c.QUESTION_TYPE_YES_NO:
+		v = strings.ToLower(v)
+		return []string{v}, v == "yes" || v == "no"
+	case c.QUESTION_TYPE_NUMBER:
+		_, err := strconv.ParseFloat(v, 64)
+		return []string{v}, err == nil
+	case c.QUESTION_TYPE_CHOICE:
+		for _, o := range q.Options {
+			if o == v {
+				return []string{v}, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// JobSummary writes details as one line for handymen, short enough to be the
+// customer message of an order.
+func JobSummary(details []JobDetail) string {
+	parts := make([]string, 0, len(details))
+	for _, d := range details {
+		value := strings.Join(d.Values, ", ")
+		if d.Type == c.QUESTION_TYPE_PHOTOS {
+			value = fmt.Sprintf("%d photo(s)", len(d.Values))
+		}
+		parts = append(parts, d.Label+": "+value)
+	}
+	summary := strings.Join(parts, "; ")
+	if utf8.RuneCountInString(summary) > MAX_JOB_SUMMARY_LENGTH {
+		summary = string([]rune(summary)[:MAX_JOB_SUMMARY_LENGTH-3]) + "..."
+	}
+	return summary
+}
+
+func encodeJobDetails(details []JobDetail) (*string, error) {
+	if len(details) == 0 {
+		return nil, nil
+	}
+	raw, err := json.Marshal(details)
+	if err != nil {
+		return nil, xerrors.Errorf("%w", err)
+	}
+	return utils.StrPtr(string(raw)), nil
+}
+
+// SetCategoryQuestions replaces the questions of a category. Orders already
+// posted keep the answers they were given.
+func (s *ServerModel) SetCategoryQuestions(ctx context.Context, categoryId interface{}, questions []CategoryQuestion) error {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.SetCategoryQuestions))
+	defer span.End()
+
+	cid, err := lib.ToUUID(categoryId)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return err
+	}
+	if err := checkCategoryQuestions(questions); err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return err
+	}
+	if questions == nil {
+		questions = []CategoryQuestion{}
+	}
+	raw, err := json.Marshal(questions)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return err
+	}
+	err = s.Repo.UpdateCategory(ctx, &category.Search{
+		Category: category.Category{
+			BaseModel: database.BaseModel{ID: cid},
+		},
+	}, &category.Category{
+		Questions: utils.StrPtr(string(raw)),
+	})
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return err
+	}
+	return nil
+}
+
+func convertQuestionsToProto(u *category.Category, upb *pb.Category) {
+	for _, q := range CategoryQuestions(u) {
+		upb.Questions = append(upb.Questions, &pb.CategoryQuestion{
+			Key:      q.Key,
+			Label:    q.Label,
+			Type:     q.Type,
+			Required: q.Required,
+			Options:  q.Options,
+		})
+	}
+}
+
+func convertJobDetailsToProto(u *order.Order, upb *pb.Order) {
+	if u.JobDetails == nil {
+		return
+	}
+	var details []JobDetail
+	// details are written by CreateOrderV2, a broken value is only dropped
+	_ = json.Unmarshal([]byte(*u.JobDetails), &details)
+	for _, d := range details {
+		upb.Details = append(upb.Details, &pb.JobDetail{
+			Key:    d.Key,
+			Label:  d.Label,
+			Type:   d.Type,
+			Values: d.Values,
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/aqaurius6666/apiservice/src/internal/db/order"
+	"github.com/aqaurius6666/apiservice/src/internal/var/c"
+	"github.com/aqaurius6666/apiservice/src/internal/var/e"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/xerrors"
+)
+
+var plumbingQuestions = []CategoryQuestion{
+	{Key: "leak", Label: "Leak location", Type: c.QUESTION_TYPE_CHOICE, Required: true, Options: []string{"Kitchen", "Bathroom"}},
+	{Key: "shutoff", Label: "Water shut off", Type: c.QUESTION_TYPE_YES_NO, Required: true},
+	{Key: "pipes", Label: "Pipes affected", Type: c.QUESTION_TYPE_NUMBER},
+	{Key: "photos", Label: "Photos", Type: c.QUESTION_TYPE_PHOTOS},
+}
+
+func TestCheckCategoryQuestions(t *testing.T) {
+	assert.Nil(t, checkCategoryQuestions(plumbingQuestions))
+	assert.Nil(t, checkCategoryQuestions(nil))
+
+	for _, qs := range [][]CategoryQuestion{
+		{{Key: "", Label: "x"}},
+		{{Key: "a", Label: " "}},
+		{{Key: "a", Label: "x"}, {Key: "a", Label: "y"}},
+		{{Key: "a", Label: "x", Type: c.QUESTION_TYPE(42)}},
+		{{Key: "a", Label: "x", Type: c.QUESTION_TYPE_TEXT, Options: []string{"a", "b"}}},
+		{{Key: "a", Label: "x", Type: c.QUESTION_TYPE_CHOICE, Options: []string{"a"}}},
+		{{Key: "a", Label: "x", Type: c.QUESTION_TYPE_CHOICE, Options: []string{"a", "a"}}},
+	} {
+		assert.True(t, xerrors.Is(checkCategoryQuestions(qs), e.ErrInvalidQuestions), qs)
+	}
+}
+
+func TestAnswerCategoryQuestions(t *testing.T) {
+	details, err := AnswerCategoryQuestions(plumbingQuestions, map[string][]string{
+		"leak":    {"Kitchen"},
+		"shutoff": {" YES "},
+		"photos":  {"https://cdn.example.com/a.png", "https://cdn.example.com/b.png"},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []JobDetail{
+		{Key: "leak", Label: "Leak location", Type: c.QUESTION_TYPE_CHOICE, Values: []string{"Kitchen"}},
+		{Key: "shutoff", Label: "Water shut off", Type: c.QUESTION_TYPE_YES_NO, Values: []string{"yes"}},
+		{Key: "photos", Label: "Photos", Type: c.QUESTION_TYPE_PHOTOS, Values: []string{"https://cdn.example.com/a.png", "https://cdn.example.com/b.png"}},
+	}, details)
+	assert.Equal(t, "Leak location: Kitchen; Water shut off: yes; Photos: 2 photo(s)", JobSummary(details))
+
+	for key, answers := range map[string]map[string][]string{
+		"shutoff": {"leak": {"Kitchen"}},
+		"leak":    {"leak": {"Garage"}, "shutoff": {"no"}},
+		"pipes":   {"leak": {"Kitchen"}, "shutoff": {"no"}, "pipes": {"two"}},
+		"photos":  {"leak": {"Kitchen"}, "shutoff": {"no"}, "photos": {"file:///etc/passwd"}},
+		"color":   {"leak": {"Kitchen"}, "shutoff": {"no"}, "color": {"red"}},
+	} {
+		_, err := AnswerCategoryQuestions(plumbingQuestions, answers)
+		assert.Equal(t, e.ErrInvalidAnswer(key).Error(), err.Error(), key)
+	}
+
+	details, err = AnswerCategoryQuestions(nil, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "", JobSummary(details))
+}
+
+func TestConvertJobDetailsToProto(t *testing.T) {
+	s := &ServerModel{}
+	details := []JobDetail{{Key: "shutoff", Label: "Water shut off", Type: c.QUESTION_TYPE_YES_NO, Values: []string{"no"}}}
+	raw, err := encodeJobDetails(details)
+	assert.Nil(t, err)
+
+	upb := s.ConvertOrderToProto(&order.Order{JobDetails: raw})
+	assert.Len(t, upb.Details, 1)
+	assert.Equal(t, "Water shut off", upb.Details[0].Label)
+	assert.Equal(t, []string{"no"}, upb.Details[0].Values)
+
+	raw, err = encodeJobDetails(nil)
+	assert.Nil(t, err)
+	assert.Nil(t, raw)
+}
@@ -0,0 +1,340 @@
+package model
+
+import (
+	"context"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/aqaurius6666/apiservice/src/internal/db/business"
+	"github.com/aqaurius6666/apiservice/src/internal/db/dispute"
+	"github.com/aqaurius6666/apiservice/src/internal/db/order"
+	"github.com/aqaurius6666/apiservice/src/internal/db/transaction"
+	"github.com/aqaurius6666/apiservice/src/internal/lib"
+	"github.com/aqaurius6666/apiservice/src/internal/var/c"
+	"github.com/aqaurius6666/apiservice/src/internal/var/e"
+	"github.com/aqaurius6666/apiservice/src/pb"
+	"github.com/aqaurius6666/go-utils/database"
+	"github.com/aqaurius6666/go-utils/utils"
+	"go.opentelemetry.io/otel"
+	"golang.org/x/xerrors"
+)
+
+var (
+	_ DisputeModel = (*ServerModel)(nil)
+)
+
+const (
+	MAX_DISPUTE_NOTE_SIZE = 1024
+)
+
+type DisputeModel interface {
+	FileDispute(ctx context.Context, ord *order.Order, evidence DisputeEvidence) (*dispute.Dispute, error)
+	GetDisputeById(ctx context.Context, id interface{}) (*dispute.Dispute, error)
+	ListDisputes(ctx context.Context, search *dispute.Search) ([]*dispute.Dispute, error)
+	TotalDisputes(ctx context.Context, search *dispute.Search) (*int64, error)
+	ReviewDispute(ctx context.Context, d *dispute.Dispute, reviewerId string, approve bool, note string) (*dispute.Dispute, error)
+	PresignDisputeAttachments(ctx context.Context, ds []*dispute.Dispute, upbs []*pb.Dispute) error
+
+	ConvertDisputeToProto(u *dispute.Dispute) *pb.Dispute
+	ConvertDisputeToProtos(u []*dispute.Dispute) []*pb.Dispute
+}
+
+// DisputeEvidence is what a handyman gives to back a dispute. Attachments are
+// checked by CheckOrderAttachments.
+type DisputeEvidence struct {
+	Reason      c.DISPUTE_REASON
+	Note        string
+	Attachments []Attachment
+}
+
+func (v DisputeEvidence) check() error {
+	if _, ok := c.DISPUTE_REASON_name[int32(v.Reason)]; !ok {
+		return e.ErrInvalidDispute
+	}
+	if strings.TrimSpace(v.Note) == "" || utf8.RuneCountInString(v.Note) > MAX_DISPUTE_NOTE_SIZE {
+		return e.ErrInvalidDispute
+	}
+	return nil
+}
+
+// FileDispute disputes the connection fee of ord for its handyman. Only
+// connected orders whose fee was recorded can be disputed, once.
+func (s *ServerModel) FileDispute(ctx context.Context, ord *order.Order, evidence DisputeEvidence) (*dispute.Dispute, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.FileDispute))
+	defer span.End()
+
+	if err := evidence.check(); err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	if utils.Int32Val(ord.Status) != int32(c.ORDER_STATUS_CONNECTED) {
+		err := xerrors.Errorf("%w", e.ErrInvalidOrderStatus)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	_, err := s.Repo.SelectDispute(ctx, &dispute.Search{Dispute: dispute.Dispute{OrderId: ord.ID}})
+	if err == nil {
+		err = xerrors.Errorf("%w", e.ErrDisputeExisted)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	if !xerrors.Is(err, dispute.ErrNotFound) {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	txn, err := s.Repo.SelectTransaction(ctx, &transaction.Search{Transaction: transaction.Transaction{
+		OrderId:    ord.ID,
+		BusinessId: ord.BusinessId,
+	}})
+	if xerrors.Is(err, transaction.ErrNotFound) {
+		err = xerrors.Errorf("%w", e.ErrNoConnectionFee)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	attachments, err := encodeAttachments(evidence.Attachments)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+
+	d, err := s.Repo.InsertDispute(ctx, &dispute.Dispute{
+		OrderId:       ord.ID,
+		TransactionId: txn.ID,
+		BusinessId:    ord.BusinessId,
+		Reason:        utils.Int32Ptr(int32(evidence.Reason)),
+		Note:          utils.StrPtr(strings.TrimSpace(evidence.Note)),
+		Attachments:   attachments,
+		Fee:           txn.Fee,
+		Status:        utils.Int32Ptr(int32(c.DISPUTE_STATUS_UNDER_REVIEW)),
+	})
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	return d, nil
+}
+
+func (s *ServerModel) GetDisputeById(ctx context.Context, id interface{}) (*dispute.Dispute, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.GetDisputeById))
+	defer span.End()
+
+	did, err := lib.ToUUID(id)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	d, err := s.Repo.SelectDispute(ctx, &dispute.Search{Dispute: dispute.Dispute{
+		BaseModel: database.BaseModel{ID: did},
+	}})
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	return d, nil
+}
+
+func (s *ServerModel) ListDisputes(ctx context.Context, search *dispute.Search) ([]*dispute.Dispute, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.ListDisputes))
+	defer span.End()
+
+	r, err := s.Repo.ListDisputes(ctx, search)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	return r, nil
+}
+
+func (s *ServerModel) TotalDisputes(ctx context.Context, search *dispute.Search) (*int64, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.TotalDisputes))
+	defer span.End()
+
+	r, err := s.Repo.TotalDisputes(ctx, search)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	return r, nil
+}
+
+// ReviewDispute approves or denies d for reviewerId. The review is recorded
+// first so two reviewers cannot both settle it. An approval then gives the
+// fee back; when that fails the dispute goes back under review.
+func (s *ServerModel) ReviewDispute(ctx context.Context, d *dispute.Dispute, reviewerId string, approve bool, note string) (*dispute.Dispute, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.ReviewDispute))
+	defer span.End()
+
+	rid, err := lib.ToUUID(reviewerId)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	if utf8.RuneCountInString(note) > MAX_DISPUTE_NOTE_SIZE {
+		err = xerrors.Errorf("%w", e.ErrInvalidDispute)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	status := c.DISPUTE_STATUS_DENIED
+	if approve {
+		status = c.DISPUTE_STATUS_APPROVED
+	}
+	d.Status = utils.Int32Ptr(int32(status))
+	d.ReviewerId = rid
+	d.ReviewNote = utils.SafeStrPtr(note)
+	d.ReviewedAt = utils.Int64Ptr(time.Now().UnixMilli())
+	if err := s.Repo.ReviewDispute(ctx, d); err != nil {
+		if xerrors.Is(err, dispute.ErrReviewed) {
+			err = e.ErrDisputeReviewed
+		}
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	if !approve {
+		return d, nil
+	}
+
+	search := &dispute.Search{Dispute: dispute.Dispute{BaseModel: database.BaseModel{ID: d.ID}}}
+	resolution, refundId, err := s.settleDispute(ctx, d)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		if rerr := s.Repo.UpdateDisputes(ctx, search, &dispute.Dispute{
+			Status:     utils.Int32Ptr(int32(c.DISPUTE_STATUS_UNDER_REVIEW)),
+			ReviewedAt: utils.Int64Ptr(0),
+		}); rerr != nil {
+			lib.RecordError(span, xerrors.Errorf("%w", rerr), ctx)
+		}
+		return nil, err
+	}
+	d.Resolution = utils.Int32Ptr(int32(resolution))
+	d.RefundId = refundId
+	if err := s.Repo.UpdateDisputes(ctx, search, &dispute.Dispute{
+		Resolution: d.Resolution,
+		RefundId:   d.RefundId,
+	}); err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	return d, nil
+}
+
+// settleDispute gives the fee of d back: a free contact is credited back to
+// the business, a fee not billed yet is waived and a billed one refunded.
+// Refunds are keyed by the dispute, so settling again never pays twice.
+func (s *ServerModel) settleDispute(ctx context.Context, d *dispute.Dispute) (c.DISPUTE_RESOLUTION, *string, error) {
+	search := &transaction.Search{Transaction: transaction.Transaction{
+		BaseModel: database.BaseModel{ID: d.TransactionId},
+	}}
+	txn, err := s.Repo.SelectTransaction(ctx, search)
+	if err != nil {
+		return c.DISPUTE_RESOLUTION_NOT_RESOLVED, nil, xerrors.Errorf("%w", err)
+	}
+
+	if utils.BoolVal(txn.IsFree) {
+		bus, err := s.GetBusinessById(ctx, d.BusinessId)
+		if err != nil {
+			return c.DISPUTE_RESOLUTION_NOT_RESOLVED, nil, xerrors.Errorf("%w", err)
+		}
+		freeContact := utils.Int32Val(bus.FreeContact) + 1
+		if err := s.UpdateBusiness(ctx, d.BusinessId, &business.Business{FreeContact: &freeContact}); err != nil {
+			return c.DISPUTE_RESOLUTION_NOT_RESOLVED, nil, xerrors.Errorf("%w", err)
+		}
+		return c.DISPUTE_RESOLUTION_CREDITED, nil, nil
+	}
+
+	err = s.Repo.WaiveTransaction(ctx, txn)
+	if err == nil {
+		return c.DISPUTE_RESOLUTION_WAIVED, nil, nil
+	}
+	if !xerrors.Is(err, transaction.ErrBilled) {
+		return c.DISPUTE_RESOLUTION_NOT_RESOLVED, nil, xerrors.Errorf("%w", err)
+	}
+	// billed in the meantime, the payment intent is read again
+	txn, err = s.Repo.SelectTransaction(ctx, search)
+	if err != nil {
+		return c.DISPUTE_RESOLUTION_NOT_RESOLVED, nil, xerrors.Errorf("%w", err)
+	}
+	if txn.PaymentIntentId == nil {
+		return c.DISPUTE_RESOLUTION_NOT_RESOLVED, nil, xerrors.Errorf("%w", e.ErrPayment)
+	}
+	refundId, err := s.Payment.Refund(ctx, txn.PaymentIntentId, txn.Fee, d.ID.String())
+	if err != nil {
+		return c.DISPUTE_RESOLUTION_NOT_RESOLVED, nil, xerrors.Errorf("%w", err)
+	}
+	// a refunded fee is not owed anymore either
+	if err := s.Repo.UpdateTransaction(ctx, search, &transaction.Transaction{IsWaived: utils.BoolPtr(true)}); err != nil {
+		return c.DISPUTE_RESOLUTION_NOT_RESOLVED, nil, xerrors.Errorf("%w", err)
+	}
+	return c.DISPUTE_RESOLUTION_REFUNDED, refundId, nil
+}
+
+// PresignDisputeAttachments sets short-lived links on the attachments of ds.
+// upbs are ds converted by ConvertDisputeToProtos.
+func (s *ServerModel) PresignDisputeAttachments(ctx context.Context, ds []*dispute.Dispute, upbs []*pb.Dispute) error {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.PresignDisputeAttachments))
+	defer span.End()
+
+	for i, d := range ds {
+		for j, a := range decodeAttachments(d.Attachments) {
+			url, err := s.S3.GetPresignedGetObject(ctx, a.Key, c.ATTACHMENT_URL_EXPIRE_TIME)
+			if err != nil {
+				err = xerrors.Errorf("%w", err)
+				lib.RecordError(span, err, ctx)
+				return err
+			}
+			upbs[i].Attachments[j].Url = strings.Replace(url, "http", "https", 1)
+		}
+	}
+	return nil
+}
+
+func (s *ServerModel) ConvertDisputeToProto(u *dispute.Dispute) *pb.Dispute {
+	upb := &pb.Dispute{
+		Id:           u.ID.String(),
+		OrderId:      u.OrderId.String(),
+		BusinessId:   u.BusinessId.String(),
+		BusinessName: utils.StrVal(u.BusinessName),
+		Reason:       c.DISPUTE_REASON(utils.Int32Val(u.Reason)),
+		Note:         utils.StrVal(u.Note),
+		Status:       c.DISPUTE_STATUS(utils.Int32Val(u.Status)),
+		Resolution:   c.DISPUTE_RESOLUTION(utils.Int32Val(u.Resolution)),
+		ReviewNote:   utils.StrVal(u.ReviewNote),
+		ReviewedAt:   utils.Int64Val(u.ReviewedAt),
+		CreatedAt:    u.CreatedAt,
+	}
+	if u.Fee != nil {
+		upb.Fee = *lib.CentToUsd(u.Fee)
+	}
+	for _, a := range decodeAttachments(u.Attachments) {
+		upb.Attachments = append(upb.Attachments, &pb.Attachment{
+			ContentType: a.ContentType,
+			Size:        a.Size,
+		})
+	}
+	return upb
+}
+
+func (s *ServerModel) ConvertDisputeToProtos(u []*dispute.Dispute) []*pb.Dispute {
+	arr := make([]*pb.Dispute, 0, len(u))
+	for _, d := range u {
+		arr = append(arr, s.ConvertDisputeToProto(d))
+	}
+	return arr
+}
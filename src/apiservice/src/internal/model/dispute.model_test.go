@@ -0,0 +1,51 @@
+package model
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/aqaurius6666/apiservice/src/internal/db/dispute"
+	"github.com/aqaurius6666/apiservice/src/internal/var/c"
+	"github.com/aqaurius6666/apiservice/src/internal/var/e"
+	"github.com/aqaurius6666/go-utils/utils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDisputeEvidence(t *testing.T) {
+	assert.NoError(t, DisputeEvidence{Reason: c.DISPUTE_REASON_SPAM_REQUEST, Note: "fake phone number"}.check())
+	assert.ErrorIs(t, DisputeEvidence{Reason: c.DISPUTE_REASON_SPAM_REQUEST, Note: "  "}.check(), e.ErrInvalidDispute)
+	assert.ErrorIs(t, DisputeEvidence{Reason: c.DISPUTE_REASON(99), Note: "fake phone number"}.check(), e.ErrInvalidDispute)
+	assert.ErrorIs(t, DisputeEvidence{
+		Reason: c.DISPUTE_REASON_OTHER_DISPUTE_REASON,
+		Note:   strings.Repeat("a", MAX_DISPUTE_NOTE_SIZE+1),
+	}.check(), e.ErrInvalidDispute)
+}
+
+func TestConvertDisputeToProto(t *testing.T) {
+	s := &ServerModel{S3: fakeBucket{}}
+	raw, err := encodeAttachments([]Attachment{{Key: "attachments/u1/a.png", ContentType: "image/png", Size: 100}})
+	assert.NoError(t, err)
+
+	d := &dispute.Dispute{
+		OrderId:     uuid.New(),
+		BusinessId:  uuid.New(),
+		Reason:      utils.Int32Ptr(int32(c.DISPUTE_REASON_UNREACHABLE_CUSTOMER)),
+		Note:        utils.StrPtr("never answered"),
+		Attachments: raw,
+		Fee:         utils.Int64Ptr(1250),
+		Status:      utils.Int32Ptr(int32(c.DISPUTE_STATUS_APPROVED)),
+		Resolution:  utils.Int32Ptr(int32(c.DISPUTE_RESOLUTION_REFUNDED)),
+	}
+	upb := s.ConvertDisputeToProto(d)
+	assert.Equal(t, float32(12.5), upb.Fee)
+	assert.Equal(t, c.DISPUTE_STATUS_APPROVED, upb.Status)
+	assert.Equal(t, c.DISPUTE_RESOLUTION_REFUNDED, upb.Resolution)
+	assert.Len(t, upb.Attachments, 1)
+	assert.Empty(t, upb.Attachments[0].Url)
+
+	upbs := s.ConvertDisputeToProtos([]*dispute.Dispute{d})
+	assert.NoError(t, s.PresignDisputeAttachments(context.Background(), []*dispute.Dispute{d}, upbs))
+	assert.Equal(t, "https://bucket/attachments/u1/a.png?signed", upbs[0].Attachments[0].Url)
+}
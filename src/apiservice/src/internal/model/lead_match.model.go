@@ -0,0 +1,207 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aqaurius6666/apiservice/src/internal/db/business"
+	"github.com/aqaurius6666/apiservice/src/internal/db/order"
+	"github.com/aqaurius6666/apiservice/src/internal/lib"
+	"github.com/aqaurius6666/apiservice/src/internal/var/c"
+	"github.com/aqaurius6666/apiservice/src/internal/var/e"
+	"github.com/aqaurius6666/apiservice/src/pb"
+	"github.com/aqaurius6666/go-utils/database"
+	"github.com/aqaurius6666/go-utils/utils"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"golang.org/x/xerrors"
+)
+
+var (
+	_ LeadMatchModel = (*ServerModel)(nil)
+)
+
+const (
+	// LEAD_MATCH_COUNT is how many businesses a customer asking for quotes
+	// gets at most.
+	LEAD_MATCH_COUNT = 3
+
+	// businesses without many reviews are rated close to LEAD_PRIOR_RATE
+	LEAD_PRIOR_RATE    = 3.5
+	LEAD_PRIOR_REVIEWS = 5
+	// the response time and the open leads halving their part of the score
+	LEAD_RESPONSE_TIME = 4 * time.Hour
+	LEAD_OPEN_LEADS    = 5
+	// the zipcodes served past the first halving the service area part
+	LEAD_ZIPCODES = 10
+)
+
+// leadWeights are the parts of the score of a lead match by factor.
+var leadWeights = struct {
+	rating, response, load, area, promotion float64
+}{0.35, 0.25, 0.2, 0.1, 0.1}
+
+type LeadMatchModel interface {
+	MatchBusinesses(ctx context.Context, customerId string, categoryId string, zipcode string, n int) ([]LeadMatch, error)
+
+	ConvertLeadMatchToProto(u LeadMatch, orderId string) *pb.LeadMatch
+}
+
+// LeadMatch is a business picked for a customer asking for quotes, with why.
+type LeadMatch struct {
+	BusinessId   uuid.UUID
+	BusinessName string
+	Score        float64
+	Reason       string
+}
+
+// MatchBusinesses picks at most n of the businesses offering the category in
+// zipcode for customerId, best first. Businesses the customer already has an
+// open order with for the project are left out. It fails with
+// e.ErrNoMatchingBusiness when there are none.
+func (s *ServerModel) MatchBusinesses(ctx context.Context, customerId string, categoryId string, zipcode string, n int) ([]LeadMatch, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.MatchBusinesses))
+	defer span.End()
+
+	uid, err := lib.ToUUID(customerId)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	cid, err := lib.ToUUID(categoryId)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	cands, err := s.Repo.ListLeadCandidates(ctx, &business.Search{
+		Business:   business.Business{Status: utils.Int32Ptr(int32(c.ACCOUNT_STATUS_ACTIVE))},
+		CategoryId: cid,
+		Zipcode:    &zipcode,
+	})
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	ordered, err := s.Repo.ListBusinessesAlreadyOrdered(ctx, &order.Search{
+		CategoryId: cid,
+		Order: order.Order{
+			CustomerId:      uid,
+			CustomerZipcode: &zipcode,
+		},
+		DefaultSearchModel: database.DefaultSearchModel{Fields: []string{`"orders"."business_id"`}},
+	})
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	skip := make(map[uuid.UUID]bool, len(ordered))
+	for _, o := range ordered {
+		skip[o.BusinessId] = true
+	}
+
+	matches := rankLeads(cands, zipcode, skip)
+	if len(matches) == 0 {
+		err = xerrors.Errorf("%w", e.ErrNoMatchingBusiness)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	if len(matches) > n {
+		matches = matches[:n]
+	}
+	return matches, nil
+}
+
+// rankLeads scores the candidates for a lead in zipcode, but those in skip,
+// best first.
+func rankLeads(cands []*business.Business, zipcode string, skip map[uuid.UUID]bool) []LeadMatch {
+	matches := make([]LeadMatch, 0, len(cands))
+	seen := make(map[uuid.UUID]bool, len(cands))
+	for _, b := range cands {
+		// a business is a candidate once however many services match
+		if skip[b.ID] || seen[b.ID] {
+			continue
+		}
+		seen[b.ID] = true
+		matches = append(matches, LeadMatch{
+			BusinessId:   b.ID,
+			BusinessName: utils.StrVal(b.Name),
+			Score:        leadScore(b),
+			Reason:       leadReason(b, zipcode),
+		})
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		if matches[i].Score != matches[j].Score {
+			return matches[i].Score > matches[j].Score
+		}
+		return matches[i].BusinessId.String() < matches[j].BusinessId.String()
+	})
+	return matches
+}
+
+// leadScore weighs the rating, the response time, the open leads, the
+// service area and the promotion of b, each part between 0 and 1. Unknown
+// response times count half.
+func leadScore(b *business.Business) float64 {
+	reviews := float64(utils.Int32Val(b.Review))
+	rate := float64(utils.Float32Val(b.Rate))
+	rating := (rate*reviews + LEAD_PRIOR_RATE*LEAD_PRIOR_REVIEWS) / (reviews + LEAD_PRIOR_REVIEWS) / 5
+
+	response := 0.5
+	if b.ResponseTime != nil {
+		response = 1 / (1 + *b.ResponseTime/float64(LEAD_RESPONSE_TIME.Milliseconds()))
+	}
+
+	load := 1 / (1 + float64(utils.Int64Val(b.OpenLeads))/LEAD_OPEN_LEADS)
+
+	area := 1.0
+	if len(b.Zipcodes) > 1 {
+		area = 1 / (1 + float64(len(b.Zipcodes)-1)/LEAD_ZIPCODES)
+	}
+
+	promotion := 0.0
+	if utils.BoolVal(b.Promoted) {
+		promotion = 1
+	}
+
+	return leadWeights.rating*rating + leadWeights.response*response +
+		leadWeights.load*load + leadWeights.area*area + leadWeights.promotion*promotion
+}
+
+// leadReason tells the customer and the business why b was picked.
+func leadReason(b *business.Business, zipcode string) string {
+	parts := []string{fmt.Sprintf("serves %s", zipcode)}
+	if reviews := utils.Int32Val(b.Review); reviews > 0 {
+		parts = append(parts, fmt.Sprintf("rated %.1f from %d review(s)", utils.Float32Val(b.Rate), reviews))
+	} else {
+		parts = append(parts, "not rated yet")
+	}
+	if b.ResponseTime != nil {
+		hours := time.Duration(*b.ResponseTime) * time.Millisecond / time.Hour
+		if hours < 1 {
+			parts = append(parts, "responds within an hour")
+		} else {
+			parts = append(parts, fmt.Sprintf("responds in about %d hour(s)", hours))
+		}
+	}
+	parts = append(parts, fmt.Sprintf("%d open lead(s)", utils.Int64Val(b.OpenLeads)))
+	if utils.BoolVal(b.Promoted) {
+		parts = append(parts, "promoted")
+	}
+	return strings.Join(parts, ", ")
+}
+
+func (s *ServerModel) ConvertLeadMatchToProto(u LeadMatch, orderId string) *pb.LeadMatch {
+	return &pb.LeadMatch{
+		OrderId:      orderId,
+		BusinessId:   u.BusinessId.String(),
+		BusinessName: u.BusinessName,
+		Reason:       u.Reason,
+	}
+}
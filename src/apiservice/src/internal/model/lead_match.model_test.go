@@ -0,0 +1,60 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aqaurius6666/apiservice/src/internal/db/business"
+	"github.com/aqaurius6666/go-utils/database"
+	"github.com/aqaurius6666/go-utils/utils"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+func leadCandidate(name string, rate float32, reviews int32, openLeads int64) *business.Business {
+	return &business.Business{
+		BaseModel: database.BaseModel{ID: uuid.New()},
+		Name:      utils.StrPtr(name),
+		Zipcodes:  pq.StringArray{"10001"},
+		Rate:      utils.Float32Ptr(rate),
+		Review:    utils.Int32Ptr(reviews),
+		OpenLeads: utils.Int64Ptr(openLeads),
+	}
+}
+
+func TestRankLeads(t *testing.T) {
+	rated := leadCandidate("Rated", 4.9, 40, 0)
+	busy := leadCandidate("Busy", 4.9, 40, 20)
+	fresh := leadCandidate("Fresh", 0, 0, 0)
+	ordered := leadCandidate("Ordered", 5, 100, 0)
+
+	matches := rankLeads([]*business.Business{fresh, busy, rated, ordered, rated}, "10001", map[uuid.UUID]bool{ordered.ID: true})
+	names := make([]string, 0, len(matches))
+	for _, m := range matches {
+		names = append(names, m.BusinessName)
+	}
+	// a business swamped with leads gives way to a new one
+	assert.Equal(t, []string{"Rated", "Fresh", "Busy"}, names)
+
+	// a promotion and a quick response lift a new business over a rated one
+	fresh.Promoted = utils.BoolPtr(true)
+	responseTime := float64((30 * time.Minute).Milliseconds())
+	fresh.ResponseTime = &responseTime
+	assert.Greater(t, leadScore(fresh), leadScore(rated))
+
+	// businesses serving many zipcodes rank below local ones
+	wide := leadCandidate("Wide", 4.9, 40, 0)
+	wide.Zipcodes = make(pq.StringArray, 30)
+	assert.Greater(t, leadScore(rated), leadScore(wide))
+}
+
+func TestLeadReason(t *testing.T) {
+	b := leadCandidate("Rated", 4.86, 12, 2)
+	responseTime := float64((3 * time.Hour).Milliseconds())
+	b.ResponseTime = &responseTime
+	b.Promoted = utils.BoolPtr(true)
+	assert.Equal(t, "serves 10001, rated 4.9 from 12 review(s), responds in about 3 hour(s), 2 open lead(s), promoted", leadReason(b, "10001"))
+
+	assert.Equal(t, "serves 10001, not rated yet, 0 open lead(s)", leadReason(leadCandidate("Fresh", 0, 0, 0), "10001"))
+}
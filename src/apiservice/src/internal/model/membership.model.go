@@ -0,0 +1,126 @@
+package model
+
+import (
+	"context"
+
+	"github.com/aqaurius6666/apiservice/src/internal/lib"
+	"github.com/aqaurius6666/apiservice/src/internal/var/c"
+	"github.com/aqaurius6666/apiservice/src/internal/var/e"
+	"github.com/aqaurius6666/apiservice/src/services/authservice"
+	"github.com/aqaurius6666/go-utils/utils"
+	"go.opentelemetry.io/otel"
+	"golang.org/x/xerrors"
+)
+
+var (
+	_ MembershipModel = (*ServerModel)(nil)
+)
+
+type MembershipModel interface {
+	ListMembers(ctx context.Context, businessId interface{}) ([]*authservice.Member, error)
+	InviteMember(ctx context.Context, userId, businessId interface{}, mail string, role c.BUSINESS_ROLE) (*authservice.Member, error)
+	AcceptInvitation(ctx context.Context, userId interface{}, otpId, otp string) (*authservice.Membership, error)
+	RemoveMember(ctx context.Context, userId interface{}, memberId string) error
+}
+
+func (s *ServerModel) ListMembers(ctx context.Context, businessId interface{}) ([]*authservice.Member, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.ListMembers))
+	defer span.End()
+
+	bid, err := lib.ToUUID(businessId)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	members, err := s.Auth.ListMembers(ctx, bid.String())
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	return members, nil
+}
+
+// InviteMember invites mail to join the business userId acts for. The name
+// of the business is shown in the invitation mail.
+func (s *ServerModel) InviteMember(ctx context.Context, userId, businessId interface{}, mail string, role c.BUSINESS_ROLE) (*authservice.Member, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.InviteMember))
+	defer span.End()
+
+	uid, err := lib.ToUUID(userId)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	bus, err := s.GetBusinessById(ctx, businessId)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	member, err := s.Auth.InviteMember(ctx, uid.String(), mail, role, utils.StrVal(bus.Name))
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	return member, nil
+}
+
+// AcceptInvitation verifies the otp sent with an invitation and makes userId
+// a member of the business that sent it.
+func (s *ServerModel) AcceptInvitation(ctx context.Context, userId interface{}, otpId, otp string) (*authservice.Membership, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.AcceptInvitation))
+	defer span.End()
+
+	uid, err := lib.ToUUID(userId)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	typ, err := s.Auth.VerifyOTP(ctx, otpId, otp)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	if typ != c.OTP_TYPE_BUSINESS_INVITE {
+		err = xerrors.Errorf("%w", e.ErrOTPFail)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	membership, err := s.Auth.AcceptInvitation(ctx, otpId, uid.String())
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	return membership, nil
+}
+
+func (s *ServerModel) RemoveMember(ctx context.Context, userId interface{}, memberId string) error {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.RemoveMember))
+	defer span.End()
+
+	uid, err := lib.ToUUID(userId)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return err
+	}
+	mid, err := lib.ToUUID(memberId)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return err
+	}
+	if err := s.Auth.RemoveMember(ctx, uid.String(), mid.String()); err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return err
+	}
+	return nil
+}
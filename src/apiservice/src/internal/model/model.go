@@ -22,6 +22,14 @@ type Server interface {
 	FeedbackModel
 	ServiceModel
 	OrderModel
+	OrderEventModel
+	OrderScheduleModel
+	OrderCompletionModel
+	CategoryQuestionModel
+	OrderAttachmentModel
+	QuoteModel
+	DisputeModel
+	LeadMatchModel
 	CategoryModel
 	PaymentModel
 	ChatModel
@@ -30,6 +38,8 @@ type Server interface {
 	AdvertiseOrderModel
 	TransactionModel
 	NotificationModel
+	MembershipModel
+	ApiKeyModel
 }
 
 type ServerModel struct {
@@ -15,13 +15,20 @@ import (
 type NotificationModel interface {
 	SubscribeNotification(context.Context, string, string) error
 	UnsubscribeNotification(context.Context, string, string) error
-	SendRequestedNotification(ctx context.Context, handymanId string, customerName string, categoryName string, zipcode string) error
+	SendRequestedNotification(ctx context.Context, handymanId string, customerName string, categoryName string, zipcode string, jobSummary string) error
 	SendCancelNotification(ctx context.Context, handymanId string, customerName string, categoryName string, zipcode string) error
 	SendFeeNotification(ctx context.Context, handymanId string, fee float32) error
 
 	SendConnectNotification(ctx context.Context, customerId string, businessName string, conversationId string) error
 	SendCompleteNotification(ctx context.Context, customerId string, businessName string, businessId string) error
 	SendRejectNotification(ctx context.Context, customerId string, businessName string, businessId string) error
+	SendSlotProposedNotification(ctx context.Context, customerId string, businessName string, orderId string) error
+	SendSlotAnsweredNotification(ctx context.Context, handymanId string, customerName string, orderId string, accepted bool) error
+	SendQuoteSubmittedNotification(ctx context.Context, customerId string, businessName string, orderId string) error
+	SendQuoteAnsweredNotification(ctx context.Context, handymanId string, customerName string, orderId string, won bool) error
+	SendDisputeReviewedNotification(ctx context.Context, handymanId string, orderId string, status c.DISPUTE_STATUS, resolution c.DISPUTE_RESOLUTION) error
+	SendCompletionRequestedNotification(ctx context.Context, userId string, requesterName string, orderId string) error
+	SendCompletionDisputedNotification(ctx context.Context, userId string, disputerName string, orderId string) error
 }
 
 func (s *ServerModel) SendConnectNotification(ctx context.Context, customerId string, businessName string, conversationId string) error {
@@ -107,6 +114,222 @@ func (s *ServerModel) SendRejectNotification(ctx context.Context, customerId str
 	}
 	return nil
 }
+func (s *ServerModel) SendSlotProposedNotification(ctx context.Context, customerId string, businessName string, orderId string) error {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.SendSlotProposedNotification))
+	defer span.End()
+	title := "Your request"
+	body := fmt.Sprintf("%s has proposed another time for your request", businessName)
+
+	nid := uuid.New()
+	message := map[string]string{
+		"id":      nid.String(),
+		"seq":     fmt.Sprint(nid.ClockSequence()),
+		"type":    c.SLOT_PROPOSED_CUSTOMER_NOTIFICATION,
+		"orderId": orderId,
+	}
+	messageByte, err := json.Marshal(message)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return err
+	}
+	err = s.Mail.SendNotification(ctx, customerId, title, body, string(messageByte))
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return err
+	}
+	return nil
+}
+
+func (s *ServerModel) SendSlotAnsweredNotification(ctx context.Context, handymanId string, customerName string, orderId string, accepted bool) error {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.SendSlotAnsweredNotification))
+	defer span.End()
+	title := "AnyGoNow"
+	body := fmt.Sprintf("%s has declined the time you proposed", customerName)
+	if accepted {
+		body = fmt.Sprintf("%s has accepted the time you proposed", customerName)
+	}
+
+	nid := uuid.New()
+	message := map[string]string{
+		"id":      nid.String(),
+		"seq":     fmt.Sprint(nid.ClockSequence()),
+		"type":    c.SLOT_ANSWERED_HANDYMAN_NOTIFICATION,
+		"orderId": orderId,
+	}
+	messageByte, err := json.Marshal(message)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return err
+	}
+	err = s.Mail.SendNotification(ctx, handymanId, title, body, string(messageByte))
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return err
+	}
+	return nil
+}
+
+func (s *ServerModel) SendQuoteSubmittedNotification(ctx context.Context, customerId string, businessName string, orderId string) error {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.SendQuoteSubmittedNotification))
+	defer span.End()
+	title := "Your request"
+	body := fmt.Sprintf("%s has sent you a quote", businessName)
+
+	nid := uuid.New()
+	message := map[string]string{
+		"id":      nid.String(),
+		"seq":     fmt.Sprint(nid.ClockSequence()),
+		"type":    c.QUOTE_SUBMITTED_CUSTOMER_NOTIFICATION,
+		"orderId": orderId,
+	}
+	messageByte, err := json.Marshal(message)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return err
+	}
+	err = s.Mail.SendNotification(ctx, customerId, title, body, string(messageByte))
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return err
+	}
+	return nil
+}
+
+func (s *ServerModel) SendQuoteAnsweredNotification(ctx context.Context, handymanId string, customerName string, orderId string, won bool) error {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.SendQuoteAnsweredNotification))
+	defer span.End()
+	title := "AnyGoNow"
+	body := fmt.Sprintf("%s has hired another business for their request", customerName)
+	if won {
+		body = fmt.Sprintf("%s has accepted your quote", customerName)
+	}
+
+	nid := uuid.New()
+	message := map[string]string{
+		"id":      nid.String(),
+		"seq":     fmt.Sprint(nid.ClockSequence()),
+		"type":    c.QUOTE_ANSWERED_HANDYMAN_NOTIFICATION,
+		"orderId": orderId,
+	}
+	messageByte, err := json.Marshal(message)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return err
+	}
+	err = s.Mail.SendNotification(ctx, handymanId, title, body, string(messageByte))
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return err
+	}
+	return nil
+}
+
+func (s *ServerModel) SendDisputeReviewedNotification(ctx context.Context, handymanId string, orderId string, status c.DISPUTE_STATUS, resolution c.DISPUTE_RESOLUTION) error {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.SendDisputeReviewedNotification))
+	defer span.End()
+	title := "AnyGoNow"
+	body := "Your dispute of a connection fee was denied"
+	if status == c.DISPUTE_STATUS_APPROVED {
+		switch resolution {
+		case c.DISPUTE_RESOLUTION_WAIVED:
+			body = "Your dispute of a connection fee was approved, you will not be charged for it"
+		case c.DISPUTE_RESOLUTION_REFUNDED:
+			body = "Your dispute of a connection fee was approved, the fee is refunded"
+		case c.DISPUTE_RESOLUTION_CREDITED:
+			body = "Your dispute of a connection fee was approved, your free contact is given back"
+		}
+	}
+
+	nid := uuid.New()
+	message := map[string]string{
+		"id":      nid.String(),
+		"seq":     fmt.Sprint(nid.ClockSequence()),
+		"type":    c.DISPUTE_REVIEWED_HANDYMAN_NOTIFICATION,
+		"orderId": orderId,
+	}
+	messageByte, err := json.Marshal(message)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return err
+	}
+	err = s.Mail.SendNotification(ctx, handymanId, title, body, string(messageByte))
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return err
+	}
+	return nil
+}
+
+// SendCompletionRequestedNotification asks userId to confirm or dispute the
+// job requesterName marked done.
+func (s *ServerModel) SendCompletionRequestedNotification(ctx context.Context, userId string, requesterName string, orderId string) error {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.SendCompletionRequestedNotification))
+	defer span.End()
+	title := "Your request"
+	body := fmt.Sprintf("%s marked the job as done. Confirm it or dispute it within %d hours", requesterName, int(COMPLETION_CONFIRM_WINDOW.Hours()))
+
+	nid := uuid.New()
+	message := map[string]string{
+		"id":      nid.String(),
+		"seq":     fmt.Sprint(nid.ClockSequence()),
+		"type":    c.COMPLETION_REQUESTED_NOTIFICATION,
+		"orderId": orderId,
+	}
+	messageByte, err := json.Marshal(message)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return err
+	}
+	err = s.Mail.SendNotification(ctx, userId, title, body, string(messageByte))
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return err
+	}
+	return nil
+}
+
+// SendCompletionDisputedNotification tells userId that disputerName does not
+// agree the job is done.
+func (s *ServerModel) SendCompletionDisputedNotification(ctx context.Context, userId string, disputerName string, orderId string) error {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.SendCompletionDisputedNotification))
+	defer span.End()
+	title := "Your request"
+	body := fmt.Sprintf("%s disputed that the job is done", disputerName)
+
+	nid := uuid.New()
+	message := map[string]string{
+		"id":      nid.String(),
+		"seq":     fmt.Sprint(nid.ClockSequence()),
+		"type":    c.COMPLETION_DISPUTED_NOTIFICATION,
+		"orderId": orderId,
+	}
+	messageByte, err := json.Marshal(message)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return err
+	}
+	err = s.Mail.SendNotification(ctx, userId, title, body, string(messageByte))
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return err
+	}
+	return nil
+}
+
 func (s *ServerModel) SendFeeNotification(ctx context.Context, handymanId string, fee float32) error {
 	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.SendFeeNotification))
 	defer span.End()
@@ -134,12 +357,15 @@ func (s *ServerModel) SendFeeNotification(ctx context.Context, handymanId string
 	return nil
 }
 
-func (s *ServerModel) SendRequestedNotification(ctx context.Context, handymanId string, customerName string, categoryName string, zipcode string) error {
+func (s *ServerModel) SendRequestedNotification(ctx context.Context, handymanId string, customerName string, categoryName string, zipcode string, jobSummary string) error {
 	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.SendRequestedNotification))
 	defer span.End()
 	s.Logger.Info(handymanId, customerName, categoryName, zipcode)
 	title := "You have a new request"
 	body := fmt.Sprintf("%s sent a request for %s service at zipcode %s", customerName, categoryName, zipcode)
+	if jobSummary != "" {
+		body += "\n" + jobSummary
+	}
 
 	nid := uuid.New()
 	message := map[string]string{
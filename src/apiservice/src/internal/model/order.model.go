@@ -4,8 +4,13 @@ import (
 	"context"
 	"time"
 
+	"github.com/aqaurius6666/apiservice/src/internal/db/business"
+	"github.com/aqaurius6666/apiservice/src/internal/db/category"
 	"github.com/aqaurius6666/apiservice/src/internal/db/order"
+	"github.com/aqaurius6666/apiservice/src/internal/db/order_event"
+	"github.com/aqaurius6666/apiservice/src/internal/db/quote"
 	"github.com/aqaurius6666/apiservice/src/internal/db/service"
+	"github.com/aqaurius6666/apiservice/src/internal/db/transaction"
 	"github.com/aqaurius6666/apiservice/src/internal/lib"
 	"github.com/aqaurius6666/apiservice/src/internal/var/c"
 	"github.com/aqaurius6666/apiservice/src/internal/var/e"
@@ -19,16 +24,31 @@ import (
 
 var THREE_DAYS = 3 * 24 * time.Hour
 
+// MAX_ORDER_EXPIRY bounds how long the orders of a category may wait for the
+// handyman.
+var MAX_ORDER_EXPIRY = 30 * 24 * time.Hour
+
+// OrderExpiry is how long the orders of cat wait for the handyman before the
+// cronjob expires them, THREE_DAYS unless the category sets it.
+func OrderExpiry(cat *category.Category) time.Duration {
+	if h := utils.Int32Val(cat.OrderExpiry); h > 0 {
+		return time.Duration(h) * time.Hour
+	}
+	return THREE_DAYS
+}
+
 type OrderModel interface {
 	CreateOrder(ctx context.Context, uid, sid interface{}, zipcode *string, phone *string, message *string) (*order.Order, error)
-	CreateOrderV2(ctx context.Context, uid, bid, cid interface{}, zipcode *string, phone *string, message *string, customerName *string) (*order.Order, error)
+	CreateOrderV2(ctx context.Context, uid, bid, cid interface{}, zipcode *string, phone *string, message *string, customerName *string, schedule OrderSchedule, details []JobDetail, attachments []Attachment, matchReason *string) (*order.Order, error)
 	GetCurrentOrderCount(ctx context.Context, uid interface{}, zipcode *string) (*int64, error)
 	ListOrders(context.Context, *order.Search) ([]*order.Order, error)
 	TotalOrders(context.Context, *order.Search) (*int64, error)
 	GetOrderById(context.Context, interface{}) (*order.Order, error)
-	UpdateOrderStatusById(ctx context.Context, orderId interface{}, status c.ORDER_STATUS) error
+	TransitionOrder(ctx context.Context, orderId interface{}, value *order.Order, actor OrderActor) error
+	ConnectOrder(ctx context.Context, orderId interface{}, fee *float32, q *quote.Quote, actor OrderActor) error
+	CheckOrderTransition(ord *order.Order, to c.ORDER_STATUS, actor OrderActor) error
 	UpdateOrderById(ctx context.Context, orderId interface{}, value *order.Order) error
-	UpdateOrdersStatusByUser(ctx context.Context, userId interface{}, status c.ORDER_STATUS) error
+	UpdateOrdersStatusByUser(ctx context.Context, userId interface{}, status c.ORDER_STATUS, actor OrderActor) error
 	ListBusinessesAlreadyOrdered(context.Context, *order.Search) ([]*order.Order, error)
 	CloseConversation(ctx context.Context, orderId uuid.UUID) error
 
@@ -40,9 +60,9 @@ type OrderModel interface {
 
 	ListProjects(ctx context.Context, search *order.Search) ([]*order.Order, error)
 	TotalProjects(ctx context.Context, search *order.Search) (*int64, error)
-	CancelProject(ctx context.Context, search *order.Search) error
+	CancelProject(ctx context.Context, search *order.Search, actor OrderActor) ([]*order.Order, error)
 
-	CheckPermissionUpdateOrder(idRequest string, idFromOrder uuid.UUID) bool
+	CheckPermissionUpdateOrder(ctx context.Context, userId string, partyId uuid.UUID) bool
 }
 
 func (s *ServerModel) CloseConversation(ctx context.Context, orderId uuid.UUID) error {
@@ -180,6 +200,13 @@ func (s *ServerModel) ConvertOrderToProto(u *order.Order) *pb.Order {
 	if u.HandymanMail != nil {
 		upb.HandymanMail = *u.HandymanMail
 	}
+	if u.MatchReason != nil {
+		upb.MatchReason = *u.MatchReason
+	}
+	convertScheduleToProto(u, upb)
+	convertCompletionToProto(u, upb)
+	convertJobDetailsToProto(u, upb)
+	convertAttachmentsToProto(u, upb)
 	return upb
 }
 
@@ -218,13 +245,6 @@ func (s *ServerModel) ListOrders(ctx context.Context, search *order.Search) ([]*
 	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.ListOrders))
 	defer span.End()
 
-	err := s.Repo.UpdateOrderStatusIfExpireTime(ctx)
-	if err != nil {
-		err = xerrors.Errorf("%w", err)
-		lib.RecordError(span, err, ctx)
-		return nil, err
-	}
-
 	odrs, err := s.Repo.ListOrders(ctx, search)
 	if err != nil {
 		err = xerrors.Errorf("%w", err)
@@ -297,11 +317,16 @@ func (s *ServerModel) CreateOrder(ctx context.Context, uid, sid interface{}, zip
 		lib.RecordError(span, err, ctx)
 		return nil, err
 	}
+	if err := s.insertPlacedEvent(ctx, ord, cid); err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
 
 	return ord, nil
 }
 
-func (s *ServerModel) CreateOrderV2(ctx context.Context, uid, bid, cid interface{}, zipcode *string, phone *string, message *string, customerName *string) (*order.Order, error) {
+func (s *ServerModel) CreateOrderV2(ctx context.Context, uid, bid, cid interface{}, zipcode *string, phone *string, message *string, customerName *string, schedule OrderSchedule, details []JobDetail, attachments []Attachment, matchReason *string) (*order.Order, error) {
 	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.CreateOrderV2))
 	defer span.End()
 
@@ -338,6 +363,14 @@ func (s *ServerModel) CreateOrderV2(ctx context.Context, uid, bid, cid interface
 		lib.RecordError(span, err, ctx)
 		return nil, err
 	}
+	cat, err := s.Repo.SelectCategory(ctx, &category.Search{
+		Category: category.Category{BaseModel: database.BaseModel{ID: cidd}},
+	})
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
 	if _, err = s.Repo.SelectOrder(ctx, &order.Search{
 		Order: order.Order{
 			CustomerId:      uidd,
@@ -350,28 +383,64 @@ func (s *ServerModel) CreateOrderV2(ctx context.Context, uid, bid, cid interface
 		lib.RecordError(span, err, ctx)
 		return nil, err
 	}
+	jobDetails, err := encodeJobDetails(details)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	jobAttachments, err := encodeAttachments(attachments)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
 	now := time.Now()
-	ord, err := s.Repo.InsertOrder(ctx, &order.Order{
+	value := &order.Order{
 		CustomerId:      uidd,
 		BusinessId:      ser.BusinessId,
 		ServiceId:       ser.ID,
 		StartDate:       utils.Int64Ptr(now.UnixMilli()),
-		EndDate:         utils.Int64Ptr(now.Add(THREE_DAYS).UnixMilli()),
+		EndDate:         utils.Int64Ptr(now.Add(OrderExpiry(cat)).UnixMilli()),
 		Status:          utils.Int32Ptr(int32(c.ORDER_STATUS_PENDING)),
 		CustomerZipcode: zipcode,
 		CustomerMessage: message,
 		CustomerPhone:   phone,
 		CustomerName:    customerName,
-	})
+		JobDetails:      jobDetails,
+		Attachments:     jobAttachments,
+		MatchReason:     matchReason,
+	}
+	schedule.apply(value)
+	ord, err := s.Repo.InsertOrder(ctx, value)
 	if err != nil {
 		err = xerrors.Errorf("%w", err)
 		lib.RecordError(span, err, ctx)
 		return nil, err
 	}
+	if err := s.insertPlacedEvent(ctx, ord, uidd); err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
 
 	return ord, nil
 }
 
+// insertPlacedEvent starts the timeline of ord, placed by customerId.
+func (s *ServerModel) insertPlacedEvent(ctx context.Context, ord *order.Order, customerId uuid.UUID) error {
+	err := s.Repo.InsertOrderEvent(ctx, &order_event.OrderEvent{
+		OrderId:   ord.ID,
+		ActorId:   customerId,
+		ActorRole: utils.Int32Ptr(int32(c.ROLE_CUSTOMER)),
+		NewStatus: ord.Status,
+	})
+	if err != nil {
+		return xerrors.Errorf("%w", err)
+	}
+	return nil
+}
+
 func (s *ServerModel) GetCurrentOrderCount(ctx context.Context, uid interface{}, zipcode *string) (*int64, error) {
 	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.GetCurrentOrderCount))
 	defer span.End()
@@ -399,35 +468,97 @@ func (s *ServerModel) GetCurrentOrderCount(ctx context.Context, uid interface{},
 
 }
 
-func (s *ServerModel) UpdateOrderStatusById(ctx context.Context, orderId interface{}, status c.ORDER_STATUS) error {
-	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.UpdateOrderStatusById))
+// TransitionOrder moves the order to the status of value, recording the
+// change on its timeline. It fails with e.ErrInvalidOrderStatus when the
+// order state machine does not let actor make the change from the status the
+// order is in.
+func (s *ServerModel) TransitionOrder(ctx context.Context, orderId interface{}, value *order.Order, actor OrderActor) error {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.TransitionOrder))
 	defer span.End()
 
-	uid, err := lib.ToUUID(orderId)
+	oid, err := lib.ToUUID(orderId)
 	if err != nil {
 		err = xerrors.Errorf("%w", err)
 		lib.RecordError(span, err, ctx)
 		return err
 	}
-	err = s.Repo.UpdateOrder(ctx, &order.Search{
-		Order: order.Order{
-			BaseModel: database.BaseModel{ID: uid},
-		},
-	}, &order.Order{
-		Status: utils.Int32Ptr(int32(status)),
-	})
-
+	moved, err := s.transitionOrders(ctx, &order.Search{
+		Order: order.Order{BaseModel: database.BaseModel{ID: oid}},
+	}, value, actor)
 	if err != nil {
 		err = xerrors.Errorf("%w", err)
 		lib.RecordError(span, err, ctx)
 		return err
 	}
+	if len(moved) == 0 {
+		err = xerrors.Errorf("%w", e.ErrInvalidOrderStatus)
+		lib.RecordError(span, err, ctx)
+		return err
+	}
+	return nil
+}
 
+// ConnectOrder moves the order to connected for actor and, in the same
+// transaction, accepts q and charges its business fee in USD, each when set.
+// Accepting q marks it won and the other open quotes of its project lost. Of
+// concurrent connects of the order only one moves it and is charged, the
+// others fail with e.ErrInvalidOrderStatus, and nothing is done when q cannot
+// be accepted anymore, which fails with e.ErrQuoteNotOpen.
+func (s *ServerModel) ConnectOrder(ctx context.Context, orderId interface{}, fee *float32, q *quote.Quote, actor OrderActor) error {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.ConnectOrder))
+	defer span.End()
+
+	if q != nil && !IsOpen(q, time.Now()) {
+		err := xerrors.Errorf("%w", e.ErrQuoteNotOpen)
+		lib.RecordError(span, err, ctx)
+		return err
+	}
+	oid, err := lib.ToUUID(orderId)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return err
+	}
+	from, err := orderSources(c.ORDER_STATUS_CONNECTED, actor)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return err
+	}
+	ev, err := actor.toEvent()
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return err
+	}
+	var tr *transaction.Transaction
+	if fee != nil {
+		tr = &transaction.Transaction{Fee: lib.UsdFloatToCent(fee)}
+	}
+	moved, err := s.Repo.ConnectOrder(ctx, &order.Search{
+		Order:    order.Order{BaseModel: database.BaseModel{ID: oid}},
+		Statuses: from,
+	}, &order.Order{
+		Status: utils.Int32Ptr(int32(c.ORDER_STATUS_CONNECTED)),
+	}, ev, q, tr)
+	if xerrors.Is(err, quote.ErrNotOpen) {
+		err = e.ErrQuoteNotOpen
+	}
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return err
+	}
+	if len(moved) == 0 {
+		err = xerrors.Errorf("%w", e.ErrInvalidOrderStatus)
+		lib.RecordError(span, err, ctx)
+		return err
+	}
 	return nil
 }
 
-func (s *ServerModel) UpdateOrdersStatusByUser(ctx context.Context, userId interface{}, status c.ORDER_STATUS) error {
-	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.UpdateOrderStatusById))
+func (s *ServerModel) UpdateOrdersStatusByUser(ctx context.Context, userId interface{}, status c.ORDER_STATUS, actor OrderActor) error {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.UpdateOrdersStatusByUser))
 	defer span.End()
 
 	uid, err := lib.ToUUID(userId)
@@ -436,11 +567,11 @@ func (s *ServerModel) UpdateOrdersStatusByUser(ctx context.Context, userId inter
 		lib.RecordError(span, err, ctx)
 		return err
 	}
-	err = s.Repo.UpdateOrdersCancelledStatusByUser(ctx, &order.Search{
+	_, err = s.transitionOrders(ctx, &order.Search{
 		UserId: uid,
 	}, &order.Order{
 		Status: utils.Int32Ptr(int32(status)),
-	})
+	}, actor)
 
 	if err != nil {
 		err = xerrors.Errorf("%w", err)
@@ -451,10 +582,61 @@ func (s *ServerModel) UpdateOrdersStatusByUser(ctx context.Context, userId inter
 	return nil
 }
 
-func (s *ServerModel) CheckPermissionUpdateOrder(idRequest string, idFromOrder uuid.UUID) bool {
-	uid := idFromOrder.String()
+// transitionOrders moves the orders matching search that actor may move to
+// the status of value, as the order state machine allows.
+func (s *ServerModel) transitionOrders(ctx context.Context, search *order.Search, value *order.Order, actor OrderActor) ([]*order.Order, error) {
+	from, err := orderSources(c.ORDER_STATUS(*value.Status), actor)
+	if err != nil {
+		return nil, xerrors.Errorf("%w", err)
+	}
+	ev, err := actor.toEvent()
+	if err != nil {
+		return nil, xerrors.Errorf("%w", err)
+	}
+	search.Statuses = from
+	moved, err := s.Repo.TransitionOrders(ctx, search, value, ev)
+	if err != nil {
+		return nil, xerrors.Errorf("%w", err)
+	}
+	return moved, nil
+}
+
+// CheckPermissionUpdateOrder tells whether userId may act on an order for
+// partyId, its customer or its business: userId is partyId itself or an
+// accepted member of the business partyId. Members are only looked up when
+// partyId is a business.
+func (s *ServerModel) CheckPermissionUpdateOrder(ctx context.Context, userId string, partyId uuid.UUID) bool {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.CheckPermissionUpdateOrder))
+	defer span.End()
 
-	return uid == idRequest
+	if userId == "" {
+		return false
+	}
+	if partyId.String() == userId {
+		return true
+	}
+	if _, err := s.Repo.SelectBusiness(ctx, &business.Search{
+		Business:           business.Business{BaseModel: database.BaseModel{ID: partyId}},
+		DefaultSearchModel: database.DefaultSearchModel{Fields: []string{`"businesses"."id"`}},
+	}); err != nil {
+		if !xerrors.Is(err, business.ErrNotFound) {
+			err = xerrors.Errorf("%w", err)
+			lib.RecordError(span, err, ctx)
+		}
+		return false
+	}
+	members, err := s.ListMembers(ctx, partyId)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return false
+	}
+	for _, m := range members {
+		if m.Accepted && m.UserId == userId {
+			return true
+		}
+	}
+	return false
 }
 
 func (s *ServerModel) ListProjects(ctx context.Context, search *order.Search) ([]*order.Order, error) {
@@ -493,19 +675,35 @@ func (s *ServerModel) TotalProjects(ctx context.Context, search *order.Search) (
 	return total, nil
 }
 
-func (s *ServerModel) CancelProject(ctx context.Context, search *order.Search) error {
+// CancelProject cancels the orders of a project of the customer that are
+// still pending and returns them.
+func (s *ServerModel) CancelProject(ctx context.Context, search *order.Search, actor OrderActor) ([]*order.Order, error) {
 	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.CancelProject))
 	defer span.End()
 
-	err := s.Repo.CancelProject(ctx, search, &order.Order{
+	value := &order.Order{
 		Status: utils.Int32Ptr(int32(c.ORDER_STATUS_CANCELED)),
-	})
+	}
+	from, err := orderSources(c.ORDER_STATUS_CANCELED, actor)
 	if err != nil {
 		err = xerrors.Errorf("%w", err)
 		lib.RecordError(span, err, ctx)
-		return err
+		return nil, err
 	}
-	return nil
+	ev, err := actor.toEvent()
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	search.Statuses = from
+	canceled, err := s.Repo.CancelProject(ctx, search, value, ev)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	return canceled, nil
 }
 
 func (s *ServerModel) ListBusinessesAlreadyOrdered(ctx context.Context, search *order.Search) ([]*order.Order, error) {
@@ -0,0 +1,147 @@
+package model
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aqaurius6666/apiservice/src/internal/db"
+	"github.com/aqaurius6666/apiservice/src/internal/db/business"
+	"github.com/aqaurius6666/apiservice/src/internal/db/category"
+	"github.com/aqaurius6666/apiservice/src/internal/db/order"
+	"github.com/aqaurius6666/apiservice/src/internal/db/order_event"
+	"github.com/aqaurius6666/apiservice/src/internal/db/quote"
+	"github.com/aqaurius6666/apiservice/src/internal/db/transaction"
+	"github.com/aqaurius6666/apiservice/src/internal/var/c"
+	"github.com/aqaurius6666/apiservice/src/internal/var/e"
+	"github.com/aqaurius6666/apiservice/src/services/authservice"
+	"github.com/aqaurius6666/go-utils/database"
+	"github.com/aqaurius6666/go-utils/utils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrderExpiry(t *testing.T) {
+	assert.Equal(t, THREE_DAYS, OrderExpiry(&category.Category{}))
+	assert.Equal(t, THREE_DAYS, OrderExpiry(&category.Category{OrderExpiry: utils.Int32Ptr(0)}))
+	assert.Equal(t, 12*time.Hour, OrderExpiry(&category.Category{OrderExpiry: utils.Int32Ptr(12)}))
+}
+
+type fakeMembers struct {
+	authservice.Service
+	members map[string][]*authservice.Member
+	calls   int
+}
+
+func (f *fakeMembers) ListMembers(ctx context.Context, businessId string) ([]*authservice.Member, error) {
+	f.calls++
+	return f.members[businessId], nil
+}
+
+// businessRepo finds the businesses in ids only.
+type businessRepo struct {
+	db.ServerRepo
+	ids map[uuid.UUID]bool
+}
+
+func (r businessRepo) SelectBusiness(ctx context.Context, search *business.Search) (*business.Business, error) {
+	if !r.ids[search.ID] {
+		return nil, business.ErrNotFound
+	}
+	return &business.Business{BaseModel: database.BaseModel{ID: search.ID}}, nil
+}
+
+func TestCheckPermissionUpdateOrder(t *testing.T) {
+	bid, customer := uuid.New(), uuid.New()
+	staff, invited, stranger := uuid.NewString(), uuid.NewString(), uuid.NewString()
+	auth := &fakeMembers{members: map[string][]*authservice.Member{
+		bid.String(): {
+			{UserId: staff, Accepted: true},
+			{UserId: invited},
+		},
+	}}
+	s := &ServerModel{Auth: auth, Repo: businessRepo{ids: map[uuid.UUID]bool{bid: true}}}
+	ctx := context.Background()
+
+	assert.True(t, s.CheckPermissionUpdateOrder(ctx, bid.String(), bid))
+	assert.True(t, s.CheckPermissionUpdateOrder(ctx, staff, bid))
+	assert.False(t, s.CheckPermissionUpdateOrder(ctx, invited, bid))
+	assert.False(t, s.CheckPermissionUpdateOrder(ctx, stranger, bid))
+	assert.False(t, s.CheckPermissionUpdateOrder(ctx, "", bid))
+	assert.Equal(t, 3, auth.calls)
+
+	// a customer has no members to look up
+	assert.True(t, s.CheckPermissionUpdateOrder(ctx, customer.String(), customer))
+	assert.False(t, s.CheckPermissionUpdateOrder(ctx, staff, customer))
+	assert.Equal(t, 3, auth.calls)
+}
+
+// connectRepo connects the orders in pending and accepts the quotes open in
+// quotes, rolling back when a quote is not open anymore, as the database
+// would.
+type connectRepo struct {
+	db.ServerRepo
+	pending map[uuid.UUID]bool
+	quotes  map[uuid.UUID]bool
+	fees    []*transaction.Transaction
+}
+
+func (r *connectRepo) ConnectOrder(ctx context.Context, search *order.Search, value *order.Order, event *order_event.OrderEvent, accept *quote.Quote, fee *transaction.Transaction) ([]*order.Order, error) {
+	if !r.pending[search.ID] {
+		return nil, nil
+	}
+	if accept != nil {
+		if !r.quotes[accept.ID] {
+			return nil, quote.ErrNotOpen
+		}
+		delete(r.quotes, accept.ID)
+	}
+	delete(r.pending, search.ID)
+	if fee != nil {
+		r.fees = append(r.fees, fee)
+	}
+	return []*order.Order{{Status: value.Status}}, nil
+}
+
+func TestConnectOrder(t *testing.T) {
+	oid := uuid.New()
+	repo := &connectRepo{pending: map[uuid.UUID]bool{oid: true}}
+	s := &ServerModel{Repo: repo}
+	ctx := context.Background()
+	actor := OrderActor{Id: uuid.NewString(), Role: c.ROLE_HANDYMAN}
+	fee := float32(12.5)
+
+	assert.NoError(t, s.ConnectOrder(ctx, oid, &fee, nil, actor))
+	// a second connect of the same order moves nothing and is not charged
+	assert.ErrorIs(t, s.ConnectOrder(ctx, oid, &fee, nil, actor), e.ErrInvalidOrderStatus)
+	assert.Len(t, repo.fees, 1)
+	assert.Equal(t, int64(1250), utils.Int64Val(repo.fees[0].Fee))
+}
+
+func TestConnectOrderQuote(t *testing.T) {
+	oid := uuid.New()
+	valid := utils.Int64Ptr(time.Now().Add(time.Hour).UnixMilli())
+	open := utils.Int32Ptr(int32(c.QUOTE_STATUS_OPEN))
+	won := &quote.Quote{BaseModel: database.BaseModel{ID: uuid.New()}, Status: open, ValidUntil: valid}
+	lost := &quote.Quote{BaseModel: database.BaseModel{ID: uuid.New()}, Status: utils.Int32Ptr(int32(c.QUOTE_STATUS_LOST)), ValidUntil: valid}
+	lapsed := &quote.Quote{BaseModel: database.BaseModel{ID: uuid.New()}, Status: open, ValidUntil: utils.Int64Ptr(time.Now().Add(-time.Hour).UnixMilli())}
+	// taken was open when read but lost to a concurrent accept since
+	taken := &quote.Quote{BaseModel: database.BaseModel{ID: uuid.New()}, Status: open, ValidUntil: valid}
+	repo := &connectRepo{
+		pending: map[uuid.UUID]bool{oid: true},
+		quotes:  map[uuid.UUID]bool{won.ID: true, lost.ID: true, lapsed.ID: true},
+	}
+	s := &ServerModel{Repo: repo}
+	ctx := context.Background()
+	actor := OrderActor{Id: uuid.NewString(), Role: c.ROLE_CUSTOMER, Reason: c.ORDER_REASON_QUOTE_ACCEPTED}
+	fee := float32(12.5)
+
+	for _, q := range []*quote.Quote{lost, lapsed, taken} {
+		assert.ErrorIs(t, s.ConnectOrder(ctx, oid, &fee, q, actor), e.ErrQuoteNotOpen)
+	}
+	assert.Empty(t, repo.fees)
+	assert.True(t, repo.pending[oid])
+
+	assert.NoError(t, s.ConnectOrder(ctx, oid, &fee, won, actor))
+	assert.Len(t, repo.fees, 1)
+}
@@ -0,0 +1,158 @@
+package model
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/aqaurius6666/apiservice/src/internal/db/order"
+	"github.com/aqaurius6666/apiservice/src/internal/lib"
+	"github.com/aqaurius6666/apiservice/src/internal/var/c"
+	"github.com/aqaurius6666/apiservice/src/internal/var/e"
+	"github.com/aqaurius6666/apiservice/src/pb"
+	"github.com/aqaurius6666/go-utils/utils"
+	"go.opentelemetry.io/otel"
+	"golang.org/x/xerrors"
+)
+
+var (
+	_ OrderAttachmentModel = (*ServerModel)(nil)
+)
+
+const (
+	MAX_ORDER_ATTACHMENTS = 5
+	// http.DetectContentType looks at no more than this
+	SNIFF_LENGTH = 512
+)
+
+type OrderAttachmentModel interface {
+	CheckOrderAttachments(ctx context.Context, userId string, keys []string) ([]Attachment, error)
+	PresignOrderAttachments(ctx context.Context, viewerId string, ords []*order.Order, upbs []*pb.Order) error
+}
+
+// Attachment is an uploaded file attached to an order. It is stored as json
+// on the order.
+type Attachment struct {
+	Key         string `json:"key"`
+	ContentType string `json:"contentType"`
+	Size        int64  `json:"size"`
+}
+
+// CheckOrderAttachments checks the files userId uploaded to attach to an
+// order, by key. The bucket is asked for each file, which must fit the
+// ORDER_ATTACHMENT policy by both its content type and its content.
+func (s *ServerModel) CheckOrderAttachments(ctx context.Context, userId string, keys []string) ([]Attachment, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.CheckOrderAttachments))
+	defer span.End()
+
+	if len(keys) > MAX_ORDER_ATTACHMENTS {
+		err := xerrors.Errorf("%w", e.ErrInvalidAttachment)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	policy := uploadPolicies[c.UPLOAD_PURPOSE_ORDER_ATTACHMENT]
+	seen := make(map[string]bool, len(keys))
+	attachments := make([]Attachment, 0, len(keys))
+	for _, key := range keys {
+		if !strings.HasPrefix(key, policy.userPrefix(userId)) || strings.Contains(key, "..") || seen[key] {
+			err := xerrors.Errorf("%w", e.ErrInvalidAttachment)
+			lib.RecordError(span, err, ctx)
+			return nil, err
+		}
+		seen[key] = true
+		info, err := s.S3.StatObject(ctx, key)
+		if err != nil {
+			lib.RecordError(span, err, ctx)
+			err = xerrors.Errorf("%w", e.ErrInvalidAttachment)
+			return nil, err
+		}
+		if info.Size > policy.maxSize {
+			err := xerrors.Errorf("%w", e.ErrFileTooLarge)
+			lib.RecordError(span, err, ctx)
+			return nil, err
+		}
+		head, err := s.S3.ReadObjectHead(ctx, key, SNIFF_LENGTH)
+		if err != nil {
+			err = xerrors.Errorf("%w", err)
+			lib.RecordError(span, err, ctx)
+			return nil, err
+		}
+		if !policy.sniffed(info.ContentType, head) {
+			err := xerrors.Errorf("%w", e.ErrInvalidAttachment)
+			lib.RecordError(span, err, ctx)
+			return nil, err
+		}
+		attachments = append(attachments, Attachment{Key: key, ContentType: info.ContentType, Size: info.Size})
+	}
+	return attachments, nil
+}
+
+// attachmentsVisible tells whether viewerId may open the attachments of ord:
+// its customer always, its handyman once connected.
+func attachmentsVisible(ord *order.Order, viewerId string) bool {
+	if ord.CustomerId.String() == viewerId {
+		return true
+	}
+	if ord.BusinessId.String() != viewerId || ord.Status == nil {
+		return false
+	}
+	switch c.ORDER_STATUS(*ord.Status) {
+	case c.ORDER_STATUS_CONNECTED, c.ORDER_STATUS_AWAITING_CONFIRMATION, c.ORDER_STATUS_COMPLETED:
+		return true
+	}
+	return false
+}
+
+func decodeAttachments(raw *string) []Attachment {
+	if raw == nil {
+		return nil
+	}
+	var attachments []Attachment
+	// attachments are written by encodeAttachments, a broken value is only
+	// dropped
+	_ = json.Unmarshal([]byte(*raw), &attachments)
+	return attachments
+}
+
+func encodeAttachments(attachments []Attachment) (*string, error) {
+	if len(attachments) == 0 {
+		return nil, nil
+	}
+	raw, err := json.Marshal(attachments)
+	if err != nil {
+		return nil, xerrors.Errorf("%w", err)
+	}
+	return utils.StrPtr(string(raw)), nil
+}
+
+// PresignOrderAttachments sets short-lived links on the attachments of the
+// orders viewerId may open. upbs are ords converted by ConvertOrderToProtos.
+func (s *ServerModel) PresignOrderAttachments(ctx context.Context, viewerId string, ords []*order.Order, upbs []*pb.Order) error {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.PresignOrderAttachments))
+	defer span.End()
+
+	for i, ord := range ords {
+		if !attachmentsVisible(ord, viewerId) {
+			continue
+		}
+		for j, a := range decodeAttachments(ord.Attachments) {
+			url, err := s.S3.GetPresignedGetObject(ctx, a.Key, c.ATTACHMENT_URL_EXPIRE_TIME)
+			if err != nil {
+				err = xerrors.Errorf("%w", err)
+				lib.RecordError(span, err, ctx)
+				return err
+			}
+			upbs[i].Attachments[j].Url = strings.Replace(url, "http", "https", 1)
+		}
+	}
+	return nil
+}
+
+func convertAttachmentsToProto(u *order.Order, upb *pb.Order) {
+	for _, a := range decodeAttachments(u.Attachments) {
+		upb.Attachments = append(upb.Attachments, &pb.Attachment{
+			ContentType: a.ContentType,
+			Size:        a.Size,
+		})
+	}
+}
@@ -0,0 +1,132 @@
+package model
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/aqaurius6666/apiservice/src/internal/db/order"
+	"github.com/aqaurius6666/apiservice/src/internal/var/c"
+	"github.com/aqaurius6666/apiservice/src/internal/var/e"
+	"github.com/aqaurius6666/apiservice/src/pb"
+	"github.com/aqaurius6666/apiservice/src/services/s3"
+	"github.com/aqaurius6666/go-utils/utils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/xerrors"
+)
+
+type fakeObject struct {
+	info s3.ObjectInfo
+	body []byte
+}
+
+type fakeBucket map[string]fakeObject
+
+func (b fakeBucket) GetObject(key *string) (io.ReadCloser, error) {
+	panic("not implemented")
+}
+
+func (b fakeBucket) GetPresignedPutObject(ctx context.Context, key string, contentLength int64, contentType string) (string, map[string]string, error) {
+	return "http://bucket/" + key, map[string]string{"key": key}, nil
+}
+
+func (b fakeBucket) GetPresignedGetObject(ctx context.Context, key string, expires time.Duration) (string, error) {
+	return "http://bucket/" + key + "?signed", nil
+}
+
+func (b fakeBucket) StatObject(ctx context.Context, key string) (*s3.ObjectInfo, error) {
+	o, ok := b[key]
+	if !ok {
+		return nil, xerrors.New("no such key")
+	}
+	return &o.info, nil
+}
+
+func (b fakeBucket) ReadObjectHead(ctx context.Context, key string, n int64) ([]byte, error) {
+	body := b[key].body
+	if int64(len(body)) > n {
+		body = body[:n]
+	}
+	return body, nil
+}
+
+var pngHead = []byte("\x89PNG\x0D\x0A\x1A\x0A\x00\x00\x00\x0DIHDR")
+
+func TestGetUploadUrl(t *testing.T) {
+	s := &ServerModel{S3: fakeBucket{}}
+	ctx := context.Background()
+
+	url, _, key, err := s.GetUploadUrl(ctx, "logo.svg", 1000, "u1", c.UPLOAD_PURPOSE_IMAGE)
+	assert.Nil(t, err)
+	assert.Equal(t, "u1/logo.svg", key)
+	assert.Equal(t, "https://bucket/u1/logo.svg", url)
+
+	_, _, key, err = s.GetUploadUrl(ctx, "sink.png", 1000, "u1", c.UPLOAD_PURPOSE_ORDER_ATTACHMENT)
+	assert.Nil(t, err)
+	assert.Regexp(t, `^attachments/u1/[0-9a-f-]{36}\.png$`, key)
+
+	_, _, _, err = s.GetUploadUrl(ctx, "logo.svg", 1000, "u1", c.UPLOAD_PURPOSE_ORDER_ATTACHMENT)
+	assert.True(t, xerrors.Is(err, e.ErrInvalidFile))
+	_, _, _, err = s.GetUploadUrl(ctx, "sink.png", 30<<20, "u1", c.UPLOAD_PURPOSE_ORDER_ATTACHMENT)
+	assert.True(t, xerrors.Is(err, e.ErrFileTooLarge))
+	_, _, _, err = s.GetUploadUrl(ctx, "sink.png", 1000, "u1", c.UPLOAD_PURPOSE(42))
+	assert.True(t, xerrors.Is(err, e.ErrInvalidFile))
+}
+
+func TestCheckOrderAttachments(t *testing.T) {
+	s := &ServerModel{S3: fakeBucket{
+		"attachments/u1/a.png":    {info: s3.ObjectInfo{Size: 100, ContentType: "image/png"}, body: pngHead},
+		"attachments/u1/fake.png": {info: s3.ObjectInfo{Size: 100, ContentType: "image/png"}, body: []byte("<html><script>")},
+		"attachments/u1/big.png":  {info: s3.ObjectInfo{Size: 30 << 20, ContentType: "image/png"}, body: pngHead},
+		"attachments/u2/b.png":    {info: s3.ObjectInfo{Size: 100, ContentType: "image/png"}, body: pngHead},
+	}}
+	ctx := context.Background()
+
+	attachments, err := s.CheckOrderAttachments(ctx, "u1", []string{"attachments/u1/a.png"})
+	assert.Nil(t, err)
+	assert.Equal(t, []Attachment{{Key: "attachments/u1/a.png", ContentType: "image/png", Size: 100}}, attachments)
+
+	for _, keys := range [][]string{
+		{"attachments/u1/fake.png"},
+		{"attachments/u2/b.png"},
+		{"attachments/u1/missing.png"},
+		{"attachments/u1/../u2/b.png"},
+		{"attachments/u1/a.png", "attachments/u1/a.png"},
+		{"1", "2", "3", "4", "5", "6"},
+	} {
+		_, err := s.CheckOrderAttachments(ctx, "u1", keys)
+		assert.True(t, xerrors.Is(err, e.ErrInvalidAttachment), keys)
+	}
+	_, err = s.CheckOrderAttachments(ctx, "u1", []string{"attachments/u1/big.png"})
+	assert.True(t, xerrors.Is(err, e.ErrFileTooLarge))
+}
+
+func TestPresignOrderAttachments(t *testing.T) {
+	s := &ServerModel{S3: fakeBucket{}}
+	customerId, handymanId := uuid.New(), uuid.New()
+	raw, err := encodeAttachments([]Attachment{{Key: "attachments/x/a.png", ContentType: "image/png", Size: 100}})
+	assert.Nil(t, err)
+	ord := &order.Order{
+		CustomerId:  customerId,
+		BusinessId:  handymanId,
+		Status:      utils.Int32Ptr(int32(c.ORDER_STATUS_PENDING)),
+		Attachments: raw,
+	}
+
+	urls := func(viewerId uuid.UUID) string {
+		upbs := []*pb.Order{s.ConvertOrderToProto(ord)}
+		assert.Nil(t, s.PresignOrderAttachments(context.Background(), viewerId.String(), []*order.Order{ord}, upbs))
+		assert.Len(t, upbs[0].Attachments, 1)
+		assert.Equal(t, "image/png", upbs[0].Attachments[0].ContentType)
+		return upbs[0].Attachments[0].Url
+	}
+	assert.Equal(t, "https://bucket/attachments/x/a.png?signed", urls(customerId))
+	assert.Equal(t, "", urls(handymanId))
+	assert.Equal(t, "", urls(uuid.New()))
+
+	ord.Status = utils.Int32Ptr(int32(c.ORDER_STATUS_CONNECTED))
+	assert.Equal(t, "https://bucket/attachments/x/a.png?signed", urls(handymanId))
+	assert.Equal(t, "", urls(uuid.New()))
+}
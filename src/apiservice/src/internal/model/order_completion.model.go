@@ -0,0 +1,141 @@
+package model
+
+import (
+	"context"
+	"time"
+
+	"github.com/aqaurius6666/apiservice/src/internal/db/order"
+	"github.com/aqaurius6666/apiservice/src/internal/lib"
+	"github.com/aqaurius6666/apiservice/src/internal/var/c"
+	"github.com/aqaurius6666/apiservice/src/internal/var/e"
+	"github.com/aqaurius6666/apiservice/src/pb"
+	"github.com/aqaurius6666/go-utils/utils"
+	"go.opentelemetry.io/otel"
+	"golang.org/x/xerrors"
+)
+
+var (
+	_ OrderCompletionModel = (*ServerModel)(nil)
+)
+
+// COMPLETION_CONFIRM_WINDOW is how long the other side has to answer a job
+// marked done before the cronjob confirms it.
+const COMPLETION_CONFIRM_WINDOW = 72 * time.Hour
+
+type OrderCompletionModel interface {
+	MarkOrderDone(ctx context.Context, ord *order.Order, actor OrderActor) error
+	ConfirmOrderCompletion(ctx context.Context, ord *order.Order, actor OrderActor) error
+	DisputeOrderCompletion(ctx context.Context, ord *order.Order, actor OrderActor) error
+}
+
+// MarkOrderDone records that the side of actor finished the job of ord. The
+// other side has COMPLETION_CONFIRM_WINDOW to confirm or dispute it.
+func (s *ServerModel) MarkOrderDone(ctx context.Context, ord *order.Order, actor OrderActor) error {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.MarkOrderDone))
+	defer span.End()
+
+	if err := s.CheckOrderTransition(ord, c.ORDER_STATUS_AWAITING_CONFIRMATION, actor); err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return err
+	}
+	now := time.Now()
+	err := s.TransitionOrder(ctx, ord.ID, &order.Order{
+		Status:                 utils.Int32Ptr(int32(c.ORDER_STATUS_AWAITING_CONFIRMATION)),
+		CompletionRequestedBy:  utils.Int32Ptr(int32(actor.Role)),
+		CompletionRequestedAt:  utils.Int64Ptr(now.UnixMilli()),
+		ConfirmBy:              utils.Int64Ptr(now.Add(COMPLETION_CONFIRM_WINDOW).UnixMilli()),
+		CompletionConfirmation: utils.Int32Ptr(int32(c.COMPLETION_CONFIRMATION_UNCONFIRMED)),
+	}, actor)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return err
+	}
+	return nil
+}
+
+// ConfirmOrderCompletion completes the job of ord marked done by the other
+// side of actor.
+func (s *ServerModel) ConfirmOrderCompletion(ctx context.Context, ord *order.Order, actor OrderActor) error {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.ConfirmOrderCompletion))
+	defer span.End()
+
+	if err := checkCompletionAnswer(ord, actor); err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return err
+	}
+	err := s.TransitionOrder(ctx, ord.ID, &order.Order{
+		Status:                 utils.Int32Ptr(int32(c.ORDER_STATUS_COMPLETED)),
+		CompletionConfirmation: utils.Int32Ptr(int32(completionConfirmation(actor.Role))),
+		CompletedAt:            utils.Int64Ptr(time.Now().UnixMilli()),
+	}, actor)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return err
+	}
+	return nil
+}
+
+// DisputeOrderCompletion sends the job of ord marked done by the other side
+// of actor back to connected.
+func (s *ServerModel) DisputeOrderCompletion(ctx context.Context, ord *order.Order, actor OrderActor) error {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.DisputeOrderCompletion))
+	defer span.End()
+
+	if err := checkCompletionAnswer(ord, actor); err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return err
+	}
+	actor.Reason = c.ORDER_REASON_COMPLETION_DISPUTED
+	err := s.TransitionOrder(ctx, ord.ID, &order.Order{
+		Status: utils.Int32Ptr(int32(c.ORDER_STATUS_CONNECTED)),
+	}, actor)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return err
+	}
+	return nil
+}
+
+// checkCompletionAnswer tells whether actor may confirm or dispute the job of
+// ord, which only the side that did not mark it done can.
+func checkCompletionAnswer(ord *order.Order, actor OrderActor) error {
+	if utils.Int32Val(ord.Status) != int32(c.ORDER_STATUS_AWAITING_CONFIRMATION) {
+		return e.ErrInvalidOrderStatus
+	}
+	if ord.CompletionRequestedBy == nil || c.ROLE(*ord.CompletionRequestedBy) == actor.Role {
+		return e.ErrOwnCompletion
+	}
+	return nil
+}
+
+func completionConfirmation(role c.ROLE) c.COMPLETION_CONFIRMATION {
+	switch role {
+	case c.ROLE_CUSTOMER:
+		return c.COMPLETION_CONFIRMATION_CONFIRMED_BY_CUSTOMER
+	case c.ROLE_HANDYMAN:
+		return c.COMPLETION_CONFIRMATION_CONFIRMED_BY_HANDYMAN
+	}
+	return c.COMPLETION_CONFIRMATION_UNCONFIRMED
+}
+
+func convertCompletionToProto(u *order.Order, upb *pb.Order) {
+	if u.CompletionRequestedBy == nil {
+		return
+	}
+	switch c.ORDER_STATUS(utils.Int32Val(u.Status)) {
+	case c.ORDER_STATUS_AWAITING_CONFIRMATION, c.ORDER_STATUS_COMPLETED:
+		upb.Completion = &pb.Completion{
+			RequestedBy:  c.ROLE(*u.CompletionRequestedBy),
+			RequestedAt:  utils.Int64Val(u.CompletionRequestedAt),
+			ConfirmBy:    utils.Int64Val(u.ConfirmBy),
+			Confirmation: c.COMPLETION_CONFIRMATION(utils.Int32Val(u.CompletionConfirmation)),
+			CompletedAt:  utils.Int64Val(u.CompletedAt),
+		}
+	}
+}
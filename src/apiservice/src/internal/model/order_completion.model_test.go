@@ -0,0 +1,53 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/aqaurius6666/apiservice/src/internal/db/order"
+	"github.com/aqaurius6666/apiservice/src/internal/var/c"
+	"github.com/aqaurius6666/apiservice/src/internal/var/e"
+	"github.com/aqaurius6666/apiservice/src/pb"
+	"github.com/aqaurius6666/go-utils/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckCompletionAnswer(t *testing.T) {
+	handyman := OrderActor{Id: "b", Role: c.ROLE_HANDYMAN}
+	customer := OrderActor{Id: "c", Role: c.ROLE_CUSTOMER}
+	markedByHandyman := &order.Order{
+		Status:                utils.Int32Ptr(int32(c.ORDER_STATUS_AWAITING_CONFIRMATION)),
+		CompletionRequestedBy: utils.Int32Ptr(int32(c.ROLE_HANDYMAN)),
+	}
+
+	assert.NoError(t, checkCompletionAnswer(markedByHandyman, customer))
+	assert.ErrorIs(t, checkCompletionAnswer(markedByHandyman, handyman), e.ErrOwnCompletion)
+	assert.ErrorIs(t, checkCompletionAnswer(&order.Order{
+		Status:                utils.Int32Ptr(int32(c.ORDER_STATUS_CONNECTED)),
+		CompletionRequestedBy: utils.Int32Ptr(int32(c.ROLE_HANDYMAN)),
+	}, customer), e.ErrInvalidOrderStatus)
+
+	assert.Equal(t, c.COMPLETION_CONFIRMATION_CONFIRMED_BY_CUSTOMER, completionConfirmation(c.ROLE_CUSTOMER))
+	assert.Equal(t, c.COMPLETION_CONFIRMATION_CONFIRMED_BY_HANDYMAN, completionConfirmation(c.ROLE_HANDYMAN))
+}
+
+func TestConvertCompletionToProto(t *testing.T) {
+	u := &order.Order{
+		Status:                 utils.Int32Ptr(int32(c.ORDER_STATUS_COMPLETED)),
+		CompletionRequestedBy:  utils.Int32Ptr(int32(c.ROLE_CUSTOMER)),
+		CompletionRequestedAt:  utils.Int64Ptr(1000),
+		ConfirmBy:              utils.Int64Ptr(2000),
+		CompletionConfirmation: utils.Int32Ptr(int32(c.COMPLETION_CONFIRMATION_AUTO_CONFIRMED)),
+		CompletedAt:            utils.Int64Ptr(2000),
+	}
+	upb := &pb.Order{}
+	convertCompletionToProto(u, upb)
+	assert.Equal(t, c.ROLE_CUSTOMER, upb.Completion.RequestedBy)
+	assert.Equal(t, c.COMPLETION_CONFIRMATION_AUTO_CONFIRMED, upb.Completion.Confirmation)
+	assert.Equal(t, int64(2000), upb.Completion.CompletedAt)
+
+	// a disputed completion is left on the order but not shown
+	u.Status = utils.Int32Ptr(int32(c.ORDER_STATUS_CONNECTED))
+	upb = &pb.Order{}
+	convertCompletionToProto(u, upb)
+	assert.Nil(t, upb.Completion)
+}
@@ -0,0 +1,134 @@
+package model
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/aqaurius6666/apiservice/src/internal/db/order_event"
+	"github.com/aqaurius6666/apiservice/src/internal/lib"
+	"github.com/aqaurius6666/apiservice/src/internal/var/c"
+	"github.com/aqaurius6666/apiservice/src/pb"
+	"github.com/aqaurius6666/go-utils/utils"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"golang.org/x/xerrors"
+)
+
+var (
+	_ OrderEventModel = (*ServerModel)(nil)
+)
+
+type OrderEventModel interface {
+	ListOrderEvents(ctx context.Context, orderId interface{}) ([]*order_event.OrderEvent, error)
+
+	ConvertOrderEventToProtos(u []*order_event.OrderEvent) []*pb.OrderEvent
+	ConvertOrderEventToProto(u *order_event.OrderEvent) *pb.OrderEvent
+}
+
+// OrderActor is who changed the status of an order and why. A zero Id means
+// the system did. Note is free text shown along the reason.
+type OrderActor struct {
+	Id       string
+	Role     c.ROLE
+	Reason   c.ORDER_REASON
+	Note     string
+	Metadata map[string]string
+}
+
+// SystemActor returns the actor of changes nobody asked for, like expiry.
+func SystemActor(reason c.ORDER_REASON) OrderActor {
+	return OrderActor{Reason: reason}
+}
+
+func (a OrderActor) toEvent() (*order_event.OrderEvent, error) {
+	ev := &order_event.OrderEvent{}
+	if a.Id != "" {
+		id, err := lib.ToUUID(a.Id)
+		if err != nil {
+			return nil, xerrors.Errorf("%w", err)
+		}
+		ev.ActorId = id
+		ev.ActorRole = utils.Int32Ptr(int32(a.Role))
+	}
+	if a.Reason != c.ORDER_REASON_NO_REASON {
+		ev.Reason = utils.Int32Ptr(int32(a.Reason))
+	}
+	if a.Note != "" {
+		ev.Note = utils.StrPtr(a.Note)
+	}
+	if len(a.Metadata) != 0 {
+		raw, err := json.Marshal(a.Metadata)
+		if err != nil {
+			return nil, xerrors.Errorf("%w", err)
+		}
+		ev.Metadata = utils.StrPtr(string(raw))
+	}
+	return ev, nil
+}
+
+func (s *ServerModel) ListOrderEvents(ctx context.Context, orderId interface{}) ([]*order_event.OrderEvent, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.ListOrderEvents))
+	defer span.End()
+
+	oid, err := lib.ToUUID(orderId)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	evs, err := s.Repo.ListOrderEvents(ctx, &order_event.Search{
+		OrderEvent: order_event.OrderEvent{OrderId: oid},
+	})
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	return evs, nil
+}
+
+func (s *ServerModel) ConvertOrderEventToProto(u *order_event.OrderEvent) *pb.OrderEvent {
+	upb := new(pb.OrderEvent)
+	if u.ID != uuid.Nil {
+		upb.Id = u.ID.String()
+	}
+	if u.OrderId != uuid.Nil {
+		upb.OrderId = u.OrderId.String()
+	}
+	if u.ActorId != uuid.Nil {
+		upb.ActorId = u.ActorId.String()
+	}
+	if u.ActorRole != nil {
+		upb.ActorRole = c.ROLE(*u.ActorRole)
+	}
+	if u.OldStatus != nil {
+		upb.OldStatus = c.ORDER_STATUS(*u.OldStatus)
+	} else {
+		upb.Initial = true
+	}
+	if u.NewStatus != nil {
+		upb.NewStatus = c.ORDER_STATUS(*u.NewStatus)
+	}
+	if u.Reason != nil {
+		upb.Reason = c.ORDER_REASON(*u.Reason)
+	}
+	if u.Note != nil {
+		upb.Note = *u.Note
+	}
+	if u.Metadata != nil {
+		// metadata is written by toEvent, a broken value is only dropped
+		_ = json.Unmarshal([]byte(*u.Metadata), &upb.Metadata)
+	}
+	if u.CreatedAt != 0 {
+		upb.CreatedAt = u.CreatedAt
+	}
+	return upb
+}
+
+func (s *ServerModel) ConvertOrderEventToProtos(u []*order_event.OrderEvent) []*pb.OrderEvent {
+	arr := make([]*pb.OrderEvent, 0)
+	for _, a := range u {
+		arr = append(arr, s.ConvertOrderEventToProto(a))
+	}
+	return arr
+}
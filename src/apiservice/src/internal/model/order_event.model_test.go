@@ -0,0 +1,43 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/aqaurius6666/apiservice/src/internal/var/c"
+	"github.com/aqaurius6666/go-utils/utils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrderActorToEvent(t *testing.T) {
+	s := &ServerModel{}
+	id := uuid.New()
+
+	ev, err := OrderActor{
+		Id:       id.String(),
+		Role:     c.ROLE_HANDYMAN,
+		Reason:   c.ORDER_REASON_FULLY_BOOKED,
+		Note:     "busy",
+		Metadata: map[string]string{"conversationId": "x"},
+	}.toEvent()
+	assert.Nil(t, err)
+	ev.OldStatus = utils.Int32Ptr(int32(c.ORDER_STATUS_PENDING))
+	ev.NewStatus = utils.Int32Ptr(int32(c.ORDER_STATUS_REJECTED))
+	pb := s.ConvertOrderEventToProto(ev)
+	assert.Equal(t, id.String(), pb.ActorId)
+	assert.Equal(t, c.ROLE_HANDYMAN, pb.ActorRole)
+	assert.Equal(t, c.ORDER_REASON_FULLY_BOOKED, pb.Reason)
+	assert.Equal(t, "busy", pb.Note)
+	assert.Equal(t, "x", pb.Metadata["conversationId"])
+	assert.False(t, pb.Initial)
+
+	ev, err = SystemActor(c.ORDER_REASON_EXPIRED).toEvent()
+	assert.Nil(t, err)
+	assert.Equal(t, uuid.Nil, ev.ActorId)
+	assert.Nil(t, ev.ActorRole)
+	assert.Nil(t, ev.Metadata)
+	assert.True(t, s.ConvertOrderEventToProto(ev).Initial)
+
+	_, err = OrderActor{Id: "not an id"}.toEvent()
+	assert.NotNil(t, err)
+}
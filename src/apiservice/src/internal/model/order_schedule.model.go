@@ -0,0 +1,174 @@
+package model
+
+import (
+	"context"
+	"time"
+
+	"github.com/aqaurius6666/apiservice/src/internal/db/order"
+	"github.com/aqaurius6666/apiservice/src/internal/lib"
+	"github.com/aqaurius6666/apiservice/src/internal/var/c"
+	"github.com/aqaurius6666/apiservice/src/internal/var/e"
+	"github.com/aqaurius6666/apiservice/src/pb"
+	"github.com/aqaurius6666/go-utils/utils"
+	"github.com/lib/pq"
+	"go.opentelemetry.io/otel"
+	"golang.org/x/xerrors"
+)
+
+var (
+	_ OrderScheduleModel = (*ServerModel)(nil)
+)
+
+const (
+	MAX_ORDER_WINDOWS = 5
+)
+
+type OrderScheduleModel interface {
+	ProposeOrderSlot(ctx context.Context, orderId interface{}, slot TimeWindow) error
+	AnswerOrderSlot(ctx context.Context, ord *order.Order, accept bool) error
+}
+
+// TimeWindow is a span of time in unix milliseconds.
+type TimeWindow struct {
+	Start int64
+	End   int64
+}
+
+// OrderSchedule is when a customer needs the job of an order done.
+// PreferredDate and Windows are only set for ORDER_URGENCY_ON_DATE.
+type OrderSchedule struct {
+	Urgency       c.ORDER_URGENCY
+	PreferredDate int64
+	Windows       []TimeWindow
+}
+
+// NewOrderSchedule checks the schedule a customer asked for: dates and
+// windows must not be in the past, and there are at most MAX_ORDER_WINDOWS
+// windows. The preferred date defaults to the start of the earliest window.
+func NewOrderSchedule(urgency c.ORDER_URGENCY, preferredDate int64, windows []TimeWindow) (OrderSchedule, error) {
+	if _, ok := c.ORDER_URGENCY_name[int32(urgency)]; !ok {
+		return OrderSchedule{}, e.ErrInvalidSchedule
+	}
+	if urgency != c.ORDER_URGENCY_ON_DATE {
+		if preferredDate != 0 || len(windows) != 0 {
+			return OrderSchedule{}, e.ErrInvalidSchedule
+		}
+		return OrderSchedule{Urgency: urgency}, nil
+	}
+	if len(windows) > MAX_ORDER_WINDOWS {
+		return OrderSchedule{}, e.ErrInvalidSchedule
+	}
+	now := time.Now().UnixMilli()
+	earliest := int64(0)
+	for _, w := range windows {
+		if !w.valid(now) {
+			return OrderSchedule{}, e.ErrInvalidSchedule
+		}
+		if earliest == 0 || w.Start < earliest {
+			earliest = w.Start
+		}
+	}
+	if preferredDate == 0 {
+		preferredDate = earliest
+	}
+	if preferredDate < now {
+		return OrderSchedule{}, e.ErrInvalidSchedule
+	}
+	return OrderSchedule{Urgency: urgency, PreferredDate: preferredDate, Windows: windows}, nil
+}
+
+// valid tells whether the window is well formed and starts at now or later.
+func (w TimeWindow) valid(now int64) bool {
+	return w.Start >= now && w.End > w.Start
+}
+
+// apply sets the schedule on the order about to be inserted.
+func (sc OrderSchedule) apply(ord *order.Order) {
+	ord.Urgency = utils.Int32Ptr(int32(sc.Urgency))
+	if sc.PreferredDate != 0 {
+		ord.PreferredDate = utils.Int64Ptr(sc.PreferredDate)
+	}
+	if len(sc.Windows) != 0 {
+		ord.WindowStarts = make(pq.Int64Array, 0, len(sc.Windows))
+		ord.WindowEnds = make(pq.Int64Array, 0, len(sc.Windows))
+		for _, w := range sc.Windows {
+			ord.WindowStarts = append(ord.WindowStarts, w.Start)
+			ord.WindowEnds = append(ord.WindowEnds, w.End)
+		}
+	}
+}
+
+// ProposeOrderSlot offers the customer of an order another slot for the job,
+// replacing any earlier proposal. The slot must not be in the past.
+func (s *ServerModel) ProposeOrderSlot(ctx context.Context, orderId interface{}, slot TimeWindow) error {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.ProposeOrderSlot))
+	defer span.End()
+
+	if !slot.valid(time.Now().UnixMilli()) {
+		err := xerrors.Errorf("%w", e.ErrInvalidSchedule)
+		lib.RecordError(span, err, ctx)
+		return err
+	}
+	err := s.UpdateOrderById(ctx, orderId, &order.Order{
+		ProposedStart:  utils.Int64Ptr(slot.Start),
+		ProposedEnd:    utils.Int64Ptr(slot.End),
+		ProposalStatus: utils.Int32Ptr(int32(c.SLOT_PROPOSAL_STATUS_PROPOSED)),
+	})
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return err
+	}
+	return nil
+}
+
+// AnswerOrderSlot records the answer of the customer to the slot proposed for
+// ord. An accepted slot becomes the schedule of the order.
+func (s *ServerModel) AnswerOrderSlot(ctx context.Context, ord *order.Order, accept bool) error {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.AnswerOrderSlot))
+	defer span.End()
+
+	if ord.ProposalStatus == nil || *ord.ProposalStatus != int32(c.SLOT_PROPOSAL_STATUS_PROPOSED) {
+		err := xerrors.Errorf("%w", e.ErrNoSlotProposal)
+		lib.RecordError(span, err, ctx)
+		return err
+	}
+	value := &order.Order{
+		ProposalStatus: utils.Int32Ptr(int32(c.SLOT_PROPOSAL_STATUS_DECLINED)),
+	}
+	if accept {
+		value.ProposalStatus = utils.Int32Ptr(int32(c.SLOT_PROPOSAL_STATUS_ACCEPTED))
+		OrderSchedule{
+			Urgency:       c.ORDER_URGENCY_ON_DATE,
+			PreferredDate: *ord.ProposedStart,
+			Windows:       []TimeWindow{{Start: *ord.ProposedStart, End: *ord.ProposedEnd}},
+		}.apply(value)
+	}
+	err := s.UpdateOrderById(ctx, ord.ID, value)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return err
+	}
+	return nil
+}
+
+func convertScheduleToProto(u *order.Order, upb *pb.Order) {
+	if u.Urgency != nil {
+		upb.Urgency = c.ORDER_URGENCY(*u.Urgency)
+	}
+	if u.PreferredDate != nil {
+		upb.PreferredDate = *u.PreferredDate
+	}
+	for i := range u.WindowStarts {
+		if i < len(u.WindowEnds) {
+			upb.TimeWindows = append(upb.TimeWindows, &pb.TimeWindow{Start: u.WindowStarts[i], End: u.WindowEnds[i]})
+		}
+	}
+	if u.ProposedStart != nil && u.ProposedEnd != nil && u.ProposalStatus != nil {
+		upb.SlotProposal = &pb.SlotProposal{
+			Slot:   &pb.TimeWindow{Start: *u.ProposedStart, End: *u.ProposedEnd},
+			Status: c.SLOT_PROPOSAL_STATUS(*u.ProposalStatus),
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package model
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aqaurius6666/apiservice/src/internal/db/order"
+	"github.com/aqaurius6666/apiservice/src/internal/var/c"
+	"github.com/aqaurius6666/apiservice/src/internal/var/e"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/xerrors"
+)
+
+func TestNewOrderSchedule(t *testing.T) {
+	sc, err := NewOrderSchedule(c.ORDER_URGENCY_ASAP, 0, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, c.ORDER_URGENCY_ASAP, sc.Urgency)
+
+	_, err = NewOrderSchedule(c.ORDER_URGENCY_ASAP, 1000, nil)
+	assert.True(t, xerrors.Is(err, e.ErrInvalidSchedule))
+
+	_, err = NewOrderSchedule(c.ORDER_URGENCY_ON_DATE, 0, nil)
+	assert.True(t, xerrors.Is(err, e.ErrInvalidSchedule))
+
+	now := time.Now().UnixMilli()
+	hour := time.Hour.Milliseconds()
+	_, err = NewOrderSchedule(c.ORDER_URGENCY_ON_DATE, 0, []TimeWindow{{Start: now + 2*hour, End: now + hour}})
+	assert.True(t, xerrors.Is(err, e.ErrInvalidSchedule))
+
+	sc, err = NewOrderSchedule(c.ORDER_URGENCY_ON_DATE, 0, []TimeWindow{{Start: now + 5*hour, End: now + 6*hour}, {Start: now + 3*hour, End: now + 4*hour}})
+	assert.Nil(t, err)
+	assert.Equal(t, now+3*hour, sc.PreferredDate)
+
+	ord := &order.Order{}
+	sc.apply(ord)
+	assert.Equal(t, int32(c.ORDER_URGENCY_ON_DATE), *ord.Urgency)
+	assert.Equal(t, []int64{now + 5*hour, now + 3*hour}, []int64(ord.WindowStarts))
+	assert.Equal(t, []int64{now + 6*hour, now + 4*hour}, []int64(ord.WindowEnds))
+
+	// a given preferred date is kept, even after the earliest window
+	sc, err = NewOrderSchedule(c.ORDER_URGENCY_ON_DATE, now+5*hour, []TimeWindow{{Start: now + 3*hour, End: now + 4*hour}})
+	assert.Nil(t, err)
+	assert.Equal(t, now+5*hour, sc.PreferredDate)
+
+	// past dates and windows are refused
+	_, err = NewOrderSchedule(c.ORDER_URGENCY_ON_DATE, now-hour, nil)
+	assert.True(t, xerrors.Is(err, e.ErrInvalidSchedule))
+	_, err = NewOrderSchedule(c.ORDER_URGENCY_ON_DATE, now+hour, []TimeWindow{{Start: now - 2*hour, End: now - hour}})
+	assert.True(t, xerrors.Is(err, e.ErrInvalidSchedule))
+
+	windows := make([]TimeWindow, MAX_ORDER_WINDOWS+1)
+	for i := range windows {
+		windows[i] = TimeWindow{Start: now + int64(i+1)*hour, End: now + int64(i+2)*hour}
+	}
+	_, err = NewOrderSchedule(c.ORDER_URGENCY_ON_DATE, 0, windows[:MAX_ORDER_WINDOWS])
+	assert.Nil(t, err)
+	_, err = NewOrderSchedule(c.ORDER_URGENCY_ON_DATE, 0, windows)
+	assert.True(t, xerrors.Is(err, e.ErrInvalidSchedule))
+}
+
+func TestProposeOrderSlot(t *testing.T) {
+	s := &ServerModel{}
+	ctx := context.Background()
+	now := time.Now()
+
+	TEST_CASE := []TimeWindow{
+		{Start: now.Add(-2 * time.Hour).UnixMilli(), End: now.Add(-time.Hour).UnixMilli()},
+		{Start: now.Add(-time.Hour).UnixMilli(), End: now.Add(time.Hour).UnixMilli()},
+		{Start: now.Add(2 * time.Hour).UnixMilli(), End: now.Add(time.Hour).UnixMilli()},
+	}
+	for _, slot := range TEST_CASE {
+		err := s.ProposeOrderSlot(ctx, "order", slot)
+		assert.True(t, xerrors.Is(err, e.ErrInvalidSchedule), slot)
+	}
+}
@@ -0,0 +1,187 @@
+package model
+
+import (
+	"sort"
+
+	"github.com/aqaurius6666/apiservice/src/internal/db/order"
+	"github.com/aqaurius6666/apiservice/src/internal/var/c"
+	"github.com/aqaurius6666/apiservice/src/internal/var/e"
+	"golang.org/x/xerrors"
+)
+
+// orderRule lets actors with one of roles, or the system, make a status
+// change. Actors must give one of reasons when required is set and may give
+// one of them otherwise.
+type orderRule struct {
+	roles    []c.ROLE
+	system   bool
+	reasons  []c.ORDER_REASON
+	required bool
+}
+
+var (
+	rejectReasons = []c.ORDER_REASON{
+		c.ORDER_REASON_OUTSIDE_SERVICE_AREA,
+		c.ORDER_REASON_FULLY_BOOKED,
+		c.ORDER_REASON_SERVICE_NOT_OFFERED,
+		c.ORDER_REASON_OTHER_REASON,
+	}
+	cancelReasons = []c.ORDER_REASON{
+		c.ORDER_REASON_CHANGED_MIND,
+		c.ORDER_REASON_HIRED_ELSEWHERE,
+		c.ORDER_REASON_OTHER_REASON,
+	}
+	staffRoles = []c.ROLE{c.ROLE_ADMIN, c.ROLE_SUPPORT, c.ROLE_FINANCE, c.ROLE_MODERATOR}
+	sideRoles  = []c.ROLE{c.ROLE_CUSTOMER, c.ROLE_HANDYMAN}
+
+	suspendRule = orderRule{
+		roles:    staffRoles,
+		reasons:  []c.ORDER_REASON{c.ORDER_REASON_SUSPENDED},
+		required: true,
+	}
+	deleteAccountRule = orderRule{
+		roles:    sideRoles,
+		reasons:  []c.ORDER_REASON{c.ORDER_REASON_ACCOUNT_DELETED},
+		required: true,
+	}
+)
+
+// orderTransitions is the order state machine, the rules of every allowed
+// status change by old and new status. Completed, rejected, canceled and
+// expired orders never change. A handyman may still connect a pending order
+// directly, without a quote, as before quotes existed; only the customer's
+// connect must come from accepting a quote, and a handyman cannot claim that
+// reason. The cronjob expires pending orders past their end date. Jobs are
+// completed by a handshake: one side marks the job done and the other
+// confirms it or disputes it back to connected, which only
+// checkCompletionAnswer can tell apart from the side that marked it. The
+// cronjob confirms jobs nobody answered in time.
+var orderTransitions = map[c.ORDER_STATUS]map[c.ORDER_STATUS][]orderRule{
+	c.ORDER_STATUS_PENDING: {
+		c.ORDER_STATUS_CONNECTED: {
+			{roles: []c.ROLE{c.ROLE_HANDYMAN}},
+			{roles: []c.ROLE{c.ROLE_CUSTOMER}, reasons: []c.ORDER_REASON{c.ORDER_REASON_QUOTE_ACCEPTED}, required: true},
+		},
+		c.ORDER_STATUS_REJECTED: {
+			{roles: []c.ROLE{c.ROLE_HANDYMAN}, reasons: rejectReasons, required: true},
+		},
+		c.ORDER_STATUS_CANCELED: {
+			{roles: []c.ROLE{c.ROLE_CUSTOMER}, reasons: cancelReasons},
+			suspendRule,
+			deleteAccountRule,
+		},
+		c.ORDER_STATUS_EXPIRED_STATUS: {
+			{system: true, reasons: []c.ORDER_REASON{c.ORDER_REASON_EXPIRED}, required: true},
+		},
+	},
+	c.ORDER_STATUS_CONNECTED: {
+		c.ORDER_STATUS_REJECTED: {
+			{
+				roles:    []c.ROLE{c.ROLE_HANDYMAN},
+				reasons:  append([]c.ORDER_REASON{c.ORDER_REASON_CUSTOMER_UNRESPONSIVE}, rejectReasons...),
+				required: true,
+			},
+		},
+		c.ORDER_STATUS_CANCELED: {
+			suspendRule,
+			deleteAccountRule,
+		},
+		c.ORDER_STATUS_AWAITING_CONFIRMATION: {
+			{roles: sideRoles},
+		},
+	},
+	c.ORDER_STATUS_AWAITING_CONFIRMATION: {
+		c.ORDER_STATUS_COMPLETED: {
+			{roles: sideRoles},
+			{system: true, reasons: []c.ORDER_REASON{c.ORDER_REASON_CONFIRMATION_TIMEOUT}, required: true},
+		},
+		c.ORDER_STATUS_CONNECTED: {
+			{roles: sideRoles, reasons: []c.ORDER_REASON{c.ORDER_REASON_COMPLETION_DISPUTED}, required: true},
+		},
+		c.ORDER_STATUS_CANCELED: {
+			suspendRule,
+			deleteAccountRule,
+		},
+	},
+}
+
+func (r orderRule) actedBy(actor OrderActor) bool {
+	if actor.Id == "" {
+		return r.system
+	}
+	for _, ro := range r.roles {
+		if ro == actor.Role {
+			return true
+		}
+	}
+	return false
+}
+
+func (r orderRule) accepts(reason c.ORDER_REASON) bool {
+	if reason == c.ORDER_REASON_NO_REASON {
+		return !r.required
+	}
+	for _, re := range r.reasons {
+		if re == reason {
+			return true
+		}
+	}
+	return false
+}
+
+// checkOrderTransition tells whether actor may move an order from one status
+// to another. It fails with e.ErrInvalidOrderStatus when the change is not
+// allowed at all, e.ErrNoPermission when it is not allowed to actor and
+// e.ErrInvalidOrderReason when actor gave a wrong reason.
+func checkOrderTransition(from, to c.ORDER_STATUS, actor OrderActor) error {
+	rules := orderTransitions[from][to]
+	if len(rules) == 0 {
+		return e.ErrInvalidOrderStatus
+	}
+	err := e.ErrNoPermission
+	for _, r := range rules {
+		if !r.actedBy(actor) {
+			continue
+		}
+		if !r.accepts(actor.Reason) {
+			err = e.ErrInvalidOrderReason
+			continue
+		}
+		return nil
+	}
+	return err
+}
+
+// orderSources returns the statuses actor may move orders from to reach to,
+// failing with the error of checkOrderTransition when there are none.
+func orderSources(to c.ORDER_STATUS, actor OrderActor) ([]int32, error) {
+	var (
+		from []int32
+		err  = e.ErrInvalidOrderStatus
+	)
+	for st := range orderTransitions {
+		switch cerr := checkOrderTransition(st, to, actor); {
+		case cerr == nil:
+			from = append(from, int32(st))
+		case xerrors.Is(err, e.ErrInvalidOrderStatus), xerrors.Is(cerr, e.ErrInvalidOrderReason):
+			err = cerr
+		}
+	}
+	if len(from) == 0 {
+		return nil, err
+	}
+	sort.Slice(from, func(i, j int) bool { return from[i] < from[j] })
+	return from, nil
+}
+
+// CheckOrderTransition tells whether actor may move ord to status to, so
+// callers can check before work that has to happen ahead of the change.
+func (s *ServerModel) CheckOrderTransition(ord *order.Order, to c.ORDER_STATUS, actor OrderActor) error {
+	if ord.Status == nil {
+		return xerrors.Errorf("%w", e.ErrInvalidOrderStatus)
+	}
+	if err := checkOrderTransition(c.ORDER_STATUS(*ord.Status), to, actor); err != nil {
+		return xerrors.Errorf("%w", err)
+	}
+	return nil
+}
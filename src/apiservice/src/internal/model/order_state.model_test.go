@@ -0,0 +1,78 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/aqaurius6666/apiservice/src/internal/var/c"
+	"github.com/aqaurius6666/apiservice/src/internal/var/e"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/xerrors"
+)
+
+func TestCheckOrderTransition(t *testing.T) {
+	handyman := OrderActor{Id: "b", Role: c.ROLE_HANDYMAN}
+	customer := OrderActor{Id: "c", Role: c.ROLE_CUSTOMER}
+	fullyBooked := OrderActor{Id: "b", Role: c.ROLE_HANDYMAN, Reason: c.ORDER_REASON_FULLY_BOOKED}
+	quoteAccepted := OrderActor{Id: "c", Role: c.ROLE_CUSTOMER, Reason: c.ORDER_REASON_QUOTE_ACCEPTED}
+	handymanQuote := OrderActor{Id: "b", Role: c.ROLE_HANDYMAN, Reason: c.ORDER_REASON_QUOTE_ACCEPTED}
+	disputed := OrderActor{Id: "c", Role: c.ROLE_CUSTOMER, Reason: c.ORDER_REASON_COMPLETION_DISPUTED}
+
+	TEST_CASE := []struct {
+		from, to c.ORDER_STATUS
+		actor    OrderActor
+		err      error
+	}{
+		// a handyman connects directly, without a quote, on purpose
+		{c.ORDER_STATUS_PENDING, c.ORDER_STATUS_CONNECTED, handyman, nil},
+		{c.ORDER_STATUS_PENDING, c.ORDER_STATUS_CONNECTED, handymanQuote, e.ErrInvalidOrderReason},
+		{c.ORDER_STATUS_PENDING, c.ORDER_STATUS_CONNECTED, customer, e.ErrInvalidOrderReason},
+		{c.ORDER_STATUS_PENDING, c.ORDER_STATUS_CONNECTED, quoteAccepted, nil},
+		{c.ORDER_STATUS_PENDING, c.ORDER_STATUS_CANCELED, quoteAccepted, e.ErrInvalidOrderReason},
+		{c.ORDER_STATUS_PENDING, c.ORDER_STATUS_REJECTED, handyman, e.ErrInvalidOrderReason},
+		{c.ORDER_STATUS_PENDING, c.ORDER_STATUS_REJECTED, fullyBooked, nil},
+		{c.ORDER_STATUS_PENDING, c.ORDER_STATUS_EXPIRED_STATUS, SystemActor(c.ORDER_REASON_EXPIRED), nil},
+		{c.ORDER_STATUS_PENDING, c.ORDER_STATUS_EXPIRED_STATUS, customer, e.ErrNoPermission},
+		{c.ORDER_STATUS_PENDING, c.ORDER_STATUS_REJECTED, SystemActor(c.ORDER_REASON_EXPIRED), e.ErrNoPermission},
+		{c.ORDER_STATUS_CONNECTED, c.ORDER_STATUS_EXPIRED_STATUS, SystemActor(c.ORDER_REASON_EXPIRED), e.ErrInvalidOrderStatus},
+		{c.ORDER_STATUS_PENDING, c.ORDER_STATUS_CANCELED, customer, nil},
+		{c.ORDER_STATUS_CONNECTED, c.ORDER_STATUS_CANCELED, customer, e.ErrInvalidOrderReason},
+		{c.ORDER_STATUS_CONNECTED, c.ORDER_STATUS_COMPLETED, customer, e.ErrInvalidOrderStatus},
+		{c.ORDER_STATUS_CONNECTED, c.ORDER_STATUS_AWAITING_CONFIRMATION, customer, nil},
+		{c.ORDER_STATUS_CONNECTED, c.ORDER_STATUS_AWAITING_CONFIRMATION, handyman, nil},
+		{c.ORDER_STATUS_AWAITING_CONFIRMATION, c.ORDER_STATUS_COMPLETED, handyman, nil},
+		{c.ORDER_STATUS_AWAITING_CONFIRMATION, c.ORDER_STATUS_COMPLETED, SystemActor(c.ORDER_REASON_CONFIRMATION_TIMEOUT), nil},
+		{c.ORDER_STATUS_AWAITING_CONFIRMATION, c.ORDER_STATUS_COMPLETED, SystemActor(c.ORDER_REASON_EXPIRED), e.ErrInvalidOrderReason},
+		{c.ORDER_STATUS_AWAITING_CONFIRMATION, c.ORDER_STATUS_CONNECTED, disputed, nil},
+		{c.ORDER_STATUS_AWAITING_CONFIRMATION, c.ORDER_STATUS_CONNECTED, handyman, e.ErrInvalidOrderReason},
+		{c.ORDER_STATUS_PENDING, c.ORDER_STATUS_COMPLETED, customer, e.ErrInvalidOrderStatus},
+		{c.ORDER_STATUS_COMPLETED, c.ORDER_STATUS_CANCELED, customer, e.ErrInvalidOrderStatus},
+	}
+	for _, tc := range TEST_CASE {
+		err := checkOrderTransition(tc.from, tc.to, tc.actor)
+		if tc.err == nil {
+			assert.Nil(t, err, "%s -> %s", tc.from, tc.to)
+		} else {
+			assert.True(t, xerrors.Is(err, tc.err), "%s -> %s: %v", tc.from, tc.to, err)
+		}
+	}
+}
+
+func TestOrderSources(t *testing.T) {
+	from, err := orderSources(c.ORDER_STATUS_CANCELED, OrderActor{Id: "a", Role: c.ROLE_ADMIN, Reason: c.ORDER_REASON_SUSPENDED})
+	assert.Nil(t, err)
+	assert.Equal(t, []int32{int32(c.ORDER_STATUS_PENDING), int32(c.ORDER_STATUS_CONNECTED), int32(c.ORDER_STATUS_AWAITING_CONFIRMATION)}, from)
+
+	from, err = orderSources(c.ORDER_STATUS_CONNECTED, OrderActor{Id: "b", Role: c.ROLE_HANDYMAN})
+	assert.Nil(t, err)
+	assert.Equal(t, []int32{int32(c.ORDER_STATUS_PENDING)}, from)
+
+	from, err = orderSources(c.ORDER_STATUS_CANCELED, OrderActor{Id: "c", Role: c.ROLE_CUSTOMER})
+	assert.Nil(t, err)
+	assert.Equal(t, []int32{int32(c.ORDER_STATUS_PENDING)}, from)
+
+	_, err = orderSources(c.ORDER_STATUS_REJECTED, OrderActor{Id: "b", Role: c.ROLE_HANDYMAN})
+	assert.True(t, xerrors.Is(err, e.ErrInvalidOrderReason))
+
+	_, err = orderSources(c.ORDER_STATUS_CONNECTED, SystemActor(c.ORDER_REASON_EXPIRED))
+	assert.True(t, xerrors.Is(err, e.ErrNoPermission))
+}
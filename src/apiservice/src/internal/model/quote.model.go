@@ -0,0 +1,220 @@
+package model
+
+import (
+	"context"
+	"time"
+	"unicode/utf8"
+
+	"github.com/aqaurius6666/apiservice/src/internal/db/order"
+	"github.com/aqaurius6666/apiservice/src/internal/db/quote"
+	"github.com/aqaurius6666/apiservice/src/internal/lib"
+	"github.com/aqaurius6666/apiservice/src/internal/var/c"
+	"github.com/aqaurius6666/apiservice/src/internal/var/e"
+	"github.com/aqaurius6666/apiservice/src/pb"
+	"github.com/aqaurius6666/go-utils/database"
+	"github.com/aqaurius6666/go-utils/utils"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"golang.org/x/xerrors"
+)
+
+var (
+	_ QuoteModel = (*ServerModel)(nil)
+)
+
+const (
+	QUOTE_VALIDITY         = 7 * 24 * time.Hour
+	MAX_QUOTE_VALIDITY     = 30 * 24 * time.Hour
+	MAX_QUOTE_MESSAGE_SIZE = 512
+)
+
+type QuoteModel interface {
+	SubmitQuote(ctx context.Context, ord *order.Order, offer QuoteOffer) (*quote.Quote, error)
+	GetQuoteById(ctx context.Context, id interface{}) (*quote.Quote, error)
+	ListQuotes(ctx context.Context, search *quote.Search) ([]*quote.Quote, error)
+
+	ConvertQuoteToProto(u *quote.Quote) *pb.Quote
+	ConvertQuoteToProtos(u []*quote.Quote) []*pb.Quote
+}
+
+// QuoteOffer is what a handyman offers in a quote. A zero PriceMax is a fixed
+// price, a zero ValidUntil lasts QUOTE_VALIDITY.
+type QuoteOffer struct {
+	PriceMin   float32
+	PriceMax   float32
+	VisitFee   float32
+	Message    string
+	ValidUntil int64
+}
+
+// check checks the offer made at now, filling in its defaults.
+func (o QuoteOffer) check(now time.Time) (QuoteOffer, error) {
+	if o.PriceMax == 0 {
+		o.PriceMax = o.PriceMin
+	}
+	if o.ValidUntil == 0 {
+		o.ValidUntil = now.Add(QUOTE_VALIDITY).UnixMilli()
+	}
+	if o.PriceMin <= 0 || o.PriceMax < o.PriceMin || o.VisitFee < 0 ||
+		utf8.RuneCountInString(o.Message) > MAX_QUOTE_MESSAGE_SIZE ||
+		o.ValidUntil <= now.UnixMilli() || o.ValidUntil > now.Add(MAX_QUOTE_VALIDITY).UnixMilli() {
+		return QuoteOffer{}, e.ErrInvalidQuote
+	}
+	return o, nil
+}
+
+// IsOpen tells whether q can still be accepted at now.
+func IsOpen(q *quote.Quote, now time.Time) bool {
+	return utils.Int32Val(q.Status) == int32(c.QUOTE_STATUS_OPEN) && utils.Int64Val(q.ValidUntil) > now.UnixMilli()
+}
+
+// SubmitQuote makes the quote of the handyman of ord, replacing their open
+// quote for it.
+func (s *ServerModel) SubmitQuote(ctx context.Context, ord *order.Order, offer QuoteOffer) (*quote.Quote, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.SubmitQuote))
+	defer span.End()
+
+	offer, err := offer.check(time.Now())
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	value := &quote.Quote{
+		PriceMin:   utils.Float32Ptr(offer.PriceMin),
+		PriceMax:   utils.Float32Ptr(offer.PriceMax),
+		VisitFee:   utils.Float32Ptr(offer.VisitFee),
+		Message:    utils.StrPtr(offer.Message),
+		ValidUntil: utils.Int64Ptr(offer.ValidUntil),
+	}
+	q, err := s.Repo.SelectQuote(ctx, &quote.Search{Quote: quote.Quote{
+		OrderId: ord.ID,
+		Status:  utils.Int32Ptr(int32(c.QUOTE_STATUS_OPEN)),
+	}})
+	if err == nil {
+		err = s.Repo.UpdateQuotes(ctx, &quote.Search{
+			Quote: quote.Quote{BaseModel: database.BaseModel{ID: q.ID}},
+		}, value)
+		if err != nil {
+			err = xerrors.Errorf("%w", err)
+			lib.RecordError(span, err, ctx)
+			return nil, err
+		}
+		q.PriceMin, q.PriceMax, q.VisitFee = value.PriceMin, value.PriceMax, value.VisitFee
+		q.Message, q.ValidUntil = value.Message, value.ValidUntil
+		return q, nil
+	}
+	if !xerrors.Is(err, quote.ErrNotFound) {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+
+	ser, err := s.GetServiceById(ctx, ord.ServiceId)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	value.OrderId = ord.ID
+	value.BusinessId = ord.BusinessId
+	value.CustomerId = ord.CustomerId
+	value.CategoryId = ser.CategoryId
+	value.CustomerZipcode = ord.CustomerZipcode
+	value.Status = utils.Int32Ptr(int32(c.QUOTE_STATUS_OPEN))
+	q, err = s.Repo.InsertQuote(ctx, value)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	return q, nil
+}
+
+func (s *ServerModel) GetQuoteById(ctx context.Context, id interface{}) (*quote.Quote, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.GetQuoteById))
+	defer span.End()
+
+	qid, err := lib.ToUUID(id)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	q, err := s.Repo.SelectQuote(ctx, &quote.Search{Quote: quote.Quote{BaseModel: database.BaseModel{ID: qid}}})
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	return q, nil
+}
+
+// ListQuotes lists quotes. Open quotes past their validity are lapsed by the
+// cronjob, not here.
+func (s *ServerModel) ListQuotes(ctx context.Context, search *quote.Search) ([]*quote.Quote, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.ListQuotes))
+	defer span.End()
+
+	r, err := s.Repo.ListQuotes(ctx, search)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	return r, nil
+}
+
+func (s *ServerModel) ConvertQuoteToProto(u *quote.Quote) *pb.Quote {
+	upb := new(pb.Quote)
+	if u.ID != uuid.Nil {
+		upb.Id = u.ID.String()
+	}
+	if u.OrderId != uuid.Nil {
+		upb.OrderId = u.OrderId.String()
+	}
+	if u.BusinessId != uuid.Nil {
+		upb.BusinessId = u.BusinessId.String()
+	}
+	if u.BusinessName != nil {
+		upb.BusinessName = *u.BusinessName
+	}
+	if u.BusinessLogo != nil {
+		upb.BusinessLogo = *u.BusinessLogo
+	}
+	if u.PriceMin != nil {
+		upb.PriceMin = *u.PriceMin
+	}
+	if u.PriceMax != nil {
+		upb.PriceMax = *u.PriceMax
+	}
+	if u.VisitFee != nil {
+		upb.VisitFee = *u.VisitFee
+	}
+	if u.Message != nil {
+		upb.Message = *u.Message
+	}
+	if u.ValidUntil != nil {
+		upb.ValidUntil = *u.ValidUntil
+	}
+	if u.Status != nil {
+		upb.Status = c.QUOTE_STATUS(*u.Status)
+	}
+	// an open quote past its validity is shown lapsed until the cronjob
+	// lapses it
+	if upb.Status == c.QUOTE_STATUS_OPEN && !IsOpen(u, time.Now()) {
+		upb.Status = c.QUOTE_STATUS_LAPSED
+	}
+	if u.CreatedAt != 0 {
+		upb.CreatedAt = u.CreatedAt
+	}
+	return upb
+}
+
+func (s *ServerModel) ConvertQuoteToProtos(u []*quote.Quote) []*pb.Quote {
+	arr := make([]*pb.Quote, 0)
+	for _, a := range u {
+		arr = append(arr, s.ConvertQuoteToProto(a))
+	}
+	return arr
+}
@@ -0,0 +1,66 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aqaurius6666/apiservice/src/internal/db/quote"
+	"github.com/aqaurius6666/apiservice/src/internal/var/c"
+	"github.com/aqaurius6666/apiservice/src/internal/var/e"
+	"github.com/aqaurius6666/go-utils/utils"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/xerrors"
+)
+
+func TestQuoteOfferCheck(t *testing.T) {
+	now := time.Now()
+
+	offer, err := QuoteOffer{PriceMin: 120}.check(now)
+	assert.Nil(t, err)
+	assert.Equal(t, float32(120), offer.PriceMax)
+	assert.Equal(t, now.Add(QUOTE_VALIDITY).UnixMilli(), offer.ValidUntil)
+
+	offer, err = QuoteOffer{PriceMin: 100, PriceMax: 150, VisitFee: 30, ValidUntil: now.Add(time.Hour).UnixMilli()}.check(now)
+	assert.Nil(t, err)
+	assert.Equal(t, float32(150), offer.PriceMax)
+
+	for _, o := range []QuoteOffer{
+		{},
+		{PriceMin: -1},
+		{PriceMin: 100, PriceMax: 50},
+		{PriceMin: 100, VisitFee: -1},
+		{PriceMin: 100, ValidUntil: now.Add(-time.Hour).UnixMilli()},
+		{PriceMin: 100, ValidUntil: now.Add(MAX_QUOTE_VALIDITY + time.Hour).UnixMilli()},
+		{PriceMin: 100, Message: string(make([]rune, MAX_QUOTE_MESSAGE_SIZE+1))},
+	} {
+		_, err := o.check(now)
+		assert.True(t, xerrors.Is(err, e.ErrInvalidQuote), o)
+	}
+}
+
+func TestIsOpen(t *testing.T) {
+	now := time.Now()
+	q := &quote.Quote{
+		Status:     utils.Int32Ptr(int32(c.QUOTE_STATUS_OPEN)),
+		ValidUntil: utils.Int64Ptr(now.Add(time.Hour).UnixMilli()),
+	}
+	assert.True(t, IsOpen(q, now))
+	assert.False(t, IsOpen(q, now.Add(2*time.Hour)))
+
+	q.Status = utils.Int32Ptr(int32(c.QUOTE_STATUS_LOST))
+	assert.False(t, IsOpen(q, now))
+}
+
+func TestConvertQuoteToProto(t *testing.T) {
+	s := &ServerModel{}
+	upb := s.ConvertQuoteToProto(&quote.Quote{
+		BusinessName: utils.StrPtr("Acme Plumbing"),
+		PriceMin:     utils.Float32Ptr(100),
+		PriceMax:     utils.Float32Ptr(150),
+		Status:       utils.Int32Ptr(int32(c.QUOTE_STATUS_WON)),
+	})
+	assert.Equal(t, "Acme Plumbing", upb.BusinessName)
+	assert.Equal(t, float32(150), upb.PriceMax)
+	assert.Equal(t, c.QUOTE_STATUS_WON, upb.Status)
+	assert.Equal(t, "", upb.Id)
+}
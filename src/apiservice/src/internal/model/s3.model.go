@@ -3,34 +3,116 @@ package model
 import (
 	"context"
 	"fmt"
+	"mime"
+	"net/http"
+	"path"
 	"strings"
 
 	"github.com/aqaurius6666/apiservice/src/internal/lib"
 	"github.com/aqaurius6666/apiservice/src/internal/var/c"
+	"github.com/aqaurius6666/apiservice/src/internal/var/e"
 	"go.opentelemetry.io/otel"
 	"golang.org/x/xerrors"
 )
 
 type S3Model interface {
-	GetUploadUrl(ctx context.Context, key string, contentLength int64, userId string) (string, map[string]string, error)
+	GetUploadUrl(ctx context.Context, key string, contentLength int64, userId string, purpose c.UPLOAD_PURPOSE) (string, map[string]string, string, error)
 }
 
-func (s *ServerModel) GetUploadUrl(ctx context.Context, filename string, contentLength int64, userId string) (string, map[string]string, error) {
+// uploadPolicy is what may be uploaded for a purpose. Files of purposes with
+// a prefix are stored under it, per user, with a random name. A nil
+// contentTypes accepts any image.
+type uploadPolicy struct {
+	prefix       string
+	maxSize      int64
+	contentTypes []string
+}
+
+var uploadPolicies = map[c.UPLOAD_PURPOSE]uploadPolicy{
+	c.UPLOAD_PURPOSE_IMAGE: {
+		maxSize: 10 << 20,
+	},
+	c.UPLOAD_PURPOSE_ORDER_ATTACHMENT: {
+		prefix:       "attachments",
+		maxSize:      20 << 20,
+		contentTypes: []string{"image/jpeg", "image/png", "image/webp", "application/pdf"},
+	},
+}
+
+// contentType returns the content type of filename, failing with
+// e.ErrInvalidFile when the policy does not accept it.
+func (p uploadPolicy) contentType(filename string) (string, error) {
+	if p.contentTypes == nil {
+		return lib.GetContentType(filename)
+	}
+	typ, _, err := mime.ParseMediaType(mime.TypeByExtension(path.Ext(filename)))
+	if err != nil || !p.accepts(typ) {
+		return "", e.ErrInvalidFile
+	}
+	return typ, nil
+}
+
+func (p uploadPolicy) accepts(contentType string) bool {
+	for _, t := range p.contentTypes {
+		if t == contentType {
+			return true
+		}
+	}
+	return false
+}
+
+// sniffed tells whether an uploaded object declared as contentType, starting
+// with head, really is a file the policy accepts. The content type comes from
+// the uploader, so it is checked against the bytes as well.
+func (p uploadPolicy) sniffed(contentType string, head []byte) bool {
+	typ, _, err := mime.ParseMediaType(contentType)
+	if err != nil || !p.accepts(typ) {
+		return false
+	}
+	sniffed, _, err := mime.ParseMediaType(http.DetectContentType(head))
+	return err == nil && sniffed == typ
+}
+
+func (p uploadPolicy) userPrefix(userId string) string {
+	return fmt.Sprintf("%s/%s/", p.prefix, userId)
+}
+
+func (p uploadPolicy) key(userId string, filename string) string {
+	if p.prefix == "" {
+		return fmt.Sprintf("%s/%s", userId, filename)
+	}
+	return p.userPrefix(userId) + lib.RandomKey(filename)
+}
+
+// GetUploadUrl returns a presigned form to upload filename for purpose, and
+// the key the file will have.
+func (s *ServerModel) GetUploadUrl(ctx context.Context, filename string, contentLength int64, userId string, purpose c.UPLOAD_PURPOSE) (string, map[string]string, string, error) {
 	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.GetUploadUrl))
 	defer span.End()
 
-	contentType, err := lib.GetContentType(filename)
+	policy, ok := uploadPolicies[purpose]
+	if !ok {
+		err := xerrors.Errorf("%w", e.ErrInvalidFile)
+		lib.RecordError(span, err, ctx)
+		return "", nil, "", err
+	}
+	if contentLength > policy.maxSize {
+		err := xerrors.Errorf("%w", e.ErrFileTooLarge)
+		lib.RecordError(span, err, ctx)
+		return "", nil, "", err
+	}
+	contentType, err := policy.contentType(filename)
 	if err != nil {
 		err = xerrors.Errorf("%w", err)
 		lib.RecordError(span, err, ctx)
-		return "", nil, err
+		return "", nil, "", err
 	}
-	key := fmt.Sprintf("%s/%s", userId, filename)
+	key := policy.key(userId, filename)
 	url, form, err := s.S3.GetPresignedPutObject(ctx, key, contentLength, contentType)
 	if err != nil {
 		err = xerrors.Errorf("%w", err)
 		lib.RecordError(span, err, ctx)
-		return "", nil, err
+		return "", nil, "", err
 	}
-	return strings.Replace(url, "http", "https", 1), form, nil
+	return strings.Replace(url, "http", "https", 1), form, key, nil
 }
@@ -10,7 +10,9 @@ import (
 	"github.com/aqaurius6666/apiservice/src/internal/lib"
 	"github.com/aqaurius6666/apiservice/src/internal/var/c"
 	"github.com/aqaurius6666/apiservice/src/pb"
+	"github.com/aqaurius6666/apiservice/src/services/authservice"
 	"github.com/aqaurius6666/go-utils/database"
+	"github.com/aqaurius6666/go-utils/utils"
 	"github.com/google/uuid"
 	"go.opentelemetry.io/otel"
 	"golang.org/x/xerrors"
@@ -25,19 +27,28 @@ type UserModel interface {
 	TotalUsers(context.Context, *user.Search) (*int64, error)
 	GetUser(context.Context, *user.Search) (*user.User, error)
 
-	GetCredential(ctx context.Context, data string) (id, pubb, priv string, isDef bool, err error)
-	Register(ctx context.Context, mail, phone, pub, enpriv string, role c.ROLE, refCode string) (otpId string, err error)
+	GetCredential(ctx context.Context, data, totp string) (id, pubb, priv string, isDef bool, err error)
+	Register(ctx context.Context, mail, phone, pub, enpriv string, role c.ROLE, refCode string, channel c.OTP_CHANNEL) (otpId string, err error)
 	VerifyOTP(ctx context.Context, otpId, otp string) (err error)
 	ChangeMail(ctx context.Context, id interface{}, mail string) (string, error)
 	// ChangeMailOTP(ctx context.Context, otpId, mail string) (string, error)
 	ChangePassword(ctx context.Context, id, pub, enc string) error
 	ForgotPasswordOTP(ctx context.Context, otpId, pub, enc string) error
-	ForgotPassword(ctx context.Context, mail string) (otpId string, err error)
+	ForgotPassword(ctx context.Context, mail, phone string) (id, otpId string, err error)
 	CreateUser(ctx context.Context, id interface{}, mail, phone string) (*user.User, error)
 	CheckCredential(ctx context.Context, identifier string) (bool, error)
 	ResendOtp(ctx context.Context, otpId string) error
 
 	ChangePassAndMail(context context.Context, userId string, mail string, pub string, enc string) error
+	LogoutAll(ctx context.Context, id string) (int64, error)
+	IssueToken(ctx context.Context, header, body []byte, method string) (*authservice.Token, error)
+	RefreshToken(ctx context.Context, refreshToken string, header, body []byte, method string) (*authservice.Token, error)
+	EnrollTotp(ctx context.Context, id string) (secret, uri string, err error)
+	ConfirmTotp(ctx context.Context, id, code string) ([]string, error)
+	DisableTotp(ctx context.Context, id, code string) error
+	VerifyPhone(ctx context.Context, id interface{}, phone string) (string, error)
+	DeleteAccount(ctx context.Context, id interface{}) (string, error)
+	ListSecurityEvents(ctx context.Context, id interface{}, limit, offset int32) ([]*authservice.SecurityEvent, *int64, error)
 
 	GetUserById(ctx context.Context, id interface{}) (*user.User, error)
 	UpdateUser(ctx context.Context, id interface{}, u *user.User) error
@@ -47,6 +58,7 @@ type UserModel interface {
 
 	ConvertUserToProto(*user.User) *pb.User
 	ConvertUsersToProtos([]*user.User) []*pb.User
+	ConvertSecurityEventsToProtos([]*authservice.SecurityEvent) []*pb.SecurityEvent
 }
 
 func (s *ServerModel) ConvertUsersToProtos(u []*user.User) []*pb.User {
@@ -57,6 +69,22 @@ func (s *ServerModel) ConvertUsersToProtos(u []*user.User) []*pb.User {
 	return arr
 }
 
+func (s *ServerModel) ConvertSecurityEventsToProtos(evs []*authservice.SecurityEvent) []*pb.SecurityEvent {
+	arr := make([]*pb.SecurityEvent, 0, len(evs))
+	for _, ev := range evs {
+		arr = append(arr, &pb.SecurityEvent{
+			Id:        ev.Id,
+			Type:      ev.Type,
+			Ip:        ev.Ip,
+			UserAgent: ev.UserAgent,
+			Success:   ev.Success,
+			Reason:    ev.Reason,
+			CreatedAt: ev.CreatedAt,
+		})
+	}
+	return arr
+}
+
 func (s *ServerModel) ConvertUserToProto(u *user.User) *pb.User {
 	upb := new(pb.User)
 	if u.ID != uuid.Nil {
@@ -188,11 +216,11 @@ func (s *ServerModel) CheckCredential(ctx context.Context, identifier string) (b
 	return existed, nil
 }
 
-func (s *ServerModel) Register(ctx context.Context, mail, phone, pub, enpriv string, role c.ROLE, refCode string) (otpId string, err error) {
+func (s *ServerModel) Register(ctx context.Context, mail, phone, pub, enpriv string, role c.ROLE, refCode string, channel c.OTP_CHANNEL) (otpId string, err error) {
 	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.Register))
 	defer span.End()
 
-	otpId, err = s.Auth.Register(ctx, mail, phone, pub, enpriv, role, refCode)
+	otpId, err = s.Auth.Register(ctx, mail, phone, pub, enpriv, role, refCode, channel)
 	if err != nil {
 		err = xerrors.Errorf("%w", err)
 		lib.RecordError(span, err, ctx)
@@ -256,6 +284,44 @@ func (s *ServerModel) ChangeMail(ctx context.Context, id interface{}, mail strin
 	return otpId, nil
 }
 
+func (s *ServerModel) VerifyPhone(ctx context.Context, id interface{}, phone string) (string, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.VerifyPhone))
+	defer span.End()
+
+	uid, err := lib.ToUUID(id)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return "", err
+	}
+	otpId, err := s.Auth.VerifyPhone(ctx, uid.String(), phone)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return "", err
+	}
+	return otpId, nil
+}
+
+func (s *ServerModel) DeleteAccount(ctx context.Context, id interface{}) (string, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.DeleteAccount))
+	defer span.End()
+
+	uid, err := lib.ToUUID(id)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return "", err
+	}
+	otpId, err := s.Auth.DeleteAccount(ctx, uid.String())
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return "", err
+	}
+	return otpId, nil
+}
+
 func (s *ServerModel) UpdateUser(ctx context.Context, id interface{}, u *user.User) error {
 	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.UpdateUser))
 	defer span.End()
@@ -331,6 +397,10 @@ func (s *ServerModel) VerifyOTP(ctx context.Context, otpId, otp string) (err err
 		return s.handleChangeMailAfter(ctx, otpId)
 	case c.OTP_TYPE_CHANGE_MAIL_AND_PASS:
 		return s.handleChangeMailAndPassAfter(ctx, otpId)
+	case c.OTP_TYPE_VERIFY_PHONE:
+		return s.handleVerifyPhoneAfter(ctx, otpId)
+	case c.OTP_TYPE_DELETE_ACCOUNT:
+		return s.handleDeleteAccountAfter(ctx, otpId)
 	}
 	return nil
 }
@@ -428,6 +498,80 @@ func (s *ServerModel) handleChangeMailAndPassAfter(ctx context.Context, otpId st
 	return nil
 }
 
+func (s *ServerModel) handleVerifyPhoneAfter(ctx context.Context, otpId string) error {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.handleVerifyPhoneAfter))
+	defer span.End()
+
+	id, phone, role, err := s.Auth.VerifyPhoneOTP(ctx, otpId)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return err
+	}
+	switch role {
+	case c.ROLE_CUSTOMER:
+		if err := s.UpdateUser(ctx, id, &user.User{
+			Phone: &phone,
+		}); err != nil {
+			err = xerrors.Errorf("%w", err)
+			lib.RecordError(span, err, ctx)
+			return err
+		}
+	case c.ROLE_HANDYMAN:
+		if err := s.UpdateBusiness(ctx, id, &business.Business{
+			Phone: &phone,
+		}); err != nil {
+			err = xerrors.Errorf("%w", err)
+			lib.RecordError(span, err, ctx)
+			return err
+		}
+	}
+	return nil
+}
+
+// handleDeleteAccountAfter hides the account from the marketplace until the
+// deletion job erases it. Open orders are canceled as on a ban.
+func (s *ServerModel) handleDeleteAccountAfter(ctx context.Context, otpId string) error {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.handleDeleteAccountAfter))
+	defer span.End()
+
+	id, role, _, err := s.Auth.DeleteAccountOTP(ctx, otpId)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return err
+	}
+	if err := s.setAccountStatus(ctx, id, role, c.ACCOUNT_STATUS_INACTIVE); err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return err
+	}
+	if err := s.UpdateOrdersStatusByUser(ctx, id, c.ORDER_STATUS_CANCELED, OrderActor{
+		Id:     id,
+		Role:   role,
+		Reason: c.ORDER_REASON_ACCOUNT_DELETED,
+	}); err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return err
+	}
+	return nil
+}
+
+func (s *ServerModel) setAccountStatus(ctx context.Context, id string, role c.ROLE, status c.ACCOUNT_STATUS) error {
+	switch role {
+	case c.ROLE_CUSTOMER:
+		return s.UpdateUser(ctx, id, &user.User{
+			Status: utils.Int32Ptr(int32(status)),
+		})
+	case c.ROLE_HANDYMAN:
+		return s.UpdateBusiness(ctx, id, &business.Business{
+			Status: utils.Int32Ptr(int32(status)),
+		})
+	}
+	return nil
+}
+
 func (s *ServerModel) ListUsers(ctx context.Context, search *user.Search) ([]*user.User, error) {
 	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.ListUsers))
 	defer span.End()
@@ -455,17 +599,17 @@ func (s *ServerModel) TotalUsers(ctx context.Context, search *user.Search) (*int
 	return c, nil
 }
 
-func (s *ServerModel) ForgotPassword(ctx context.Context, mail string) (otpId string, err error) {
+func (s *ServerModel) ForgotPassword(ctx context.Context, mail, phone string) (id, otpId string, err error) {
 	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.ForgotPassword))
 	defer span.End()
 
-	otpId, err = s.Auth.ForgotPassword(ctx, mail)
+	id, otpId, err = s.Auth.ForgotPassword(ctx, mail, phone)
 	if err != nil {
 		err = xerrors.Errorf("%w", err)
 		lib.RecordError(span, err, ctx)
-		return "", err
+		return "", "", err
 	}
-	return otpId, nil
+	return id, otpId, nil
 }
 
 func (s *ServerModel) ForgotPasswordOTP(ctx context.Context, otpId, pub, enc string) error {
@@ -494,11 +638,11 @@ func (s *ServerModel) ChangePassword(ctx context.Context, id, pub, enc string) e
 	return nil
 }
 
-func (s *ServerModel) GetCredential(ctx context.Context, data string) (id, pubb, priv string, isDef bool, err error) {
+func (s *ServerModel) GetCredential(ctx context.Context, data, totp string) (id, pubb, priv string, isDef bool, err error) {
 	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.GetCredential))
 	defer span.End()
 
-	id, pub, priv, isDef, err := s.Auth.GetCredential(ctx, data)
+	id, pub, priv, isDef, err := s.Auth.GetCredential(ctx, data, totp)
 	if err != nil {
 		err = xerrors.Errorf("%w", err)
 		lib.RecordError(span, err, ctx)
@@ -533,3 +677,106 @@ func (s *ServerModel) ChangePassAndMail(ctx context.Context, userId string, mail
 
 	return nil
 }
+
+func (s *ServerModel) LogoutAll(ctx context.Context, id string) (int64, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.LogoutAll))
+	defer span.End()
+
+	notBefore, err := s.Auth.RevokeSessions(ctx, id)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return 0, err
+	}
+	return notBefore, nil
+}
+
+func (s *ServerModel) ListSecurityEvents(ctx context.Context, id interface{}, limit, offset int32) ([]*authservice.SecurityEvent, *int64, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.ListSecurityEvents))
+	defer span.End()
+
+	uid, err := lib.ToUUID(id)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, nil, err
+	}
+	events, total, err := s.Auth.ListSecurityEvents(ctx, uid.String(), limit, offset)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, nil, err
+	}
+	return events, &total, nil
+}
+
+func (s *ServerModel) IssueToken(ctx context.Context, header, body []byte, method string) (*authservice.Token, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.IssueToken))
+	defer span.End()
+
+	token, err := s.Auth.IssueToken(ctx, header, body, method)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	if token.DeletionCanceled {
+		if err := s.setAccountStatus(ctx, token.Id, token.Role, c.ACCOUNT_STATUS_ACTIVE); err != nil {
+			err = xerrors.Errorf("%w", err)
+			lib.RecordError(span, err, ctx)
+			return nil, err
+		}
+	}
+	return token, nil
+}
+
+func (s *ServerModel) RefreshToken(ctx context.Context, refreshToken string, header, body []byte, method string) (*authservice.Token, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.RefreshToken))
+	defer span.End()
+
+	token, err := s.Auth.RefreshToken(ctx, refreshToken, header, body, method)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	return token, nil
+}
+
+func (s *ServerModel) EnrollTotp(ctx context.Context, id string) (string, string, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.EnrollTotp))
+	defer span.End()
+
+	secret, uri, err := s.Auth.EnrollTotp(ctx, id)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return "", "", err
+	}
+	return secret, uri, nil
+}
+
+func (s *ServerModel) ConfirmTotp(ctx context.Context, id, code string) ([]string, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.ConfirmTotp))
+	defer span.End()
+
+	codes, err := s.Auth.ConfirmTotp(ctx, id, code)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	return codes, nil
+}
+
+func (s *ServerModel) DisableTotp(ctx context.Context, id, code string) error {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.DisableTotp))
+	defer span.End()
+
+	if err := s.Auth.DisableTotp(ctx, id, code); err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return err
+	}
+	return nil
+}
@@ -2,8 +2,10 @@ package main
 
 import (
 	"os"
+	"path/filepath"
 	"time"
 
+	"github.com/aqaurius6666/apiservice/src/internal/lib/mtls"
 	cli2 "github.com/aqaurius6666/go-utils/cli"
 	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli/v2"
@@ -37,14 +39,14 @@ func makeApp() *cli.App {
 			},
 		},
 		Action: runMain,
-		Flags:  makeFlags(cli2.GormFlag, cli2.PrometheusFlag, cli2.CommonServerFlag, cli2.FeatureToggleFlag, cli2.LoggerFlag, CustomFlag),
+		Flags:  makeFlags(cli2.GormFlag, cli2.PrometheusFlag, cli2.CommonServerFlag, cli2.FeatureToggleFlag, cli2.LoggerFlag, CustomFlag, TLSFlag),
 		Commands: []*cli.Command{
 			{
 				Name:    "serve",
 				Aliases: []string{"s"},
 				Usage:   "run server",
 				Action:  runMain,
-				Flags:   makeFlags(cli2.GormFlag, cli2.PrometheusFlag, cli2.CommonServerFlag, cli2.FeatureToggleFlag, cli2.LoggerFlag, CustomFlag),
+				Flags:   makeFlags(cli2.GormFlag, cli2.PrometheusFlag, cli2.CommonServerFlag, cli2.FeatureToggleFlag, cli2.LoggerFlag, CustomFlag, TLSFlag),
 			},
 			{
 				Name:   "seed",
@@ -126,6 +128,38 @@ var (
 	}
 )
 
+var (
+	TLSFlag = []cli.Flag{
+		&cli.StringFlag{
+			Name:    "tls-mode",
+			Usage:   "transport security of internal gRPC servers and clients: disabled, dev or mtls",
+			EnvVars: []string{"CONFIG_TLS_MODE"},
+			Value:   mtls.ModeDisabled,
+		},
+		&cli.StringFlag{
+			Name:    "tls-ca-file",
+			Usage:   "PEM encoded CA that signs the certificates of every service",
+			EnvVars: []string{"CONFIG_TLS_CA_FILE"},
+		},
+		&cli.StringFlag{
+			Name:    "tls-cert-file",
+			Usage:   "PEM encoded certificate of this service, its common name is the service identity",
+			EnvVars: []string{"CONFIG_TLS_CERT_FILE"},
+		},
+		&cli.StringFlag{
+			Name:    "tls-key-file",
+			Usage:   "PEM encoded private key of tls-cert-file",
+			EnvVars: []string{"CONFIG_TLS_KEY_FILE"},
+		},
+		&cli.StringFlag{
+			Name:    "tls-dev-dir",
+			Usage:   "where dev mode keeps the local CA shared by services on this machine",
+			EnvVars: []string{"CONFIG_TLS_DEV_DIR"},
+			Value:   filepath.Join(os.TempDir(), "grpc-dev-ca"),
+		},
+	}
+)
+
 func makeFlags(lists ...interface{}) []cli.Flag {
 	flags := make([]cli.Flag, 0)
 	for _, f := range lists {
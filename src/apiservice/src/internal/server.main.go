@@ -13,6 +13,7 @@ import (
 
 	"github.com/aqaurius6666/apiservice/src/internal/api"
 	"github.com/aqaurius6666/apiservice/src/internal/db"
+	"github.com/aqaurius6666/apiservice/src/internal/lib/mtls"
 	"github.com/aqaurius6666/apiservice/src/internal/lib/unleash"
 	"github.com/aqaurius6666/apiservice/src/internal/var/c"
 	"github.com/aqaurius6666/apiservice/src/services/authservice"
@@ -36,6 +37,7 @@ import (
 	"go.opentelemetry.io/otel/sdk/resource"
 	tracesdk "go.opentelemetry.io/otel/sdk/trace"
 	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+	"golang.org/x/xerrors"
 	"google.golang.org/grpc"
 	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
@@ -75,6 +77,11 @@ func runMain(appCtx *cli.Context) error {
 		logger.Fatal(err)
 		return err
 	}
+	creds, err := newTransportCredentials(appCtx)
+	if err != nil {
+		logger.Fatal(err)
+		return err
+	}
 	mainServer, err := InitMainServer(ctx, logger, ServerOptions{
 		DBDsn:           db.DBDsn(appCtx.String("db-uri")),
 		AuthserviceAddr: authservice.AuthServiceAddr(appCtx.String("authservice-address")),
@@ -83,6 +90,7 @@ func runMain(appCtx *cli.Context) error {
 		ChatserviceAddr: chatservice.ChatserviceAddr(appCtx.String("chatservice-address")),
 		SignKey:         api.STRIPE_SIGNATURE_KEY(appCtx.String("stripe-signature-verification")),
 		MailserviceAddr: mailservice.MailserviceAddr(appCtx.String("mailservice-address")),
+		Creds:           creds,
 	})
 	if err != nil {
 		logger.Fatal(err)
@@ -128,10 +136,10 @@ func runMain(appCtx *cli.Context) error {
 		defer wg.Done()
 		if appCtx.Bool("disable-stats") {
 			logger.Info("Stats disabled.")
-			srv = grpc.NewServer()
+			srv = grpc.NewServer(creds.ServerOptions(grpcPolicy)...)
 		} else {
 			logger.Info("Stats enabled.")
-			srv = grpc.NewServer(grpc.StatsHandler(&ocgrpc.ServerHandler{}))
+			srv = grpc.NewServer(append(creds.ServerOptions(grpcPolicy), grpc.StatsHandler(&ocgrpc.ServerHandler{}))...)
 		}
 		healthpb.RegisterHealthServer(srv, commonServer)
 		commonpb.RegisterCommonServer(srv, commonServer)
@@ -286,3 +294,24 @@ func initOtelCollector(ctx context.Context, otelAddress string) {
 // 	}
 // 	logger.Warn("could not initialize Stackdriver profiler after retrying, giving up")
 // }
+
+// newTransportCredentials loads the certificate this service presents to its
+// callers and to the services it dials. Without one internal gRPC traffic is
+// plaintext and any pod can call any RPC.
+func newTransportCredentials(appCtx *cli.Context) (*mtls.Credentials, error) {
+	creds, err := mtls.New(mtls.Config{
+		Mode:     appCtx.String("tls-mode"),
+		CAFile:   appCtx.String("tls-ca-file"),
+		CertFile: appCtx.String("tls-cert-file"),
+		KeyFile:  appCtx.String("tls-key-file"),
+		DevDir:   appCtx.String("tls-dev-dir"),
+		Identity: c.SERVICE_NAME,
+	})
+	if err != nil {
+		return nil, xerrors.Errorf("%w", err)
+	}
+	if creds == nil {
+		logger.Warn("tls-mode disabled, internal gRPC traffic is not authenticated")
+	}
+	return creds, nil
+}
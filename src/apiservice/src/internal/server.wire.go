@@ -8,6 +8,7 @@ import (
 
 	"github.com/aqaurius6666/apiservice/src/internal/api"
 	"github.com/aqaurius6666/apiservice/src/internal/db"
+	"github.com/aqaurius6666/apiservice/src/internal/lib/mtls"
 	"github.com/aqaurius6666/apiservice/src/internal/model"
 	"github.com/aqaurius6666/apiservice/src/services/authservice"
 	"github.com/aqaurius6666/apiservice/src/services/chatservice"
@@ -31,12 +32,13 @@ type ServerOptions struct {
 	Bucket          s3.BucketName
 	Key             payment.STRIPE_API_KEY
 	SignKey         api.STRIPE_SIGNATURE_KEY
+	Creds           *mtls.Credentials
 }
 
 func InitMainServer(ctx context.Context, logger *logrus.Logger, opts ServerOptions) (*Server, error) {
 
 	wire.Build(
-		wire.FieldsOf(&opts, "DBDsn", "AuthserviceAddr", "Bucket", "Key", "ChatserviceAddr", "SignKey", "MailserviceAddr"),
+		wire.FieldsOf(&opts, "DBDsn", "AuthserviceAddr", "Bucket", "Key", "ChatserviceAddr", "SignKey", "MailserviceAddr", "Creds"),
 		db.ServerRepoSet,
 		api.ApiServerSet,
 		model.ServerModelSet,
@@ -13,9 +13,14 @@ import "time"
 // )
 
 const (
-	PRESIGNED_URL_EXPIRE_TIME = 5 * time.Minute
+	PRESIGNED_URL_EXPIRE_TIME  = 5 * time.Minute
+	ATTACHMENT_URL_EXPIRE_TIME = 15 * time.Minute
 )
 
+// USER_SUSPENDED is the ErrorInfo reason authservice sends when a suspended
+// user authenticates.
+const USER_SUSPENDED = "USER_SUSPENDED"
+
 var (
 	SERVICE_NAME              = "api-service"
 	ID                  int64 = 2
@@ -28,6 +33,31 @@ var (
 // 	ROLE_ADMIN
 // )
 
+// Permission codes checked by Require, seeded by authservice.
+const (
+	PERM_USERS_READ         = "users.read"
+	PERM_USERS_WRITE        = "users.write"
+	PERM_CONVERSATIONS_READ = "conversations.read"
+	PERM_FEES_WRITE         = "fees.write"
+	PERM_TRANSACTIONS_READ  = "transactions.read"
+	PERM_REFUNDS_WRITE      = "refunds.write"
+	PERM_CONTENT_MODERATE   = "content.moderate"
+	PERM_ROLES_MANAGE       = "roles.manage"
+	PERM_USERS_IMPERSONATE  = "users.impersonate"
+	PERM_ORDERS_READ        = "orders.read"
+)
+
+// API_KEY_HEADER is the header partners send their api key in.
+const API_KEY_HEADER = "X-Api-Key"
+
+// Api key scopes checked by Scope, the list authservice accepts.
+const (
+	SCOPE_ORDERS_READ        = "orders:read"
+	SCOPE_ORDERS_WRITE       = "orders:write"
+	SCOPE_BUSINESSES_WRITE   = "businesses:write"
+	SCOPE_CONVERSATIONS_READ = "conversations:read"
+)
+
 var (
 	REQUEST_HANDYMAN_NOTIFICATION  = "request-notification"
 	CANCEL_HANDYMAN_NOTIFICATION   = "cancel-notification"
@@ -36,4 +66,15 @@ var (
 
 	CONNECT_CUSTOMER_NOTIFICATION = "connect-notification"
 	REJECT_CUSTOMER_NOTIFICATION  = "reject-notification"
+
+	SLOT_PROPOSED_CUSTOMER_NOTIFICATION = "slot-proposed-notification"
+	SLOT_ANSWERED_HANDYMAN_NOTIFICATION = "slot-answered-notification"
+
+	QUOTE_SUBMITTED_CUSTOMER_NOTIFICATION = "quote-submitted-notification"
+	QUOTE_ANSWERED_HANDYMAN_NOTIFICATION  = "quote-answered-notification"
+
+	DISPUTE_REVIEWED_HANDYMAN_NOTIFICATION = "dispute-reviewed-notification"
+
+	COMPLETION_REQUESTED_NOTIFICATION = "completion-requested-notification"
+	COMPLETION_DISPUTED_NOTIFICATION  = "completion-disputed-notification"
 )
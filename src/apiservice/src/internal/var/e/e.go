@@ -1,6 +1,9 @@
 package e
 
 import (
+	"time"
+
+	"github.com/aqaurius6666/apiservice/src/internal/var/c"
 	"golang.org/x/xerrors"
 )
 
@@ -14,9 +17,25 @@ var (
 	ErrIdInvalidFormat      = xerrors.New("invalid id")
 	ErrNoPermission         = xerrors.New("no permisssion")
 	ErrInvalidFile          = xerrors.New("invalid file")
+	ErrFileTooLarge         = xerrors.New("file too large")
+	ErrInvalidAttachment    = xerrors.New("invalid attachment")
 	ErrExceedMaxOrders      = xerrors.New("exceed max orders")
 	ErrAlreadyOrdered       = xerrors.New("already ordered")
+	ErrNoMatchingBusiness   = xerrors.New("no matching business")
 	ErrInvalidOrderStatus   = xerrors.New("invalid order status")
+	ErrInvalidOrderReason   = xerrors.New("invalid order reason")
+	ErrInvalidSchedule      = xerrors.New("invalid schedule")
+	ErrInvalidOrderExpiry   = xerrors.New("invalid order expiry")
+	ErrNoSlotProposal       = xerrors.New("no slot proposal to answer")
+	ErrInvalidQuote         = xerrors.New("invalid quote")
+	ErrQuoteNotOpen         = xerrors.New("quote is not open")
+	ErrOwnCompletion        = xerrors.New("the other side must answer the completion")
+	ErrInvalidDispute       = xerrors.New("invalid dispute")
+	ErrDisputeExisted       = xerrors.New("you already disputed this order")
+	ErrDisputeReviewed      = xerrors.New("dispute already reviewed")
+	ErrNoConnectionFee      = xerrors.New("no connection fee to dispute")
+	ErrInvalidQuestions     = xerrors.New("invalid category questions")
+	ErrInvalidAnswer        = func(key string) error { return xerrors.Errorf("invalid answer: %s", key) }
 	ErrCategoryExisted      = xerrors.New("service existed")
 	ErrPayment              = xerrors.New("payment error")
 	ErrGroupExisted         = xerrors.New("group existed")
@@ -24,4 +43,38 @@ var (
 	RefCodeNotFound         = xerrors.New("cannot found your referral account")
 	ExceedBuyAdvertiseLimit = xerrors.New("exceed limit for buying advertise")
 	ErrStripeHeader         = xerrors.New("cannot get stripe header")
+	ErrTotpEnrollRequired   = xerrors.New("totp enrollment required")
+	ErrReadOnlyToken        = xerrors.New("token is read only")
+	ErrApiKeyNotAllowed     = xerrors.New("api keys cannot be used here")
 )
+
+// TooManyRequestsError is returned when an upstream service throttled the
+// request. RetryAfter tells the client how long to wait.
+type TooManyRequestsError struct {
+	Err        error
+	RetryAfter time.Duration
+}
+
+func (t *TooManyRequestsError) Error() string {
+	return t.Err.Error()
+}
+
+func (t *TooManyRequestsError) Unwrap() error {
+	return t.Err
+}
+
+// SuspendedError is returned when a suspended user authenticates. ExpiresAt
+// is in milliseconds, 0 when the suspension lasts until it is lifted.
+type SuspendedError struct {
+	Err       error
+	Reason    c.SUSPENSION_REASON
+	ExpiresAt int64
+}
+
+func (s *SuspendedError) Error() string {
+	return s.Err.Error()
+}
+
+func (s *SuspendedError) Unwrap() error {
+	return s.Err
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/aqaurius6666/apiservice/src/internal/api/middleware"
 	"github.com/aqaurius6666/apiservice/src/internal/db"
 	"github.com/aqaurius6666/apiservice/src/internal/db/cockroach"
+	"github.com/aqaurius6666/apiservice/src/internal/lib/mtls"
 	"github.com/aqaurius6666/apiservice/src/internal/model"
 	"github.com/aqaurius6666/apiservice/src/services/authservice"
 	"github.com/aqaurius6666/apiservice/src/services/chatservice"
@@ -43,7 +44,8 @@ func InitMainServer(ctx context.Context, logger2 *logrus.Logger, opts ServerOpti
 		CDBRepository: cdbRepository,
 	}
 	authServiceAddr := opts.AuthserviceAddr
-	authServiceClient, err := authservice.ConnectClient(ctx, authServiceAddr)
+	credentials := opts.Creds
+	authServiceClient, err := authservice.ConnectClient(ctx, authServiceAddr, credentials)
 	if err != nil {
 		return nil, err
 	}
@@ -62,7 +64,7 @@ func InitMainServer(ctx context.Context, logger2 *logrus.Logger, opts ServerOpti
 		return nil, err
 	}
 	chatserviceAddr := opts.ChatserviceAddr
-	chatServiceClient, err := chatservice.ConnectClient(ctx, chatserviceAddr)
+	chatServiceClient, err := chatservice.ConnectClient(ctx, chatserviceAddr, credentials)
 	if err != nil {
 		return nil, err
 	}
@@ -71,7 +73,7 @@ func InitMainServer(ctx context.Context, logger2 *logrus.Logger, opts ServerOpti
 		Client: chatServiceClient,
 	}
 	mailserviceAddr := opts.MailserviceAddr
-	mailServiceClient, err := mailservice.ConnectClient(ctx, mailserviceAddr)
+	mailServiceClient, err := mailservice.ConnectClient(ctx, mailserviceAddr, credentials)
 	if err != nil {
 		return nil, err
 	}
@@ -117,7 +119,8 @@ func InitMainServer(ctx context.Context, logger2 *logrus.Logger, opts ServerOpti
 		S: authService,
 	}
 	adminService := api.AdminService{
-		Model: serverModel,
+		Model:  serverModel,
+		Logger: logger2,
 	}
 	adminController := api.AdminController{
 		S: adminService,
@@ -128,9 +131,11 @@ func InitMainServer(ctx context.Context, logger2 *logrus.Logger, opts ServerOpti
 	categoryController := api.CategoryController{
 		S: categoryService,
 	}
+	keySet := middleware.NewKeySet(serviceGRPC)
 	middlewareV1 := &middleware.MiddlewareV1{
 		Logger: logger2,
 		Auth:   serviceGRPC,
+		Keys:   keySet,
 	}
 	userService := api.UserService{
 		Model: serverModel,
@@ -195,4 +200,5 @@ type ServerOptions struct {
 	Bucket          s3.BucketName
 	Key             payment.STRIPE_API_KEY
 	SignKey         api.STRIPE_SIGNATURE_KEY
+	Creds           *mtls.Credentials
 }
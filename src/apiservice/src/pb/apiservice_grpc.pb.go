@@ -40,6 +40,29 @@ type ApiServiceClient interface {
 	AuthForgotReset(ctx context.Context, in *AuthForgotResetPostRequest, opts ...grpc.CallOption) (*AuthForgotResetPostResponse, error)
 	// Auth OTP change mail and password
 	AuthChangeMailAndPassPut(ctx context.Context, in *AuthChangeMailAndPassPostRequest, opts ...grpc.CallOption) (*AuthChangeMailAndPassPostResponse, error)
+	// Auth Logout All to revoke every certificate issued so far
+	AuthLogoutAllPost(ctx context.Context, in *AuthLogoutAllPostRequest, opts ...grpc.CallOption) (*AuthLogoutAllPostResponse, error)
+	// Auth TOTP Enroll to generate a TOTP secret
+	AuthTotpEnrollPost(ctx context.Context, in *AuthTotpEnrollPostRequest, opts ...grpc.CallOption) (*AuthTotpEnrollPostResponse, error)
+	// Auth TOTP Verify to enable TOTP and get recovery codes
+	AuthTotpVerifyPost(ctx context.Context, in *AuthTotpVerifyPostRequest, opts ...grpc.CallOption) (*AuthTotpVerifyPostResponse, error)
+	// Auth TOTP Disable to turn TOTP off
+	AuthTotpDisablePost(ctx context.Context, in *AuthTotpDisablePostRequest, opts ...grpc.CallOption) (*AuthTotpDisablePostResponse, error)
+	AuthPhonePost(ctx context.Context, in *AuthPhonePostRequest, opts ...grpc.CallOption) (*AuthPhonePostResponse, error)
+	// Auth Token to exchange a signed certificate for an access token
+	AuthTokenPost(ctx context.Context, in *AuthTokenPostRequest, opts ...grpc.CallOption) (*AuthTokenPostResponse, error)
+	// Auth Token Refresh to rotate a refresh token for a new access token
+	AuthTokenRefreshPost(ctx context.Context, in *AuthTokenRefreshPostRequest, opts ...grpc.CallOption) (*AuthTokenRefreshPostResponse, error)
+	// Auth Activity to list the security events of the account
+	AuthActivityGet(ctx context.Context, in *AuthActivityGetRequest, opts ...grpc.CallOption) (*AuthActivityGetResponse, error)
+	// Auth Delete to request the deletion of the account, confirmed by otp
+	AuthDeletePost(ctx context.Context, in *AuthDeletePostRequest, opts ...grpc.CallOption) (*AuthDeletePostResponse, error)
+	// Auth Api Keys to list the api keys of the user
+	AuthApiKeysGet(ctx context.Context, in *AuthApiKeysGetRequest, opts ...grpc.CallOption) (*AuthApiKeysGetResponse, error)
+	// Auth Api Keys to create an api key, sent in the X-Api-Key header. The
+	// key is only returned here.
+	AuthApiKeysPost(ctx context.Context, in *AuthApiKeysPostRequest, opts ...grpc.CallOption) (*AuthApiKeysPostResponse, error)
+	AuthApiKeysRevokePost(ctx context.Context, in *AuthApiKeysRevokePostRequest, opts ...grpc.CallOption) (*AuthApiKeysRevokePostResponse, error)
 	// User for create new user
 	UserPost(ctx context.Context, in *UserPostRequest, opts ...grpc.CallOption) (*UserPostResponse, error)
 	// Business for create new handyman (business)
@@ -57,6 +80,12 @@ type ApiServiceClient interface {
 	BusinessPaymentMethodDeletePost(ctx context.Context, in *BusinessPaymentMethodDeletePostRequest, opts ...grpc.CallOption) (*BusinessPaymentMethodDeletePostResponse, error)
 	BusinessGetInvitationCode(ctx context.Context, in *BusinessInterestGetRequest, opts ...grpc.CallOption) (*BusinessInvitationCodeGetResponse, error)
 	BusinessVerifyRefCode(ctx context.Context, in *BusinessVerifyRefCodePutRequest, opts ...grpc.CallOption) (*BusinessVerifyRefCodePutResponse, error)
+	BusinessMembersGet(ctx context.Context, in *BusinessMembersGetRequest, opts ...grpc.CallOption) (*BusinessMembersGetResponse, error)
+	BusinessMembersInvitePost(ctx context.Context, in *BusinessMembersInvitePostRequest, opts ...grpc.CallOption) (*BusinessMembersInvitePostResponse, error)
+	// Accepting an invitation only changes new tokens, so the client should
+	// refresh its access token afterwards.
+	BusinessMembersAcceptPost(ctx context.Context, in *BusinessMembersAcceptPostRequest, opts ...grpc.CallOption) (*BusinessMembersAcceptPostResponse, error)
+	BusinessMembersDeletePost(ctx context.Context, in *BusinessMembersDeletePostRequest, opts ...grpc.CallOption) (*BusinessMembersDeletePostResponse, error)
 	// Business to get business by id
 	ContactGet(ctx context.Context, in *ContactGetRequest, opts ...grpc.CallOption) (*ContactGetResponse, error)
 	// Business to get business by id
@@ -80,10 +109,18 @@ type ApiServiceClient interface {
 	AdminUsersDeletePost(ctx context.Context, in *AdminUsersDeletePostRequest, opts ...grpc.CallOption) (*AdminUsersDeletePostResponse, error)
 	AdminUsersUnbanPost(ctx context.Context, in *AdminUsersUnbanPostRequest, opts ...grpc.CallOption) (*AdminUsersUnbanPostResponse, error)
 	AdminBusinessesUnbanPost(ctx context.Context, in *AdminBusinessesUnbanPostRequest, opts ...grpc.CallOption) (*AdminBusinessesUnbanPostResponse, error)
+	AdminUserActivityGet(ctx context.Context, in *AdminUserActivityGetRequest, opts ...grpc.CallOption) (*AdminUserActivityGetResponse, error)
+	AdminUserImpersonatePost(ctx context.Context, in *AdminUserImpersonatePostRequest, opts ...grpc.CallOption) (*AdminUserImpersonatePostResponse, error)
+	AdminPermissionsGet(ctx context.Context, in *AdminPermissionsGetRequest, opts ...grpc.CallOption) (*AdminPermissionsGetResponse, error)
+	AdminRolesGet(ctx context.Context, in *AdminRolesGetRequest, opts ...grpc.CallOption) (*AdminRolesGetResponse, error)
+	AdminRolePermissionsPut(ctx context.Context, in *AdminRolePermissionsPutRequest, opts ...grpc.CallOption) (*AdminRolePermissionsPutResponse, error)
+	AdminDisputesGet(ctx context.Context, in *AdminDisputesGetRequest, opts ...grpc.CallOption) (*AdminDisputesGetResponse, error)
+	AdminDisputeReviewPost(ctx context.Context, in *AdminDisputeReviewPostRequest, opts ...grpc.CallOption) (*AdminDisputeReviewPostResponse, error)
 	AdminUsersGet(ctx context.Context, in *AdminUsersGetRequest, opts ...grpc.CallOption) (*AdminUsersGetResponse, error)
 	AdminPostCategories(ctx context.Context, in *AdminCategoryPostRequest, opts ...grpc.CallOption) (*AdminCategoryPostResponese, error)
 	AdminPostEditCategories(ctx context.Context, in *AdminCategoryPostEditRequest, opts ...grpc.CallOption) (*AdminCategoryPostEditResponese, error)
 	AdminPostDeleteCategories(ctx context.Context, in *AdminCategoryPostDeleteRequest, opts ...grpc.CallOption) (*AdminCategoryPostDeleteResponese, error)
+	AdminPostCategoryQuestions(ctx context.Context, in *AdminCategoryQuestionsPostRequest, opts ...grpc.CallOption) (*AdminCategoryQuestionsPostResponse, error)
 	AdminAdvertiseManagementPost(ctx context.Context, in *AdminAdvertiseManagementPostRequest, opts ...grpc.CallOption) (*AdminAdvertiseManagementPostResponse, error)
 	AdminAdvertiseMangementGet(ctx context.Context, in *AdminAdvertiseManagementGetRequest, opts ...grpc.CallOption) (*AdminAdvertiseManagementGetResponse, error)
 	AdminAdvertiseManagementPut(ctx context.Context, in *AdminAdvertiseManagementPutRequest, opts ...grpc.CallOption) (*AdminAdvertiseManagementPutResponse, error)
@@ -103,6 +140,15 @@ type ApiServiceClient interface {
 	UpdateOrderRejectPost(ctx context.Context, in *UpdateOrderStatusPostRequest, opts ...grpc.CallOption) (*UpdateOrderStatusPostResponse, error)
 	UpdateOrderCancelPost(ctx context.Context, in *UpdateOrderStatusPostRequest, opts ...grpc.CallOption) (*UpdateOrderStatusPostResponse, error)
 	UpdateOrderCompletePost(ctx context.Context, in *UpdateOrderStatusPostRequest, opts ...grpc.CallOption) (*UpdateOrderStatusPostResponse, error)
+	UpdateOrderCompleteDisputePost(ctx context.Context, in *UpdateOrderStatusPostRequest, opts ...grpc.CallOption) (*UpdateOrderStatusPostResponse, error)
+	OrderSlotProposePost(ctx context.Context, in *OrderSlotProposePostRequest, opts ...grpc.CallOption) (*OrderSlotProposePostResponse, error)
+	OrderSlotRespondPost(ctx context.Context, in *OrderSlotRespondPostRequest, opts ...grpc.CallOption) (*OrderSlotRespondPostResponse, error)
+	OrderQuotePost(ctx context.Context, in *OrderQuotePostRequest, opts ...grpc.CallOption) (*OrderQuotePostResponse, error)
+	OrderQuotesGet(ctx context.Context, in *OrderQuotesGetRequest, opts ...grpc.CallOption) (*OrderQuotesGetResponse, error)
+	OrderQuoteAcceptPost(ctx context.Context, in *OrderQuoteAcceptPostRequest, opts ...grpc.CallOption) (*OrderQuoteAcceptPostResponse, error)
+	OrderDisputePost(ctx context.Context, in *OrderDisputePostRequest, opts ...grpc.CallOption) (*OrderDisputePostResponse, error)
+	OrderDisputesGet(ctx context.Context, in *OrderDisputesGetRequest, opts ...grpc.CallOption) (*OrderDisputesGetResponse, error)
+	OrdersTimelineGet(ctx context.Context, in *OrdersTimelineGetRequest, opts ...grpc.CallOption) (*OrdersTimelineGetResponse, error)
 	FeedbacksPost(ctx context.Context, in *FeedbacksPostRequest, opts ...grpc.CallOption) (*FeedbacksPostResponse, error)
 	FeedbacksPut(ctx context.Context, in *FeedbackPutRequest, opts ...grpc.CallOption) (*FeedbackPutResponse, error)
 	FeedbacksGet(ctx context.Context, in *FeedbackGetRequest, opts ...grpc.CallOption) (*FeedbackGetResponse, error)
@@ -220,6 +266,114 @@ func (c *apiServiceClient) AuthChangeMailAndPassPut(ctx context.Context, in *Aut
 	return out, nil
 }
 
+func (c *apiServiceClient) AuthLogoutAllPost(ctx context.Context, in *AuthLogoutAllPostRequest, opts ...grpc.CallOption) (*AuthLogoutAllPostResponse, error) {
+	out := new(AuthLogoutAllPostResponse)
+	err := c.cc.Invoke(ctx, "/apiservice.ApiService/AuthLogoutAllPost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) AuthTotpEnrollPost(ctx context.Context, in *AuthTotpEnrollPostRequest, opts ...grpc.CallOption) (*AuthTotpEnrollPostResponse, error) {
+	out := new(AuthTotpEnrollPostResponse)
+	err := c.cc.Invoke(ctx, "/apiservice.ApiService/AuthTotpEnrollPost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) AuthTotpVerifyPost(ctx context.Context, in *AuthTotpVerifyPostRequest, opts ...grpc.CallOption) (*AuthTotpVerifyPostResponse, error) {
+	out := new(AuthTotpVerifyPostResponse)
+	err := c.cc.Invoke(ctx, "/apiservice.ApiService/AuthTotpVerifyPost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) AuthTotpDisablePost(ctx context.Context, in *AuthTotpDisablePostRequest, opts ...grpc.CallOption) (*AuthTotpDisablePostResponse, error) {
+	out := new(AuthTotpDisablePostResponse)
+	err := c.cc.Invoke(ctx, "/apiservice.ApiService/AuthTotpDisablePost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) AuthPhonePost(ctx context.Context, in *AuthPhonePostRequest, opts ...grpc.CallOption) (*AuthPhonePostResponse, error) {
+	out := new(AuthPhonePostResponse)
+	err := c.cc.Invoke(ctx, "/apiservice.ApiService/AuthPhonePost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) AuthTokenPost(ctx context.Context, in *AuthTokenPostRequest, opts ...grpc.CallOption) (*AuthTokenPostResponse, error) {
+	out := new(AuthTokenPostResponse)
+	err := c.cc.Invoke(ctx, "/apiservice.ApiService/AuthTokenPost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) AuthTokenRefreshPost(ctx context.Context, in *AuthTokenRefreshPostRequest, opts ...grpc.CallOption) (*AuthTokenRefreshPostResponse, error) {
+	out := new(AuthTokenRefreshPostResponse)
+	err := c.cc.Invoke(ctx, "/apiservice.ApiService/AuthTokenRefreshPost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) AuthActivityGet(ctx context.Context, in *AuthActivityGetRequest, opts ...grpc.CallOption) (*AuthActivityGetResponse, error) {
+	out := new(AuthActivityGetResponse)
+	err := c.cc.Invoke(ctx, "/apiservice.ApiService/AuthActivityGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) AuthDeletePost(ctx context.Context, in *AuthDeletePostRequest, opts ...grpc.CallOption) (*AuthDeletePostResponse, error) {
+	out := new(AuthDeletePostResponse)
+	err := c.cc.Invoke(ctx, "/apiservice.ApiService/AuthDeletePost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) AuthApiKeysGet(ctx context.Context, in *AuthApiKeysGetRequest, opts ...grpc.CallOption) (*AuthApiKeysGetResponse, error) {
+	out := new(AuthApiKeysGetResponse)
+	err := c.cc.Invoke(ctx, "/apiservice.ApiService/AuthApiKeysGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) AuthApiKeysPost(ctx context.Context, in *AuthApiKeysPostRequest, opts ...grpc.CallOption) (*AuthApiKeysPostResponse, error) {
+	out := new(AuthApiKeysPostResponse)
+	err := c.cc.Invoke(ctx, "/apiservice.ApiService/AuthApiKeysPost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) AuthApiKeysRevokePost(ctx context.Context, in *AuthApiKeysRevokePostRequest, opts ...grpc.CallOption) (*AuthApiKeysRevokePostResponse, error) {
+	out := new(AuthApiKeysRevokePostResponse)
+	err := c.cc.Invoke(ctx, "/apiservice.ApiService/AuthApiKeysRevokePost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *apiServiceClient) UserPost(ctx context.Context, in *UserPostRequest, opts ...grpc.CallOption) (*UserPostResponse, error) {
 	out := new(UserPostResponse)
 	err := c.cc.Invoke(ctx, "/apiservice.ApiService/UserPost", in, out, opts...)
@@ -328,6 +482,42 @@ func (c *apiServiceClient) BusinessVerifyRefCode(ctx context.Context, in *Busine
 	return out, nil
 }
 
+func (c *apiServiceClient) BusinessMembersGet(ctx context.Context, in *BusinessMembersGetRequest, opts ...grpc.CallOption) (*BusinessMembersGetResponse, error) {
+	out := new(BusinessMembersGetResponse)
+	err := c.cc.Invoke(ctx, "/apiservice.ApiService/BusinessMembersGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) BusinessMembersInvitePost(ctx context.Context, in *BusinessMembersInvitePostRequest, opts ...grpc.CallOption) (*BusinessMembersInvitePostResponse, error) {
+	out := new(BusinessMembersInvitePostResponse)
+	err := c.cc.Invoke(ctx, "/apiservice.ApiService/BusinessMembersInvitePost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) BusinessMembersAcceptPost(ctx context.Context, in *BusinessMembersAcceptPostRequest, opts ...grpc.CallOption) (*BusinessMembersAcceptPostResponse, error) {
+	out := new(BusinessMembersAcceptPostResponse)
+	err := c.cc.Invoke(ctx, "/apiservice.ApiService/BusinessMembersAcceptPost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) BusinessMembersDeletePost(ctx context.Context, in *BusinessMembersDeletePostRequest, opts ...grpc.CallOption) (*BusinessMembersDeletePostResponse, error) {
+	out := new(BusinessMembersDeletePostResponse)
+	err := c.cc.Invoke(ctx, "/apiservice.ApiService/BusinessMembersDeletePost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *apiServiceClient) ContactGet(ctx context.Context, in *ContactGetRequest, opts ...grpc.CallOption) (*ContactGetResponse, error) {
 	out := new(ContactGetResponse)
 	err := c.cc.Invoke(ctx, "/apiservice.ApiService/ContactGet", in, out, opts...)
@@ -517,6 +707,69 @@ func (c *apiServiceClient) AdminBusinessesUnbanPost(ctx context.Context, in *Adm
 	return out, nil
 }
 
+func (c *apiServiceClient) AdminUserActivityGet(ctx context.Context, in *AdminUserActivityGetRequest, opts ...grpc.CallOption) (*AdminUserActivityGetResponse, error) {
+	out := new(AdminUserActivityGetResponse)
+	err := c.cc.Invoke(ctx, "/apiservice.ApiService/AdminUserActivityGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) AdminUserImpersonatePost(ctx context.Context, in *AdminUserImpersonatePostRequest, opts ...grpc.CallOption) (*AdminUserImpersonatePostResponse, error) {
+	out := new(AdminUserImpersonatePostResponse)
+	err := c.cc.Invoke(ctx, "/apiservice.ApiService/AdminUserImpersonatePost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) AdminPermissionsGet(ctx context.Context, in *AdminPermissionsGetRequest, opts ...grpc.CallOption) (*AdminPermissionsGetResponse, error) {
+	out := new(AdminPermissionsGetResponse)
+	err := c.cc.Invoke(ctx, "/apiservice.ApiService/AdminPermissionsGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) AdminRolesGet(ctx context.Context, in *AdminRolesGetRequest, opts ...grpc.CallOption) (*AdminRolesGetResponse, error) {
+	out := new(AdminRolesGetResponse)
+	err := c.cc.Invoke(ctx, "/apiservice.ApiService/AdminRolesGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) AdminRolePermissionsPut(ctx context.Context, in *AdminRolePermissionsPutRequest, opts ...grpc.CallOption) (*AdminRolePermissionsPutResponse, error) {
+	out := new(AdminRolePermissionsPutResponse)
+	err := c.cc.Invoke(ctx, "/apiservice.ApiService/AdminRolePermissionsPut", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) AdminDisputesGet(ctx context.Context, in *AdminDisputesGetRequest, opts ...grpc.CallOption) (*AdminDisputesGetResponse, error) {
+	out := new(AdminDisputesGetResponse)
+	err := c.cc.Invoke(ctx, "/apiservice.ApiService/AdminDisputesGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) AdminDisputeReviewPost(ctx context.Context, in *AdminDisputeReviewPostRequest, opts ...grpc.CallOption) (*AdminDisputeReviewPostResponse, error) {
+	out := new(AdminDisputeReviewPostResponse)
+	err := c.cc.Invoke(ctx, "/apiservice.ApiService/AdminDisputeReviewPost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *apiServiceClient) AdminUsersGet(ctx context.Context, in *AdminUsersGetRequest, opts ...grpc.CallOption) (*AdminUsersGetResponse, error) {
 	out := new(AdminUsersGetResponse)
 	err := c.cc.Invoke(ctx, "/apiservice.ApiService/AdminUsersGet", in, out, opts...)
@@ -553,6 +806,15 @@ func (c *apiServiceClient) AdminPostDeleteCategories(ctx context.Context, in *Ad
 	return out, nil
 }
 
+func (c *apiServiceClient) AdminPostCategoryQuestions(ctx context.Context, in *AdminCategoryQuestionsPostRequest, opts ...grpc.CallOption) (*AdminCategoryQuestionsPostResponse, error) {
+	out := new(AdminCategoryQuestionsPostResponse)
+	err := c.cc.Invoke(ctx, "/apiservice.ApiService/AdminPostCategoryQuestions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *apiServiceClient) AdminAdvertiseManagementPost(ctx context.Context, in *AdminAdvertiseManagementPostRequest, opts ...grpc.CallOption) (*AdminAdvertiseManagementPostResponse, error) {
 	out := new(AdminAdvertiseManagementPostResponse)
 	err := c.cc.Invoke(ctx, "/apiservice.ApiService/AdminAdvertiseManagementPost", in, out, opts...)
@@ -724,6 +986,87 @@ func (c *apiServiceClient) UpdateOrderCompletePost(ctx context.Context, in *Upda
 	return out, nil
 }
 
+func (c *apiServiceClient) UpdateOrderCompleteDisputePost(ctx context.Context, in *UpdateOrderStatusPostRequest, opts ...grpc.CallOption) (*UpdateOrderStatusPostResponse, error) {
+	out := new(UpdateOrderStatusPostResponse)
+	err := c.cc.Invoke(ctx, "/apiservice.ApiService/UpdateOrderCompleteDisputePost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) OrderSlotProposePost(ctx context.Context, in *OrderSlotProposePostRequest, opts ...grpc.CallOption) (*OrderSlotProposePostResponse, error) {
+	out := new(OrderSlotProposePostResponse)
+	err := c.cc.Invoke(ctx, "/apiservice.ApiService/OrderSlotProposePost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) OrderSlotRespondPost(ctx context.Context, in *OrderSlotRespondPostRequest, opts ...grpc.CallOption) (*OrderSlotRespondPostResponse, error) {
+	out := new(OrderSlotRespondPostResponse)
+	err := c.cc.Invoke(ctx, "/apiservice.ApiService/OrderSlotRespondPost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) OrderQuotePost(ctx context.Context, in *OrderQuotePostRequest, opts ...grpc.CallOption) (*OrderQuotePostResponse, error) {
+	out := new(OrderQuotePostResponse)
+	err := c.cc.Invoke(ctx, "/apiservice.ApiService/OrderQuotePost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) OrderQuotesGet(ctx context.Context, in *OrderQuotesGetRequest, opts ...grpc.CallOption) (*OrderQuotesGetResponse, error) {
+	out := new(OrderQuotesGetResponse)
+	err := c.cc.Invoke(ctx, "/apiservice.ApiService/OrderQuotesGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) OrderQuoteAcceptPost(ctx context.Context, in *OrderQuoteAcceptPostRequest, opts ...grpc.CallOption) (*OrderQuoteAcceptPostResponse, error) {
+	out := new(OrderQuoteAcceptPostResponse)
+	err := c.cc.Invoke(ctx, "/apiservice.ApiService/OrderQuoteAcceptPost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) OrderDisputePost(ctx context.Context, in *OrderDisputePostRequest, opts ...grpc.CallOption) (*OrderDisputePostResponse, error) {
+	out := new(OrderDisputePostResponse)
+	err := c.cc.Invoke(ctx, "/apiservice.ApiService/OrderDisputePost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) OrderDisputesGet(ctx context.Context, in *OrderDisputesGetRequest, opts ...grpc.CallOption) (*OrderDisputesGetResponse, error) {
+	out := new(OrderDisputesGetResponse)
+	err := c.cc.Invoke(ctx, "/apiservice.ApiService/OrderDisputesGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) OrdersTimelineGet(ctx context.Context, in *OrdersTimelineGetRequest, opts ...grpc.CallOption) (*OrdersTimelineGetResponse, error) {
+	out := new(OrdersTimelineGetResponse)
+	err := c.cc.Invoke(ctx, "/apiservice.ApiService/OrdersTimelineGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *apiServiceClient) FeedbacksPost(ctx context.Context, in *FeedbacksPostRequest, opts ...grpc.CallOption) (*FeedbacksPostResponse, error) {
 	out := new(FeedbacksPostResponse)
 	err := c.cc.Invoke(ctx, "/apiservice.ApiService/FeedbacksPost", in, out, opts...)
@@ -899,6 +1242,29 @@ type ApiServiceServer interface {
 	AuthForgotReset(context.Context, *AuthForgotResetPostRequest) (*AuthForgotResetPostResponse, error)
 	// Auth OTP change mail and password
 	AuthChangeMailAndPassPut(context.Context, *AuthChangeMailAndPassPostRequest) (*AuthChangeMailAndPassPostResponse, error)
+	// Auth Logout All to revoke every certificate issued so far
+	AuthLogoutAllPost(context.Context, *AuthLogoutAllPostRequest) (*AuthLogoutAllPostResponse, error)
+	// Auth TOTP Enroll to generate a TOTP secret
+	AuthTotpEnrollPost(context.Context, *AuthTotpEnrollPostRequest) (*AuthTotpEnrollPostResponse, error)
+	// Auth TOTP Verify to enable TOTP and get recovery codes
+	AuthTotpVerifyPost(context.Context, *AuthTotpVerifyPostRequest) (*AuthTotpVerifyPostResponse, error)
+	// Auth TOTP Disable to turn TOTP off
+	AuthTotpDisablePost(context.Context, *AuthTotpDisablePostRequest) (*AuthTotpDisablePostResponse, error)
+	AuthPhonePost(context.Context, *AuthPhonePostRequest) (*AuthPhonePostResponse, error)
+	// Auth Token to exchange a signed certificate for an access token
+	AuthTokenPost(context.Context, *AuthTokenPostRequest) (*AuthTokenPostResponse, error)
+	// Auth Token Refresh to rotate a refresh token for a new access token
+	AuthTokenRefreshPost(context.Context, *AuthTokenRefreshPostRequest) (*AuthTokenRefreshPostResponse, error)
+	// Auth Activity to list the security events of the account
+	AuthActivityGet(context.Context, *AuthActivityGetRequest) (*AuthActivityGetResponse, error)
+	// Auth Delete to request the deletion of the account, confirmed by otp
+	AuthDeletePost(context.Context, *AuthDeletePostRequest) (*AuthDeletePostResponse, error)
+	// Auth Api Keys to list the api keys of the user
+	AuthApiKeysGet(context.Context, *AuthApiKeysGetRequest) (*AuthApiKeysGetResponse, error)
+	// Auth Api Keys to create an api key, sent in the X-Api-Key header. The
+	// key is only returned here.
+	AuthApiKeysPost(context.Context, *AuthApiKeysPostRequest) (*AuthApiKeysPostResponse, error)
+	AuthApiKeysRevokePost(context.Context, *AuthApiKeysRevokePostRequest) (*AuthApiKeysRevokePostResponse, error)
 	// User for create new user
 	UserPost(context.Context, *UserPostRequest) (*UserPostResponse, error)
 	// Business for create new handyman (business)
@@ -916,6 +1282,12 @@ type ApiServiceServer interface {
 	BusinessPaymentMethodDeletePost(context.Context, *BusinessPaymentMethodDeletePostRequest) (*BusinessPaymentMethodDeletePostResponse, error)
 	BusinessGetInvitationCode(context.Context, *BusinessInterestGetRequest) (*BusinessInvitationCodeGetResponse, error)
 	BusinessVerifyRefCode(context.Context, *BusinessVerifyRefCodePutRequest) (*BusinessVerifyRefCodePutResponse, error)
+	BusinessMembersGet(context.Context, *BusinessMembersGetRequest) (*BusinessMembersGetResponse, error)
+	BusinessMembersInvitePost(context.Context, *BusinessMembersInvitePostRequest) (*BusinessMembersInvitePostResponse, error)
+	// Accepting an invitation only changes new tokens, so the client should
+	// refresh its access token afterwards.
+	BusinessMembersAcceptPost(context.Context, *BusinessMembersAcceptPostRequest) (*BusinessMembersAcceptPostResponse, error)
+	BusinessMembersDeletePost(context.Context, *BusinessMembersDeletePostRequest) (*BusinessMembersDeletePostResponse, error)
 	// Business to get business by id
 	ContactGet(context.Context, *ContactGetRequest) (*ContactGetResponse, error)
 	// Business to get business by id
@@ -939,10 +1311,18 @@ type ApiServiceServer interface {
 	AdminUsersDeletePost(context.Context, *AdminUsersDeletePostRequest) (*AdminUsersDeletePostResponse, error)
 	AdminUsersUnbanPost(context.Context, *AdminUsersUnbanPostRequest) (*AdminUsersUnbanPostResponse, error)
 	AdminBusinessesUnbanPost(context.Context, *AdminBusinessesUnbanPostRequest) (*AdminBusinessesUnbanPostResponse, error)
+	AdminUserActivityGet(context.Context, *AdminUserActivityGetRequest) (*AdminUserActivityGetResponse, error)
+	AdminUserImpersonatePost(context.Context, *AdminUserImpersonatePostRequest) (*AdminUserImpersonatePostResponse, error)
+	AdminPermissionsGet(context.Context, *AdminPermissionsGetRequest) (*AdminPermissionsGetResponse, error)
+	AdminRolesGet(context.Context, *AdminRolesGetRequest) (*AdminRolesGetResponse, error)
+	AdminRolePermissionsPut(context.Context, *AdminRolePermissionsPutRequest) (*AdminRolePermissionsPutResponse, error)
+	AdminDisputesGet(context.Context, *AdminDisputesGetRequest) (*AdminDisputesGetResponse, error)
+	AdminDisputeReviewPost(context.Context, *AdminDisputeReviewPostRequest) (*AdminDisputeReviewPostResponse, error)
 	AdminUsersGet(context.Context, *AdminUsersGetRequest) (*AdminUsersGetResponse, error)
 	AdminPostCategories(context.Context, *AdminCategoryPostRequest) (*AdminCategoryPostResponese, error)
 	AdminPostEditCategories(context.Context, *AdminCategoryPostEditRequest) (*AdminCategoryPostEditResponese, error)
 	AdminPostDeleteCategories(context.Context, *AdminCategoryPostDeleteRequest) (*AdminCategoryPostDeleteResponese, error)
+	AdminPostCategoryQuestions(context.Context, *AdminCategoryQuestionsPostRequest) (*AdminCategoryQuestionsPostResponse, error)
 	AdminAdvertiseManagementPost(context.Context, *AdminAdvertiseManagementPostRequest) (*AdminAdvertiseManagementPostResponse, error)
 	AdminAdvertiseMangementGet(context.Context, *AdminAdvertiseManagementGetRequest) (*AdminAdvertiseManagementGetResponse, error)
 	AdminAdvertiseManagementPut(context.Context, *AdminAdvertiseManagementPutRequest) (*AdminAdvertiseManagementPutResponse, error)
@@ -962,6 +1342,15 @@ type ApiServiceServer interface {
 	UpdateOrderRejectPost(context.Context, *UpdateOrderStatusPostRequest) (*UpdateOrderStatusPostResponse, error)
 	UpdateOrderCancelPost(context.Context, *UpdateOrderStatusPostRequest) (*UpdateOrderStatusPostResponse, error)
 	UpdateOrderCompletePost(context.Context, *UpdateOrderStatusPostRequest) (*UpdateOrderStatusPostResponse, error)
+	UpdateOrderCompleteDisputePost(context.Context, *UpdateOrderStatusPostRequest) (*UpdateOrderStatusPostResponse, error)
+	OrderSlotProposePost(context.Context, *OrderSlotProposePostRequest) (*OrderSlotProposePostResponse, error)
+	OrderSlotRespondPost(context.Context, *OrderSlotRespondPostRequest) (*OrderSlotRespondPostResponse, error)
+	OrderQuotePost(context.Context, *OrderQuotePostRequest) (*OrderQuotePostResponse, error)
+	OrderQuotesGet(context.Context, *OrderQuotesGetRequest) (*OrderQuotesGetResponse, error)
+	OrderQuoteAcceptPost(context.Context, *OrderQuoteAcceptPostRequest) (*OrderQuoteAcceptPostResponse, error)
+	OrderDisputePost(context.Context, *OrderDisputePostRequest) (*OrderDisputePostResponse, error)
+	OrderDisputesGet(context.Context, *OrderDisputesGetRequest) (*OrderDisputesGetResponse, error)
+	OrdersTimelineGet(context.Context, *OrdersTimelineGetRequest) (*OrdersTimelineGetResponse, error)
 	FeedbacksPost(context.Context, *FeedbacksPostRequest) (*FeedbacksPostResponse, error)
 	FeedbacksPut(context.Context, *FeedbackPutRequest) (*FeedbackPutResponse, error)
 	FeedbacksGet(context.Context, *FeedbackGetRequest) (*FeedbackGetResponse, error)
@@ -1016,6 +1405,42 @@ func (UnimplementedApiServiceServer) AuthForgotReset(context.Context, *AuthForgo
 func (UnimplementedApiServiceServer) AuthChangeMailAndPassPut(context.Context, *AuthChangeMailAndPassPostRequest) (*AuthChangeMailAndPassPostResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AuthChangeMailAndPassPut not implemented")
 }
+func (UnimplementedApiServiceServer) AuthLogoutAllPost(context.Context, *AuthLogoutAllPostRequest) (*AuthLogoutAllPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthLogoutAllPost not implemented")
+}
+func (UnimplementedApiServiceServer) AuthTotpEnrollPost(context.Context, *AuthTotpEnrollPostRequest) (*AuthTotpEnrollPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthTotpEnrollPost not implemented")
+}
+func (UnimplementedApiServiceServer) AuthTotpVerifyPost(context.Context, *AuthTotpVerifyPostRequest) (*AuthTotpVerifyPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthTotpVerifyPost not implemented")
+}
+func (UnimplementedApiServiceServer) AuthTotpDisablePost(context.Context, *AuthTotpDisablePostRequest) (*AuthTotpDisablePostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthTotpDisablePost not implemented")
+}
+func (UnimplementedApiServiceServer) AuthPhonePost(context.Context, *AuthPhonePostRequest) (*AuthPhonePostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthPhonePost not implemented")
+}
+func (UnimplementedApiServiceServer) AuthTokenPost(context.Context, *AuthTokenPostRequest) (*AuthTokenPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthTokenPost not implemented")
+}
+func (UnimplementedApiServiceServer) AuthTokenRefreshPost(context.Context, *AuthTokenRefreshPostRequest) (*AuthTokenRefreshPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthTokenRefreshPost not implemented")
+}
+func (UnimplementedApiServiceServer) AuthActivityGet(context.Context, *AuthActivityGetRequest) (*AuthActivityGetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthActivityGet not implemented")
+}
+func (UnimplementedApiServiceServer) AuthDeletePost(context.Context, *AuthDeletePostRequest) (*AuthDeletePostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthDeletePost not implemented")
+}
+func (UnimplementedApiServiceServer) AuthApiKeysGet(context.Context, *AuthApiKeysGetRequest) (*AuthApiKeysGetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthApiKeysGet not implemented")
+}
+func (UnimplementedApiServiceServer) AuthApiKeysPost(context.Context, *AuthApiKeysPostRequest) (*AuthApiKeysPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthApiKeysPost not implemented")
+}
+func (UnimplementedApiServiceServer) AuthApiKeysRevokePost(context.Context, *AuthApiKeysRevokePostRequest) (*AuthApiKeysRevokePostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthApiKeysRevokePost not implemented")
+}
 func (UnimplementedApiServiceServer) UserPost(context.Context, *UserPostRequest) (*UserPostResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UserPost not implemented")
 }
@@ -1052,6 +1477,18 @@ func (UnimplementedApiServiceServer) BusinessGetInvitationCode(context.Context,
 func (UnimplementedApiServiceServer) BusinessVerifyRefCode(context.Context, *BusinessVerifyRefCodePutRequest) (*BusinessVerifyRefCodePutResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BusinessVerifyRefCode not implemented")
 }
+func (UnimplementedApiServiceServer) BusinessMembersGet(context.Context, *BusinessMembersGetRequest) (*BusinessMembersGetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BusinessMembersGet not implemented")
+}
+func (UnimplementedApiServiceServer) BusinessMembersInvitePost(context.Context, *BusinessMembersInvitePostRequest) (*BusinessMembersInvitePostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BusinessMembersInvitePost not implemented")
+}
+func (UnimplementedApiServiceServer) BusinessMembersAcceptPost(context.Context, *BusinessMembersAcceptPostRequest) (*BusinessMembersAcceptPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BusinessMembersAcceptPost not implemented")
+}
+func (UnimplementedApiServiceServer) BusinessMembersDeletePost(context.Context, *BusinessMembersDeletePostRequest) (*BusinessMembersDeletePostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BusinessMembersDeletePost not implemented")
+}
 func (UnimplementedApiServiceServer) ContactGet(context.Context, *ContactGetRequest) (*ContactGetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ContactGet not implemented")
 }
@@ -1115,6 +1552,27 @@ func (UnimplementedApiServiceServer) AdminUsersUnbanPost(context.Context, *Admin
 func (UnimplementedApiServiceServer) AdminBusinessesUnbanPost(context.Context, *AdminBusinessesUnbanPostRequest) (*AdminBusinessesUnbanPostResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AdminBusinessesUnbanPost not implemented")
 }
+func (UnimplementedApiServiceServer) AdminUserActivityGet(context.Context, *AdminUserActivityGetRequest) (*AdminUserActivityGetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminUserActivityGet not implemented")
+}
+func (UnimplementedApiServiceServer) AdminUserImpersonatePost(context.Context, *AdminUserImpersonatePostRequest) (*AdminUserImpersonatePostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminUserImpersonatePost not implemented")
+}
+func (UnimplementedApiServiceServer) AdminPermissionsGet(context.Context, *AdminPermissionsGetRequest) (*AdminPermissionsGetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminPermissionsGet not implemented")
+}
+func (UnimplementedApiServiceServer) AdminRolesGet(context.Context, *AdminRolesGetRequest) (*AdminRolesGetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminRolesGet not implemented")
+}
+func (UnimplementedApiServiceServer) AdminRolePermissionsPut(context.Context, *AdminRolePermissionsPutRequest) (*AdminRolePermissionsPutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminRolePermissionsPut not implemented")
+}
+func (UnimplementedApiServiceServer) AdminDisputesGet(context.Context, *AdminDisputesGetRequest) (*AdminDisputesGetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminDisputesGet not implemented")
+}
+func (UnimplementedApiServiceServer) AdminDisputeReviewPost(context.Context, *AdminDisputeReviewPostRequest) (*AdminDisputeReviewPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminDisputeReviewPost not implemented")
+}
 func (UnimplementedApiServiceServer) AdminUsersGet(context.Context, *AdminUsersGetRequest) (*AdminUsersGetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AdminUsersGet not implemented")
 }
@@ -1127,6 +1585,9 @@ func (UnimplementedApiServiceServer) AdminPostEditCategories(context.Context, *A
 func (UnimplementedApiServiceServer) AdminPostDeleteCategories(context.Context, *AdminCategoryPostDeleteRequest) (*AdminCategoryPostDeleteResponese, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AdminPostDeleteCategories not implemented")
 }
+func (UnimplementedApiServiceServer) AdminPostCategoryQuestions(context.Context, *AdminCategoryQuestionsPostRequest) (*AdminCategoryQuestionsPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminPostCategoryQuestions not implemented")
+}
 func (UnimplementedApiServiceServer) AdminAdvertiseManagementPost(context.Context, *AdminAdvertiseManagementPostRequest) (*AdminAdvertiseManagementPostResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AdminAdvertiseManagementPost not implemented")
 }
@@ -1184,6 +1645,33 @@ func (UnimplementedApiServiceServer) UpdateOrderCancelPost(context.Context, *Upd
 func (UnimplementedApiServiceServer) UpdateOrderCompletePost(context.Context, *UpdateOrderStatusPostRequest) (*UpdateOrderStatusPostResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateOrderCompletePost not implemented")
 }
+func (UnimplementedApiServiceServer) UpdateOrderCompleteDisputePost(context.Context, *UpdateOrderStatusPostRequest) (*UpdateOrderStatusPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateOrderCompleteDisputePost not implemented")
+}
+func (UnimplementedApiServiceServer) OrderSlotProposePost(context.Context, *OrderSlotProposePostRequest) (*OrderSlotProposePostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OrderSlotProposePost not implemented")
+}
+func (UnimplementedApiServiceServer) OrderSlotRespondPost(context.Context, *OrderSlotRespondPostRequest) (*OrderSlotRespondPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OrderSlotRespondPost not implemented")
+}
+func (UnimplementedApiServiceServer) OrderQuotePost(context.Context, *OrderQuotePostRequest) (*OrderQuotePostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OrderQuotePost not implemented")
+}
+func (UnimplementedApiServiceServer) OrderQuotesGet(context.Context, *OrderQuotesGetRequest) (*OrderQuotesGetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OrderQuotesGet not implemented")
+}
+func (UnimplementedApiServiceServer) OrderQuoteAcceptPost(context.Context, *OrderQuoteAcceptPostRequest) (*OrderQuoteAcceptPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OrderQuoteAcceptPost not implemented")
+}
+func (UnimplementedApiServiceServer) OrderDisputePost(context.Context, *OrderDisputePostRequest) (*OrderDisputePostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OrderDisputePost not implemented")
+}
+func (UnimplementedApiServiceServer) OrderDisputesGet(context.Context, *OrderDisputesGetRequest) (*OrderDisputesGetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OrderDisputesGet not implemented")
+}
+func (UnimplementedApiServiceServer) OrdersTimelineGet(context.Context, *OrdersTimelineGetRequest) (*OrdersTimelineGetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OrdersTimelineGet not implemented")
+}
 func (UnimplementedApiServiceServer) FeedbacksPost(context.Context, *FeedbacksPostRequest) (*FeedbacksPostResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FeedbacksPost not implemented")
 }
@@ -1428,89 +1916,305 @@ func _ApiService_AuthChangeMailAndPassPut_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_UserPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UserPostRequest)
+func _ApiService_AuthLogoutAllPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthLogoutAllPostRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).UserPost(ctx, in)
+		return srv.(ApiServiceServer).AuthLogoutAllPost(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/apiservice.ApiService/UserPost",
+		FullMethod: "/apiservice.ApiService/AuthLogoutAllPost",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).UserPost(ctx, req.(*UserPostRequest))
+		return srv.(ApiServiceServer).AuthLogoutAllPost(ctx, req.(*AuthLogoutAllPostRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_BusinessPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BusinessPostRequest)
+func _ApiService_AuthTotpEnrollPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthTotpEnrollPostRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).BusinessPost(ctx, in)
+		return srv.(ApiServiceServer).AuthTotpEnrollPost(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/apiservice.ApiService/BusinessPost",
+		FullMethod: "/apiservice.ApiService/AuthTotpEnrollPost",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).BusinessPost(ctx, req.(*BusinessPostRequest))
+		return srv.(ApiServiceServer).AuthTotpEnrollPost(ctx, req.(*AuthTotpEnrollPostRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_UserGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UserGetRequest)
+func _ApiService_AuthTotpVerifyPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthTotpVerifyPostRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).UserGet(ctx, in)
+		return srv.(ApiServiceServer).AuthTotpVerifyPost(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/apiservice.ApiService/UserGet",
+		FullMethod: "/apiservice.ApiService/AuthTotpVerifyPost",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).UserGet(ctx, req.(*UserGetRequest))
+		return srv.(ApiServiceServer).AuthTotpVerifyPost(ctx, req.(*AuthTotpVerifyPostRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_UserStateGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UserStateGetRequest)
+func _ApiService_AuthTotpDisablePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthTotpDisablePostRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).UserStateGet(ctx, in)
+		return srv.(ApiServiceServer).AuthTotpDisablePost(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/apiservice.ApiService/UserStateGet",
+		FullMethod: "/apiservice.ApiService/AuthTotpDisablePost",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).UserStateGet(ctx, req.(*UserStateGetRequest))
+		return srv.(ApiServiceServer).AuthTotpDisablePost(ctx, req.(*AuthTotpDisablePostRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_BusinessGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BusinessGetRequest)
+func _ApiService_AuthPhonePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthPhonePostRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).BusinessGet(ctx, in)
+		return srv.(ApiServiceServer).AuthPhonePost(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/apiservice.ApiService/BusinessGet",
+		FullMethod: "/apiservice.ApiService/AuthPhonePost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).AuthPhonePost(ctx, req.(*AuthPhonePostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_AuthTokenPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthTokenPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).AuthTokenPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apiservice.ApiService/AuthTokenPost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).AuthTokenPost(ctx, req.(*AuthTokenPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_AuthTokenRefreshPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthTokenRefreshPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).AuthTokenRefreshPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apiservice.ApiService/AuthTokenRefreshPost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).AuthTokenRefreshPost(ctx, req.(*AuthTokenRefreshPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_AuthActivityGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthActivityGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).AuthActivityGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apiservice.ApiService/AuthActivityGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).AuthActivityGet(ctx, req.(*AuthActivityGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_AuthDeletePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthDeletePostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).AuthDeletePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apiservice.ApiService/AuthDeletePost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).AuthDeletePost(ctx, req.(*AuthDeletePostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_AuthApiKeysGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthApiKeysGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).AuthApiKeysGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apiservice.ApiService/AuthApiKeysGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).AuthApiKeysGet(ctx, req.(*AuthApiKeysGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_AuthApiKeysPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthApiKeysPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).AuthApiKeysPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apiservice.ApiService/AuthApiKeysPost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).AuthApiKeysPost(ctx, req.(*AuthApiKeysPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_AuthApiKeysRevokePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthApiKeysRevokePostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).AuthApiKeysRevokePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apiservice.ApiService/AuthApiKeysRevokePost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).AuthApiKeysRevokePost(ctx, req.(*AuthApiKeysRevokePostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_UserPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).UserPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apiservice.ApiService/UserPost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).UserPost(ctx, req.(*UserPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_BusinessPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BusinessPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).BusinessPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apiservice.ApiService/BusinessPost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).BusinessPost(ctx, req.(*BusinessPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_UserGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).UserGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apiservice.ApiService/UserGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).UserGet(ctx, req.(*UserGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_UserStateGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserStateGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).UserStateGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apiservice.ApiService/UserStateGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).UserStateGet(ctx, req.(*UserStateGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_BusinessGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BusinessGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).BusinessGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apiservice.ApiService/BusinessGet",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(ApiServiceServer).BusinessGet(ctx, req.(*BusinessGetRequest))
@@ -1644,6 +2348,78 @@ func _ApiService_BusinessVerifyRefCode_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApiService_BusinessMembersGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BusinessMembersGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).BusinessMembersGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apiservice.ApiService/BusinessMembersGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).BusinessMembersGet(ctx, req.(*BusinessMembersGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_BusinessMembersInvitePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BusinessMembersInvitePostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).BusinessMembersInvitePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apiservice.ApiService/BusinessMembersInvitePost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).BusinessMembersInvitePost(ctx, req.(*BusinessMembersInvitePostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_BusinessMembersAcceptPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BusinessMembersAcceptPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).BusinessMembersAcceptPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apiservice.ApiService/BusinessMembersAcceptPost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).BusinessMembersAcceptPost(ctx, req.(*BusinessMembersAcceptPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_BusinessMembersDeletePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BusinessMembersDeletePostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).BusinessMembersDeletePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apiservice.ApiService/BusinessMembersDeletePost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).BusinessMembersDeletePost(ctx, req.(*BusinessMembersDeletePostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ApiService_ContactGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ContactGetRequest)
 	if err := dec(in); err != nil {
@@ -1852,172 +2628,298 @@ func _ApiService_BusinessInterestGet_Handler(srv interface{}, ctx context.Contex
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/apiservice.ApiService/BusinessInterestGet",
+		FullMethod: "/apiservice.ApiService/BusinessInterestGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).BusinessInterestGet(ctx, req.(*BusinessInterestGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_OrdersGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrdersGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).OrdersGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apiservice.ApiService/OrdersGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).OrdersGet(ctx, req.(*OrdersGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_BusinessServiceGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BusinessServiceGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).BusinessServiceGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apiservice.ApiService/BusinessServiceGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).BusinessServiceGet(ctx, req.(*BusinessServiceGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_BusinessFreeContactGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BusinessFreeContactGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).BusinessFreeContactGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apiservice.ApiService/BusinessFreeContactGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).BusinessFreeContactGet(ctx, req.(*BusinessFreeContactGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_AdminBusinessesGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminBusinessesGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).AdminBusinessesGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apiservice.ApiService/AdminBusinessesGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).AdminBusinessesGet(ctx, req.(*AdminBusinessesGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_AdminBusinessBanPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminBusinessBanPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).AdminBusinessBanPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apiservice.ApiService/AdminBusinessBanPost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).AdminBusinessBanPost(ctx, req.(*AdminBusinessBanPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_AdminBusinessDeletePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminBusinessDeletePostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).AdminBusinessDeletePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apiservice.ApiService/AdminBusinessDeletePost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).AdminBusinessDeletePost(ctx, req.(*AdminBusinessDeletePostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_AdminUsersDeletePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminUsersDeletePostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).AdminUsersDeletePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apiservice.ApiService/AdminUsersDeletePost",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).BusinessInterestGet(ctx, req.(*BusinessInterestGetRequest))
+		return srv.(ApiServiceServer).AdminUsersDeletePost(ctx, req.(*AdminUsersDeletePostRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_OrdersGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(OrdersGetRequest)
+func _ApiService_AdminUsersUnbanPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminUsersUnbanPostRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).OrdersGet(ctx, in)
+		return srv.(ApiServiceServer).AdminUsersUnbanPost(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/apiservice.ApiService/OrdersGet",
+		FullMethod: "/apiservice.ApiService/AdminUsersUnbanPost",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).OrdersGet(ctx, req.(*OrdersGetRequest))
+		return srv.(ApiServiceServer).AdminUsersUnbanPost(ctx, req.(*AdminUsersUnbanPostRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_BusinessServiceGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BusinessServiceGetRequest)
+func _ApiService_AdminBusinessesUnbanPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminBusinessesUnbanPostRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).BusinessServiceGet(ctx, in)
+		return srv.(ApiServiceServer).AdminBusinessesUnbanPost(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/apiservice.ApiService/BusinessServiceGet",
+		FullMethod: "/apiservice.ApiService/AdminBusinessesUnbanPost",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).BusinessServiceGet(ctx, req.(*BusinessServiceGetRequest))
+		return srv.(ApiServiceServer).AdminBusinessesUnbanPost(ctx, req.(*AdminBusinessesUnbanPostRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_BusinessFreeContactGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BusinessFreeContactGetRequest)
+func _ApiService_AdminUserActivityGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminUserActivityGetRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).BusinessFreeContactGet(ctx, in)
+		return srv.(ApiServiceServer).AdminUserActivityGet(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/apiservice.ApiService/BusinessFreeContactGet",
+		FullMethod: "/apiservice.ApiService/AdminUserActivityGet",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).BusinessFreeContactGet(ctx, req.(*BusinessFreeContactGetRequest))
+		return srv.(ApiServiceServer).AdminUserActivityGet(ctx, req.(*AdminUserActivityGetRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_AdminBusinessesGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AdminBusinessesGetRequest)
+func _ApiService_AdminUserImpersonatePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminUserImpersonatePostRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).AdminBusinessesGet(ctx, in)
+		return srv.(ApiServiceServer).AdminUserImpersonatePost(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/apiservice.ApiService/AdminBusinessesGet",
+		FullMethod: "/apiservice.ApiService/AdminUserImpersonatePost",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).AdminBusinessesGet(ctx, req.(*AdminBusinessesGetRequest))
+		return srv.(ApiServiceServer).AdminUserImpersonatePost(ctx, req.(*AdminUserImpersonatePostRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_AdminBusinessBanPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AdminBusinessBanPostRequest)
+func _ApiService_AdminPermissionsGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminPermissionsGetRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).AdminBusinessBanPost(ctx, in)
+		return srv.(ApiServiceServer).AdminPermissionsGet(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/apiservice.ApiService/AdminBusinessBanPost",
+		FullMethod: "/apiservice.ApiService/AdminPermissionsGet",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).AdminBusinessBanPost(ctx, req.(*AdminBusinessBanPostRequest))
+		return srv.(ApiServiceServer).AdminPermissionsGet(ctx, req.(*AdminPermissionsGetRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_AdminBusinessDeletePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AdminBusinessDeletePostRequest)
+func _ApiService_AdminRolesGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminRolesGetRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).AdminBusinessDeletePost(ctx, in)
+		return srv.(ApiServiceServer).AdminRolesGet(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/apiservice.ApiService/AdminBusinessDeletePost",
+		FullMethod: "/apiservice.ApiService/AdminRolesGet",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).AdminBusinessDeletePost(ctx, req.(*AdminBusinessDeletePostRequest))
+		return srv.(ApiServiceServer).AdminRolesGet(ctx, req.(*AdminRolesGetRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_AdminUsersDeletePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AdminUsersDeletePostRequest)
+func _ApiService_AdminRolePermissionsPut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminRolePermissionsPutRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).AdminUsersDeletePost(ctx, in)
+		return srv.(ApiServiceServer).AdminRolePermissionsPut(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/apiservice.ApiService/AdminUsersDeletePost",
+		FullMethod: "/apiservice.ApiService/AdminRolePermissionsPut",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).AdminUsersDeletePost(ctx, req.(*AdminUsersDeletePostRequest))
+		return srv.(ApiServiceServer).AdminRolePermissionsPut(ctx, req.(*AdminRolePermissionsPutRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_AdminUsersUnbanPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AdminUsersUnbanPostRequest)
+func _ApiService_AdminDisputesGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminDisputesGetRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).AdminUsersUnbanPost(ctx, in)
+		return srv.(ApiServiceServer).AdminDisputesGet(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/apiservice.ApiService/AdminUsersUnbanPost",
+		FullMethod: "/apiservice.ApiService/AdminDisputesGet",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).AdminUsersUnbanPost(ctx, req.(*AdminUsersUnbanPostRequest))
+		return srv.(ApiServiceServer).AdminDisputesGet(ctx, req.(*AdminDisputesGetRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_AdminBusinessesUnbanPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AdminBusinessesUnbanPostRequest)
+func _ApiService_AdminDisputeReviewPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminDisputeReviewPostRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).AdminBusinessesUnbanPost(ctx, in)
+		return srv.(ApiServiceServer).AdminDisputeReviewPost(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/apiservice.ApiService/AdminBusinessesUnbanPost",
+		FullMethod: "/apiservice.ApiService/AdminDisputeReviewPost",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).AdminBusinessesUnbanPost(ctx, req.(*AdminBusinessesUnbanPostRequest))
+		return srv.(ApiServiceServer).AdminDisputeReviewPost(ctx, req.(*AdminDisputeReviewPostRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -2094,6 +2996,24 @@ func _ApiService_AdminPostDeleteCategories_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApiService_AdminPostCategoryQuestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminCategoryQuestionsPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).AdminPostCategoryQuestions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apiservice.ApiService/AdminPostCategoryQuestions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).AdminPostCategoryQuestions(ctx, req.(*AdminCategoryQuestionsPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ApiService_AdminAdvertiseManagementPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AdminAdvertiseManagementPostRequest)
 	if err := dec(in); err != nil {
@@ -2436,6 +3356,168 @@ func _ApiService_UpdateOrderCompletePost_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApiService_UpdateOrderCompleteDisputePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateOrderStatusPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).UpdateOrderCompleteDisputePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apiservice.ApiService/UpdateOrderCompleteDisputePost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).UpdateOrderCompleteDisputePost(ctx, req.(*UpdateOrderStatusPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_OrderSlotProposePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderSlotProposePostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).OrderSlotProposePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apiservice.ApiService/OrderSlotProposePost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).OrderSlotProposePost(ctx, req.(*OrderSlotProposePostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_OrderSlotRespondPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderSlotRespondPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).OrderSlotRespondPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apiservice.ApiService/OrderSlotRespondPost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).OrderSlotRespondPost(ctx, req.(*OrderSlotRespondPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_OrderQuotePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderQuotePostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).OrderQuotePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apiservice.ApiService/OrderQuotePost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).OrderQuotePost(ctx, req.(*OrderQuotePostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_OrderQuotesGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderQuotesGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).OrderQuotesGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apiservice.ApiService/OrderQuotesGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).OrderQuotesGet(ctx, req.(*OrderQuotesGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_OrderQuoteAcceptPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderQuoteAcceptPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).OrderQuoteAcceptPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apiservice.ApiService/OrderQuoteAcceptPost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).OrderQuoteAcceptPost(ctx, req.(*OrderQuoteAcceptPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_OrderDisputePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderDisputePostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).OrderDisputePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apiservice.ApiService/OrderDisputePost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).OrderDisputePost(ctx, req.(*OrderDisputePostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_OrderDisputesGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderDisputesGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).OrderDisputesGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apiservice.ApiService/OrderDisputesGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).OrderDisputesGet(ctx, req.(*OrderDisputesGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_OrdersTimelineGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrdersTimelineGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).OrdersTimelineGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apiservice.ApiService/OrdersTimelineGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).OrdersTimelineGet(ctx, req.(*OrdersTimelineGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ApiService_FeedbacksPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(FeedbacksPostRequest)
 	if err := dec(in); err != nil {
@@ -2789,6 +3871,54 @@ var ApiService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AuthChangeMailAndPassPut",
 			Handler:    _ApiService_AuthChangeMailAndPassPut_Handler,
 		},
+		{
+			MethodName: "AuthLogoutAllPost",
+			Handler:    _ApiService_AuthLogoutAllPost_Handler,
+		},
+		{
+			MethodName: "AuthTotpEnrollPost",
+			Handler:    _ApiService_AuthTotpEnrollPost_Handler,
+		},
+		{
+			MethodName: "AuthTotpVerifyPost",
+			Handler:    _ApiService_AuthTotpVerifyPost_Handler,
+		},
+		{
+			MethodName: "AuthTotpDisablePost",
+			Handler:    _ApiService_AuthTotpDisablePost_Handler,
+		},
+		{
+			MethodName: "AuthPhonePost",
+			Handler:    _ApiService_AuthPhonePost_Handler,
+		},
+		{
+			MethodName: "AuthTokenPost",
+			Handler:    _ApiService_AuthTokenPost_Handler,
+		},
+		{
+			MethodName: "AuthTokenRefreshPost",
+			Handler:    _ApiService_AuthTokenRefreshPost_Handler,
+		},
+		{
+			MethodName: "AuthActivityGet",
+			Handler:    _ApiService_AuthActivityGet_Handler,
+		},
+		{
+			MethodName: "AuthDeletePost",
+			Handler:    _ApiService_AuthDeletePost_Handler,
+		},
+		{
+			MethodName: "AuthApiKeysGet",
+			Handler:    _ApiService_AuthApiKeysGet_Handler,
+		},
+		{
+			MethodName: "AuthApiKeysPost",
+			Handler:    _ApiService_AuthApiKeysPost_Handler,
+		},
+		{
+			MethodName: "AuthApiKeysRevokePost",
+			Handler:    _ApiService_AuthApiKeysRevokePost_Handler,
+		},
 		{
 			MethodName: "UserPost",
 			Handler:    _ApiService_UserPost_Handler,
@@ -2837,6 +3967,22 @@ var ApiService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BusinessVerifyRefCode",
 			Handler:    _ApiService_BusinessVerifyRefCode_Handler,
 		},
+		{
+			MethodName: "BusinessMembersGet",
+			Handler:    _ApiService_BusinessMembersGet_Handler,
+		},
+		{
+			MethodName: "BusinessMembersInvitePost",
+			Handler:    _ApiService_BusinessMembersInvitePost_Handler,
+		},
+		{
+			MethodName: "BusinessMembersAcceptPost",
+			Handler:    _ApiService_BusinessMembersAcceptPost_Handler,
+		},
+		{
+			MethodName: "BusinessMembersDeletePost",
+			Handler:    _ApiService_BusinessMembersDeletePost_Handler,
+		},
 		{
 			MethodName: "ContactGet",
 			Handler:    _ApiService_ContactGet_Handler,
@@ -2921,6 +4067,34 @@ var ApiService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AdminBusinessesUnbanPost",
 			Handler:    _ApiService_AdminBusinessesUnbanPost_Handler,
 		},
+		{
+			MethodName: "AdminUserActivityGet",
+			Handler:    _ApiService_AdminUserActivityGet_Handler,
+		},
+		{
+			MethodName: "AdminUserImpersonatePost",
+			Handler:    _ApiService_AdminUserImpersonatePost_Handler,
+		},
+		{
+			MethodName: "AdminPermissionsGet",
+			Handler:    _ApiService_AdminPermissionsGet_Handler,
+		},
+		{
+			MethodName: "AdminRolesGet",
+			Handler:    _ApiService_AdminRolesGet_Handler,
+		},
+		{
+			MethodName: "AdminRolePermissionsPut",
+			Handler:    _ApiService_AdminRolePermissionsPut_Handler,
+		},
+		{
+			MethodName: "AdminDisputesGet",
+			Handler:    _ApiService_AdminDisputesGet_Handler,
+		},
+		{
+			MethodName: "AdminDisputeReviewPost",
+			Handler:    _ApiService_AdminDisputeReviewPost_Handler,
+		},
 		{
 			MethodName: "AdminUsersGet",
 			Handler:    _ApiService_AdminUsersGet_Handler,
@@ -2937,6 +4111,10 @@ var ApiService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AdminPostDeleteCategories",
 			Handler:    _ApiService_AdminPostDeleteCategories_Handler,
 		},
+		{
+			MethodName: "AdminPostCategoryQuestions",
+			Handler:    _ApiService_AdminPostCategoryQuestions_Handler,
+		},
 		{
 			MethodName: "AdminAdvertiseManagementPost",
 			Handler:    _ApiService_AdminAdvertiseManagementPost_Handler,
@@ -3013,6 +4191,42 @@ var ApiService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateOrderCompletePost",
 			Handler:    _ApiService_UpdateOrderCompletePost_Handler,
 		},
+		{
+			MethodName: "UpdateOrderCompleteDisputePost",
+			Handler:    _ApiService_UpdateOrderCompleteDisputePost_Handler,
+		},
+		{
+			MethodName: "OrderSlotProposePost",
+			Handler:    _ApiService_OrderSlotProposePost_Handler,
+		},
+		{
+			MethodName: "OrderSlotRespondPost",
+			Handler:    _ApiService_OrderSlotRespondPost_Handler,
+		},
+		{
+			MethodName: "OrderQuotePost",
+			Handler:    _ApiService_OrderQuotePost_Handler,
+		},
+		{
+			MethodName: "OrderQuotesGet",
+			Handler:    _ApiService_OrderQuotesGet_Handler,
+		},
+		{
+			MethodName: "OrderQuoteAcceptPost",
+			Handler:    _ApiService_OrderQuoteAcceptPost_Handler,
+		},
+		{
+			MethodName: "OrderDisputePost",
+			Handler:    _ApiService_OrderDisputePost_Handler,
+		},
+		{
+			MethodName: "OrderDisputesGet",
+			Handler:    _ApiService_OrderDisputesGet_Handler,
+		},
+		{
+			MethodName: "OrdersTimelineGet",
+			Handler:    _ApiService_OrdersTimelineGet_Handler,
+		},
 		{
 			MethodName: "FeedbacksPost",
 			Handler:    _ApiService_FeedbacksPost_Handler,
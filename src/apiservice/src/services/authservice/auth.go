@@ -3,6 +3,7 @@ package authservice
 import (
 	"context"
 
+	"github.com/aqaurius6666/apiservice/src/internal/lib/jwt"
 	"github.com/aqaurius6666/apiservice/src/internal/var/c"
 	"github.com/google/wire"
 )
@@ -10,12 +11,12 @@ import (
 var Set = wire.NewSet(wire.Bind(new(Service), new(ServiceGRPC)), wire.Struct(new(ServiceGRPC), "*"), ConnectClient)
 
 type Service interface {
-	CheckAuth(ctx context.Context, header, body []byte, method string) (string, c.ROLE, error)
-	GetCredential(ctx context.Context, data string) (id, pub, priv string, isDef bool, err error)
-	Register(ctx context.Context, mail, phone, pub, enpriv string, role c.ROLE, refCode string) (otpId string, err error)
+	CheckAuth(ctx context.Context, header, body []byte, method string) (id string, role c.ROLE, totpEnrollRequired bool, permissions []string, membership *Membership, err error)
+	GetCredential(ctx context.Context, data, totp string) (id, pub, priv string, isDef bool, err error)
+	Register(ctx context.Context, mail, phone, pub, enpriv string, role c.ROLE, refCode string, channel c.OTP_CHANNEL) (otpId string, err error)
 	RegisterOTP(ctx context.Context, otpId string) (id, mail, phone string, role c.ROLE, refCode string, err error)
 	ForgotPasswordOTP(ctx context.Context, otpId, pub, enc string) (err error)
-	ForgotPassword(ctx context.Context, mail string) (otpId string, err error)
+	ForgotPassword(ctx context.Context, mail, phone string) (id, otpId string, err error)
 	VerifyOTP(ctx context.Context, otpId, otp string) (c.OTP_TYPE, error)
 	ChangePassword(ctx context.Context, id, pub, enc string) error
 	BanUser(ctx context.Context, id string, status bool) (string, c.ROLE, error)
@@ -26,4 +27,116 @@ type Service interface {
 	ResendOTP(ctx context.Context, otpId string) error
 	ChangeMailAndPass(ctx context.Context, userId, mail, pub, enc string) error
 	ChangeMailAndPassOTP(ctx context.Context, otpId string) (string, string, c.ROLE, error)
+	RevokeSessions(ctx context.Context, id string) (notBefore int64, err error)
+	EnrollTotp(ctx context.Context, id string) (secret, uri string, err error)
+	ConfirmTotp(ctx context.Context, id, code string) ([]string, error)
+	DisableTotp(ctx context.Context, id, code string) error
+	VerifyPhone(ctx context.Context, id, phone string) (otpId string, err error)
+	VerifyPhoneOTP(ctx context.Context, otpId string) (id, phone string, role c.ROLE, err error)
+	IssueToken(ctx context.Context, header, body []byte, method string) (*Token, error)
+	RefreshToken(ctx context.Context, refreshToken string, header, body []byte, method string) (*Token, error)
+	GetJwks(ctx context.Context) ([]jwt.JWK, error)
+	ListPermissions(ctx context.Context) ([]*Permission, error)
+	ListRoles(ctx context.Context) ([]*Role, error)
+	SetRolePermissions(ctx context.Context, role c.ROLE, permissions []string) ([]string, error)
+	ListSecurityEvents(ctx context.Context, id string, limit, offset int32) ([]*SecurityEvent, int64, error)
+	DeleteAccount(ctx context.Context, id string) (otpId string, err error)
+	DeleteAccountOTP(ctx context.Context, otpId string) (id string, role c.ROLE, deleteAfter int64, err error)
+	Impersonate(ctx context.Context, adminId, userId, reason string) (accessToken string, expiresAt int64, sessionId string, err error)
+	RecordImpersonationAction(ctx context.Context, sessionId, adminId, userId, method, path string, status int32) error
+	SuspendUser(ctx context.Context, id string, sus *Suspension) (c.ROLE, *Suspension, error)
+	LiftSuspension(ctx context.Context, id, adminId string) (c.ROLE, error)
+	InviteMember(ctx context.Context, userId, mail string, role c.BUSINESS_ROLE, businessName string) (*Member, error)
+	AcceptInvitation(ctx context.Context, otpId, userId string) (*Membership, error)
+	ListMembers(ctx context.Context, businessId string) ([]*Member, error)
+	RemoveMember(ctx context.Context, userId, memberId string) error
+	CreateApiKey(ctx context.Context, userId string, key *ApiKey, forBusiness bool) (*ApiKey, string, error)
+	ListApiKeys(ctx context.Context, userId string) ([]*ApiKey, error)
+	RevokeApiKey(ctx context.Context, userId, id string) error
+	CheckApiKey(ctx context.Context, key string) (id string, role c.ROLE, scopes []string, membership *Membership, err error)
+}
+
+// ApiKey is an api key without its secret. BusinessId is set when the key
+// acts for a business. RateLimit is in requests per minute, times are in
+// milliseconds and ExpiresAt is 0 for a key that never expires.
+type ApiKey struct {
+	Id         string
+	Name       string
+	Prefix     string
+	Scopes     []string
+	BusinessId string
+	RateLimit  int64
+	ExpiresAt  int64
+	LastUsedAt int64
+	Revoked    bool
+	CreatedAt  int64
+}
+
+// Membership is the business a user acts for and its role there. A handyman
+// owns the business that has its own id.
+type Membership struct {
+	BusinessId string
+	Role       c.BUSINESS_ROLE
+}
+
+// Member is a member of a business, or an invitation to become one while
+// Accepted is false, in which case UserId is empty. CreatedAt is in
+// milliseconds.
+type Member struct {
+	Id        string
+	UserId    string
+	Mail      string
+	Role      c.BUSINESS_ROLE
+	Accepted  bool
+	CreatedAt int64
+}
+
+// Suspension keeps a user from signing in until ExpiresAt, or until it is
+// lifted when ExpiresAt is 0. Times are in milliseconds and Note is only
+// shown to staff.
+type Suspension struct {
+	Reason    c.SUSPENSION_REASON
+	Note      string
+	ExpiresAt int64
+	IssuedBy  string
+	CreatedAt int64
+}
+
+// Token is an access token and the refresh token issued with it. Times are
+// in milliseconds.
+type Token struct {
+	AccessToken      string
+	ExpiresAt        int64
+	RefreshToken     string
+	RefreshExpiresAt int64
+	// Id and Role are only set by IssueToken, and DeletionCanceled
+	// when the sign in restored an account scheduled for deletion.
+	Id               string
+	Role             c.ROLE
+	DeletionCanceled bool
+}
+
+// Permission is a permission code that can be granted to a role.
+type Permission struct {
+	Code        string
+	Description string
+}
+
+// Role is a role with the permissions it currently grants.
+type Role struct {
+	Role        c.ROLE
+	Name        string
+	Permissions []string
+}
+
+// SecurityEvent is a security relevant action on an account. CreatedAt is in
+// milliseconds.
+type SecurityEvent struct {
+	Id        string
+	Type      c.SECURITY_EVENT
+	Ip        string
+	UserAgent string
+	Success   bool
+	Reason    string
+	CreatedAt int64
 }
@@ -2,17 +2,20 @@ package authservice
 
 import (
 	"context"
+	"strconv"
 	"time"
 
 	"github.com/aqaurius6666/apiservice/src/internal/lib"
+	"github.com/aqaurius6666/apiservice/src/internal/lib/jwt"
+	"github.com/aqaurius6666/apiservice/src/internal/lib/mtls"
 	"github.com/aqaurius6666/apiservice/src/internal/var/c"
 	"github.com/aqaurius6666/apiservice/src/internal/var/e"
 	"github.com/aqaurius6666/apiservice/src/pb/authpb"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"go.opentelemetry.io/otel"
 	"golang.org/x/xerrors"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/status"
 )
 
@@ -28,10 +31,36 @@ type ServiceGRPC struct {
 	Client authpb.AuthServiceClient
 }
 
-func ConnectClient(ctx context.Context, addr AuthServiceAddr) (authpb.AuthServiceClient, error) {
+// errorFromStatus converts a grpc status to an error carrying its message. A
+// status with retry info becomes an *e.TooManyRequestsError.
+func errorFromStatus(err error) error {
+	stt, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+	for _, d := range stt.Details() {
+		if info, ok := d.(*errdetails.RetryInfo); ok {
+			return &e.TooManyRequestsError{
+				Err:        xerrors.New(stt.Message()),
+				RetryAfter: info.RetryDelay.AsDuration(),
+			}
+		}
+		if info, ok := d.(*errdetails.ErrorInfo); ok && info.Reason == c.USER_SUSPENDED {
+			expiresAt, _ := strconv.ParseInt(info.Metadata["expiresAt"], 10, 64)
+			return &e.SuspendedError{
+				Err:       xerrors.New(stt.Message()),
+				Reason:    c.SUSPENSION_REASON(c.SUSPENSION_REASON_value[info.Metadata["reason"]]),
+				ExpiresAt: expiresAt,
+			}
+		}
+	}
+	return xerrors.New(stt.Message())
+}
+
+func ConnectClient(ctx context.Context, addr AuthServiceAddr, creds *mtls.Credentials) (authpb.AuthServiceClient, error) {
 	nctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
-	conn, err := grpc.DialContext(nctx, string(addr), grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithChainUnaryInterceptor(
+	conn, err := grpc.DialContext(nctx, string(addr), creds.DialOption(), grpc.WithChainUnaryInterceptor(
 		otelgrpc.UnaryClientInterceptor(),
 	))
 	if err != nil {
@@ -46,10 +75,7 @@ func (s ServiceGRPC) ResendOTP(ctx context.Context, id string) error {
 		Id: id,
 	})
 	if err != nil {
-		stt, ok := status.FromError(err)
-		if ok {
-			err = xerrors.New(stt.Message())
-		}
+		err = errorFromStatus(err)
 		err = xerrors.Errorf("%w", err)
 		lib.RecordError(span, err, ctx)
 		return err
@@ -116,25 +142,23 @@ func (s ServiceGRPC) BanUser(ctx context.Context, id string, stt bool) (string,
 	return res.Id, res.Role, nil
 }
 
-func (s ServiceGRPC) ForgotPassword(ctx context.Context, mail string) (string, error) {
+func (s ServiceGRPC) ForgotPassword(ctx context.Context, mail, phone string) (string, string, error) {
 	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.ForgotPassword))
 	defer span.End()
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 	res, err := s.Client.ForgotPassword(ctx, &authpb.ForgotPasswordRequest{
-		Mail: mail,
+		Mail:  mail,
+		Phone: phone,
 	})
 	if err != nil {
-		stt, ok := status.FromError(err)
-		if ok {
-			err = xerrors.New(stt.Message())
-		}
+		err = errorFromStatus(err)
 		err = xerrors.Errorf("%w", err)
 		lib.RecordError(span, err, ctx)
 
-		return "", err
+		return "", "", err
 	}
-	return res.OtpId, nil
+	return res.Id, res.OtpId, nil
 }
 
 func (s ServiceGRPC) ChangeMail(ctx context.Context, id string, mail string) (string, error) {
@@ -148,10 +172,7 @@ func (s ServiceGRPC) ChangeMail(ctx context.Context, id string, mail string) (st
 		Id:   id,
 	})
 	if err != nil {
-		stt, ok := status.FromError(err)
-		if ok {
-			err = xerrors.New(stt.Message())
-		}
+		err = errorFromStatus(err)
 		err = xerrors.Errorf("%w", err)
 		lib.RecordError(span, err, ctx)
 		return "", err
@@ -269,7 +290,7 @@ func (s ServiceGRPC) VerifyOTP(ctx context.Context, otpId, otp string) (typ c.OT
 	return res.Type, nil
 }
 
-func (s ServiceGRPC) CheckAuth(ctx context.Context, header, body []byte, method string) (string, c.ROLE, error) {
+func (s ServiceGRPC) CheckAuth(ctx context.Context, header, body []byte, method string) (string, c.ROLE, bool, []string, *Membership, error) {
 	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.CheckAuth))
 	defer span.End()
 	ctx, cancel := context.WithTimeout(ctx, timeout)
@@ -286,12 +307,19 @@ func (s ServiceGRPC) CheckAuth(ctx context.Context, header, body []byte, method
 		}
 		err = xerrors.Errorf("%w", err)
 		lib.RecordError(span, err, ctx)
-		return "", 0, err
+		return "", 0, false, nil, nil, err
 	}
-	return res.Id, res.Role, nil
+	var membership *Membership
+	if res.BusinessId != "" {
+		membership = &Membership{
+			BusinessId: res.BusinessId,
+			Role:       res.BusinessRole,
+		}
+	}
+	return res.Id, res.Role, res.TotpEnrollRequired, res.Permissions, membership, nil
 }
 
-func (s ServiceGRPC) Register(ctx context.Context, mail, phone, pub, enpriv string, role c.ROLE, refCode string) (otpId string, err error) {
+func (s ServiceGRPC) Register(ctx context.Context, mail, phone, pub, enpriv string, role c.ROLE, refCode string, channel c.OTP_CHANNEL) (otpId string, err error) {
 	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.Register))
 	defer span.End()
 
@@ -305,13 +333,11 @@ func (s ServiceGRPC) Register(ctx context.Context, mail, phone, pub, enpriv stri
 		EncryptedPrivateKey: enpriv,
 		Role:                role,
 		RefCode:             refCode,
+		Channel:             channel,
 	})
 
 	if err != nil {
-		stt, ok := status.FromError(err)
-		if ok {
-			err = xerrors.New(stt.Message())
-		}
+		err = errorFromStatus(err)
 		err = xerrors.Errorf("%w", err)
 		lib.RecordError(span, err, ctx)
 		return
@@ -319,7 +345,7 @@ func (s ServiceGRPC) Register(ctx context.Context, mail, phone, pub, enpriv stri
 	return res.OtpId, nil
 }
 
-func (s ServiceGRPC) GetCredential(ctx context.Context, data string) (id, pub, priv string, isDef bool, err error) {
+func (s ServiceGRPC) GetCredential(ctx context.Context, data, totp string) (id, pub, priv string, isDef bool, err error) {
 	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.GetCredential))
 	defer span.End()
 
@@ -328,6 +354,7 @@ func (s ServiceGRPC) GetCredential(ctx context.Context, data string) (id, pub, p
 
 	res, err := s.Client.GetCredential(ctx, &authpb.GetCredentialRequest{
 		Identifier: data,
+		Totp:       totp,
 	})
 	if err != nil {
 		stt, ok := status.FromError(err)
@@ -374,6 +401,125 @@ func (s ServiceGRPC) ChangeMailAndPass(ctx context.Context, userId, mail, pub, e
 		UserId:              userId,
 		PublicKey:           pub,
 	})
+	if err != nil {
+		err = errorFromStatus(err)
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return err
+	}
+	return nil
+}
+
+func (s ServiceGRPC) RevokeSessions(ctx context.Context, id string) (int64, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.RevokeSessions))
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	res, err := s.Client.RevokeSessions(ctx, &authpb.RevokeSessionsRequest{
+		Id: id,
+	})
+	if err != nil {
+		stt, ok := status.FromError(err)
+		if ok {
+			err = xerrors.New(stt.Message())
+		}
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return 0, err
+	}
+	return res.NotBefore, nil
+}
+
+func (s ServiceGRPC) EnrollTotp(ctx context.Context, id string) (string, string, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.EnrollTotp))
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	res, err := s.Client.EnrollTotp(ctx, &authpb.EnrollTotpRequest{
+		UserId: id,
+	})
+	if err != nil {
+		stt, ok := status.FromError(err)
+		if ok {
+			err = xerrors.New(stt.Message())
+		}
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return "", "", err
+	}
+	return res.Secret, res.Uri, nil
+}
+
+func (s ServiceGRPC) ConfirmTotp(ctx context.Context, id, code string) ([]string, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.ConfirmTotp))
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	res, err := s.Client.ConfirmTotp(ctx, &authpb.ConfirmTotpRequest{
+		UserId: id,
+		Code:   code,
+	})
+	if err != nil {
+		stt, ok := status.FromError(err)
+		if ok {
+			err = xerrors.New(stt.Message())
+		}
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	return res.RecoveryCodes, nil
+}
+
+func (s ServiceGRPC) DisableTotp(ctx context.Context, id, code string) error {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.DisableTotp))
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	_, err := s.Client.DisableTotp(ctx, &authpb.DisableTotpRequest{
+		UserId: id,
+		Code:   code,
+	})
+	if err != nil {
+		stt, ok := status.FromError(err)
+		if ok {
+			err = xerrors.New(stt.Message())
+		}
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return err
+	}
+	return nil
+}
+
+func (s ServiceGRPC) VerifyPhone(ctx context.Context, id, phone string) (string, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.VerifyPhone))
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	res, err := s.Client.VerifyPhone(ctx, &authpb.VerifyPhoneRequest{
+		UserId: id,
+		Phone:  phone,
+	})
+	if err != nil {
+		err = errorFromStatus(err)
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return "", err
+	}
+	return res.OtpId, nil
+}
+
+func (s ServiceGRPC) VerifyPhoneOTP(ctx context.Context, otpId string) (string, string, c.ROLE, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.VerifyPhoneOTP))
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	res, err := s.Client.VerifyPhoneOTP(ctx, &authpb.VerifyPhoneOTPRequest{
+		OtpId: otpId,
+	})
 	if err != nil {
 		stt, ok := status.FromError(err)
 		if ok {
@@ -381,7 +527,512 @@ func (s ServiceGRPC) ChangeMailAndPass(ctx context.Context, userId, mail, pub, e
 		}
 		err = xerrors.Errorf("%w", err)
 		lib.RecordError(span, err, ctx)
+		return "", "", 0, err
+	}
+	return res.Id, res.Phone, res.Role, nil
+}
+
+func (s ServiceGRPC) IssueToken(ctx context.Context, header, body []byte, method string) (*Token, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.IssueToken))
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	res, err := s.Client.IssueToken(ctx, &authpb.IssueTokenRequest{
+		Header: header,
+		Body:   body,
+		Method: method,
+	})
+	if err != nil {
+		err = errorFromStatus(err)
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	return &Token{
+		AccessToken:      res.AccessToken,
+		ExpiresAt:        res.ExpiresAt,
+		RefreshToken:     res.RefreshToken,
+		RefreshExpiresAt: res.RefreshExpiresAt,
+		Id:               res.Id,
+		Role:             res.Role,
+		DeletionCanceled: res.DeletionCanceled,
+	}, nil
+}
+
+func (s ServiceGRPC) RefreshToken(ctx context.Context, refreshToken string, header, body []byte, method string) (*Token, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.RefreshToken))
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	res, err := s.Client.RefreshToken(ctx, &authpb.RefreshTokenRequest{
+		Header:       header,
+		Body:         body,
+		Method:       method,
+		RefreshToken: refreshToken,
+	})
+	if err != nil {
+		err = errorFromStatus(err)
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	return &Token{
+		AccessToken:      res.AccessToken,
+		ExpiresAt:        res.ExpiresAt,
+		RefreshToken:     res.RefreshToken,
+		RefreshExpiresAt: res.RefreshExpiresAt,
+	}, nil
+}
+
+func (s ServiceGRPC) GetJwks(ctx context.Context) ([]jwt.JWK, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.GetJwks))
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	res, err := s.Client.GetJwks(ctx, &authpb.GetJwksRequest{})
+	if err != nil {
+		err = errorFromStatus(err)
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	keys := make([]jwt.JWK, 0, len(res.Keys))
+	for _, k := range res.Keys {
+		keys = append(keys, jwt.JWK{
+			Kty: k.Kty,
+			Crv: k.Crv,
+			X:   k.X,
+			Y:   k.Y,
+			Kid: k.Kid,
+			Alg: k.Alg,
+			Use: k.Use,
+		})
+	}
+	return keys, nil
+}
+
+func (s ServiceGRPC) ListPermissions(ctx context.Context) ([]*Permission, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.ListPermissions))
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	res, err := s.Client.ListPermissions(ctx, &authpb.ListPermissionsRequest{})
+	if err != nil {
+		err = errorFromStatus(err)
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	perms := make([]*Permission, 0, len(res.Permissions))
+	for _, p := range res.Permissions {
+		perms = append(perms, &Permission{
+			Code:        p.Code,
+			Description: p.Description,
+		})
+	}
+	return perms, nil
+}
+
+func (s ServiceGRPC) ListRoles(ctx context.Context) ([]*Role, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.ListRoles))
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	res, err := s.Client.ListRoles(ctx, &authpb.ListRolesRequest{})
+	if err != nil {
+		err = errorFromStatus(err)
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	roles := make([]*Role, 0, len(res.Roles))
+	for _, r := range res.Roles {
+		roles = append(roles, &Role{
+			Role:        r.Role,
+			Name:        r.Name,
+			Permissions: r.Permissions,
+		})
+	}
+	return roles, nil
+}
+
+func (s ServiceGRPC) SetRolePermissions(ctx context.Context, role c.ROLE, permissions []string) ([]string, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.SetRolePermissions))
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	res, err := s.Client.SetRolePermissions(ctx, &authpb.SetRolePermissionsRequest{
+		Role:        role,
+		Permissions: permissions,
+	})
+	if err != nil {
+		err = errorFromStatus(err)
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	return res.Permissions, nil
+}
+
+func (s ServiceGRPC) ListSecurityEvents(ctx context.Context, id string, limit, offset int32) ([]*SecurityEvent, int64, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.ListSecurityEvents))
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	res, err := s.Client.ListSecurityEvents(ctx, &authpb.ListSecurityEventsRequest{
+		UserId: id,
+		Limit:  limit,
+		Offset: offset,
+	})
+	if err != nil {
+		err = errorFromStatus(err)
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, 0, err
+	}
+	events := make([]*SecurityEvent, 0, len(res.Events))
+	for _, ev := range res.Events {
+		events = append(events, &SecurityEvent{
+			Id:        ev.Id,
+			Type:      ev.Type,
+			Ip:        ev.Ip,
+			UserAgent: ev.UserAgent,
+			Success:   ev.Success,
+			Reason:    ev.Reason,
+			CreatedAt: ev.CreatedAt,
+		})
+	}
+	return events, res.Total, nil
+}
+
+func (s ServiceGRPC) DeleteAccount(ctx context.Context, id string) (string, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.DeleteAccount))
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	res, err := s.Client.DeleteAccount(ctx, &authpb.DeleteAccountRequest{
+		UserId: id,
+	})
+	if err != nil {
+		err = errorFromStatus(err)
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return "", err
+	}
+	return res.OtpId, nil
+}
+
+func (s ServiceGRPC) DeleteAccountOTP(ctx context.Context, otpId string) (string, c.ROLE, int64, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.DeleteAccountOTP))
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	res, err := s.Client.DeleteAccountOTP(ctx, &authpb.DeleteAccountOTPRequest{
+		OtpId: otpId,
+	})
+	if err != nil {
+		err = errorFromStatus(err)
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return "", 0, 0, err
+	}
+	return res.Id, res.Role, res.DeleteAfter, nil
+}
+
+func (s ServiceGRPC) Impersonate(ctx context.Context, adminId, userId, reason string) (string, int64, string, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.Impersonate))
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	res, err := s.Client.Impersonate(ctx, &authpb.ImpersonateRequest{
+		AdminId: adminId,
+		UserId:  userId,
+		Reason:  reason,
+	})
+	if err != nil {
+		err = errorFromStatus(err)
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return "", 0, "", err
+	}
+	return res.AccessToken, res.ExpiresAt, res.SessionId, nil
+}
+
+func (s ServiceGRPC) RecordImpersonationAction(ctx context.Context, sessionId, adminId, userId, method, path string, status int32) error {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.RecordImpersonationAction))
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	_, err := s.Client.RecordImpersonationAction(ctx, &authpb.RecordImpersonationActionRequest{
+		SessionId: sessionId,
+		AdminId:   adminId,
+		UserId:    userId,
+		Method:    method,
+		Path:      path,
+		Status:    status,
+	})
+	if err != nil {
+		err = errorFromStatus(err)
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return err
+	}
+	return nil
+}
+
+func (s ServiceGRPC) SuspendUser(ctx context.Context, id string, sus *Suspension) (c.ROLE, *Suspension, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.SuspendUser))
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	res, err := s.Client.SuspendUser(ctx, &authpb.SuspendUserRequest{
+		Id:        id,
+		AdminId:   sus.IssuedBy,
+		Reason:    sus.Reason,
+		Note:      sus.Note,
+		ExpiresAt: sus.ExpiresAt,
+	})
+	if err != nil {
+		err = errorFromStatus(err)
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return 0, nil, err
+	}
+	return res.Role, &Suspension{
+		Reason:    res.Suspension.Reason,
+		Note:      res.Suspension.Note,
+		ExpiresAt: res.Suspension.ExpiresAt,
+		IssuedBy:  res.Suspension.IssuedBy,
+		CreatedAt: res.Suspension.CreatedAt,
+	}, nil
+}
+
+func (s ServiceGRPC) LiftSuspension(ctx context.Context, id, adminId string) (c.ROLE, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.LiftSuspension))
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	res, err := s.Client.LiftSuspension(ctx, &authpb.LiftSuspensionRequest{
+		Id:      id,
+		AdminId: adminId,
+	})
+	if err != nil {
+		err = errorFromStatus(err)
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return 0, err
+	}
+	return res.Role, nil
+}
+
+func (s ServiceGRPC) InviteMember(ctx context.Context, userId, mail string, role c.BUSINESS_ROLE, businessName string) (*Member, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.InviteMember))
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	res, err := s.Client.InviteMember(ctx, &authpb.InviteMemberRequest{
+		UserId:       userId,
+		Mail:         mail,
+		Role:         role,
+		BusinessName: businessName,
+	})
+	if err != nil {
+		err = errorFromStatus(err)
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	return memberFromPb(res.Member), nil
+}
+
+func (s ServiceGRPC) AcceptInvitation(ctx context.Context, otpId, userId string) (*Membership, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.AcceptInvitation))
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	res, err := s.Client.AcceptInvitation(ctx, &authpb.AcceptInvitationRequest{
+		OtpId:  otpId,
+		UserId: userId,
+	})
+	if err != nil {
+		err = errorFromStatus(err)
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	return &Membership{
+		BusinessId: res.BusinessId,
+		Role:       res.Role,
+	}, nil
+}
+
+func (s ServiceGRPC) ListMembers(ctx context.Context, businessId string) ([]*Member, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.ListMembers))
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	res, err := s.Client.ListMembers(ctx, &authpb.ListMembersRequest{
+		BusinessId: businessId,
+	})
+	if err != nil {
+		err = errorFromStatus(err)
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	members := make([]*Member, 0, len(res.Members))
+	for _, m := range res.Members {
+		members = append(members, memberFromPb(m))
+	}
+	return members, nil
+}
+
+func (s ServiceGRPC) RemoveMember(ctx context.Context, userId, memberId string) error {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.RemoveMember))
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	_, err := s.Client.RemoveMember(ctx, &authpb.RemoveMemberRequest{
+		UserId:   userId,
+		MemberId: memberId,
+	})
+	if err != nil {
+		err = errorFromStatus(err)
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
 		return err
 	}
 	return nil
 }
+
+func memberFromPb(m *authpb.Member) *Member {
+	return &Member{
+		Id:        m.Id,
+		UserId:    m.UserId,
+		Mail:      m.Mail,
+		Role:      m.Role,
+		Accepted:  m.Accepted,
+		CreatedAt: m.CreatedAt,
+	}
+}
+
+func (s ServiceGRPC) CreateApiKey(ctx context.Context, userId string, key *ApiKey, forBusiness bool) (*ApiKey, string, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.CreateApiKey))
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	res, err := s.Client.CreateApiKey(ctx, &authpb.CreateApiKeyRequest{
+		UserId:      userId,
+		Name:        key.Name,
+		Scopes:      key.Scopes,
+		ExpiresAt:   key.ExpiresAt,
+		RateLimit:   key.RateLimit,
+		ForBusiness: forBusiness,
+	})
+	if err != nil {
+		err = errorFromStatus(err)
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, "", err
+	}
+	return apiKeyFromPb(res.ApiKey), res.Key, nil
+}
+
+func (s ServiceGRPC) ListApiKeys(ctx context.Context, userId string) ([]*ApiKey, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.ListApiKeys))
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	res, err := s.Client.ListApiKeys(ctx, &authpb.ListApiKeysRequest{
+		UserId: userId,
+	})
+	if err != nil {
+		err = errorFromStatus(err)
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	keys := make([]*ApiKey, 0, len(res.ApiKeys))
+	for _, k := range res.ApiKeys {
+		keys = append(keys, apiKeyFromPb(k))
+	}
+	return keys, nil
+}
+
+func (s ServiceGRPC) RevokeApiKey(ctx context.Context, userId, id string) error {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.RevokeApiKey))
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	_, err := s.Client.RevokeApiKey(ctx, &authpb.RevokeApiKeyRequest{
+		UserId: userId,
+		Id:     id,
+	})
+	if err != nil {
+		err = errorFromStatus(err)
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return err
+	}
+	return nil
+}
+
+func (s ServiceGRPC) CheckApiKey(ctx context.Context, key string) (string, c.ROLE, []string, *Membership, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.CheckApiKey))
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	res, err := s.Client.CheckApiKey(ctx, &authpb.CheckApiKeyRequest{
+		Key: key,
+	})
+	if err != nil {
+		err = errorFromStatus(err)
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return "", 0, nil, nil, err
+	}
+	var membership *Membership
+	if res.BusinessId != "" {
+		membership = &Membership{
+			BusinessId: res.BusinessId,
+			Role:       res.BusinessRole,
+		}
+	}
+	return res.Id, res.Role, res.Scopes, membership, nil
+}
+
+func apiKeyFromPb(k *authpb.ApiKey) *ApiKey {
+	return &ApiKey{
+		Id:         k.Id,
+		Name:       k.Name,
+		Prefix:     k.Prefix,
+		Scopes:     k.Scopes,
+		BusinessId: k.BusinessId,
+		RateLimit:  k.RateLimit,
+		ExpiresAt:  k.ExpiresAt,
+		LastUsedAt: k.LastUsedAt,
+		Revoked:    k.Revoked,
+		CreatedAt:  k.CreatedAt,
+	}
+}
@@ -5,13 +5,13 @@ import (
 	"time"
 
 	"github.com/aqaurius6666/apiservice/src/internal/lib"
+	"github.com/aqaurius6666/apiservice/src/internal/lib/mtls"
 	"github.com/aqaurius6666/apiservice/src/internal/var/c"
 	"github.com/aqaurius6666/apiservice/src/pb/chatpb"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"go.opentelemetry.io/otel"
 	"golang.org/x/xerrors"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 )
 
 type ChatserviceAddr string
@@ -26,10 +26,10 @@ type ServiceGRPC struct {
 	Client chatpb.ChatServiceClient
 }
 
-func ConnectClient(ctx context.Context, addr ChatserviceAddr) (chatpb.ChatServiceClient, error) {
+func ConnectClient(ctx context.Context, addr ChatserviceAddr, creds *mtls.Credentials) (chatpb.ChatServiceClient, error) {
 	nctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
-	conn, err := grpc.DialContext(nctx, string(addr), grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithChainUnaryInterceptor(
+	conn, err := grpc.DialContext(nctx, string(addr), creds.DialOption(), grpc.WithChainUnaryInterceptor(
 		otelgrpc.UnaryClientInterceptor(),
 	))
 	if err != nil {
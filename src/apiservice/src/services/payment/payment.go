@@ -20,5 +20,7 @@ type Interface interface {
 	GetPaymentMethodInfo(ctx context.Context, id *string) (*pb.PaymentMethodInfo, error)
 	GetPaymentIntentAmount(ctx context.Context, id *string) (int64, error)
 	PaymentIntent(ctx context.Context, customerId *string, amount *int64, paymentMethodId *string) (*string, error)
+	// refund part of a payment intent, once per idempotency key
+	Refund(ctx context.Context, paymentIntentId *string, amount *int64, idempotencyKey string) (*string, error)
 	// ConfirmSetupIntent(ctx context.Context, clientSecret *string) error
 }
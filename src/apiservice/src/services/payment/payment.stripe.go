@@ -182,3 +182,26 @@ func (s *Stripe) PaymentIntent(ctx context.Context, customerId *string, amount *
 
 	return (*string)(&pi.Status), nil
 }
+
+func (s *Stripe) Refund(ctx context.Context, paymentIntentId *string, amount *int64, idempotencyKey string) (*string, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.Refund))
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	re, err := s.client.Refunds.New(&stripe.RefundParams{
+		Params: stripe.Params{
+			Context:        ctx,
+			IdempotencyKey: stripe.String(idempotencyKey),
+		},
+		PaymentIntent: stripe.String(*paymentIntentId),
+		Amount:        stripe.Int64(*amount),
+	})
+	if err != nil {
+		lib.RecordError(span, err, ctx)
+		err = xerrors.Errorf("%w", e.ErrPayment)
+		return nil, err
+	}
+	return &re.ID, nil
+}
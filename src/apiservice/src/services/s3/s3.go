@@ -22,4 +22,13 @@ var (
 type Service interface {
 	GetObject(key *string) (io.ReadCloser, error)
 	GetPresignedPutObject(ctx context.Context, key string, contentLength int64, contentTYpe string) (url string, form map[string]string, err error)
+	GetPresignedGetObject(ctx context.Context, key string, expires time.Duration) (url string, err error)
+	StatObject(ctx context.Context, key string) (*ObjectInfo, error)
+	ReadObjectHead(ctx context.Context, key string, n int64) ([]byte, error)
+}
+
+// ObjectInfo is what the bucket knows about an uploaded object.
+type ObjectInfo struct {
+	Size        int64
+	ContentType string
 }
@@ -60,3 +60,66 @@ func (s *V2) GetPresignedPutObject(ctx context.Context, key string, contentLengt
 
 	return u.String(), form, nil
 }
+
+func (s *V2) GetPresignedGetObject(ctx context.Context, key string, expires time.Duration) (string, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.GetPresignedGetObject))
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	u, err := s.Client.PresignedGetObject(ctx, string(s.Bucket), key, expires, nil)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return "", err
+	}
+	return u.String(), nil
+}
+
+func (s *V2) StatObject(ctx context.Context, key string) (*ObjectInfo, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.StatObject))
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	info, err := s.Client.StatObject(ctx, string(s.Bucket), key, minio.StatObjectOptions{})
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	return &ObjectInfo{
+		Size:        info.Size,
+		ContentType: info.ContentType,
+	}, nil
+}
+
+// ReadObjectHead reads the first n bytes of an object, enough to sniff what
+// it really is.
+func (s *V2) ReadObjectHead(ctx context.Context, key string, n int64) ([]byte, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.ReadObjectHead))
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	opts := minio.GetObjectOptions{}
+	if err := opts.SetRange(0, n-1); err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	obj, err := s.Client.GetObject(ctx, string(s.Bucket), key, opts)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	defer obj.Close()
+	head, err := io.ReadAll(io.LimitReader(obj, n))
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err, ctx)
+		return nil, err
+	}
+	return head, nil
+}
@@ -99,10 +99,27 @@ var APISERVICE_JSON = `{
             "pattern": "message"
           },
           {
-            "name": "UserId",
-            "in": "query",
-            "required": false,
-            "type": "string"
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "properties": {
+                "UserId": {
+                  "type": "string"
+                },
+                "reason": {
+                  "type": "string"
+                },
+                "note": {
+                  "type": "string"
+                },
+                "expiresAt": {
+                  "type": "string",
+                  "format": "int64"
+                }
+              }
+            }
           }
         ],
         "tags": [
@@ -280,6 +297,142 @@ var APISERVICE_JSON = `{
         ]
       }
     },
+    "/admin/categories/questions": {
+      "post": {
+        "operationId": "ApiService_AdminPostCategoryQuestions",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/apiserviceAdminCategoryQuestionsPostResponse"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/rpcStatus"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/apiserviceAdminCategoryQuestionsPostRequest"
+            }
+          }
+        ],
+        "tags": [
+          "ApiService"
+        ]
+      }
+    },
+    "/admin/disputes": {
+      "get": {
+        "operationId": "ApiService_AdminDisputesGet",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/apiserviceAdminDisputesGetResponse"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/rpcStatus"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "UserId",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "status",
+            "in": "query",
+            "required": false,
+            "type": "string",
+            "enum": [
+              "UNDER_REVIEW",
+              "APPROVED",
+              "DENIED"
+            ],
+            "default": "UNDER_REVIEW"
+          },
+          {
+            "name": "limit",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "offset",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "ApiService"
+        ]
+      }
+    },
+    "/admin/disputes/{id}/review": {
+      "post": {
+        "operationId": "ApiService_AdminDisputeReviewPost",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/apiserviceAdminDisputeReviewPostResponse"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/rpcStatus"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "id",
+            "in": "path",
+            "required": true,
+            "type": "string",
+            "pattern": "message"
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "properties": {
+                "UserId": {
+                  "type": "string"
+                },
+                "approve": {
+                  "type": "boolean"
+                },
+                "note": {
+                  "type": "string"
+                }
+              }
+            }
+          }
+        ],
+        "tags": [
+          "ApiService"
+        ]
+      }
+    },
     "/admin/groups": {
       "get": {
         "operationId": "ApiService_AdminGroupGet",
@@ -415,6 +568,36 @@ var APISERVICE_JSON = `{
         ]
       }
     },
+    "/admin/permissions": {
+      "get": {
+        "operationId": "ApiService_AdminPermissionsGet",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/apiserviceAdminPermissionsGetResponse"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/rpcStatus"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "UserId",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "ApiService"
+        ]
+      }
+    },
     "/admin/promote-management": {
       "get": {
         "operationId": "ApiService_AdminAdvertiseMangementGet",
@@ -593,6 +776,86 @@ var APISERVICE_JSON = `{
         ]
       }
     },
+    "/admin/roles": {
+      "get": {
+        "operationId": "ApiService_AdminRolesGet",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/apiserviceAdminRolesGetResponse"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/rpcStatus"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "UserId",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "ApiService"
+        ]
+      }
+    },
+    "/admin/roles/{role}/permissions": {
+      "put": {
+        "operationId": "ApiService_AdminRolePermissionsPut",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/apiserviceAdminRolePermissionsPutResponse"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/rpcStatus"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "role",
+            "in": "path",
+            "required": true,
+            "type": "string",
+            "pattern": "message"
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "properties": {
+                "UserId": {
+                  "type": "string"
+                },
+                "permissions": {
+                  "type": "array",
+                  "items": {
+                    "type": "string"
+                  }
+                }
+              }
+            }
+          }
+        ],
+        "tags": [
+          "ApiService"
+        ]
+      }
+    },
     "/admin/users": {
       "get": {
         "operationId": "ApiService_AdminUsersGet",
@@ -647,14 +910,14 @@ var APISERVICE_JSON = `{
         ]
       }
     },
-    "/admin/users/{id}/ban": {
-      "post": {
-        "operationId": "ApiService_AdminBanUserPost",
+    "/admin/users/{id}/activity": {
+      "get": {
+        "operationId": "ApiService_AdminUserActivityGet",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/apiserviceAdminBanUserPostResponse"
+              "$ref": "#/definitions/apiserviceAdminUserActivityGetResponse"
             }
           },
           "default": {
@@ -677,6 +940,72 @@ var APISERVICE_JSON = `{
             "in": "query",
             "required": false,
             "type": "string"
+          },
+          {
+            "name": "limit",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "offset",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "ApiService"
+        ]
+      }
+    },
+    "/admin/users/{id}/ban": {
+      "post": {
+        "operationId": "ApiService_AdminBanUserPost",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/apiserviceAdminBanUserPostResponse"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/rpcStatus"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "id",
+            "in": "path",
+            "required": true,
+            "type": "string",
+            "pattern": "message"
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "properties": {
+                "UserId": {
+                  "type": "string"
+                },
+                "reason": {
+                  "type": "string"
+                },
+                "note": {
+                  "type": "string"
+                },
+                "expiresAt": {
+                  "type": "string",
+                  "format": "int64"
+                }
+              }
+            }
           }
         ],
         "tags": [
@@ -721,6 +1050,53 @@ var APISERVICE_JSON = `{
         ]
       }
     },
+    "/admin/users/{id}/impersonate": {
+      "post": {
+        "operationId": "ApiService_AdminUserImpersonatePost",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/apiserviceAdminUserImpersonatePostResponse"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/rpcStatus"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "id",
+            "in": "path",
+            "required": true,
+            "type": "string",
+            "pattern": "message"
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "properties": {
+                "UserId": {
+                  "type": "string"
+                },
+                "reason": {
+                  "type": "string"
+                }
+              }
+            }
+          }
+        ],
+        "tags": [
+          "ApiService"
+        ]
+      }
+    },
     "/admin/users/{id}/unban": {
       "post": {
         "operationId": "ApiService_AdminUsersUnbanPost",
@@ -758,15 +1134,15 @@ var APISERVICE_JSON = `{
         ]
       }
     },
-    "/auth/change-mail-and-pass": {
-      "put": {
-        "summary": "Auth OTP change mail and password",
-        "operationId": "ApiService_AuthChangeMailAndPassPut",
+    "/auth/activity": {
+      "get": {
+        "summary": "Auth Activity to list the security events of the account",
+        "operationId": "ApiService_AuthActivityGet",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/apiserviceAuthChangeMailAndPassPostResponse"
+              "$ref": "#/definitions/apiserviceAuthActivityGetResponse"
             }
           },
           "default": {
@@ -778,12 +1154,22 @@ var APISERVICE_JSON = `{
         },
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/apiserviceAuthChangeMailAndPassPostRequest"
-            }
+            "name": "UserId",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "limit",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "offset",
+            "in": "query",
+            "required": false,
+            "type": "string"
           }
         ],
         "tags": [
@@ -791,14 +1177,15 @@ var APISERVICE_JSON = `{
         ]
       }
     },
-    "/auth/check": {
+    "/auth/api-keys": {
       "get": {
-        "operationId": "ApiService_AuthCheckGet",
+        "summary": "Auth Api Keys to list the api keys of the user",
+        "operationId": "ApiService_AuthApiKeysGet",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/apiserviceAuthCheckGetResponse"
+              "$ref": "#/definitions/apiserviceAuthApiKeysGetResponse"
             }
           },
           "default": {
@@ -810,7 +1197,7 @@ var APISERVICE_JSON = `{
         },
         "parameters": [
           {
-            "name": "identifier",
+            "name": "UserId",
             "in": "query",
             "required": false,
             "type": "string"
@@ -819,17 +1206,15 @@ var APISERVICE_JSON = `{
         "tags": [
           "ApiService"
         ]
-      }
-    },
-    "/auth/credential": {
+      },
       "post": {
-        "summary": "Auth Credential for get credential infomation",
-        "operationId": "ApiService_AuthCredential",
+        "summary": "Auth Api Keys to create an api key, sent in the X-Api-Key header. The\nkey is only returned here.",
+        "operationId": "ApiService_AuthApiKeysPost",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/apiserviceAuthCredentialResponse"
+              "$ref": "#/definitions/apiserviceAuthApiKeysPostResponse"
             }
           },
           "default": {
@@ -845,7 +1230,7 @@ var APISERVICE_JSON = `{
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/apiserviceAuthCredentialRequest"
+              "$ref": "#/definitions/apiserviceAuthApiKeysPostRequest"
             }
           }
         ],
@@ -854,15 +1239,14 @@ var APISERVICE_JSON = `{
         ]
       }
     },
-    "/auth/forgot": {
+    "/auth/api-keys/{id}/revoke": {
       "post": {
-        "summary": "Forgot to send OTP for forgotting password",
-        "operationId": "ApiService_AuthForgotPost",
+        "operationId": "ApiService_AuthApiKeysRevokePost",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/apiserviceAuthForgotPostResponse"
+              "$ref": "#/definitions/apiserviceAuthApiKeysRevokePostResponse"
             }
           },
           "default": {
@@ -873,12 +1257,25 @@ var APISERVICE_JSON = `{
           }
         },
         "parameters": [
+          {
+            "name": "id",
+            "in": "path",
+            "required": true,
+            "type": "string",
+            "pattern": "message"
+          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/apiserviceAuthForgotPostRequest"
+              "type": "object",
+              "properties": {
+                "UserId": {
+                  "type": "string"
+                }
+              },
+              "title": "AuthApiKeysRevokePost(AuthApiKeysRevokePostRequest) returns (AuthApiKeysRevokePostResponse)"
             }
           }
         ],
@@ -887,15 +1284,15 @@ var APISERVICE_JSON = `{
         ]
       }
     },
-    "/auth/forgot/reset": {
-      "post": {
-        "summary": "Auth OTP Forgot to update new password when forgotting",
-        "operationId": "ApiService_AuthForgotReset",
+    "/auth/change-mail-and-pass": {
+      "put": {
+        "summary": "Auth OTP change mail and password",
+        "operationId": "ApiService_AuthChangeMailAndPassPut",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/apiserviceAuthForgotResetPostResponse"
+              "$ref": "#/definitions/apiserviceAuthChangeMailAndPassPostResponse"
             }
           },
           "default": {
@@ -911,7 +1308,7 @@ var APISERVICE_JSON = `{
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/apiserviceAuthForgotResetPostRequest"
+              "$ref": "#/definitions/apiserviceAuthChangeMailAndPassPostRequest"
             }
           }
         ],
@@ -920,14 +1317,14 @@ var APISERVICE_JSON = `{
         ]
       }
     },
-    "/auth/mail": {
-      "post": {
-        "operationId": "ApiService_AuthMailPost",
+    "/auth/check": {
+      "get": {
+        "operationId": "ApiService_AuthCheckGet",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/apiserviceAuthMailPostResponse"
+              "$ref": "#/definitions/apiserviceAuthCheckGetResponse"
             }
           },
           "default": {
@@ -939,12 +1336,10 @@ var APISERVICE_JSON = `{
         },
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/apiserviceAuthMailPostRequest"
-            }
+            "name": "identifier",
+            "in": "query",
+            "required": false,
+            "type": "string"
           }
         ],
         "tags": [
@@ -952,15 +1347,15 @@ var APISERVICE_JSON = `{
         ]
       }
     },
-    "/auth/otp": {
+    "/auth/credential": {
       "post": {
-        "summary": "Auth OTP to send OTP",
-        "operationId": "ApiService_AuthOTPPost",
+        "summary": "Auth Credential for get credential infomation",
+        "operationId": "ApiService_AuthCredential",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/apiserviceAuthOTPPostResponse"
+              "$ref": "#/definitions/apiserviceAuthCredentialResponse"
             }
           },
           "default": {
@@ -976,7 +1371,7 @@ var APISERVICE_JSON = `{
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/apiserviceAuthOTPPostRequest"
+              "$ref": "#/definitions/apiserviceAuthCredentialRequest"
             }
           }
         ],
@@ -985,15 +1380,15 @@ var APISERVICE_JSON = `{
         ]
       }
     },
-    "/auth/otp/resend": {
+    "/auth/delete": {
       "post": {
-        "summary": "Auth Resend OTP to resend OTP",
-        "operationId": "ApiService_AuthResendOTP",
+        "summary": "Auth Delete to request the deletion of the account, confirmed by otp",
+        "operationId": "ApiService_AuthDeletePost",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/apiserviceAuthResendOTPPostResponse"
+              "$ref": "#/definitions/apiserviceAuthDeletePostResponse"
             }
           },
           "default": {
@@ -1009,7 +1404,7 @@ var APISERVICE_JSON = `{
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/apiserviceAuthResendOTPPostRequest"
+              "$ref": "#/definitions/apiserviceAuthDeletePostRequest"
             }
           }
         ],
@@ -1018,15 +1413,15 @@ var APISERVICE_JSON = `{
         ]
       }
     },
-    "/auth/password": {
+    "/auth/forgot": {
       "post": {
-        "summary": "Auth Password to change password",
-        "operationId": "ApiService_AuthPasswordPost",
+        "summary": "Forgot to send OTP for forgotting password",
+        "operationId": "ApiService_AuthForgotPost",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/apiserviceAuthPasswordPostResponse"
+              "$ref": "#/definitions/apiserviceAuthForgotPostResponse"
             }
           },
           "default": {
@@ -1042,7 +1437,7 @@ var APISERVICE_JSON = `{
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/apiserviceAuthPasswordPostRequest"
+              "$ref": "#/definitions/apiserviceAuthForgotPostRequest"
             }
           }
         ],
@@ -1051,15 +1446,15 @@ var APISERVICE_JSON = `{
         ]
       }
     },
-    "/auth/ping": {
+    "/auth/forgot/reset": {
       "post": {
-        "summary": "Auth Ping to check whether is authorized",
-        "operationId": "ApiService_AuthPing",
+        "summary": "Auth OTP Forgot to update new password when forgotting",
+        "operationId": "ApiService_AuthForgotReset",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/apiserviceAuthPingResponse"
+              "$ref": "#/definitions/apiserviceAuthForgotResetPostResponse"
             }
           },
           "default": {
@@ -1071,22 +1466,12 @@ var APISERVICE_JSON = `{
         },
         "parameters": [
           {
-            "name": "UserId",
-            "in": "query",
-            "required": false,
-            "type": "string"
-          },
-          {
-            "name": "Role",
-            "in": "query",
-            "required": false,
-            "type": "string",
-            "enum": [
-              "CUSTOMER",
-              "HANDYMAN",
-              "ADMIN"
-            ],
-            "default": "CUSTOMER"
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/apiserviceAuthForgotResetPostRequest"
+            }
           }
         ],
         "tags": [
@@ -1094,14 +1479,15 @@ var APISERVICE_JSON = `{
         ]
       }
     },
-    "/businesses": {
-      "get": {
-        "operationId": "ApiService_BusinesssGet",
+    "/auth/logout-all": {
+      "post": {
+        "summary": "Auth Logout All to revoke every certificate issued so far",
+        "operationId": "ApiService_AuthLogoutAllPost",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/apiserviceBusinessesGetResponse"
+              "$ref": "#/definitions/apiserviceAuthLogoutAllPostResponse"
             }
           },
           "default": {
@@ -1113,66 +1499,27 @@ var APISERVICE_JSON = `{
         },
         "parameters": [
           {
-            "name": "categoryId",
-            "in": "query",
-            "required": false,
-            "type": "string"
-          },
-          {
-            "name": "zipcode",
-            "in": "query",
-            "required": false,
-            "type": "string"
-          },
-          {
-            "name": "offset",
-            "in": "query",
-            "required": false,
-            "type": "string"
-          },
-          {
-            "name": "limit",
-            "in": "query",
-            "required": false,
-            "type": "string"
-          },
-          {
-            "name": "mail",
-            "in": "query",
-            "required": false,
-            "type": "string"
-          },
-          {
-            "name": "phone",
-            "in": "query",
-            "required": false,
-            "type": "string"
-          },
-          {
-            "name": "query",
-            "in": "query",
-            "required": false,
-            "type": "string",
-            "enum": [
-              "DEFAULT",
-              "REQUEST",
-              "REVIEW"
-            ],
-            "default": "DEFAULT"
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/apiserviceAuthLogoutAllPostRequest"
+            }
           }
         ],
         "tags": [
           "ApiService"
         ]
-      },
+      }
+    },
+    "/auth/mail": {
       "post": {
-        "summary": "Business for create new handyman (business)",
-        "operationId": "ApiService_BusinessPost",
+        "operationId": "ApiService_AuthMailPost",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/apiserviceBusinessPostResponse"
+              "$ref": "#/definitions/apiserviceAuthMailPostResponse"
             }
           },
           "default": {
@@ -1188,7 +1535,7 @@ var APISERVICE_JSON = `{
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/apiserviceBusinessPostRequest"
+              "$ref": "#/definitions/apiserviceAuthMailPostRequest"
             }
           }
         ],
@@ -1197,14 +1544,15 @@ var APISERVICE_JSON = `{
         ]
       }
     },
-    "/businesses/buy-promote": {
+    "/auth/otp": {
       "post": {
-        "operationId": "ApiService_BusinessBuyAdvertisePost",
+        "summary": "Auth OTP to send OTP",
+        "operationId": "ApiService_AuthOTPPost",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/apiserviceBusinessBuyAdvertisePostResponse"
+              "$ref": "#/definitions/apiserviceAuthOTPPostResponse"
             }
           },
           "default": {
@@ -1220,7 +1568,7 @@ var APISERVICE_JSON = `{
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/apiserviceBusinessBuyAdvertisePostRequest"
+              "$ref": "#/definitions/apiserviceAuthOTPPostRequest"
             }
           }
         ],
@@ -1229,14 +1577,15 @@ var APISERVICE_JSON = `{
         ]
       }
     },
-    "/businesses/buy-promote/setup": {
+    "/auth/otp/resend": {
       "post": {
-        "operationId": "ApiService_BusinessBuyAdvertiseSetupPost",
+        "summary": "Auth Resend OTP to resend OTP",
+        "operationId": "ApiService_AuthResendOTP",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/apiserviceBusinessBuyAdvertiseSetupPostResponse"
+              "$ref": "#/definitions/apiserviceAuthResendOTPPostResponse"
             }
           },
           "default": {
@@ -1252,7 +1601,7 @@ var APISERVICE_JSON = `{
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/apiserviceBusinessBuyAdvertiseSetupPostRequest"
+              "$ref": "#/definitions/apiserviceAuthResendOTPPostRequest"
             }
           }
         ],
@@ -1261,14 +1610,15 @@ var APISERVICE_JSON = `{
         ]
       }
     },
-    "/businesses/buy-promote/validate": {
+    "/auth/password": {
       "post": {
-        "operationId": "ApiService_BusinessBuyAdvertiseValidatePost",
+        "summary": "Auth Password to change password",
+        "operationId": "ApiService_AuthPasswordPost",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/apiserviceBusinessValidateBuyAdvertisePostResponse"
+              "$ref": "#/definitions/apiserviceAuthPasswordPostResponse"
             }
           },
           "default": {
@@ -1284,7 +1634,7 @@ var APISERVICE_JSON = `{
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/apiserviceBusinessValidateBuyAdvertisePostRequest"
+              "$ref": "#/definitions/apiserviceAuthPasswordPostRequest"
             }
           }
         ],
@@ -1293,14 +1643,14 @@ var APISERVICE_JSON = `{
         ]
       }
     },
-    "/businesses/interest": {
-      "get": {
-        "operationId": "ApiService_BusinessInterestGet",
+    "/auth/phone": {
+      "post": {
+        "operationId": "ApiService_AuthPhonePost",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/apiserviceBusinessInterestGetResponse"
+              "$ref": "#/definitions/apiserviceAuthPhonePostResponse"
             }
           },
           "default": {
@@ -1310,19 +1660,30 @@ var APISERVICE_JSON = `{
             }
           }
         },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/apiserviceAuthPhonePostRequest"
+            }
+          }
+        ],
         "tags": [
           "ApiService"
         ]
       }
     },
-    "/businesses/invitation-code": {
-      "get": {
-        "operationId": "ApiService_BusinessGetInvitationCode",
+    "/auth/ping": {
+      "post": {
+        "summary": "Auth Ping to check whether is authorized",
+        "operationId": "ApiService_AuthPing",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/apiserviceBusinessInvitationCodeGetResponse"
+              "$ref": "#/definitions/apiserviceAuthPingResponse"
             }
           },
           "default": {
@@ -1332,19 +1693,53 @@ var APISERVICE_JSON = `{
             }
           }
         },
+        "parameters": [
+          {
+            "name": "UserId",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "Role",
+            "in": "query",
+            "required": false,
+            "type": "string",
+            "enum": [
+              "CUSTOMER",
+              "HANDYMAN",
+              "ADMIN",
+              "SUPPORT",
+              "FINANCE",
+              "MODERATOR"
+            ],
+            "default": "CUSTOMER"
+          },
+          {
+            "name": "Permissions",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
+          }
+        ],
         "tags": [
           "ApiService"
         ]
       }
     },
-    "/businesses/near": {
-      "get": {
-        "operationId": "ApiService_BusinessNearGet",
+    "/auth/token": {
+      "post": {
+        "summary": "Auth Token to exchange a signed certificate for an access token",
+        "operationId": "ApiService_AuthTokenPost",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/apiserviceBusinessNearGetResponse"
+              "$ref": "#/definitions/apiserviceAuthTokenPostResponse"
             }
           },
           "default": {
@@ -1356,10 +1751,12 @@ var APISERVICE_JSON = `{
         },
         "parameters": [
           {
-            "name": "UserId",
-            "in": "query",
-            "required": false,
-            "type": "string"
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/apiserviceAuthTokenPostRequest"
+            }
           }
         ],
         "tags": [
@@ -1367,14 +1764,15 @@ var APISERVICE_JSON = `{
         ]
       }
     },
-    "/businesses/payment-method": {
-      "get": {
-        "operationId": "ApiService_BusinessPaymentMethodGet",
+    "/auth/token/refresh": {
+      "post": {
+        "summary": "Auth Token Refresh to rotate a refresh token for a new access token",
+        "operationId": "ApiService_AuthTokenRefreshPost",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/apiserviceBusinessPaymentMethodGetResponse"
+              "$ref": "#/definitions/apiserviceAuthTokenRefreshPostResponse"
             }
           },
           "default": {
@@ -1386,23 +1784,28 @@ var APISERVICE_JSON = `{
         },
         "parameters": [
           {
-            "name": "UserId",
-            "in": "query",
-            "required": false,
-            "type": "string"
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/apiserviceAuthTokenRefreshPostRequest"
+            }
           }
         ],
         "tags": [
           "ApiService"
         ]
-      },
+      }
+    },
+    "/auth/totp/disable": {
       "post": {
-        "operationId": "ApiService_BusinessPaymentMethodPost",
+        "summary": "Auth TOTP Disable to turn TOTP off",
+        "operationId": "ApiService_AuthTotpDisablePost",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/apiserviceBusinessPaymentMethodPostResponse"
+              "$ref": "#/definitions/apiserviceAuthTotpDisablePostResponse"
             }
           },
           "default": {
@@ -1418,7 +1821,7 @@ var APISERVICE_JSON = `{
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/apiserviceBusinessPaymentMethodPostRequest"
+              "$ref": "#/definitions/apiserviceAuthTotpDisablePostRequest"
             }
           }
         ],
@@ -1427,14 +1830,15 @@ var APISERVICE_JSON = `{
         ]
       }
     },
-    "/businesses/payment-method/delete": {
+    "/auth/totp/enroll": {
       "post": {
-        "operationId": "ApiService_BusinessPaymentMethodDeletePost",
+        "summary": "Auth TOTP Enroll to generate a TOTP secret",
+        "operationId": "ApiService_AuthTotpEnrollPost",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/apiserviceBusinessPaymentMethodDeletePostResponse"
+              "$ref": "#/definitions/apiserviceAuthTotpEnrollPostResponse"
             }
           },
           "default": {
@@ -1450,7 +1854,7 @@ var APISERVICE_JSON = `{
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/apiserviceBusinessPaymentMethodDeletePostRequest"
+              "$ref": "#/definitions/apiserviceAuthTotpEnrollPostRequest"
             }
           }
         ],
@@ -1459,14 +1863,15 @@ var APISERVICE_JSON = `{
         ]
       }
     },
-    "/businesses/payment-method/setup": {
+    "/auth/totp/verify": {
       "post": {
-        "operationId": "ApiService_BusinessPaymentMethodSetupPost",
+        "summary": "Auth TOTP Verify to enable TOTP and get recovery codes",
+        "operationId": "ApiService_AuthTotpVerifyPost",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/apiserviceBusinessPaymentMethodSetupPostResponse"
+              "$ref": "#/definitions/apiserviceAuthTotpVerifyPostResponse"
             }
           },
           "default": {
@@ -1482,7 +1887,7 @@ var APISERVICE_JSON = `{
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/apiserviceBusinessPaymentMethodSetupPostRequest"
+              "$ref": "#/definitions/apiserviceAuthTotpVerifyPostRequest"
             }
           }
         ],
@@ -1491,14 +1896,14 @@ var APISERVICE_JSON = `{
         ]
       }
     },
-    "/businesses/payment-summary": {
+    "/businesses": {
       "get": {
-        "operationId": "ApiService_BusinessPaymentSummaryGet",
+        "operationId": "ApiService_BusinesssGet",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/apiserviceBusinessTransactionsGetResponse"
+              "$ref": "#/definitions/apiserviceBusinessesGetResponse"
             }
           },
           "default": {
@@ -1510,28 +1915,13 @@ var APISERVICE_JSON = `{
         },
         "parameters": [
           {
-            "name": "UserId",
+            "name": "categoryId",
             "in": "query",
             "required": false,
             "type": "string"
           },
           {
-            "name": "query",
-            "in": "query",
-            "required": false,
-            "type": "string",
-            "enum": [
-              "THIS_WEEK",
-              "LAST_1_WEEK",
-              "LAST_2_WEEKS",
-              "LAST_3_WEEKS",
-              "LAST_MONTH",
-              "ALL_TIME"
-            ],
-            "default": "THIS_WEEK"
-          },
-          {
-            "name": "limit",
+            "name": "zipcode",
             "in": "query",
             "required": false,
             "type": "string"
@@ -1541,78 +1931,50 @@ var APISERVICE_JSON = `{
             "in": "query",
             "required": false,
             "type": "string"
-          }
-        ],
-        "tags": [
-          "ApiService"
-        ]
-      }
-    },
-    "/businesses/payment-summary-export": {
-      "get": {
-        "operationId": "ApiService_BusinessPaymentSummaryExportGet",
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/apiserviceBusinessTransactionsGetResponse"
-            }
           },
-          "default": {
-            "description": "An unexpected error response.",
-            "schema": {
-              "$ref": "#/definitions/rpcStatus"
-            }
-          }
-        },
-        "parameters": [
           {
-            "name": "UserId",
+            "name": "limit",
             "in": "query",
             "required": false,
             "type": "string"
           },
           {
-            "name": "query",
+            "name": "mail",
             "in": "query",
             "required": false,
-            "type": "string",
-            "enum": [
-              "THIS_WEEK",
-              "LAST_1_WEEK",
-              "LAST_2_WEEKS",
-              "LAST_3_WEEKS",
-              "LAST_MONTH",
-              "ALL_TIME"
-            ],
-            "default": "THIS_WEEK"
+            "type": "string"
           },
           {
-            "name": "limit",
+            "name": "phone",
             "in": "query",
             "required": false,
             "type": "string"
           },
           {
-            "name": "offset",
+            "name": "query",
             "in": "query",
             "required": false,
-            "type": "string"
+            "type": "string",
+            "enum": [
+              "DEFAULT",
+              "REQUEST",
+              "REVIEW"
+            ],
+            "default": "DEFAULT"
           }
         ],
         "tags": [
           "ApiService"
         ]
-      }
-    },
-    "/businesses/promote": {
-      "get": {
-        "operationId": "ApiService_BusinessAdvertiseGet",
+      },
+      "post": {
+        "summary": "Business for create new handyman (business)",
+        "operationId": "ApiService_BusinessPost",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/apiserviceAdvertiseGetResponse"
+              "$ref": "#/definitions/apiserviceBusinessPostResponse"
             }
           },
           "default": {
@@ -1624,22 +1986,12 @@ var APISERVICE_JSON = `{
         },
         "parameters": [
           {
-            "name": "UserId",
-            "in": "query",
-            "required": false,
-            "type": "string"
-          },
-          {
-            "name": "limit",
-            "in": "query",
-            "required": false,
-            "type": "string"
-          },
-          {
-            "name": "offset",
-            "in": "query",
-            "required": false,
-            "type": "string"
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/apiserviceBusinessPostRequest"
+            }
           }
         ],
         "tags": [
@@ -1647,14 +1999,14 @@ var APISERVICE_JSON = `{
         ]
       }
     },
-    "/businesses/promote/order": {
-      "get": {
-        "operationId": "ApiService_BusinessAdvertiseTransactionGet",
+    "/businesses/buy-promote": {
+      "post": {
+        "operationId": "ApiService_BusinessBuyAdvertisePost",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/apiserviceBusinessAdvertiseOrderGetResponse"
+              "$ref": "#/definitions/apiserviceBusinessBuyAdvertisePostResponse"
             }
           },
           "default": {
@@ -1666,22 +2018,12 @@ var APISERVICE_JSON = `{
         },
         "parameters": [
           {
-            "name": "UserId",
-            "in": "query",
-            "required": false,
-            "type": "string"
-          },
-          {
-            "name": "limit",
-            "in": "query",
-            "required": false,
-            "type": "string"
-          },
-          {
-            "name": "offset",
-            "in": "query",
-            "required": false,
-            "type": "string"
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/apiserviceBusinessBuyAdvertisePostRequest"
+            }
           }
         ],
         "tags": [
@@ -1689,14 +2031,14 @@ var APISERVICE_JSON = `{
         ]
       }
     },
-    "/businesses/promote/{id}": {
-      "get": {
-        "operationId": "ApiService_BusinessAdvertiseDetailGet",
+    "/businesses/buy-promote/setup": {
+      "post": {
+        "operationId": "ApiService_BusinessBuyAdvertiseSetupPost",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/apiserviceAdvertiseDetailGetResponse"
+              "$ref": "#/definitions/apiserviceBusinessBuyAdvertiseSetupPostResponse"
             }
           },
           "default": {
@@ -1708,29 +2050,12 @@ var APISERVICE_JSON = `{
         },
         "parameters": [
           {
-            "name": "id",
-            "in": "path",
+            "name": "body",
+            "in": "body",
             "required": true,
-            "type": "string",
-            "pattern": "message"
-          },
-          {
-            "name": "UserId",
-            "in": "query",
-            "required": false,
-            "type": "string"
-          },
-          {
-            "name": "limit",
-            "in": "query",
-            "required": false,
-            "type": "string"
-          },
-          {
-            "name": "offset",
-            "in": "query",
-            "required": false,
-            "type": "string"
+            "schema": {
+              "$ref": "#/definitions/apiserviceBusinessBuyAdvertiseSetupPostRequest"
+            }
           }
         ],
         "tags": [
@@ -1738,15 +2063,14 @@ var APISERVICE_JSON = `{
         ]
       }
     },
-    "/businesses/{id}": {
-      "get": {
-        "summary": "Business to get business by id",
-        "operationId": "ApiService_BusinessGet",
+    "/businesses/buy-promote/validate": {
+      "post": {
+        "operationId": "ApiService_BusinessBuyAdvertiseValidatePost",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/apiserviceBusinessGetResponse"
+              "$ref": "#/definitions/apiserviceBusinessValidateBuyAdvertisePostResponse"
             }
           },
           "default": {
@@ -1758,31 +2082,27 @@ var APISERVICE_JSON = `{
         },
         "parameters": [
           {
-            "name": "id",
-            "in": "path",
+            "name": "body",
+            "in": "body",
             "required": true,
-            "type": "string",
-            "pattern": "message"
-          },
-          {
-            "name": "UserId",
-            "in": "query",
-            "required": false,
-            "type": "string"
+            "schema": {
+              "$ref": "#/definitions/apiserviceBusinessValidateBuyAdvertisePostRequest"
+            }
           }
         ],
         "tags": [
           "ApiService"
         ]
-      },
-      "put": {
-        "summary": "Business to get business by id",
-        "operationId": "ApiService_BusinessPut",
+      }
+    },
+    "/businesses/interest": {
+      "get": {
+        "operationId": "ApiService_BusinessInterestGet",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/apiserviceBusinessPutResponse"
+              "$ref": "#/definitions/apiserviceBusinessInterestGetResponse"
             }
           },
           "default": {
@@ -1792,65 +2112,41 @@ var APISERVICE_JSON = `{
             }
           }
         },
-        "parameters": [
-          {
-            "name": "id",
-            "in": "path",
-            "required": true,
-            "type": "string",
-            "pattern": "message"
+        "tags": [
+          "ApiService"
+        ]
+      }
+    },
+    "/businesses/invitation-code": {
+      "get": {
+        "operationId": "ApiService_BusinessGetInvitationCode",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/apiserviceBusinessInvitationCodeGetResponse"
+            }
           },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
+          "default": {
+            "description": "An unexpected error response.",
             "schema": {
-              "type": "object",
-              "properties": {
-                "UserId": {
-                  "type": "string"
-                },
-                "name": {
-                  "type": "string"
-                },
-                "phone": {
-                  "type": "string"
-                },
-                "logoUrl": {
-                  "type": "string"
-                },
-                "bannerUrl": {
-                  "type": "string"
-                },
-                "website": {
-                  "type": "string"
-                },
-                "description": {
-                  "type": "string"
-                },
-                "zipcodes": {
-                  "type": "array",
-                  "items": {
-                    "type": "string"
-                  }
-                }
-              }
+              "$ref": "#/definitions/rpcStatus"
             }
           }
-        ],
+        },
         "tags": [
           "ApiService"
         ]
       }
     },
-    "/businesses/{id}/feedbacks": {
+    "/businesses/members": {
       "get": {
-        "operationId": "ApiService_BusinessFeedbacksGet",
+        "operationId": "ApiService_BusinessMembersGet",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/apiserviceBusinessFeedbacksGetResponse"
+              "$ref": "#/definitions/apiserviceBusinessMembersGetResponse"
             }
           },
           "default": {
@@ -1862,26 +2158,7 @@ var APISERVICE_JSON = `{
         },
         "parameters": [
           {
-            "name": "id",
-            "in": "path",
-            "required": true,
-            "type": "string",
-            "pattern": "message"
-          },
-          {
-            "name": "offset",
-            "in": "query",
-            "required": false,
-            "type": "string"
-          },
-          {
-            "name": "limit",
-            "in": "query",
-            "required": false,
-            "type": "string"
-          },
-          {
-            "name": "rate",
+            "name": "UserId",
             "in": "query",
             "required": false,
             "type": "string"
@@ -1892,14 +2169,15 @@ var APISERVICE_JSON = `{
         ]
       }
     },
-    "/businesses/{id}/free-contact": {
-      "get": {
-        "operationId": "ApiService_BusinessFreeContactGet",
+    "/businesses/members/accept": {
+      "post": {
+        "summary": "Accepting an invitation only changes new tokens, so the client should\nrefresh its access token afterwards.",
+        "operationId": "ApiService_BusinessMembersAcceptPost",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/apiserviceBusinessFreeContactGetResponse"
+              "$ref": "#/definitions/apiserviceBusinessMembersAcceptPostResponse"
             }
           },
           "default": {
@@ -1911,11 +2189,12 @@ var APISERVICE_JSON = `{
         },
         "parameters": [
           {
-            "name": "id",
-            "in": "path",
+            "name": "body",
+            "in": "body",
             "required": true,
-            "type": "string",
-            "pattern": "message"
+            "schema": {
+              "$ref": "#/definitions/apiserviceBusinessMembersAcceptPostRequest"
+            }
           }
         ],
         "tags": [
@@ -1923,14 +2202,14 @@ var APISERVICE_JSON = `{
         ]
       }
     },
-    "/businesses/{id}/rating": {
-      "get": {
-        "operationId": "ApiService_BusinessRatingGet",
+    "/businesses/members/invite": {
+      "post": {
+        "operationId": "ApiService_BusinessMembersInvitePost",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/apiserviceBusinessRatingGetResponse"
+              "$ref": "#/definitions/apiserviceBusinessMembersInvitePostResponse"
             }
           },
           "default": {
@@ -1942,11 +2221,12 @@ var APISERVICE_JSON = `{
         },
         "parameters": [
           {
-            "name": "id",
-            "in": "path",
+            "name": "body",
+            "in": "body",
             "required": true,
-            "type": "string",
-            "pattern": "message"
+            "schema": {
+              "$ref": "#/definitions/apiserviceBusinessMembersInvitePostRequest"
+            }
           }
         ],
         "tags": [
@@ -1954,14 +2234,14 @@ var APISERVICE_JSON = `{
         ]
       }
     },
-    "/businesses/{id}/services": {
-      "get": {
-        "operationId": "ApiService_BusinessServiceGet",
+    "/businesses/members/{id}/delete": {
+      "post": {
+        "operationId": "ApiService_BusinessMembersDeletePost",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/apiserviceBusinessServiceGetResponse"
+              "$ref": "#/definitions/apiserviceBusinessMembersDeletePostResponse"
             }
           },
           "default": {
@@ -1978,19 +2258,34 @@ var APISERVICE_JSON = `{
             "required": true,
             "type": "string",
             "pattern": "message"
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "properties": {
+                "UserId": {
+                  "type": "string"
+                }
+              }
+            }
           }
         ],
         "tags": [
           "ApiService"
         ]
-      },
-      "put": {
-        "operationId": "ApiService_BusinessServicesPut",
+      }
+    },
+    "/businesses/near": {
+      "get": {
+        "operationId": "ApiService_BusinessNearGet",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/apiserviceBusinessServicesPutResponse"
+              "$ref": "#/definitions/apiserviceBusinessNearGetResponse"
             }
           },
           "default": {
@@ -2001,23 +2296,6 @@ var APISERVICE_JSON = `{
           }
         },
         "parameters": [
-          {
-            "name": "id",
-            "in": "path",
-            "required": true,
-            "type": "string",
-            "pattern": "message"
-          },
-          {
-            "name": "categoryIds",
-            "in": "query",
-            "required": false,
-            "type": "array",
-            "items": {
-              "type": "string"
-            },
-            "collectionFormat": "multi"
-          },
           {
             "name": "UserId",
             "in": "query",
@@ -2030,14 +2308,14 @@ var APISERVICE_JSON = `{
         ]
       }
     },
-    "/businesses/{id}/verify-refcode": {
-      "put": {
-        "operationId": "ApiService_BusinessVerifyRefCode",
+    "/businesses/payment-method": {
+      "get": {
+        "operationId": "ApiService_BusinessPaymentMethodGet",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/apiserviceBusinessVerifyRefCodePutResponse"
+              "$ref": "#/definitions/apiserviceBusinessPaymentMethodGetResponse"
             }
           },
           "default": {
@@ -2049,39 +2327,23 @@ var APISERVICE_JSON = `{
         },
         "parameters": [
           {
-            "name": "id",
-            "in": "path",
-            "required": true,
-            "type": "string",
-            "pattern": "message"
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "type": "object",
-              "properties": {
-                "status": {
-                  "type": "boolean"
-                }
-              }
-            }
+            "name": "UserId",
+            "in": "query",
+            "required": false,
+            "type": "string"
           }
         ],
         "tags": [
           "ApiService"
         ]
-      }
-    },
-    "/categories": {
-      "get": {
-        "operationId": "ApiService_CategoriesGet",
+      },
+      "post": {
+        "operationId": "ApiService_BusinessPaymentMethodPost",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/apiserviceCategoriesGetResponse"
+              "$ref": "#/definitions/apiserviceBusinessPaymentMethodPostResponse"
             }
           },
           "default": {
@@ -2093,34 +2355,12 @@ var APISERVICE_JSON = `{
         },
         "parameters": [
           {
-            "name": "query",
-            "in": "query",
-            "required": false,
-            "type": "string",
-            "enum": [
-              "NO",
-              "ADVERTISE",
-              "GROUP"
-            ],
-            "default": "NO"
-          },
-          {
-            "name": "limit",
-            "in": "query",
-            "required": false,
-            "type": "string"
-          },
-          {
-            "name": "offset",
-            "in": "query",
-            "required": false,
-            "type": "string"
-          },
-          {
-            "name": "name",
-            "in": "query",
-            "required": false,
-            "type": "string"
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/apiserviceBusinessPaymentMethodPostRequest"
+            }
           }
         ],
         "tags": [
@@ -2128,14 +2368,14 @@ var APISERVICE_JSON = `{
         ]
       }
     },
-    "/categories/{id}": {
-      "get": {
-        "operationId": "ApiService_CategoryGet",
+    "/businesses/payment-method/delete": {
+      "post": {
+        "operationId": "ApiService_BusinessPaymentMethodDeletePost",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/apiserviceCategoryGetResponse"
+              "$ref": "#/definitions/apiserviceBusinessPaymentMethodDeletePostResponse"
             }
           },
           "default": {
@@ -2147,11 +2387,12 @@ var APISERVICE_JSON = `{
         },
         "parameters": [
           {
-            "name": "id",
-            "in": "path",
+            "name": "body",
+            "in": "body",
             "required": true,
-            "type": "string",
-            "pattern": "message"
+            "schema": {
+              "$ref": "#/definitions/apiserviceBusinessPaymentMethodDeletePostRequest"
+            }
           }
         ],
         "tags": [
@@ -2159,14 +2400,14 @@ var APISERVICE_JSON = `{
         ]
       }
     },
-    "/chatservice/conversations": {
+    "/businesses/payment-method/setup": {
       "post": {
-        "operationId": "ApiService_ChatserviceConversationsPost",
+        "operationId": "ApiService_BusinessPaymentMethodSetupPost",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/apiserviceConversationPostResponse"
+              "$ref": "#/definitions/apiserviceBusinessPaymentMethodSetupPostResponse"
             }
           },
           "default": {
@@ -2182,7 +2423,7 @@ var APISERVICE_JSON = `{
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/apiserviceConversationPostRequest"
+              "$ref": "#/definitions/apiserviceBusinessPaymentMethodSetupPostRequest"
             }
           }
         ],
@@ -2191,14 +2432,14 @@ var APISERVICE_JSON = `{
         ]
       }
     },
-    "/contacts/states": {
+    "/businesses/payment-summary": {
       "get": {
-        "operationId": "ApiService_StatesGet",
+        "operationId": "ApiService_BusinessPaymentSummaryGet",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/apiserviceStatesGetResponse"
+              "$ref": "#/definitions/apiserviceBusinessTransactionsGetResponse"
             }
           },
           "default": {
@@ -2208,20 +2449,54 @@ var APISERVICE_JSON = `{
             }
           }
         },
+        "parameters": [
+          {
+            "name": "UserId",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "query",
+            "in": "query",
+            "required": false,
+            "type": "string",
+            "enum": [
+              "THIS_WEEK",
+              "LAST_1_WEEK",
+              "LAST_2_WEEKS",
+              "LAST_3_WEEKS",
+              "LAST_MONTH",
+              "ALL_TIME"
+            ],
+            "default": "THIS_WEEK"
+          },
+          {
+            "name": "limit",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "offset",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          }
+        ],
         "tags": [
           "ApiService"
         ]
       }
     },
-    "/contacts/{id}": {
+    "/businesses/payment-summary-export": {
       "get": {
-        "summary": "Business to get business by id",
-        "operationId": "ApiService_ContactGet",
+        "operationId": "ApiService_BusinessPaymentSummaryExportGet",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/apiserviceContactGetResponse"
+              "$ref": "#/definitions/apiserviceBusinessTransactionsGetResponse"
             }
           },
           "default": {
@@ -2233,14 +2508,34 @@ var APISERVICE_JSON = `{
         },
         "parameters": [
           {
-            "name": "id",
-            "in": "path",
-            "required": true,
+            "name": "UserId",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "query",
+            "in": "query",
+            "required": false,
             "type": "string",
-            "pattern": "message"
+            "enum": [
+              "THIS_WEEK",
+              "LAST_1_WEEK",
+              "LAST_2_WEEKS",
+              "LAST_3_WEEKS",
+              "LAST_MONTH",
+              "ALL_TIME"
+            ],
+            "default": "THIS_WEEK"
           },
           {
-            "name": "UserId",
+            "name": "limit",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "offset",
             "in": "query",
             "required": false,
             "type": "string"
@@ -2249,15 +2544,16 @@ var APISERVICE_JSON = `{
         "tags": [
           "ApiService"
         ]
-      },
-      "put": {
-        "summary": "Business to get business by id",
-        "operationId": "ApiService_ContactPut",
+      }
+    },
+    "/businesses/promote": {
+      "get": {
+        "operationId": "ApiService_BusinessAdvertiseGet",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/apiserviceContactPutResponse"
+              "$ref": "#/definitions/apiserviceAdvertiseGetResponse"
             }
           },
           "default": {
@@ -2269,39 +2565,22 @@ var APISERVICE_JSON = `{
         },
         "parameters": [
           {
-            "name": "id",
-            "in": "path",
-            "required": true,
-            "type": "string",
-            "pattern": "message"
+            "name": "UserId",
+            "in": "query",
+            "required": false,
+            "type": "string"
           },
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "type": "object",
-              "properties": {
-                "UserId": {
-                  "type": "string"
-                },
-                "zipcode": {
-                  "type": "string"
-                },
-                "address1": {
-                  "type": "string"
-                },
-                "address2": {
-                  "type": "string"
-                },
-                "stateId": {
-                  "type": "string"
-                },
-                "city": {
-                  "type": "string"
-                }
-              }
-            }
+            "name": "limit",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "offset",
+            "in": "query",
+            "required": false,
+            "type": "string"
           }
         ],
         "tags": [
@@ -2309,14 +2588,14 @@ var APISERVICE_JSON = `{
         ]
       }
     },
-    "/feedbacks": {
+    "/businesses/promote/order": {
       "get": {
-        "operationId": "ApiService_FeedbacksGet",
+        "operationId": "ApiService_BusinessAdvertiseTransactionGet",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/apiserviceFeedbackGetResponse"
+              "$ref": "#/definitions/apiserviceBusinessAdvertiseOrderGetResponse"
             }
           },
           "default": {
@@ -2334,7 +2613,13 @@ var APISERVICE_JSON = `{
             "type": "string"
           },
           {
-            "name": "orderId",
+            "name": "limit",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "offset",
             "in": "query",
             "required": false,
             "type": "string"
@@ -2343,14 +2628,16 @@ var APISERVICE_JSON = `{
         "tags": [
           "ApiService"
         ]
-      },
-      "post": {
-        "operationId": "ApiService_FeedbacksPost",
+      }
+    },
+    "/businesses/promote/{id}": {
+      "get": {
+        "operationId": "ApiService_BusinessAdvertiseDetailGet",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/apiserviceFeedbacksPostResponse"
+              "$ref": "#/definitions/apiserviceAdvertiseDetailGetResponse"
             }
           },
           "default": {
@@ -2362,12 +2649,29 @@ var APISERVICE_JSON = `{
         },
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
+            "name": "id",
+            "in": "path",
             "required": true,
-            "schema": {
-              "$ref": "#/definitions/apiserviceFeedbacksPostRequest"
-            }
+            "type": "string",
+            "pattern": "message"
+          },
+          {
+            "name": "UserId",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "limit",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "offset",
+            "in": "query",
+            "required": false,
+            "type": "string"
           }
         ],
         "tags": [
@@ -2375,14 +2679,51 @@ var APISERVICE_JSON = `{
         ]
       }
     },
-    "/feedbacks/{id}": {
+    "/businesses/{id}": {
+      "get": {
+        "summary": "Business to get business by id",
+        "operationId": "ApiService_BusinessGet",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/apiserviceBusinessGetResponse"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/rpcStatus"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "id",
+            "in": "path",
+            "required": true,
+            "type": "string",
+            "pattern": "message"
+          },
+          {
+            "name": "UserId",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "ApiService"
+        ]
+      },
       "put": {
-        "operationId": "ApiService_FeedbacksPut",
+        "summary": "Business to get business by id",
+        "operationId": "ApiService_BusinessPut",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/apiserviceFeedbackPutResponse"
+              "$ref": "#/definitions/apiserviceBusinessPutResponse"
             }
           },
           "default": {
@@ -2410,12 +2751,29 @@ var APISERVICE_JSON = `{
                 "UserId": {
                   "type": "string"
                 },
-                "rate": {
-                  "type": "number",
-                  "format": "float"
+                "name": {
+                  "type": "string"
                 },
-                "comment": {
+                "phone": {
+                  "type": "string"
+                },
+                "logoUrl": {
+                  "type": "string"
+                },
+                "bannerUrl": {
+                  "type": "string"
+                },
+                "website": {
                   "type": "string"
+                },
+                "description": {
+                  "type": "string"
+                },
+                "zipcodes": {
+                  "type": "array",
+                  "items": {
+                    "type": "string"
+                  }
                 }
               }
             }
@@ -2426,14 +2784,14 @@ var APISERVICE_JSON = `{
         ]
       }
     },
-    "/orders": {
+    "/businesses/{id}/feedbacks": {
       "get": {
-        "operationId": "ApiService_OrdersGet",
+        "operationId": "ApiService_BusinessFeedbacksGet",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/apiserviceOrdersGetResponse"
+              "$ref": "#/definitions/apiserviceBusinessFeedbacksGetResponse"
             }
           },
           "default": {
@@ -2445,24 +2803,11 @@ var APISERVICE_JSON = `{
         },
         "parameters": [
           {
-            "name": "UserId",
-            "in": "query",
-            "required": false,
-            "type": "string"
-          },
-          {
-            "name": "status",
-            "in": "query",
-            "required": false,
+            "name": "id",
+            "in": "path",
+            "required": true,
             "type": "string",
-            "enum": [
-              "PENDING",
-              "CONNECTED",
-              "REJECTED",
-              "CANCELED",
-              "COMPLETED"
-            ],
-            "default": "PENDING"
+            "pattern": "message"
           },
           {
             "name": "offset",
@@ -2477,13 +2822,7 @@ var APISERVICE_JSON = `{
             "type": "string"
           },
           {
-            "name": "serviceId",
-            "in": "query",
-            "required": false,
-            "type": "string"
-          },
-          {
-            "name": "zipcode",
+            "name": "rate",
             "in": "query",
             "required": false,
             "type": "string"
@@ -2492,14 +2831,16 @@ var APISERVICE_JSON = `{
         "tags": [
           "ApiService"
         ]
-      },
-      "post": {
-        "operationId": "ApiService_OrdersPost",
+      }
+    },
+    "/businesses/{id}/free-contact": {
+      "get": {
+        "operationId": "ApiService_BusinessFreeContactGet",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/apiserviceOrdersPostResponse"
+              "$ref": "#/definitions/apiserviceBusinessFreeContactGetResponse"
             }
           },
           "default": {
@@ -2511,12 +2852,11 @@ var APISERVICE_JSON = `{
         },
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
+            "name": "id",
+            "in": "path",
             "required": true,
-            "schema": {
-              "$ref": "#/definitions/apiserviceOrdersPostRequest"
-            }
+            "type": "string",
+            "pattern": "message"
           }
         ],
         "tags": [
@@ -2524,14 +2864,14 @@ var APISERVICE_JSON = `{
         ]
       }
     },
-    "/orders/already-ordered": {
+    "/businesses/{id}/rating": {
       "get": {
-        "operationId": "ApiService_BusinessAlreadyOrderedGet",
+        "operationId": "ApiService_BusinessRatingGet",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/apiserviceBusinessesAlreadyOrderedGetResponse"
+              "$ref": "#/definitions/apiserviceBusinessRatingGetResponse"
             }
           },
           "default": {
@@ -2543,22 +2883,11 @@ var APISERVICE_JSON = `{
         },
         "parameters": [
           {
-            "name": "UserId",
-            "in": "query",
-            "required": false,
-            "type": "string"
-          },
-          {
-            "name": "zipcode",
-            "in": "query",
-            "required": false,
-            "type": "string"
-          },
-          {
-            "name": "categoryId",
-            "in": "query",
-            "required": false,
-            "type": "string"
+            "name": "id",
+            "in": "path",
+            "required": true,
+            "type": "string",
+            "pattern": "message"
           }
         ],
         "tags": [
@@ -2566,14 +2895,14 @@ var APISERVICE_JSON = `{
         ]
       }
     },
-    "/orders/cancel": {
-      "post": {
-        "operationId": "ApiService_UpdateOrderCancelPost",
+    "/businesses/{id}/services": {
+      "get": {
+        "operationId": "ApiService_BusinessServiceGet",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/apiserviceUpdateOrderStatusPostResponse"
+              "$ref": "#/definitions/apiserviceBusinessServiceGetResponse"
             }
           },
           "default": {
@@ -2585,27 +2914,24 @@ var APISERVICE_JSON = `{
         },
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
+            "name": "id",
+            "in": "path",
             "required": true,
-            "schema": {
-              "$ref": "#/definitions/apiserviceUpdateOrderStatusPostRequest"
-            }
+            "type": "string",
+            "pattern": "message"
           }
         ],
         "tags": [
           "ApiService"
         ]
-      }
-    },
-    "/orders/complete": {
-      "post": {
-        "operationId": "ApiService_UpdateOrderCompletePost",
+      },
+      "put": {
+        "operationId": "ApiService_BusinessServicesPut",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/apiserviceUpdateOrderStatusPostResponse"
+              "$ref": "#/definitions/apiserviceBusinessServicesPutResponse"
             }
           },
           "default": {
@@ -2617,12 +2943,27 @@ var APISERVICE_JSON = `{
         },
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
+            "name": "id",
+            "in": "path",
             "required": true,
-            "schema": {
-              "$ref": "#/definitions/apiserviceUpdateOrderStatusPostRequest"
-            }
+            "type": "string",
+            "pattern": "message"
+          },
+          {
+            "name": "categoryIds",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
+          },
+          {
+            "name": "UserId",
+            "in": "query",
+            "required": false,
+            "type": "string"
           }
         ],
         "tags": [
@@ -2630,14 +2971,14 @@ var APISERVICE_JSON = `{
         ]
       }
     },
-    "/orders/connect": {
-      "post": {
-        "operationId": "ApiService_UpdateOrderConnectPost",
+    "/businesses/{id}/verify-refcode": {
+      "put": {
+        "operationId": "ApiService_BusinessVerifyRefCode",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/apiserviceUpdateOrderStatusPostResponse"
+              "$ref": "#/definitions/apiserviceBusinessVerifyRefCodePutResponse"
             }
           },
           "default": {
@@ -2649,43 +2990,23 @@ var APISERVICE_JSON = `{
         },
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/apiserviceUpdateOrderStatusPostRequest"
-            }
-          }
-        ],
-        "tags": [
-          "ApiService"
-        ]
-      }
-    },
-    "/orders/connect-all": {
-      "post": {
-        "operationId": "ApiService_UpdateAllOrderConnectPost",
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/apiserviceUpdateAllOrderStatusPostResponse"
-            }
+            "name": "id",
+            "in": "path",
+            "required": true,
+            "type": "string",
+            "pattern": "message"
           },
-          "default": {
-            "description": "An unexpected error response.",
-            "schema": {
-              "$ref": "#/definitions/rpcStatus"
-            }
-          }
-        },
-        "parameters": [
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/apiserviceUpdateAllOrderStatusPostRequest"
+              "type": "object",
+              "properties": {
+                "status": {
+                  "type": "boolean"
+                }
+              }
             }
           }
         ],
@@ -2694,14 +3015,14 @@ var APISERVICE_JSON = `{
         ]
       }
     },
-    "/orders/projects": {
+    "/categories": {
       "get": {
-        "operationId": "ApiService_OrdersProjectsGet",
+        "operationId": "ApiService_CategoriesGet",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/apiserviceUserProjectsGetResponse"
+              "$ref": "#/definitions/apiserviceCategoriesGetResponse"
             }
           },
           "default": {
@@ -2713,7 +3034,19 @@ var APISERVICE_JSON = `{
         },
         "parameters": [
           {
-            "name": "UserId",
+            "name": "query",
+            "in": "query",
+            "required": false,
+            "type": "string",
+            "enum": [
+              "NO",
+              "ADVERTISE",
+              "GROUP"
+            ],
+            "default": "NO"
+          },
+          {
+            "name": "limit",
             "in": "query",
             "required": false,
             "type": "string"
@@ -2725,7 +3058,7 @@ var APISERVICE_JSON = `{
             "type": "string"
           },
           {
-            "name": "limit",
+            "name": "name",
             "in": "query",
             "required": false,
             "type": "string"
@@ -2736,14 +3069,14 @@ var APISERVICE_JSON = `{
         ]
       }
     },
-    "/orders/projects/cancel": {
-      "post": {
-        "operationId": "ApiService_OrdersProjectCancel",
+    "/categories/{id}": {
+      "get": {
+        "operationId": "ApiService_CategoryGet",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/apiserviceCancelProjectPostResponse"
+              "$ref": "#/definitions/apiserviceCategoryGetResponse"
             }
           },
           "default": {
@@ -2755,12 +3088,11 @@ var APISERVICE_JSON = `{
         },
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
+            "name": "id",
+            "in": "path",
             "required": true,
-            "schema": {
-              "$ref": "#/definitions/apiserviceCancelProjectPostRequest"
-            }
+            "type": "string",
+            "pattern": "message"
           }
         ],
         "tags": [
@@ -2768,14 +3100,14 @@ var APISERVICE_JSON = `{
         ]
       }
     },
-    "/orders/reject": {
+    "/chatservice/conversations": {
       "post": {
-        "operationId": "ApiService_UpdateOrderRejectPost",
+        "operationId": "ApiService_ChatserviceConversationsPost",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/apiserviceUpdateOrderStatusPostResponse"
+              "$ref": "#/definitions/apiserviceConversationPostResponse"
             }
           },
           "default": {
@@ -2791,7 +3123,7 @@ var APISERVICE_JSON = `{
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/apiserviceUpdateOrderStatusPostRequest"
+              "$ref": "#/definitions/apiserviceConversationPostRequest"
             }
           }
         ],
@@ -2800,14 +3132,14 @@ var APISERVICE_JSON = `{
         ]
       }
     },
-    "/statistics": {
+    "/contacts/states": {
       "get": {
-        "operationId": "ApiService_StatisticsGet",
+        "operationId": "ApiService_StatesGet",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/apiserviceStatisticGetResponse"
+              "$ref": "#/definitions/apiserviceStatesGetResponse"
             }
           },
           "default": {
@@ -2822,14 +3154,15 @@ var APISERVICE_JSON = `{
         ]
       }
     },
-    "/stripe/key": {
+    "/contacts/{id}": {
       "get": {
-        "operationId": "ApiService_StripeKeyGet",
+        "summary": "Business to get business by id",
+        "operationId": "ApiService_ContactGet",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/apiserviceStripeKeyGetResponse"
+              "$ref": "#/definitions/apiserviceContactGetResponse"
             }
           },
           "default": {
@@ -2842,6 +3175,13 @@ var APISERVICE_JSON = `{
         "parameters": [
           {
             "name": "id",
+            "in": "path",
+            "required": true,
+            "type": "string",
+            "pattern": "message"
+          },
+          {
+            "name": "UserId",
             "in": "query",
             "required": false,
             "type": "string"
@@ -2850,16 +3190,15 @@ var APISERVICE_JSON = `{
         "tags": [
           "ApiService"
         ]
-      }
-    },
-    "/stripe/payment-method": {
-      "get": {
-        "operationId": "ApiService_StripePaymentMethodGet",
+      },
+      "put": {
+        "summary": "Business to get business by id",
+        "operationId": "ApiService_ContactPut",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/apiserviceStripePaymentMethodGetResponse"
+              "$ref": "#/definitions/apiserviceContactPutResponse"
             }
           },
           "default": {
@@ -2871,10 +3210,39 @@ var APISERVICE_JSON = `{
         },
         "parameters": [
           {
-            "name": "UserId",
-            "in": "query",
-            "required": false,
-            "type": "string"
+            "name": "id",
+            "in": "path",
+            "required": true,
+            "type": "string",
+            "pattern": "message"
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "properties": {
+                "UserId": {
+                  "type": "string"
+                },
+                "zipcode": {
+                  "type": "string"
+                },
+                "address1": {
+                  "type": "string"
+                },
+                "address2": {
+                  "type": "string"
+                },
+                "stateId": {
+                  "type": "string"
+                },
+                "city": {
+                  "type": "string"
+                }
+              }
+            }
           }
         ],
         "tags": [
@@ -2882,14 +3250,14 @@ var APISERVICE_JSON = `{
         ]
       }
     },
-    "/stripe/setup": {
+    "/feedbacks": {
       "get": {
-        "operationId": "ApiService_StripeSetupPost",
+        "operationId": "ApiService_FeedbacksGet",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/apiserviceStripeSetupPostResponse"
+              "$ref": "#/definitions/apiserviceFeedbackGetResponse"
             }
           },
           "default": {
@@ -2905,21 +3273,25 @@ var APISERVICE_JSON = `{
             "in": "query",
             "required": false,
             "type": "string"
+          },
+          {
+            "name": "orderId",
+            "in": "query",
+            "required": false,
+            "type": "string"
           }
         ],
         "tags": [
           "ApiService"
         ]
-      }
-    },
-    "/subscribe": {
+      },
       "post": {
-        "operationId": "ApiService_SubscribePost",
+        "operationId": "ApiService_FeedbacksPost",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/apiserviceSubscribePostResponse"
+              "$ref": "#/definitions/apiserviceFeedbacksPostResponse"
             }
           },
           "default": {
@@ -2935,7 +3307,7 @@ var APISERVICE_JSON = `{
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/apiserviceSubscribePostRequest"
+              "$ref": "#/definitions/apiserviceFeedbacksPostRequest"
             }
           }
         ],
@@ -2944,14 +3316,14 @@ var APISERVICE_JSON = `{
         ]
       }
     },
-    "/unsubscribe": {
-      "post": {
-        "operationId": "ApiService_UnsubscribePost",
+    "/feedbacks/{id}": {
+      "put": {
+        "operationId": "ApiService_FeedbacksPut",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/apiserviceUnsubscribePostResponse"
+              "$ref": "#/definitions/apiserviceFeedbackPutResponse"
             }
           },
           "default": {
@@ -2963,43 +3335,30 @@ var APISERVICE_JSON = `{
         },
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
+            "name": "id",
+            "in": "path",
             "required": true,
-            "schema": {
-              "$ref": "#/definitions/apiserviceUnsubscribePostRequest"
-            }
-          }
-        ],
-        "tags": [
-          "ApiService"
-        ]
-      }
-    },
-    "/upload-url": {
-      "post": {
-        "operationId": "ApiService_UploadUrlPost",
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/apiserviceUploadUrlPostResponse"
-            }
+            "type": "string",
+            "pattern": "message"
           },
-          "default": {
-            "description": "An unexpected error response.",
-            "schema": {
-              "$ref": "#/definitions/rpcStatus"
-            }
-          }
-        },
-        "parameters": [
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/apiserviceUploadUrlPostRequest"
+              "type": "object",
+              "properties": {
+                "UserId": {
+                  "type": "string"
+                },
+                "rate": {
+                  "type": "number",
+                  "format": "float"
+                },
+                "comment": {
+                  "type": "string"
+                }
+              }
             }
           }
         ],
@@ -3008,15 +3367,14 @@ var APISERVICE_JSON = `{
         ]
       }
     },
-    "/users": {
-      "post": {
-        "summary": "User for create new user",
-        "operationId": "ApiService_UserPost",
+    "/orders": {
+      "get": {
+        "operationId": "ApiService_OrdersGet",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/apiserviceUserPostResponse"
+              "$ref": "#/definitions/apiserviceOrdersGetResponse"
             }
           },
           "default": {
@@ -3028,27 +3386,85 @@ var APISERVICE_JSON = `{
         },
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/apiserviceUserPostRequest"
-            }
-          }
-        ],
-        "tags": [
-          "ApiService"
-        ]
-      }
-    },
-    "/users/state": {
-      "get": {
-        "operationId": "ApiService_UserStateGet",
+            "name": "UserId",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "status",
+            "in": "query",
+            "required": false,
+            "type": "string",
+            "enum": [
+              "PENDING",
+              "CONNECTED",
+              "REJECTED",
+              "CANCELED",
+              "COMPLETED",
+              "EXPIRED_STATUS",
+              "AWAITING_CONFIRMATION"
+            ],
+            "default": "PENDING"
+          },
+          {
+            "name": "offset",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "limit",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "serviceId",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "zipcode",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "urgency",
+            "description": "urgency is an ORDER_URGENCY name",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "scheduledFrom",
+            "description": "scheduledFrom and scheduledTo bound the preferred date",
+            "in": "query",
+            "required": false,
+            "type": "string",
+            "format": "int64"
+          },
+          {
+            "name": "scheduledTo",
+            "in": "query",
+            "required": false,
+            "type": "string",
+            "format": "int64"
+          }
+        ],
+        "tags": [
+          "ApiService"
+        ]
+      },
+      "post": {
+        "operationId": "ApiService_OrdersPost",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/apiserviceUserStateGetResponse"
+              "$ref": "#/definitions/apiserviceOrdersPostResponse"
             }
           },
           "default": {
@@ -3058,20 +3474,29 @@ var APISERVICE_JSON = `{
             }
           }
         },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/apiserviceOrdersPostRequest"
+            }
+          }
+        ],
         "tags": [
           "ApiService"
         ]
       }
     },
-    "/users/{id}": {
+    "/orders/already-ordered": {
       "get": {
-        "summary": "User to get user by id",
-        "operationId": "ApiService_UserGet",
+        "operationId": "ApiService_BusinessAlreadyOrderedGet",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/apiserviceUserGetResponse"
+              "$ref": "#/definitions/apiserviceBusinessesAlreadyOrderedGetResponse"
             }
           },
           "default": {
@@ -3083,14 +3508,19 @@ var APISERVICE_JSON = `{
         },
         "parameters": [
           {
-            "name": "id",
-            "in": "path",
-            "required": true,
-            "type": "string",
-            "pattern": "message"
+            "name": "UserId",
+            "in": "query",
+            "required": false,
+            "type": "string"
           },
           {
-            "name": "UserId",
+            "name": "zipcode",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "categoryId",
             "in": "query",
             "required": false,
             "type": "string"
@@ -3099,14 +3529,16 @@ var APISERVICE_JSON = `{
         "tags": [
           "ApiService"
         ]
-      },
-      "put": {
-        "operationId": "ApiService_UserPut",
+      }
+    },
+    "/orders/cancel": {
+      "post": {
+        "operationId": "ApiService_UpdateOrderCancelPost",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/apiserviceUserPutResponse"
+              "$ref": "#/definitions/apiserviceUpdateOrderStatusPostResponse"
             }
           },
           "default": {
@@ -3118,38 +3550,43 @@ var APISERVICE_JSON = `{
         },
         "parameters": [
           {
-            "name": "id",
-            "in": "path",
+            "name": "body",
+            "in": "body",
             "required": true,
-            "type": "string",
-            "pattern": "message"
+            "schema": {
+              "$ref": "#/definitions/apiserviceUpdateOrderStatusPostRequest"
+            }
+          }
+        ],
+        "tags": [
+          "ApiService"
+        ]
+      }
+    },
+    "/orders/complete": {
+      "post": {
+        "operationId": "ApiService_UpdateOrderCompletePost",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/apiserviceUpdateOrderStatusPostResponse"
+            }
           },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/rpcStatus"
+            }
+          }
+        },
+        "parameters": [
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "type": "object",
-              "properties": {
-                "UserId": {
-                  "type": "string"
-                },
-                "url": {
-                  "type": "string"
-                },
-                "firstName": {
-                  "type": "string"
-                },
-                "lastName": {
-                  "type": "string"
-                },
-                "phone": {
-                  "type": "string"
-                },
-                "mail": {
-                  "type": "string"
-                }
-              }
+              "$ref": "#/definitions/apiserviceUpdateOrderStatusPostRequest"
             }
           }
         ],
@@ -3157,21 +3594,2123 @@ var APISERVICE_JSON = `{
           "ApiService"
         ]
       }
-    }
-  },
-  "definitions": {
-    "ConversationMember": {
-      "type": "object",
-      "properties": {
-        "id": {
-          "type": "string"
+    },
+    "/orders/complete/dispute": {
+      "post": {
+        "operationId": "ApiService_UpdateOrderCompleteDisputePost",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/apiserviceUpdateOrderStatusPostResponse"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/rpcStatus"
+            }
+          }
         },
-        "name": {
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/apiserviceUpdateOrderStatusPostRequest"
+            }
+          }
+        ],
+        "tags": [
+          "ApiService"
+        ]
+      }
+    },
+    "/orders/connect": {
+      "post": {
+        "operationId": "ApiService_UpdateOrderConnectPost",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/apiserviceUpdateOrderStatusPostResponse"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/rpcStatus"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/apiserviceUpdateOrderStatusPostRequest"
+            }
+          }
+        ],
+        "tags": [
+          "ApiService"
+        ]
+      }
+    },
+    "/orders/connect-all": {
+      "post": {
+        "operationId": "ApiService_UpdateAllOrderConnectPost",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/apiserviceUpdateAllOrderStatusPostResponse"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/rpcStatus"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/apiserviceUpdateAllOrderStatusPostRequest"
+            }
+          }
+        ],
+        "tags": [
+          "ApiService"
+        ]
+      }
+    },
+    "/orders/dispute": {
+      "post": {
+        "operationId": "ApiService_OrderDisputePost",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/apiserviceOrderDisputePostResponse"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/rpcStatus"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/apiserviceOrderDisputePostRequest"
+            }
+          }
+        ],
+        "tags": [
+          "ApiService"
+        ]
+      }
+    },
+    "/orders/disputes": {
+      "get": {
+        "operationId": "ApiService_OrderDisputesGet",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/apiserviceOrderDisputesGetResponse"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/rpcStatus"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "UserId",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "limit",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "offset",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "ApiService"
+        ]
+      }
+    },
+    "/orders/projects": {
+      "get": {
+        "operationId": "ApiService_OrdersProjectsGet",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/apiserviceUserProjectsGetResponse"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/rpcStatus"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "UserId",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "offset",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "limit",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "ApiService"
+        ]
+      }
+    },
+    "/orders/projects/cancel": {
+      "post": {
+        "operationId": "ApiService_OrdersProjectCancel",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/apiserviceCancelProjectPostResponse"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/rpcStatus"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/apiserviceCancelProjectPostRequest"
+            }
+          }
+        ],
+        "tags": [
+          "ApiService"
+        ]
+      }
+    },
+    "/orders/quote": {
+      "post": {
+        "operationId": "ApiService_OrderQuotePost",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/apiserviceOrderQuotePostResponse"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/rpcStatus"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/apiserviceOrderQuotePostRequest"
+            }
+          }
+        ],
+        "tags": [
+          "ApiService"
+        ]
+      }
+    },
+    "/orders/quote/accept": {
+      "post": {
+        "operationId": "ApiService_OrderQuoteAcceptPost",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/apiserviceOrderQuoteAcceptPostResponse"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/rpcStatus"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/apiserviceOrderQuoteAcceptPostRequest"
+            }
+          }
+        ],
+        "tags": [
+          "ApiService"
+        ]
+      }
+    },
+    "/orders/quotes": {
+      "get": {
+        "operationId": "ApiService_OrderQuotesGet",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/apiserviceOrderQuotesGetResponse"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/rpcStatus"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "UserId",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "orderId",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "serviceId",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "zipcode",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "ActorId",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "ApiService"
+        ]
+      }
+    },
+    "/orders/reject": {
+      "post": {
+        "operationId": "ApiService_UpdateOrderRejectPost",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/apiserviceUpdateOrderStatusPostResponse"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/rpcStatus"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/apiserviceUpdateOrderStatusPostRequest"
+            }
+          }
+        ],
+        "tags": [
+          "ApiService"
+        ]
+      }
+    },
+    "/orders/slot/propose": {
+      "post": {
+        "operationId": "ApiService_OrderSlotProposePost",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/apiserviceOrderSlotProposePostResponse"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/rpcStatus"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/apiserviceOrderSlotProposePostRequest"
+            }
+          }
+        ],
+        "tags": [
+          "ApiService"
+        ]
+      }
+    },
+    "/orders/slot/respond": {
+      "post": {
+        "operationId": "ApiService_OrderSlotRespondPost",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/apiserviceOrderSlotRespondPostResponse"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/rpcStatus"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/apiserviceOrderSlotRespondPostRequest"
+            }
+          }
+        ],
+        "tags": [
+          "ApiService"
+        ]
+      }
+    },
+    "/orders/{id}/timeline": {
+      "get": {
+        "operationId": "ApiService_OrdersTimelineGet",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/apiserviceOrdersTimelineGetResponse"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/rpcStatus"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "id",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "UserId",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "Staff",
+            "description": "_staff is set when the caller may read every order",
+            "in": "query",
+            "required": false,
+            "type": "boolean"
+          },
+          {
+            "name": "ActorId",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "ApiService"
+        ]
+      }
+    },
+    "/statistics": {
+      "get": {
+        "operationId": "ApiService_StatisticsGet",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/apiserviceStatisticGetResponse"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/rpcStatus"
+            }
+          }
+        },
+        "tags": [
+          "ApiService"
+        ]
+      }
+    },
+    "/stripe/key": {
+      "get": {
+        "operationId": "ApiService_StripeKeyGet",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/apiserviceStripeKeyGetResponse"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/rpcStatus"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "id",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "ApiService"
+        ]
+      }
+    },
+    "/stripe/payment-method": {
+      "get": {
+        "operationId": "ApiService_StripePaymentMethodGet",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/apiserviceStripePaymentMethodGetResponse"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/rpcStatus"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "UserId",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "ApiService"
+        ]
+      }
+    },
+    "/stripe/setup": {
+      "get": {
+        "operationId": "ApiService_StripeSetupPost",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/apiserviceStripeSetupPostResponse"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/rpcStatus"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "UserId",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "ApiService"
+        ]
+      }
+    },
+    "/subscribe": {
+      "post": {
+        "operationId": "ApiService_SubscribePost",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/apiserviceSubscribePostResponse"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/rpcStatus"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/apiserviceSubscribePostRequest"
+            }
+          }
+        ],
+        "tags": [
+          "ApiService"
+        ]
+      }
+    },
+    "/unsubscribe": {
+      "post": {
+        "operationId": "ApiService_UnsubscribePost",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/apiserviceUnsubscribePostResponse"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/rpcStatus"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/apiserviceUnsubscribePostRequest"
+            }
+          }
+        ],
+        "tags": [
+          "ApiService"
+        ]
+      }
+    },
+    "/upload-url": {
+      "post": {
+        "operationId": "ApiService_UploadUrlPost",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/apiserviceUploadUrlPostResponse"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/rpcStatus"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/apiserviceUploadUrlPostRequest"
+            }
+          }
+        ],
+        "tags": [
+          "ApiService"
+        ]
+      }
+    },
+    "/users": {
+      "post": {
+        "summary": "User for create new user",
+        "operationId": "ApiService_UserPost",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/apiserviceUserPostResponse"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/rpcStatus"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/apiserviceUserPostRequest"
+            }
+          }
+        ],
+        "tags": [
+          "ApiService"
+        ]
+      }
+    },
+    "/users/state": {
+      "get": {
+        "operationId": "ApiService_UserStateGet",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/apiserviceUserStateGetResponse"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/rpcStatus"
+            }
+          }
+        },
+        "tags": [
+          "ApiService"
+        ]
+      }
+    },
+    "/users/{id}": {
+      "get": {
+        "summary": "User to get user by id",
+        "operationId": "ApiService_UserGet",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/apiserviceUserGetResponse"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/rpcStatus"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "id",
+            "in": "path",
+            "required": true,
+            "type": "string",
+            "pattern": "message"
+          },
+          {
+            "name": "UserId",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "ApiService"
+        ]
+      },
+      "put": {
+        "operationId": "ApiService_UserPut",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/apiserviceUserPutResponse"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response.",
+            "schema": {
+              "$ref": "#/definitions/rpcStatus"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "id",
+            "in": "path",
+            "required": true,
+            "type": "string",
+            "pattern": "message"
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "properties": {
+                "UserId": {
+                  "type": "string"
+                },
+                "url": {
+                  "type": "string"
+                },
+                "firstName": {
+                  "type": "string"
+                },
+                "lastName": {
+                  "type": "string"
+                },
+                "phone": {
+                  "type": "string"
+                },
+                "mail": {
+                  "type": "string"
+                }
+              }
+            }
+          }
+        ],
+        "tags": [
+          "ApiService"
+        ]
+      }
+    }
+  },
+  "definitions": {
+    "apiserviceAdminAdvertiseManagementDeletePostResponse": {
+      "type": "object",
+      "properties": {
+        "code": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "success": {
+          "type": "boolean"
+        },
+        "data": {
+          "$ref": "#/definitions/apiserviceAdminAdvertiseManagementDeletePostResponseData"
+        }
+      }
+    },
+    "apiserviceAdminAdvertiseManagementDeletePostResponseData": {
+      "type": "object"
+    },
+    "apiserviceAdminAdvertiseManagementGetResponse": {
+      "type": "object",
+      "properties": {
+        "code": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "success": {
+          "type": "boolean"
+        },
+        "data": {
+          "$ref": "#/definitions/apiserviceAdminAdvertiseManagementGetResponseData"
+        }
+      }
+    },
+    "apiserviceAdminAdvertiseManagementGetResponseData": {
+      "type": "object",
+      "properties": {
+        "pagination": {
+          "$ref": "#/definitions/apiservicePagination"
+        },
+        "result": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/apiserviceAdvertisePackage"
+          }
+        }
+      }
+    },
+    "apiserviceAdminAdvertiseManagementPostRequest": {
+      "type": "object",
+      "properties": {
+        "UserId": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "categoryIds": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "price": {
+          "type": "number",
+          "format": "double"
+        },
+        "bannerUrl": {
+          "type": "string"
+        },
+        "description": {
+          "type": "string"
+        }
+      }
+    },
+    "apiserviceAdminAdvertiseManagementPostResponse": {
+      "type": "object",
+      "properties": {
+        "code": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "success": {
+          "type": "boolean"
+        },
+        "data": {
+          "$ref": "#/definitions/apiserviceAdminAdvertiseManagementPostResponseData"
+        }
+      }
+    },
+    "apiserviceAdminAdvertiseManagementPostResponseData": {
+      "type": "object"
+    },
+    "apiserviceAdminAdvertiseManagementPutResponse": {
+      "type": "object",
+      "properties": {
+        "code": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "success": {
+          "type": "boolean"
+        },
+        "data": {
+          "$ref": "#/definitions/apiserviceAdminAdvertiseManagementPutResponseData"
+        }
+      }
+    },
+    "apiserviceAdminAdvertiseManagementPutResponseData": {
+      "type": "object"
+    },
+    "apiserviceAdminBanUserPostResponse": {
+      "type": "object",
+      "properties": {
+        "code": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "success": {
+          "type": "boolean"
+        },
+        "data": {
+          "$ref": "#/definitions/apiserviceAdminBanUserPostResponseData"
+        }
+      }
+    },
+    "apiserviceAdminBanUserPostResponseData": {
+      "type": "object",
+      "properties": {
+        "suspension": {
+          "$ref": "#/definitions/apiserviceSuspension"
+        }
+      }
+    },
+    "apiserviceAdminBusinessBanPostResponse": {
+      "type": "object",
+      "properties": {
+        "code": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "success": {
+          "type": "boolean"
+        },
+        "data": {
+          "$ref": "#/definitions/apiserviceAdminBusinessBanPostResponseData"
+        }
+      }
+    },
+    "apiserviceAdminBusinessBanPostResponseData": {
+      "type": "object",
+      "properties": {
+        "suspension": {
+          "$ref": "#/definitions/apiserviceSuspension"
+        }
+      }
+    },
+    "apiserviceAdminBusinessDeletePostResponse": {
+      "type": "object",
+      "properties": {
+        "code": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "success": {
+          "type": "boolean"
+        },
+        "data": {
+          "$ref": "#/definitions/apiserviceAdminBusinessDeletePostResponseData"
+        }
+      }
+    },
+    "apiserviceAdminBusinessDeletePostResponseData": {
+      "type": "object"
+    },
+    "apiserviceAdminBusinessesGetResponse": {
+      "type": "object",
+      "properties": {
+        "code": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "success": {
+          "type": "boolean"
+        },
+        "data": {
+          "$ref": "#/definitions/apiserviceAdminBusinessesGetResponseData"
+        }
+      }
+    },
+    "apiserviceAdminBusinessesGetResponseData": {
+      "type": "object",
+      "properties": {
+        "pagination": {
+          "$ref": "#/definitions/apiservicePagination"
+        },
+        "result": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/apiserviceBusiness"
+          }
+        }
+      }
+    },
+    "apiserviceAdminBusinessesUnbanPostResponse": {
+      "type": "object",
+      "properties": {
+        "code": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "success": {
+          "type": "boolean"
+        },
+        "data": {
+          "$ref": "#/definitions/apiserviceAdminBusinessesUnbanPostResponseData"
+        }
+      }
+    },
+    "apiserviceAdminBusinessesUnbanPostResponseData": {
+      "type": "object"
+    },
+    "apiserviceAdminCategoryPostDeleteRequest": {
+      "type": "object",
+      "properties": {
+        "UserId": {
+          "type": "string"
+        },
+        "categoryId": {
+          "type": "string"
+        }
+      }
+    },
+    "apiserviceAdminCategoryPostDeleteResponese": {
+      "type": "object",
+      "properties": {
+        "code": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "success": {
+          "type": "boolean"
+        },
+        "data": {
+          "$ref": "#/definitions/apiserviceAdminCategoryPostDeleteResponeseData"
+        }
+      }
+    },
+    "apiserviceAdminCategoryPostDeleteResponeseData": {
+      "type": "object"
+    },
+    "apiserviceAdminCategoryPostEditRequest": {
+      "type": "object",
+      "properties": {
+        "UserId": {
+          "type": "string"
+        },
+        "id": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "fee": {
+          "type": "number",
+          "format": "float"
+        },
+        "url": {
+          "type": "string"
+        },
+        "orderExpiryHours": {
+          "type": "integer",
+          "format": "int32",
+          "title": "orderExpiryHours is how long new orders of the category wait for the\nhandyman before they expire, 0 keeps it"
+        }
+      }
+    },
+    "apiserviceAdminCategoryPostEditResponese": {
+      "type": "object",
+      "properties": {
+        "code": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "success": {
+          "type": "boolean"
+        },
+        "data": {
+          "$ref": "#/definitions/apiserviceAdminCategoryPostEditResponeseData"
+        }
+      }
+    },
+    "apiserviceAdminCategoryPostEditResponeseData": {
+      "type": "object"
+    },
+    "apiserviceAdminCategoryPostRequest": {
+      "type": "object",
+      "properties": {
+        "UserId": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "image": {
+          "type": "string"
+        }
+      }
+    },
+    "apiserviceAdminCategoryPostResponese": {
+      "type": "object",
+      "properties": {
+        "code": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "success": {
+          "type": "boolean"
+        },
+        "data": {
+          "$ref": "#/definitions/apiserviceAdminCategoryPostResponeseData"
+        }
+      }
+    },
+    "apiserviceAdminCategoryPostResponeseData": {
+      "type": "object"
+    },
+    "apiserviceAdminCategoryQuestionsPostRequest": {
+      "type": "object",
+      "properties": {
+        "UserId": {
+          "type": "string"
+        },
+        "categoryId": {
+          "type": "string"
+        },
+        "questions": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/apiserviceCategoryQuestion"
+          }
+        }
+      },
+      "description": "AdminCategoryQuestionsPostRequest replaces the questions customers answer\nwhen they post an order for the category."
+    },
+    "apiserviceAdminCategoryQuestionsPostResponse": {
+      "type": "object",
+      "properties": {
+        "code": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "success": {
+          "type": "boolean"
+        },
+        "data": {
+          "$ref": "#/definitions/apiserviceAdminCategoryQuestionsPostResponseData"
+        }
+      }
+    },
+    "apiserviceAdminCategoryQuestionsPostResponseData": {
+      "type": "object",
+      "properties": {
+        "category": {
+          "$ref": "#/definitions/apiserviceCategory"
+        }
+      }
+    },
+    "apiserviceAdminDisputeReviewPostResponse": {
+      "type": "object",
+      "properties": {
+        "code": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "success": {
+          "type": "boolean"
+        },
+        "data": {
+          "$ref": "#/definitions/apiserviceAdminDisputeReviewPostResponseData"
+        }
+      }
+    },
+    "apiserviceAdminDisputeReviewPostResponseData": {
+      "type": "object",
+      "properties": {
+        "dispute": {
+          "$ref": "#/definitions/apiserviceDispute"
+        }
+      }
+    },
+    "apiserviceAdminDisputesGetResponse": {
+      "type": "object",
+      "properties": {
+        "code": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "success": {
+          "type": "boolean"
+        },
+        "data": {
+          "$ref": "#/definitions/apiserviceAdminDisputesGetResponseData"
+        }
+      }
+    },
+    "apiserviceAdminDisputesGetResponseData": {
+      "type": "object",
+      "properties": {
+        "pagination": {
+          "$ref": "#/definitions/apiservicePagination"
+        },
+        "result": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/apiserviceDispute"
+          }
+        }
+      }
+    },
+    "apiserviceAdminGroupGetResponse": {
+      "type": "object",
+      "properties": {
+        "code": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "success": {
+          "type": "boolean"
+        },
+        "data": {
+          "$ref": "#/definitions/apiserviceAdminGroupGetResponseData"
+        }
+      }
+    },
+    "apiserviceAdminGroupGetResponseData": {
+      "type": "object",
+      "properties": {
+        "pagination": {
+          "$ref": "#/definitions/apiservicePagination"
+        },
+        "result": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/apiserviceGroup"
+          }
+        }
+      }
+    },
+    "apiserviceAdminGroupPostRequest": {
+      "type": "object",
+      "properties": {
+        "UserId": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "fee": {
+          "type": "number",
+          "format": "float"
+        },
+        "ServiceIds": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "apiserviceAdminGroupPostResponse": {
+      "type": "object",
+      "properties": {
+        "code": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "success": {
+          "type": "boolean"
+        },
+        "data": {
+          "$ref": "#/definitions/apiserviceAdminGroupPostResponseData"
+        }
+      }
+    },
+    "apiserviceAdminGroupPostResponseData": {
+      "type": "object"
+    },
+    "apiserviceAdminGroupPutRequest": {
+      "type": "object",
+      "properties": {
+        "UserId": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "fee": {
+          "type": "number",
+          "format": "float"
+        },
+        "ServiceIds": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "id": {
+          "type": "string"
+        }
+      }
+    },
+    "apiserviceAdminPermissionsGetResponse": {
+      "type": "object",
+      "properties": {
+        "code": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "success": {
+          "type": "boolean"
+        },
+        "data": {
+          "$ref": "#/definitions/apiserviceAdminPermissionsGetResponseData"
+        }
+      }
+    },
+    "apiserviceAdminPermissionsGetResponseData": {
+      "type": "object",
+      "properties": {
+        "result": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/apiservicePermission"
+          }
+        }
+      }
+    },
+    "apiserviceAdminRolePermissionsPutResponse": {
+      "type": "object",
+      "properties": {
+        "code": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "success": {
+          "type": "boolean"
+        },
+        "data": {
+          "$ref": "#/definitions/apiserviceAdminRolePermissionsPutResponseData"
+        }
+      }
+    },
+    "apiserviceAdminRolePermissionsPutResponseData": {
+      "type": "object",
+      "properties": {
+        "result": {
+          "$ref": "#/definitions/apiserviceRole"
+        }
+      }
+    },
+    "apiserviceAdminRolesGetResponse": {
+      "type": "object",
+      "properties": {
+        "code": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "success": {
+          "type": "boolean"
+        },
+        "data": {
+          "$ref": "#/definitions/apiserviceAdminRolesGetResponseData"
+        }
+      }
+    },
+    "apiserviceAdminRolesGetResponseData": {
+      "type": "object",
+      "properties": {
+        "result": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/apiserviceRole"
+          }
+        }
+      }
+    },
+    "apiserviceAdminUserActivityGetResponse": {
+      "type": "object",
+      "properties": {
+        "code": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "success": {
+          "type": "boolean"
+        },
+        "data": {
+          "$ref": "#/definitions/apiserviceAdminUserActivityGetResponseData"
+        }
+      }
+    },
+    "apiserviceAdminUserActivityGetResponseData": {
+      "type": "object",
+      "properties": {
+        "pagination": {
+          "$ref": "#/definitions/apiservicePagination"
+        },
+        "result": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/apiserviceSecurityEvent"
+          }
+        }
+      }
+    },
+    "apiserviceAdminUserImpersonatePostResponse": {
+      "type": "object",
+      "properties": {
+        "code": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "success": {
+          "type": "boolean"
+        },
+        "data": {
+          "$ref": "#/definitions/apiserviceAdminUserImpersonatePostResponseData"
+        }
+      }
+    },
+    "apiserviceAdminUserImpersonatePostResponseData": {
+      "type": "object",
+      "properties": {
+        "accessToken": {
+          "type": "string"
+        },
+        "expiresAt": {
+          "type": "string",
+          "format": "int64"
+        },
+        "sessionId": {
+          "type": "string"
+        }
+      }
+    },
+    "apiserviceAdminUsersDeletePostResponse": {
+      "type": "object",
+      "properties": {
+        "code": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "success": {
+          "type": "boolean"
+        },
+        "data": {
+          "$ref": "#/definitions/apiserviceAdminUsersDeletePostResponseData"
+        }
+      }
+    },
+    "apiserviceAdminUsersDeletePostResponseData": {
+      "type": "object"
+    },
+    "apiserviceAdminUsersGetResponse": {
+      "type": "object",
+      "properties": {
+        "code": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "success": {
+          "type": "boolean"
+        },
+        "data": {
+          "$ref": "#/definitions/apiserviceAdminUsersGetResponseData"
+        }
+      }
+    },
+    "apiserviceAdminUsersGetResponseData": {
+      "type": "object",
+      "properties": {
+        "pagination": {
+          "$ref": "#/definitions/apiservicePagination"
+        },
+        "result": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/apiserviceUser"
+          }
+        }
+      }
+    },
+    "apiserviceAdminUsersUnbanPostResponse": {
+      "type": "object",
+      "properties": {
+        "code": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "success": {
+          "type": "boolean"
+        },
+        "data": {
+          "$ref": "#/definitions/apiserviceAdminUsersUnbanPostResponseData"
+        }
+      }
+    },
+    "apiserviceAdminUsersUnbanPostResponseData": {
+      "type": "object"
+    },
+    "apiserviceAdvertiseDetail": {
+      "type": "object",
+      "properties": {
+        "id": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "serviceInfo": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/apiserviceServiceGroup"
+          }
+        },
+        "price": {
+          "type": "number",
+          "format": "double"
+        },
+        "bannerUrl": {
+          "type": "string"
+        },
+        "description": {
+          "type": "string"
+        },
+        "zipcodes": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "apiserviceAdvertiseDetailGetResponse": {
+      "type": "object",
+      "properties": {
+        "code": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "success": {
+          "type": "boolean"
+        },
+        "data": {
+          "$ref": "#/definitions/apiserviceAdvertiseDetailGetResponseData"
+        }
+      }
+    },
+    "apiserviceAdvertiseDetailGetResponseData": {
+      "type": "object",
+      "properties": {
+        "pagination": {
+          "$ref": "#/definitions/apiservicePagination"
+        },
+        "result": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/apiserviceAdvertiseDetail"
+          }
+        }
+      }
+    },
+    "apiserviceAdvertiseGetResponse": {
+      "type": "object",
+      "properties": {
+        "code": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "success": {
+          "type": "boolean"
+        },
+        "data": {
+          "$ref": "#/definitions/apiserviceAdvertiseGetResponseData"
+        }
+      }
+    },
+    "apiserviceAdvertiseGetResponseData": {
+      "type": "object",
+      "properties": {
+        "pagination": {
+          "$ref": "#/definitions/apiservicePagination"
+        },
+        "result": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/apiserviceAdvertisePackage"
+          }
+        }
+      }
+    },
+    "apiserviceAdvertiseOrder": {
+      "type": "object",
+      "properties": {
+        "id": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "categoryName": {
+          "type": "string"
+        },
+        "price": {
+          "type": "number",
+          "format": "double"
+        },
+        "bannerUrl": {
+          "type": "string"
+        },
+        "description": {
+          "type": "string"
+        },
+        "zipcode": {
+          "type": "string"
+        },
+        "startDate": {
+          "type": "string",
+          "format": "int64"
+        },
+        "endDate": {
+          "type": "string",
+          "format": "int64"
+        },
+        "UserId": {
+          "type": "string"
+        },
+        "advertisePackageId": {
+          "type": "string"
+        }
+      }
+    },
+    "apiserviceAdvertisePackage": {
+      "type": "object",
+      "properties": {
+        "id": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "serviceInfo": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/apiserviceServiceGroup"
+          }
+        },
+        "price": {
+          "type": "number",
+          "format": "double"
+        },
+        "bannerUrl": {
+          "type": "string"
+        },
+        "description": {
+          "type": "string"
+        }
+      }
+    },
+    "apiserviceApiKey": {
+      "type": "object",
+      "properties": {
+        "id": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "prefix": {
+          "type": "string"
+        },
+        "scopes": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "businessId": {
+          "type": "string"
+        },
+        "rateLimit": {
+          "type": "string",
+          "format": "int64"
+        },
+        "expiresAt": {
+          "type": "string",
+          "format": "int64"
+        },
+        "lastUsedAt": {
+          "type": "string",
+          "format": "int64"
+        },
+        "revoked": {
+          "type": "boolean"
+        },
+        "createdAt": {
+          "type": "string",
+          "format": "int64"
+        }
+      }
+    },
+    "apiserviceAttachment": {
+      "type": "object",
+      "properties": {
+        "contentType": {
+          "type": "string"
+        },
+        "size": {
+          "type": "string",
+          "format": "int64"
+        },
+        "url": {
+          "type": "string"
+        }
+      },
+      "description": "Attachment is a file the customer attached to an order. url is a\nshort-lived link, only set for the customer and for the handyman once\nconnected."
+    },
+    "apiserviceAuthActivityGetResponse": {
+      "type": "object",
+      "properties": {
+        "code": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "success": {
+          "type": "boolean"
+        },
+        "data": {
+          "$ref": "#/definitions/apiserviceAuthActivityGetResponseData"
+        }
+      }
+    },
+    "apiserviceAuthActivityGetResponseData": {
+      "type": "object",
+      "properties": {
+        "pagination": {
+          "$ref": "#/definitions/apiservicePagination"
+        },
+        "result": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/apiserviceSecurityEvent"
+          }
+        }
+      }
+    },
+    "apiserviceAuthApiKeysGetResponse": {
+      "type": "object",
+      "properties": {
+        "code": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "success": {
+          "type": "boolean"
+        },
+        "data": {
+          "$ref": "#/definitions/apiserviceAuthApiKeysGetResponseData"
+        }
+      }
+    },
+    "apiserviceAuthApiKeysGetResponseData": {
+      "type": "object",
+      "properties": {
+        "result": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/apiserviceApiKey"
+          }
+        }
+      }
+    },
+    "apiserviceAuthApiKeysPostRequest": {
+      "type": "object",
+      "properties": {
+        "UserId": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "scopes": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "expiresAt": {
+          "type": "string",
+          "format": "int64"
+        },
+        "rateLimit": {
+          "type": "string",
+          "format": "int64"
+        },
+        "forBusiness": {
+          "type": "boolean"
+        }
+      },
+      "title": "AuthApiKeysPost(AuthApiKeysPostRequest) returns (AuthApiKeysPostResponse)"
+    },
+    "apiserviceAuthApiKeysPostResponse": {
+      "type": "object",
+      "properties": {
+        "code": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "success": {
+          "type": "boolean"
+        },
+        "data": {
+          "$ref": "#/definitions/apiserviceAuthApiKeysPostResponseData"
+        }
+      }
+    },
+    "apiserviceAuthApiKeysPostResponseData": {
+      "type": "object",
+      "properties": {
+        "apiKey": {
+          "$ref": "#/definitions/apiserviceApiKey"
+        },
+        "key": {
+          "type": "string"
+        }
+      }
+    },
+    "apiserviceAuthApiKeysRevokePostResponse": {
+      "type": "object",
+      "properties": {
+        "code": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "success": {
+          "type": "boolean"
+        },
+        "data": {
+          "$ref": "#/definitions/apiserviceAuthApiKeysRevokePostResponseData"
+        }
+      }
+    },
+    "apiserviceAuthApiKeysRevokePostResponseData": {
+      "type": "object"
+    },
+    "apiserviceAuthChangeMailAndPassPostRequest": {
+      "type": "object",
+      "properties": {
+        "mail": {
+          "type": "string"
+        },
+        "encryptedPrivateKey": {
+          "type": "string"
+        },
+        "UserId": {
+          "type": "string"
+        },
+        "publicKey": {
+          "type": "string"
+        }
+      }
+    },
+    "apiserviceAuthChangeMailAndPassPostResponse": {
+      "type": "object",
+      "properties": {
+        "code": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "success": {
+          "type": "boolean"
+        },
+        "data": {
+          "$ref": "#/definitions/apiserviceAuthChangeMailAndPassPostResponseData"
+        }
+      }
+    },
+    "apiserviceAuthChangeMailAndPassPostResponseData": {
+      "type": "object"
+    },
+    "apiserviceAuthCheckGetResponse": {
+      "type": "object",
+      "properties": {
+        "code": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "success": {
+          "type": "boolean"
+        },
+        "data": {
+          "$ref": "#/definitions/apiserviceAuthCheckGetResponseData"
+        }
+      }
+    },
+    "apiserviceAuthCheckGetResponseData": {
+      "type": "object",
+      "properties": {
+        "existed": {
+          "type": "boolean"
+        }
+      }
+    },
+    "apiserviceAuthCredentialRequest": {
+      "type": "object",
+      "properties": {
+        "identifier": {
+          "type": "string"
+        },
+        "totp": {
+          "type": "string"
+        }
+      },
+      "title": "AuthCredential(AuthCredentialRequest) returns (AuthCredentialResponse)"
+    },
+    "apiserviceAuthCredentialResponse": {
+      "type": "object",
+      "properties": {
+        "code": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "success": {
+          "type": "boolean"
+        },
+        "data": {
+          "$ref": "#/definitions/apiserviceAuthCredentialResponseData"
+        }
+      }
+    },
+    "apiserviceAuthCredentialResponseData": {
+      "type": "object",
+      "properties": {
+        "id": {
+          "type": "string"
+        },
+        "publicKey": {
+          "type": "string"
+        },
+        "encryptedPrivateKey": {
+          "type": "string"
+        },
+        "shouldChangeMail": {
+          "type": "boolean"
+        },
+        "isDefaultPassword": {
+          "type": "boolean"
+        }
+      }
+    },
+    "apiserviceAuthDeletePostRequest": {
+      "type": "object",
+      "properties": {
+        "UserId": {
+          "type": "string"
+        }
+      },
+      "title": "AuthDeletePost(AuthDeletePostRequest) returns (AuthDeletePostResponse)"
+    },
+    "apiserviceAuthDeletePostResponse": {
+      "type": "object",
+      "properties": {
+        "code": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "success": {
+          "type": "boolean"
+        },
+        "data": {
+          "$ref": "#/definitions/apiserviceAuthDeletePostResponseData"
+        }
+      }
+    },
+    "apiserviceAuthDeletePostResponseData": {
+      "type": "object",
+      "properties": {
+        "otpId": {
+          "type": "string"
+        }
+      }
+    },
+    "apiserviceAuthForgotPostRequest": {
+      "type": "object",
+      "properties": {
+        "mail": {
+          "type": "string"
+        },
+        "phone": {
+          "type": "string"
+        }
+      },
+      "title": "rpc AuthPasswordForgotPost(AuthForgotPostRequest) returns (AuthForgotPostResponse)"
+    },
+    "apiserviceAuthForgotPostResponse": {
+      "type": "object",
+      "properties": {
+        "code": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "success": {
+          "type": "boolean"
+        },
+        "data": {
+          "$ref": "#/definitions/apiserviceAuthForgotPostResponseData"
+        }
+      }
+    },
+    "apiserviceAuthForgotPostResponseData": {
+      "type": "object",
+      "properties": {
+        "otpId": {
+          "type": "string"
+        },
+        "id": {
+          "type": "string"
+        }
+      }
+    },
+    "apiserviceAuthForgotResetPostRequest": {
+      "type": "object",
+      "properties": {
+        "otpId": {
+          "type": "string"
+        },
+        "encryptedPrivateKey": {
+          "type": "string"
+        },
+        "publicKey": {
+          "type": "string"
+        },
+        "otp": {
           "type": "string"
         }
-      }
+      },
+      "title": "rpc AuthOTPForgot(AuthForgotResetPostResponse) returns (AuthForgotResetPostResponse)"
     },
-    "apiserviceAdminAdvertiseManagementDeletePostResponse": {
+    "apiserviceAuthForgotResetPostResponse": {
       "type": "object",
       "properties": {
         "code": {
@@ -3182,14 +5721,23 @@ var APISERVICE_JSON = `{
           "type": "boolean"
         },
         "data": {
-          "$ref": "#/definitions/apiserviceAdminAdvertiseManagementDeletePostResponseData"
+          "$ref": "#/definitions/apiserviceAuthForgotResetPostResponseData"
         }
       }
     },
-    "apiserviceAdminAdvertiseManagementDeletePostResponseData": {
+    "apiserviceAuthForgotResetPostResponseData": {
       "type": "object"
     },
-    "apiserviceAdminAdvertiseManagementGetResponse": {
+    "apiserviceAuthLogoutAllPostRequest": {
+      "type": "object",
+      "properties": {
+        "UserId": {
+          "type": "string"
+        }
+      },
+      "title": "AuthLogoutAllPost(AuthLogoutAllPostRequest) returns (AuthLogoutAllPostResponse)"
+    },
+    "apiserviceAuthLogoutAllPostResponse": {
       "type": "object",
       "properties": {
         "code": {
@@ -3200,52 +5748,31 @@ var APISERVICE_JSON = `{
           "type": "boolean"
         },
         "data": {
-          "$ref": "#/definitions/apiserviceAdminAdvertiseManagementGetResponseData"
+          "$ref": "#/definitions/apiserviceAuthLogoutAllPostResponseData"
         }
       }
     },
-    "apiserviceAdminAdvertiseManagementGetResponseData": {
+    "apiserviceAuthLogoutAllPostResponseData": {
       "type": "object",
       "properties": {
-        "pagination": {
-          "$ref": "#/definitions/apiservicePagination"
-        },
-        "result": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/apiserviceAdvertisePackage"
-          }
+        "notBefore": {
+          "type": "string",
+          "format": "int64"
         }
       }
     },
-    "apiserviceAdminAdvertiseManagementPostRequest": {
+    "apiserviceAuthMailPostRequest": {
       "type": "object",
       "properties": {
-        "UserId": {
-          "type": "string"
-        },
-        "name": {
-          "type": "string"
-        },
-        "categoryIds": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
-        },
-        "price": {
-          "type": "number",
-          "format": "double"
-        },
-        "bannerUrl": {
+        "mail": {
           "type": "string"
         },
-        "description": {
+        "UserId": {
           "type": "string"
         }
       }
     },
-    "apiserviceAdminAdvertiseManagementPostResponse": {
+    "apiserviceAuthMailPostResponse": {
       "type": "object",
       "properties": {
         "code": {
@@ -3256,50 +5783,31 @@ var APISERVICE_JSON = `{
           "type": "boolean"
         },
         "data": {
-          "$ref": "#/definitions/apiserviceAdminAdvertiseManagementPostResponseData"
+          "$ref": "#/definitions/apiserviceAuthMailPostResponseData"
         }
       }
     },
-    "apiserviceAdminAdvertiseManagementPostResponseData": {
-      "type": "object"
-    },
-    "apiserviceAdminAdvertiseManagementPutResponse": {
+    "apiserviceAuthMailPostResponseData": {
       "type": "object",
       "properties": {
-        "code": {
-          "type": "integer",
-          "format": "int32"
-        },
-        "success": {
-          "type": "boolean"
-        },
-        "data": {
-          "$ref": "#/definitions/apiserviceAdminAdvertiseManagementPutResponseData"
+        "otpId": {
+          "type": "string"
         }
       }
     },
-    "apiserviceAdminAdvertiseManagementPutResponseData": {
-      "type": "object"
-    },
-    "apiserviceAdminBanUserPostResponse": {
+    "apiserviceAuthOTPPostRequest": {
       "type": "object",
       "properties": {
-        "code": {
-          "type": "integer",
-          "format": "int32"
-        },
-        "success": {
-          "type": "boolean"
+        "otpId": {
+          "type": "string"
         },
-        "data": {
-          "$ref": "#/definitions/apiserviceAdminBanUserPostResponseData"
+        "otp": {
+          "type": "string"
         }
-      }
-    },
-    "apiserviceAdminBanUserPostResponseData": {
-      "type": "object"
+      },
+      "title": "rpc AuthOTPPost(AuthOTPPostRequest) returns (AuthOTPPostResponse)"
     },
-    "apiserviceAdminBusinessBanPostResponse": {
+    "apiserviceAuthOTPPostResponse": {
       "type": "object",
       "properties": {
         "code": {
@@ -3310,32 +5818,29 @@ var APISERVICE_JSON = `{
           "type": "boolean"
         },
         "data": {
-          "$ref": "#/definitions/apiserviceAdminBusinessBanPostResponseData"
+          "$ref": "#/definitions/apiserviceAuthOTPPostResponseData"
         }
       }
     },
-    "apiserviceAdminBusinessBanPostResponseData": {
+    "apiserviceAuthOTPPostResponseData": {
       "type": "object"
     },
-    "apiserviceAdminBusinessDeletePostResponse": {
+    "apiserviceAuthPasswordPostRequest": {
       "type": "object",
       "properties": {
-        "code": {
-          "type": "integer",
-          "format": "int32"
+        "publicKey": {
+          "type": "string"
         },
-        "success": {
-          "type": "boolean"
+        "encryptedPrivateKey": {
+          "type": "string"
         },
-        "data": {
-          "$ref": "#/definitions/apiserviceAdminBusinessDeletePostResponseData"
+        "UserId": {
+          "type": "string"
         }
-      }
-    },
-    "apiserviceAdminBusinessDeletePostResponseData": {
-      "type": "object"
+      },
+      "title": "AuthPasswordPost(AuthPasswordPostRequest) returns (AuthPasswordPostResponse)"
     },
-    "apiserviceAdminBusinessesGetResponse": {
+    "apiserviceAuthPasswordPostResponse": {
       "type": "object",
       "properties": {
         "code": {
@@ -3346,25 +5851,25 @@ var APISERVICE_JSON = `{
           "type": "boolean"
         },
         "data": {
-          "$ref": "#/definitions/apiserviceAdminBusinessesGetResponseData"
+          "$ref": "#/definitions/apiserviceAuthPasswordPostResponseData"
         }
       }
     },
-    "apiserviceAdminBusinessesGetResponseData": {
+    "apiserviceAuthPasswordPostResponseData": {
+      "type": "object"
+    },
+    "apiserviceAuthPhonePostRequest": {
       "type": "object",
       "properties": {
-        "pagination": {
-          "$ref": "#/definitions/apiservicePagination"
+        "phone": {
+          "type": "string"
         },
-        "result": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/apiserviceBusiness"
-          }
+        "UserId": {
+          "type": "string"
         }
       }
     },
-    "apiserviceAdminBusinessesUnbanPostResponse": {
+    "apiserviceAuthPhonePostResponse": {
       "type": "object",
       "properties": {
         "code": {
@@ -3375,25 +5880,19 @@ var APISERVICE_JSON = `{
           "type": "boolean"
         },
         "data": {
-          "$ref": "#/definitions/apiserviceAdminBusinessesUnbanPostResponseData"
+          "$ref": "#/definitions/apiserviceAuthPhonePostResponseData"
         }
       }
     },
-    "apiserviceAdminBusinessesUnbanPostResponseData": {
-      "type": "object"
-    },
-    "apiserviceAdminCategoryPostDeleteRequest": {
+    "apiserviceAuthPhonePostResponseData": {
       "type": "object",
       "properties": {
-        "UserId": {
-          "type": "string"
-        },
-        "categoryId": {
+        "otpId": {
           "type": "string"
         }
       }
     },
-    "apiserviceAdminCategoryPostDeleteResponese": {
+    "apiserviceAuthPingResponse": {
       "type": "object",
       "properties": {
         "code": {
@@ -3404,35 +5903,48 @@ var APISERVICE_JSON = `{
           "type": "boolean"
         },
         "data": {
-          "$ref": "#/definitions/apiserviceAdminCategoryPostDeleteResponeseData"
+          "$ref": "#/definitions/apiserviceAuthPingResponseData"
         }
       }
     },
-    "apiserviceAdminCategoryPostDeleteResponeseData": {
-      "type": "object"
-    },
-    "apiserviceAdminCategoryPostEditRequest": {
+    "apiserviceAuthPingResponseData": {
       "type": "object",
       "properties": {
-        "UserId": {
-          "type": "string"
-        },
         "id": {
           "type": "string"
         },
-        "name": {
+        "role": {
+          "$ref": "#/definitions/constROLE"
+        },
+        "process": {
+          "$ref": "#/definitions/constREGISTRATION_PROCESS"
+        },
+        "image": {
           "type": "string"
         },
-        "fee": {
-          "type": "number",
-          "format": "float"
+        "user": {
+          "$ref": "#/definitions/apiserviceUser"
         },
-        "url": {
+        "business": {
+          "$ref": "#/definitions/apiserviceBusiness"
+        },
+        "permissions": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "apiserviceAuthResendOTPPostRequest": {
+      "type": "object",
+      "properties": {
+        "otpId": {
           "type": "string"
         }
       }
     },
-    "apiserviceAdminCategoryPostEditResponese": {
+    "apiserviceAuthResendOTPPostResponse": {
       "type": "object",
       "properties": {
         "code": {
@@ -3443,46 +5955,35 @@ var APISERVICE_JSON = `{
           "type": "boolean"
         },
         "data": {
-          "$ref": "#/definitions/apiserviceAdminCategoryPostEditResponeseData"
+          "$ref": "#/definitions/apiserviceAuthResendOTPPostResponseData"
         }
       }
     },
-    "apiserviceAdminCategoryPostEditResponeseData": {
-      "type": "object"
-    },
-    "apiserviceAdminCategoryPostRequest": {
+    "apiserviceAuthResendOTPPostResponseData": {
       "type": "object",
       "properties": {
-        "UserId": {
-          "type": "string"
-        },
-        "name": {
-          "type": "string"
-        },
-        "image": {
+        "otpId": {
           "type": "string"
         }
       }
     },
-    "apiserviceAdminCategoryPostResponese": {
+    "apiserviceAuthTokenPostRequest": {
       "type": "object",
       "properties": {
-        "code": {
-          "type": "integer",
-          "format": "int32"
+        "Certificate": {
+          "type": "string"
         },
-        "success": {
-          "type": "boolean"
+        "RawBody": {
+          "type": "string",
+          "format": "byte"
         },
-        "data": {
-          "$ref": "#/definitions/apiserviceAdminCategoryPostResponeseData"
+        "Method": {
+          "type": "string"
         }
-      }
-    },
-    "apiserviceAdminCategoryPostResponeseData": {
-      "type": "object"
+      },
+      "title": "AuthTokenPost(AuthTokenPostRequest) returns (AuthTokenPostResponse)"
     },
-    "apiserviceAdminGroupGetResponse": {
+    "apiserviceAuthTokenPostResponse": {
       "type": "object",
       "properties": {
         "code": {
@@ -3493,46 +5994,49 @@ var APISERVICE_JSON = `{
           "type": "boolean"
         },
         "data": {
-          "$ref": "#/definitions/apiserviceAdminGroupGetResponseData"
+          "$ref": "#/definitions/apiserviceAuthTokenPostResponseData"
         }
       }
     },
-    "apiserviceAdminGroupGetResponseData": {
+    "apiserviceAuthTokenPostResponseData": {
       "type": "object",
       "properties": {
-        "pagination": {
-          "$ref": "#/definitions/apiservicePagination"
+        "accessToken": {
+          "type": "string"
         },
-        "result": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/apiserviceGroup"
-          }
+        "expiresAt": {
+          "type": "string",
+          "format": "int64"
+        },
+        "refreshToken": {
+          "type": "string"
+        },
+        "refreshExpiresAt": {
+          "type": "string",
+          "format": "int64"
         }
       }
     },
-    "apiserviceAdminGroupPostRequest": {
+    "apiserviceAuthTokenRefreshPostRequest": {
       "type": "object",
       "properties": {
-        "UserId": {
+        "refreshToken": {
           "type": "string"
         },
-        "name": {
+        "Certificate": {
           "type": "string"
         },
-        "fee": {
-          "type": "number",
-          "format": "float"
+        "RawBody": {
+          "type": "string",
+          "format": "byte"
         },
-        "ServiceIds": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
+        "Method": {
+          "type": "string"
         }
-      }
+      },
+      "title": "AuthTokenRefreshPost(AuthTokenRefreshPostRequest) returns (AuthTokenRefreshPostResponse)"
     },
-    "apiserviceAdminGroupPostResponse": {
+    "apiserviceAuthTokenRefreshPostResponse": {
       "type": "object",
       "properties": {
         "code": {
@@ -3543,38 +6047,42 @@ var APISERVICE_JSON = `{
           "type": "boolean"
         },
         "data": {
-          "$ref": "#/definitions/apiserviceAdminGroupPostResponseData"
+          "$ref": "#/definitions/apiserviceAuthTokenRefreshPostResponseData"
         }
       }
     },
-    "apiserviceAdminGroupPostResponseData": {
-      "type": "object"
-    },
-    "apiserviceAdminGroupPutRequest": {
+    "apiserviceAuthTokenRefreshPostResponseData": {
       "type": "object",
       "properties": {
-        "UserId": {
+        "accessToken": {
           "type": "string"
         },
-        "name": {
-          "type": "string"
+        "expiresAt": {
+          "type": "string",
+          "format": "int64"
         },
-        "fee": {
-          "type": "number",
-          "format": "float"
+        "refreshToken": {
+          "type": "string"
         },
-        "ServiceIds": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
+        "refreshExpiresAt": {
+          "type": "string",
+          "format": "int64"
+        }
+      }
+    },
+    "apiserviceAuthTotpDisablePostRequest": {
+      "type": "object",
+      "properties": {
+        "code": {
+          "type": "string"
         },
-        "id": {
+        "UserId": {
           "type": "string"
         }
-      }
+      },
+      "title": "AuthTotpDisablePost(AuthTotpDisablePostRequest) returns (AuthTotpDisablePostResponse)"
     },
-    "apiserviceAdminUsersDeletePostResponse": {
+    "apiserviceAuthTotpDisablePostResponse": {
       "type": "object",
       "properties": {
         "code": {
@@ -3585,14 +6093,23 @@ var APISERVICE_JSON = `{
           "type": "boolean"
         },
         "data": {
-          "$ref": "#/definitions/apiserviceAdminUsersDeletePostResponseData"
+          "$ref": "#/definitions/apiserviceAuthTotpDisablePostResponseData"
         }
       }
     },
-    "apiserviceAdminUsersDeletePostResponseData": {
+    "apiserviceAuthTotpDisablePostResponseData": {
       "type": "object"
     },
-    "apiserviceAdminUsersGetResponse": {
+    "apiserviceAuthTotpEnrollPostRequest": {
+      "type": "object",
+      "properties": {
+        "UserId": {
+          "type": "string"
+        }
+      },
+      "title": "AuthTotpEnrollPost(AuthTotpEnrollPostRequest) returns (AuthTotpEnrollPostResponse)"
+    },
+    "apiserviceAuthTotpEnrollPostResponse": {
       "type": "object",
       "properties": {
         "code": {
@@ -3603,25 +6120,34 @@ var APISERVICE_JSON = `{
           "type": "boolean"
         },
         "data": {
-          "$ref": "#/definitions/apiserviceAdminUsersGetResponseData"
+          "$ref": "#/definitions/apiserviceAuthTotpEnrollPostResponseData"
         }
       }
     },
-    "apiserviceAdminUsersGetResponseData": {
+    "apiserviceAuthTotpEnrollPostResponseData": {
       "type": "object",
       "properties": {
-        "pagination": {
-          "$ref": "#/definitions/apiservicePagination"
+        "secret": {
+          "type": "string"
         },
-        "result": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/apiserviceUser"
-          }
+        "uri": {
+          "type": "string"
         }
       }
     },
-    "apiserviceAdminUsersUnbanPostResponse": {
+    "apiserviceAuthTotpVerifyPostRequest": {
+      "type": "object",
+      "properties": {
+        "code": {
+          "type": "string"
+        },
+        "UserId": {
+          "type": "string"
+        }
+      },
+      "title": "AuthTotpVerifyPost(AuthTotpVerifyPostRequest) returns (AuthTotpVerifyPostResponse)"
+    },
+    "apiserviceAuthTotpVerifyPostResponse": {
       "type": "object",
       "properties": {
         "code": {
@@ -3632,14 +6158,22 @@ var APISERVICE_JSON = `{
           "type": "boolean"
         },
         "data": {
-          "$ref": "#/definitions/apiserviceAdminUsersUnbanPostResponseData"
+          "$ref": "#/definitions/apiserviceAuthTotpVerifyPostResponseData"
         }
       }
     },
-    "apiserviceAdminUsersUnbanPostResponseData": {
-      "type": "object"
+    "apiserviceAuthTotpVerifyPostResponseData": {
+      "type": "object",
+      "properties": {
+        "recoveryCodes": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
     },
-    "apiserviceAdvertiseDetail": {
+    "apiserviceBusiness": {
       "type": "object",
       "properties": {
         "id": {
@@ -3648,60 +6182,61 @@ var APISERVICE_JSON = `{
         "name": {
           "type": "string"
         },
-        "serviceInfo": {
+        "phone": {
+          "type": "string"
+        },
+        "logoImage": {
+          "type": "string"
+        },
+        "bannerImage": {
+          "type": "string"
+        },
+        "contactId": {
+          "type": "string"
+        },
+        "website": {
+          "type": "string"
+        },
+        "descriptions": {
+          "type": "string"
+        },
+        "services": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/apiserviceServiceGroup"
+            "type": "string"
           }
         },
-        "price": {
-          "type": "number",
-          "format": "double"
-        },
-        "bannerUrl": {
+        "mail": {
           "type": "string"
         },
-        "description": {
+        "zipcode": {
           "type": "string"
         },
+        "status": {
+          "$ref": "#/definitions/constACCOUNT_STATUS"
+        },
+        "refStatus": {
+          "$ref": "#/definitions/constSTATUS_VERIFY_REFERRAL_CODE"
+        },
         "zipcodes": {
           "type": "array",
           "items": {
             "type": "string"
           }
-        }
-      }
-    },
-    "apiserviceAdvertiseDetailGetResponse": {
-      "type": "object",
-      "properties": {
-        "code": {
-          "type": "integer",
-          "format": "int32"
-        },
-        "success": {
-          "type": "boolean"
-        },
-        "data": {
-          "$ref": "#/definitions/apiserviceAdvertiseDetailGetResponseData"
-        }
-      }
-    },
-    "apiserviceAdvertiseDetailGetResponseData": {
-      "type": "object",
-      "properties": {
-        "pagination": {
-          "$ref": "#/definitions/apiservicePagination"
         },
-        "result": {
+        "serviceInfo": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/apiserviceAdvertiseDetail"
+            "$ref": "#/definitions/apiserviceServiceGroup"
           }
+        },
+        "startDate": {
+          "type": "string",
+          "format": "int64"
         }
       }
     },
-    "apiserviceAdvertiseGetResponse": {
+    "apiserviceBusinessAdvertiseOrderGetResponse": {
       "type": "object",
       "properties": {
         "code": {
@@ -3712,11 +6247,11 @@ var APISERVICE_JSON = `{
           "type": "boolean"
         },
         "data": {
-          "$ref": "#/definitions/apiserviceAdvertiseGetResponseData"
+          "$ref": "#/definitions/apiserviceBusinessAdvertiseOrderGetResponseData"
         }
       }
     },
-    "apiserviceAdvertiseGetResponseData": {
+    "apiserviceBusinessAdvertiseOrderGetResponseData": {
       "type": "object",
       "properties": {
         "pagination": {
@@ -3725,21 +6260,21 @@ var APISERVICE_JSON = `{
         "result": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/apiserviceAdvertisePackage"
+            "$ref": "#/definitions/apiserviceAdvertiseOrder"
           }
         }
       }
     },
-    "apiserviceAdvertiseOrder": {
+    "apiserviceBusinessBuyAdvertisePostRequest": {
       "type": "object",
       "properties": {
-        "id": {
+        "UserId": {
           "type": "string"
         },
-        "name": {
+        "advertisePackageId": {
           "type": "string"
         },
-        "categoryName": {
+        "packageName": {
           "type": "string"
         },
         "price": {
@@ -3755,6 +6290,12 @@ var APISERVICE_JSON = `{
         "zipcode": {
           "type": "string"
         },
+        "categoryName": {
+          "type": "string"
+        },
+        "categoryId": {
+          "type": "string"
+        },
         "startDate": {
           "type": "string",
           "format": "int64"
@@ -3762,60 +6303,66 @@ var APISERVICE_JSON = `{
         "endDate": {
           "type": "string",
           "format": "int64"
+        }
+      }
+    },
+    "apiserviceBusinessBuyAdvertisePostResponse": {
+      "type": "object",
+      "properties": {
+        "code": {
+          "type": "integer",
+          "format": "int32"
         },
-        "UserId": {
-          "type": "string"
+        "success": {
+          "type": "boolean"
         },
-        "advertisePackageId": {
-          "type": "string"
+        "data": {
+          "$ref": "#/definitions/apiserviceBusinessBuyAdvertisePostResponseData"
         }
       }
     },
-    "apiserviceAdvertisePackage": {
+    "apiserviceBusinessBuyAdvertisePostResponseData": {
+      "type": "object"
+    },
+    "apiserviceBusinessBuyAdvertiseSetupPostRequest": {
       "type": "object",
       "properties": {
-        "id": {
-          "type": "string"
-        },
-        "name": {
+        "UserId": {
           "type": "string"
         },
-        "serviceInfo": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/apiserviceServiceGroup"
-          }
-        },
-        "price": {
-          "type": "number",
-          "format": "double"
-        },
-        "bannerUrl": {
-          "type": "string"
+        "amount": {
+          "type": "string",
+          "format": "int64"
         },
-        "description": {
+        "paymentId": {
           "type": "string"
         }
       }
     },
-    "apiserviceAuthChangeMailAndPassPostRequest": {
+    "apiserviceBusinessBuyAdvertiseSetupPostResponse": {
       "type": "object",
       "properties": {
-        "mail": {
-          "type": "string"
-        },
-        "encryptedPrivateKey": {
-          "type": "string"
+        "code": {
+          "type": "integer",
+          "format": "int32"
         },
-        "UserId": {
-          "type": "string"
+        "success": {
+          "type": "boolean"
         },
-        "publicKey": {
+        "data": {
+          "$ref": "#/definitions/apiserviceBusinessBuyAdvertiseSetupPostResponseData"
+        }
+      }
+    },
+    "apiserviceBusinessBuyAdvertiseSetupPostResponseData": {
+      "type": "object",
+      "properties": {
+        "status": {
           "type": "string"
         }
       }
     },
-    "apiserviceAuthChangeMailAndPassPostResponse": {
+    "apiserviceBusinessFeedbacksGetResponse": {
       "type": "object",
       "properties": {
         "code": {
@@ -3826,14 +6373,25 @@ var APISERVICE_JSON = `{
           "type": "boolean"
         },
         "data": {
-          "$ref": "#/definitions/apiserviceAuthChangeMailAndPassPostResponseData"
+          "$ref": "#/definitions/apiserviceBusinessFeedbacksGetResponseData"
         }
       }
     },
-    "apiserviceAuthChangeMailAndPassPostResponseData": {
-      "type": "object"
+    "apiserviceBusinessFeedbacksGetResponseData": {
+      "type": "object",
+      "properties": {
+        "pagination": {
+          "$ref": "#/definitions/apiservicePagination"
+        },
+        "result": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/apiserviceFeedback"
+          }
+        }
+      }
     },
-    "apiserviceAuthCheckGetResponse": {
+    "apiserviceBusinessFreeContactGetResponse": {
       "type": "object",
       "properties": {
         "code": {
@@ -3844,28 +6402,20 @@ var APISERVICE_JSON = `{
           "type": "boolean"
         },
         "data": {
-          "$ref": "#/definitions/apiserviceAuthCheckGetResponseData"
+          "$ref": "#/definitions/apiserviceBusinessFreeContactGetResponseData"
         }
       }
     },
-    "apiserviceAuthCheckGetResponseData": {
+    "apiserviceBusinessFreeContactGetResponseData": {
       "type": "object",
       "properties": {
-        "existed": {
-          "type": "boolean"
+        "number": {
+          "type": "integer",
+          "format": "int32"
         }
       }
     },
-    "apiserviceAuthCredentialRequest": {
-      "type": "object",
-      "properties": {
-        "identifier": {
-          "type": "string"
-        }
-      },
-      "title": "AuthCredential(AuthCredentialRequest) returns (AuthCredentialResponse)"
-    },
-    "apiserviceAuthCredentialResponse": {
+    "apiserviceBusinessGetResponse": {
       "type": "object",
       "properties": {
         "code": {
@@ -3876,40 +6426,45 @@ var APISERVICE_JSON = `{
           "type": "boolean"
         },
         "data": {
-          "$ref": "#/definitions/apiserviceAuthCredentialResponseData"
+          "$ref": "#/definitions/apiserviceBusinessGetResponseData"
         }
       }
     },
-    "apiserviceAuthCredentialResponseData": {
+    "apiserviceBusinessGetResponseData": {
       "type": "object",
       "properties": {
-        "id": {
-          "type": "string"
-        },
-        "publicKey": {
-          "type": "string"
-        },
-        "encryptedPrivateKey": {
-          "type": "string"
+        "business": {
+          "$ref": "#/definitions/apiserviceBusiness"
+        }
+      }
+    },
+    "apiserviceBusinessInterestGetResponse": {
+      "type": "object",
+      "properties": {
+        "code": {
+          "type": "integer",
+          "format": "int32"
         },
-        "shouldChangeMail": {
+        "success": {
           "type": "boolean"
         },
-        "isDefaultPassword": {
-          "type": "boolean"
+        "data": {
+          "$ref": "#/definitions/apiserviceBusinessInterestGetResponseData"
         }
       }
     },
-    "apiserviceAuthForgotPostRequest": {
+    "apiserviceBusinessInterestGetResponseData": {
       "type": "object",
       "properties": {
-        "mail": {
-          "type": "string"
+        "result": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/apiserviceBusinessRating"
+          }
         }
-      },
-      "title": "rpc AuthPasswordForgotPost(AuthForgotPostRequest) returns (AuthForgotPostResponse)"
+      }
     },
-    "apiserviceAuthForgotPostResponse": {
+    "apiserviceBusinessInvitationCodeGetResponse": {
       "type": "object",
       "properties": {
         "code": {
@@ -3920,40 +6475,33 @@ var APISERVICE_JSON = `{
           "type": "boolean"
         },
         "data": {
-          "$ref": "#/definitions/apiserviceAuthForgotPostResponseData"
+          "$ref": "#/definitions/apiserviceBusinessInvitationCodeGetResponseData"
         }
       }
     },
-    "apiserviceAuthForgotPostResponseData": {
+    "apiserviceBusinessInvitationCodeGetResponseData": {
       "type": "object",
       "properties": {
-        "otpId": {
-          "type": "string"
-        },
-        "id": {
+        "code": {
           "type": "string"
         }
       }
     },
-    "apiserviceAuthForgotResetPostRequest": {
+    "apiserviceBusinessMembersAcceptPostRequest": {
       "type": "object",
       "properties": {
-        "otpId": {
-          "type": "string"
-        },
-        "encryptedPrivateKey": {
+        "UserId": {
           "type": "string"
         },
-        "publicKey": {
+        "otpId": {
           "type": "string"
         },
         "otp": {
           "type": "string"
         }
-      },
-      "title": "rpc AuthOTPForgot(AuthForgotResetPostResponse) returns (AuthForgotResetPostResponse)"
+      }
     },
-    "apiserviceAuthForgotResetPostResponse": {
+    "apiserviceBusinessMembersAcceptPostResponse": {
       "type": "object",
       "properties": {
         "code": {
@@ -3964,25 +6512,22 @@ var APISERVICE_JSON = `{
           "type": "boolean"
         },
         "data": {
-          "$ref": "#/definitions/apiserviceAuthForgotResetPostResponseData"
+          "$ref": "#/definitions/apiserviceBusinessMembersAcceptPostResponseData"
         }
       }
     },
-    "apiserviceAuthForgotResetPostResponseData": {
-      "type": "object"
-    },
-    "apiserviceAuthMailPostRequest": {
+    "apiserviceBusinessMembersAcceptPostResponseData": {
       "type": "object",
       "properties": {
-        "mail": {
+        "businessId": {
           "type": "string"
         },
-        "UserId": {
-          "type": "string"
+        "role": {
+          "$ref": "#/definitions/constBUSINESS_ROLE"
         }
       }
     },
-    "apiserviceAuthMailPostResponse": {
+    "apiserviceBusinessMembersDeletePostResponse": {
       "type": "object",
       "properties": {
         "code": {
@@ -3993,31 +6538,14 @@ var APISERVICE_JSON = `{
           "type": "boolean"
         },
         "data": {
-          "$ref": "#/definitions/apiserviceAuthMailPostResponseData"
-        }
-      }
-    },
-    "apiserviceAuthMailPostResponseData": {
-      "type": "object",
-      "properties": {
-        "otpId": {
-          "type": "string"
+          "$ref": "#/definitions/apiserviceBusinessMembersDeletePostResponseData"
         }
       }
     },
-    "apiserviceAuthOTPPostRequest": {
-      "type": "object",
-      "properties": {
-        "otpId": {
-          "type": "string"
-        },
-        "otp": {
-          "type": "string"
-        }
-      },
-      "title": "rpc AuthOTPPost(AuthOTPPostRequest) returns (AuthOTPPostResponse)"
+    "apiserviceBusinessMembersDeletePostResponseData": {
+      "type": "object"
     },
-    "apiserviceAuthOTPPostResponse": {
+    "apiserviceBusinessMembersGetResponse": {
       "type": "object",
       "properties": {
         "code": {
@@ -4028,29 +6556,39 @@ var APISERVICE_JSON = `{
           "type": "boolean"
         },
         "data": {
-          "$ref": "#/definitions/apiserviceAuthOTPPostResponseData"
+          "$ref": "#/definitions/apiserviceBusinessMembersGetResponseData"
         }
       }
     },
-    "apiserviceAuthOTPPostResponseData": {
-      "type": "object"
+    "apiserviceBusinessMembersGetResponseData": {
+      "type": "object",
+      "properties": {
+        "result": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/apiserviceMember"
+          }
+        }
+      }
     },
-    "apiserviceAuthPasswordPostRequest": {
+    "apiserviceBusinessMembersInvitePostRequest": {
       "type": "object",
       "properties": {
-        "publicKey": {
+        "UserId": {
           "type": "string"
         },
-        "encryptedPrivateKey": {
+        "BusinessId": {
           "type": "string"
         },
-        "UserId": {
+        "mail": {
           "type": "string"
+        },
+        "role": {
+          "$ref": "#/definitions/constBUSINESS_ROLE"
         }
-      },
-      "title": "AuthPasswordPost(AuthPasswordPostRequest) returns (AuthPasswordPostResponse)"
+      }
     },
-    "apiserviceAuthPasswordPostResponse": {
+    "apiserviceBusinessMembersInvitePostResponse": {
       "type": "object",
       "properties": {
         "code": {
@@ -4061,14 +6599,19 @@ var APISERVICE_JSON = `{
           "type": "boolean"
         },
         "data": {
-          "$ref": "#/definitions/apiserviceAuthPasswordPostResponseData"
+          "$ref": "#/definitions/apiserviceBusinessMembersInvitePostResponseData"
         }
       }
     },
-    "apiserviceAuthPasswordPostResponseData": {
-      "type": "object"
+    "apiserviceBusinessMembersInvitePostResponseData": {
+      "type": "object",
+      "properties": {
+        "member": {
+          "$ref": "#/definitions/apiserviceMember"
+        }
+      }
     },
-    "apiserviceAuthPingResponse": {
+    "apiserviceBusinessNearGetResponse": {
       "type": "object",
       "properties": {
         "code": {
@@ -4079,42 +6622,30 @@ var APISERVICE_JSON = `{
           "type": "boolean"
         },
         "data": {
-          "$ref": "#/definitions/apiserviceAuthPingResponseData"
+          "$ref": "#/definitions/apiserviceBusinessNearGetResponseData"
         }
       }
     },
-    "apiserviceAuthPingResponseData": {
+    "apiserviceBusinessNearGetResponseData": {
       "type": "object",
       "properties": {
-        "id": {
-          "type": "string"
-        },
-        "role": {
-          "$ref": "#/definitions/constROLE"
-        },
-        "process": {
-          "$ref": "#/definitions/constREGISTRATION_PROCESS"
-        },
-        "image": {
-          "type": "string"
-        },
-        "user": {
-          "$ref": "#/definitions/apiserviceUser"
-        },
-        "business": {
-          "$ref": "#/definitions/apiserviceBusiness"
+        "result": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/apiserviceBusinessRating"
+          }
         }
       }
     },
-    "apiserviceAuthResendOTPPostRequest": {
+    "apiserviceBusinessPaymentMethodDeletePostRequest": {
       "type": "object",
       "properties": {
-        "otpId": {
+        "UserId": {
           "type": "string"
         }
       }
     },
-    "apiserviceAuthResendOTPPostResponse": {
+    "apiserviceBusinessPaymentMethodDeletePostResponse": {
       "type": "object",
       "properties": {
         "code": {
@@ -4125,82 +6656,48 @@ var APISERVICE_JSON = `{
           "type": "boolean"
         },
         "data": {
-          "$ref": "#/definitions/apiserviceAuthResendOTPPostResponseData"
+          "$ref": "#/definitions/apiserviceBusinessPaymentMethodDeletePostResponseData"
         }
       }
     },
-    "apiserviceAuthResendOTPPostResponseData": {
-      "type": "object",
-      "properties": {
-        "otpId": {
-          "type": "string"
-        }
-      }
+    "apiserviceBusinessPaymentMethodDeletePostResponseData": {
+      "type": "object"
     },
-    "apiserviceBusiness": {
+    "apiserviceBusinessPaymentMethodGetResponse": {
       "type": "object",
       "properties": {
-        "id": {
-          "type": "string"
-        },
-        "name": {
-          "type": "string"
-        },
-        "phone": {
-          "type": "string"
-        },
-        "logoImage": {
-          "type": "string"
-        },
-        "bannerImage": {
-          "type": "string"
-        },
-        "contactId": {
-          "type": "string"
-        },
-        "website": {
-          "type": "string"
-        },
-        "descriptions": {
-          "type": "string"
-        },
-        "services": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
-        },
-        "mail": {
-          "type": "string"
-        },
-        "zipcode": {
-          "type": "string"
-        },
-        "status": {
-          "$ref": "#/definitions/constACCOUNT_STATUS"
-        },
-        "refStatus": {
-          "$ref": "#/definitions/constSTATUS_VERIFY_REFERRAL_CODE"
+        "code": {
+          "type": "integer",
+          "format": "int32"
         },
-        "zipcodes": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
+        "success": {
+          "type": "boolean"
         },
-        "serviceInfo": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/apiserviceServiceGroup"
-          }
+        "data": {
+          "$ref": "#/definitions/apiserviceBusinessPaymentMethodGetResponseData"
+        }
+      }
+    },
+    "apiserviceBusinessPaymentMethodGetResponseData": {
+      "type": "object",
+      "properties": {
+        "payment": {
+          "$ref": "#/definitions/apiservicePayment"
+        }
+      }
+    },
+    "apiserviceBusinessPaymentMethodPostRequest": {
+      "type": "object",
+      "properties": {
+        "UserId": {
+          "type": "string"
         },
-        "startDate": {
-          "type": "string",
-          "format": "int64"
+        "paymentMethodId": {
+          "type": "string"
         }
       }
     },
-    "apiserviceBusinessAdvertiseOrderGetResponse": {
+    "apiserviceBusinessPaymentMethodPostResponse": {
       "type": "object",
       "properties": {
         "code": {
@@ -4211,66 +6708,68 @@ var APISERVICE_JSON = `{
           "type": "boolean"
         },
         "data": {
-          "$ref": "#/definitions/apiserviceBusinessAdvertiseOrderGetResponseData"
+          "$ref": "#/definitions/apiserviceBusinessPaymentMethodPostResponseData"
         }
       }
     },
-    "apiserviceBusinessAdvertiseOrderGetResponseData": {
+    "apiserviceBusinessPaymentMethodPostResponseData": {
+      "type": "object"
+    },
+    "apiserviceBusinessPaymentMethodSetupPostRequest": {
       "type": "object",
       "properties": {
-        "pagination": {
-          "$ref": "#/definitions/apiservicePagination"
-        },
-        "result": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/apiserviceAdvertiseOrder"
-          }
+        "UserId": {
+          "type": "string"
         }
       }
     },
-    "apiserviceBusinessBuyAdvertisePostRequest": {
+    "apiserviceBusinessPaymentMethodSetupPostResponse": {
       "type": "object",
       "properties": {
-        "UserId": {
-          "type": "string"
+        "code": {
+          "type": "integer",
+          "format": "int32"
         },
-        "advertisePackageId": {
-          "type": "string"
+        "success": {
+          "type": "boolean"
         },
-        "packageName": {
+        "data": {
+          "$ref": "#/definitions/apiserviceBusinessPaymentMethodSetupPostResponseData"
+        }
+      }
+    },
+    "apiserviceBusinessPaymentMethodSetupPostResponseData": {
+      "type": "object",
+      "properties": {
+        "clientSecret": {
           "type": "string"
-        },
-        "price": {
-          "type": "number",
-          "format": "double"
-        },
-        "bannerUrl": {
+        }
+      }
+    },
+    "apiserviceBusinessPostRequest": {
+      "type": "object",
+      "properties": {
+        "mail": {
           "type": "string"
         },
-        "description": {
+        "phone": {
           "type": "string"
         },
-        "zipcode": {
+        "publicKey": {
           "type": "string"
         },
-        "categoryName": {
+        "encryptedPrivateKey": {
           "type": "string"
         },
-        "categoryId": {
+        "refCode": {
           "type": "string"
         },
-        "startDate": {
-          "type": "string",
-          "format": "int64"
-        },
-        "endDate": {
-          "type": "string",
-          "format": "int64"
+        "channel": {
+          "$ref": "#/definitions/constOTP_CHANNEL"
         }
       }
     },
-    "apiserviceBusinessBuyAdvertisePostResponse": {
+    "apiserviceBusinessPostResponse": {
       "type": "object",
       "properties": {
         "code": {
@@ -4281,29 +6780,25 @@ var APISERVICE_JSON = `{
           "type": "boolean"
         },
         "data": {
-          "$ref": "#/definitions/apiserviceBusinessBuyAdvertisePostResponseData"
+          "$ref": "#/definitions/apiserviceBusinessPostResponseData"
         }
       }
     },
-    "apiserviceBusinessBuyAdvertisePostResponseData": {
-      "type": "object"
-    },
-    "apiserviceBusinessBuyAdvertiseSetupPostRequest": {
+    "apiserviceBusinessPostResponseData": {
       "type": "object",
       "properties": {
-        "UserId": {
+        "otpId": {
           "type": "string"
         },
-        "amount": {
-          "type": "string",
-          "format": "int64"
+        "mail": {
+          "type": "string"
         },
-        "paymentId": {
+        "phone": {
           "type": "string"
         }
       }
     },
-    "apiserviceBusinessBuyAdvertiseSetupPostResponse": {
+    "apiserviceBusinessPutResponse": {
       "type": "object",
       "properties": {
         "code": {
@@ -4314,19 +6809,30 @@ var APISERVICE_JSON = `{
           "type": "boolean"
         },
         "data": {
-          "$ref": "#/definitions/apiserviceBusinessBuyAdvertiseSetupPostResponseData"
+          "$ref": "#/definitions/apiserviceBusinessPutResponseData"
         }
       }
     },
-    "apiserviceBusinessBuyAdvertiseSetupPostResponseData": {
+    "apiserviceBusinessPutResponseData": {
       "type": "object",
       "properties": {
-        "status": {
-          "type": "string"
+        "business": {
+          "$ref": "#/definitions/apiserviceBusiness"
         }
       }
     },
-    "apiserviceBusinessFeedbacksGetResponse": {
+    "apiserviceBusinessRating": {
+      "type": "object",
+      "properties": {
+        "business": {
+          "$ref": "#/definitions/apiserviceBusiness"
+        },
+        "rating": {
+          "$ref": "#/definitions/apiserviceRating"
+        }
+      }
+    },
+    "apiserviceBusinessRatingGetResponse": {
       "type": "object",
       "properties": {
         "code": {
@@ -4337,25 +6843,22 @@ var APISERVICE_JSON = `{
           "type": "boolean"
         },
         "data": {
-          "$ref": "#/definitions/apiserviceBusinessFeedbacksGetResponseData"
+          "$ref": "#/definitions/apiserviceBusinessRatingGetResponseData"
         }
       }
     },
-    "apiserviceBusinessFeedbacksGetResponseData": {
+    "apiserviceBusinessRatingGetResponseData": {
       "type": "object",
       "properties": {
-        "pagination": {
-          "$ref": "#/definitions/apiservicePagination"
-        },
-        "result": {
+        "rate": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/apiserviceFeedback"
+            "$ref": "#/definitions/apiserviceRating"
           }
         }
       }
     },
-    "apiserviceBusinessFreeContactGetResponse": {
+    "apiserviceBusinessServiceGetResponse": {
       "type": "object",
       "properties": {
         "code": {
@@ -4366,20 +6869,22 @@ var APISERVICE_JSON = `{
           "type": "boolean"
         },
         "data": {
-          "$ref": "#/definitions/apiserviceBusinessFreeContactGetResponseData"
+          "$ref": "#/definitions/apiserviceBusinessServiceGetResponseData"
         }
       }
     },
-    "apiserviceBusinessFreeContactGetResponseData": {
+    "apiserviceBusinessServiceGetResponseData": {
       "type": "object",
       "properties": {
-        "number": {
-          "type": "integer",
-          "format": "int32"
+        "result": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/apiserviceService"
+          }
         }
       }
     },
-    "apiserviceBusinessGetResponse": {
+    "apiserviceBusinessServicesPutResponse": {
       "type": "object",
       "properties": {
         "code": {
@@ -4390,19 +6895,22 @@ var APISERVICE_JSON = `{
           "type": "boolean"
         },
         "data": {
-          "$ref": "#/definitions/apiserviceBusinessGetResponseData"
+          "$ref": "#/definitions/apiserviceBusinessServicesPutResponseData"
         }
       }
     },
-    "apiserviceBusinessGetResponseData": {
+    "apiserviceBusinessServicesPutResponseData": {
       "type": "object",
       "properties": {
-        "business": {
-          "$ref": "#/definitions/apiserviceBusiness"
+        "result": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/apiserviceService"
+          }
         }
       }
     },
-    "apiserviceBusinessInterestGetResponse": {
+    "apiserviceBusinessTransactionsGetResponse": {
       "type": "object",
       "properties": {
         "code": {
@@ -4413,22 +6921,43 @@ var APISERVICE_JSON = `{
           "type": "boolean"
         },
         "data": {
-          "$ref": "#/definitions/apiserviceBusinessInterestGetResponseData"
+          "$ref": "#/definitions/apiserviceBusinessTransactionsGetResponseData"
         }
       }
     },
-    "apiserviceBusinessInterestGetResponseData": {
+    "apiserviceBusinessTransactionsGetResponseData": {
       "type": "object",
       "properties": {
         "result": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/apiserviceBusinessRating"
+            "$ref": "#/definitions/apiserviceTransaction"
           }
+        },
+        "pagination": {
+          "$ref": "#/definitions/apiservicePagination"
+        },
+        "totalFee": {
+          "type": "number",
+          "format": "float"
         }
       }
     },
-    "apiserviceBusinessInvitationCodeGetResponse": {
+    "apiserviceBusinessValidateBuyAdvertisePostRequest": {
+      "type": "object",
+      "properties": {
+        "UserId": {
+          "type": "string"
+        },
+        "categoryId": {
+          "type": "string"
+        },
+        "zipcode": {
+          "type": "string"
+        }
+      }
+    },
+    "apiserviceBusinessValidateBuyAdvertisePostResponse": {
       "type": "object",
       "properties": {
         "code": {
@@ -4439,19 +6968,32 @@ var APISERVICE_JSON = `{
           "type": "boolean"
         },
         "data": {
-          "$ref": "#/definitions/apiserviceBusinessInvitationCodeGetResponseData"
+          "$ref": "#/definitions/apiserviceBusinessValidateBuyAdvertisePostResponseData"
         }
       }
     },
-    "apiserviceBusinessInvitationCodeGetResponseData": {
+    "apiserviceBusinessValidateBuyAdvertisePostResponseData": {
+      "type": "object"
+    },
+    "apiserviceBusinessVerifyRefCodePutResponse": {
       "type": "object",
       "properties": {
         "code": {
-          "type": "string"
+          "type": "integer",
+          "format": "int32"
+        },
+        "success": {
+          "type": "boolean"
+        },
+        "data": {
+          "$ref": "#/definitions/apiserviceBusinessVerifyRefCodePutResponseData"
         }
       }
     },
-    "apiserviceBusinessNearGetResponse": {
+    "apiserviceBusinessVerifyRefCodePutResponseData": {
+      "type": "object"
+    },
+    "apiserviceBusinessesAlreadyOrderedGetResponse": {
       "type": "object",
       "properties": {
         "code": {
@@ -4462,11 +7004,37 @@ var APISERVICE_JSON = `{
           "type": "boolean"
         },
         "data": {
-          "$ref": "#/definitions/apiserviceBusinessNearGetResponseData"
+          "$ref": "#/definitions/apiserviceBusinessesAlreadyOrderedGetResponseData"
         }
       }
     },
-    "apiserviceBusinessNearGetResponseData": {
+    "apiserviceBusinessesAlreadyOrderedGetResponseData": {
+      "type": "object",
+      "properties": {
+        "businessId": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "apiserviceBusinessesGetResponse": {
+      "type": "object",
+      "properties": {
+        "code": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "success": {
+          "type": "boolean"
+        },
+        "data": {
+          "$ref": "#/definitions/apiserviceBusinessesGetResponseData"
+        }
+      }
+    },
+    "apiserviceBusinessesGetResponseData": {
       "type": "object",
       "properties": {
         "result": {
@@ -4474,18 +7042,34 @@ var APISERVICE_JSON = `{
           "items": {
             "$ref": "#/definitions/apiserviceBusinessRating"
           }
+        },
+        "pagination": {
+          "$ref": "#/definitions/apiservicePagination"
         }
       }
     },
-    "apiserviceBusinessPaymentMethodDeletePostRequest": {
+    "apiserviceCancelProjectPostRequest": {
       "type": "object",
       "properties": {
         "UserId": {
           "type": "string"
+        },
+        "zipcode": {
+          "type": "string"
+        },
+        "categoryId": {
+          "type": "string"
+        },
+        "reason": {
+          "type": "string",
+          "title": "reason is an ORDER_REASON name"
+        },
+        "note": {
+          "type": "string"
         }
       }
     },
-    "apiserviceBusinessPaymentMethodDeletePostResponse": {
+    "apiserviceCancelProjectPostResponse": {
       "type": "object",
       "properties": {
         "code": {
@@ -4496,14 +7080,14 @@ var APISERVICE_JSON = `{
           "type": "boolean"
         },
         "data": {
-          "$ref": "#/definitions/apiserviceBusinessPaymentMethodDeletePostResponseData"
+          "$ref": "#/definitions/apiserviceCancelProjectPostResponseData"
         }
       }
     },
-    "apiserviceBusinessPaymentMethodDeletePostResponseData": {
+    "apiserviceCancelProjectPostResponseData": {
       "type": "object"
     },
-    "apiserviceBusinessPaymentMethodGetResponse": {
+    "apiserviceCategoriesGetResponse": {
       "type": "object",
       "properties": {
         "code": {
@@ -4514,30 +7098,57 @@ var APISERVICE_JSON = `{
           "type": "boolean"
         },
         "data": {
-          "$ref": "#/definitions/apiserviceBusinessPaymentMethodGetResponseData"
+          "$ref": "#/definitions/apiserviceCategoriesGetResponseData"
         }
       }
     },
-    "apiserviceBusinessPaymentMethodGetResponseData": {
+    "apiserviceCategoriesGetResponseData": {
       "type": "object",
       "properties": {
-        "payment": {
-          "$ref": "#/definitions/apiservicePayment"
+        "result": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/apiserviceCategory"
+          }
+        },
+        "pagination": {
+          "$ref": "#/definitions/apiservicePagination"
         }
       }
     },
-    "apiserviceBusinessPaymentMethodPostRequest": {
+    "apiserviceCategory": {
       "type": "object",
       "properties": {
-        "UserId": {
+        "id": {
           "type": "string"
         },
-        "paymentMethodId": {
+        "name": {
+          "type": "string"
+        },
+        "totalProvider": {
+          "type": "string",
+          "format": "int64"
+        },
+        "fee": {
+          "type": "number",
+          "format": "float"
+        },
+        "image": {
           "type": "string"
+        },
+        "questions": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/apiserviceCategoryQuestion"
+          }
+        },
+        "orderExpiryHours": {
+          "type": "integer",
+          "format": "int32"
         }
       }
     },
-    "apiserviceBusinessPaymentMethodPostResponse": {
+    "apiserviceCategoryGetResponse": {
       "type": "object",
       "properties": {
         "code": {
@@ -4548,65 +7159,94 @@ var APISERVICE_JSON = `{
           "type": "boolean"
         },
         "data": {
-          "$ref": "#/definitions/apiserviceBusinessPaymentMethodPostResponseData"
+          "$ref": "#/definitions/apiserviceCategoryGetResponseData"
         }
       }
     },
-    "apiserviceBusinessPaymentMethodPostResponseData": {
-      "type": "object"
-    },
-    "apiserviceBusinessPaymentMethodSetupPostRequest": {
+    "apiserviceCategoryGetResponseData": {
       "type": "object",
       "properties": {
-        "UserId": {
-          "type": "string"
+        "category": {
+          "$ref": "#/definitions/apiserviceCategory"
         }
       }
     },
-    "apiserviceBusinessPaymentMethodSetupPostResponse": {
+    "apiserviceCategoryQuestion": {
       "type": "object",
       "properties": {
-        "code": {
-          "type": "integer",
-          "format": "int32"
+        "key": {
+          "type": "string"
         },
-        "success": {
+        "label": {
+          "type": "string"
+        },
+        "type": {
+          "$ref": "#/definitions/constQUESTION_TYPE"
+        },
+        "required": {
           "type": "boolean"
         },
-        "data": {
-          "$ref": "#/definitions/apiserviceBusinessPaymentMethodSetupPostResponseData"
+        "options": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "title": "options are the allowed answers of CHOICE questions"
         }
-      }
+      },
+      "description": "CategoryQuestion is a question customers answer about the job when they\npost an order for a category. Key identifies it in answers."
     },
-    "apiserviceBusinessPaymentMethodSetupPostResponseData": {
+    "apiserviceCompletion": {
       "type": "object",
       "properties": {
-        "clientSecret": {
-          "type": "string"
+        "requestedBy": {
+          "$ref": "#/definitions/constROLE"
+        },
+        "requestedAt": {
+          "type": "string",
+          "format": "int64"
+        },
+        "confirmBy": {
+          "type": "string",
+          "format": "int64"
+        },
+        "confirmation": {
+          "$ref": "#/definitions/constCOMPLETION_CONFIRMATION"
+        },
+        "completedAt": {
+          "type": "string",
+          "format": "int64"
         }
-      }
+      },
+      "description": "Completion is the confirmation of a job marked done, set once an order is\nawaiting confirmation or completed. confirmBy is when it auto-confirms."
     },
-    "apiserviceBusinessPostRequest": {
+    "apiserviceContact": {
       "type": "object",
       "properties": {
-        "mail": {
+        "id": {
           "type": "string"
         },
-        "phone": {
+        "zipcode": {
           "type": "string"
         },
-        "publicKey": {
+        "address1": {
           "type": "string"
         },
-        "encryptedPrivateKey": {
+        "address2": {
           "type": "string"
         },
-        "refCode": {
+        "state": {
+          "type": "string"
+        },
+        "city": {
+          "type": "string"
+        },
+        "stateId": {
           "type": "string"
         }
       }
     },
-    "apiserviceBusinessPostResponse": {
+    "apiserviceContactGetResponse": {
       "type": "object",
       "properties": {
         "code": {
@@ -4617,25 +7257,19 @@ var APISERVICE_JSON = `{
           "type": "boolean"
         },
         "data": {
-          "$ref": "#/definitions/apiserviceBusinessPostResponseData"
+          "$ref": "#/definitions/apiserviceContactGetResponseData"
         }
       }
     },
-    "apiserviceBusinessPostResponseData": {
+    "apiserviceContactGetResponseData": {
       "type": "object",
       "properties": {
-        "otpId": {
-          "type": "string"
-        },
-        "mail": {
-          "type": "string"
-        },
-        "phone": {
-          "type": "string"
+        "contact": {
+          "$ref": "#/definitions/apiserviceContact"
         }
       }
     },
-    "apiserviceBusinessPutResponse": {
+    "apiserviceContactPutResponse": {
       "type": "object",
       "properties": {
         "code": {
@@ -4646,56 +7280,62 @@ var APISERVICE_JSON = `{
           "type": "boolean"
         },
         "data": {
-          "$ref": "#/definitions/apiserviceBusinessPutResponseData"
+          "$ref": "#/definitions/apiserviceContactPutResponseData"
         }
       }
     },
-    "apiserviceBusinessPutResponseData": {
+    "apiserviceContactPutResponseData": {
       "type": "object",
       "properties": {
-        "business": {
-          "$ref": "#/definitions/apiserviceBusiness"
+        "contact": {
+          "$ref": "#/definitions/apiserviceContact"
         }
       }
     },
-    "apiserviceBusinessRating": {
+    "apiserviceConversation": {
       "type": "object",
       "properties": {
-        "business": {
-          "$ref": "#/definitions/apiserviceBusiness"
+        "id": {
+          "type": "string"
         },
-        "rating": {
-          "$ref": "#/definitions/apiserviceRating"
+        "lastChat": {
+          "type": "string",
+          "format": "int64"
+        },
+        "members": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/apiserviceConversationMember"
+          }
         }
       }
     },
-    "apiserviceBusinessRatingGetResponse": {
+    "apiserviceConversationMember": {
       "type": "object",
       "properties": {
-        "code": {
-          "type": "integer",
-          "format": "int32"
-        },
-        "success": {
-          "type": "boolean"
+        "id": {
+          "type": "string"
         },
-        "data": {
-          "$ref": "#/definitions/apiserviceBusinessRatingGetResponseData"
+        "name": {
+          "type": "string"
         }
       }
     },
-    "apiserviceBusinessRatingGetResponseData": {
+    "apiserviceConversationPostRequest": {
       "type": "object",
       "properties": {
-        "rate": {
+        "UserId": {
+          "type": "string"
+        },
+        "conversationIds": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/apiserviceRating"
+            "type": "string"
           }
         }
       }
     },
-    "apiserviceBusinessServiceGetResponse": {
+    "apiserviceConversationPostResponse": {
       "type": "object",
       "properties": {
         "code": {
@@ -4706,22 +7346,98 @@ var APISERVICE_JSON = `{
           "type": "boolean"
         },
         "data": {
-          "$ref": "#/definitions/apiserviceBusinessServiceGetResponseData"
+          "$ref": "#/definitions/apiserviceConversationPostResponseData"
         }
       }
     },
-    "apiserviceBusinessServiceGetResponseData": {
+    "apiserviceConversationPostResponseData": {
       "type": "object",
       "properties": {
-        "result": {
+        "conversation": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/apiserviceService"
+            "$ref": "#/definitions/apiserviceConversation"
+          }
+        }
+      }
+    },
+    "apiserviceDispute": {
+      "type": "object",
+      "properties": {
+        "id": {
+          "type": "string"
+        },
+        "orderId": {
+          "type": "string"
+        },
+        "businessId": {
+          "type": "string"
+        },
+        "businessName": {
+          "type": "string"
+        },
+        "reason": {
+          "$ref": "#/definitions/constDISPUTE_REASON"
+        },
+        "note": {
+          "type": "string"
+        },
+        "attachments": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/apiserviceAttachment"
           }
+        },
+        "fee": {
+          "type": "number",
+          "format": "float"
+        },
+        "status": {
+          "$ref": "#/definitions/constDISPUTE_STATUS"
+        },
+        "resolution": {
+          "$ref": "#/definitions/constDISPUTE_RESOLUTION"
+        },
+        "reviewNote": {
+          "type": "string"
+        },
+        "reviewedAt": {
+          "type": "string",
+          "format": "int64"
+        },
+        "createdAt": {
+          "type": "string",
+          "format": "int64"
+        }
+      },
+      "description": "Dispute is a handyman disputing the connection fee of an order. fee is in\ndollars, reviewedAt in unix milliseconds."
+    },
+    "apiserviceFeedback": {
+      "type": "object",
+      "properties": {
+        "rate": {
+          "type": "number",
+          "format": "float"
+        },
+        "comment": {
+          "type": "string"
+        },
+        "createdAt": {
+          "type": "string",
+          "format": "int64"
+        },
+        "customerName": {
+          "type": "string"
+        },
+        "image": {
+          "type": "string"
+        },
+        "serviceOrder": {
+          "type": "string"
         }
       }
     },
-    "apiserviceBusinessServicesPutResponse": {
+    "apiserviceFeedbackGetResponse": {
       "type": "object",
       "properties": {
         "code": {
@@ -4732,22 +7448,19 @@ var APISERVICE_JSON = `{
           "type": "boolean"
         },
         "data": {
-          "$ref": "#/definitions/apiserviceBusinessServicesPutResponseData"
+          "$ref": "#/definitions/apiserviceFeedbackGetResponseData"
         }
       }
     },
-    "apiserviceBusinessServicesPutResponseData": {
+    "apiserviceFeedbackGetResponseData": {
       "type": "object",
       "properties": {
-        "result": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/apiserviceService"
-          }
+        "feedback": {
+          "$ref": "#/definitions/apiserviceFeedback"
         }
       }
     },
-    "apiserviceBusinessTransactionsGetResponse": {
+    "apiserviceFeedbackPutResponse": {
       "type": "object",
       "properties": {
         "code": {
@@ -4758,43 +7471,38 @@ var APISERVICE_JSON = `{
           "type": "boolean"
         },
         "data": {
-          "$ref": "#/definitions/apiserviceBusinessTransactionsGetResponseData"
+          "$ref": "#/definitions/apiserviceFeedbackPutResponseData"
         }
       }
     },
-    "apiserviceBusinessTransactionsGetResponseData": {
+    "apiserviceFeedbackPutResponseData": {
+      "type": "object"
+    },
+    "apiserviceFeedbacksPostRequest": {
       "type": "object",
       "properties": {
-        "result": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/apiserviceTransaction"
-          }
+        "UserId": {
+          "type": "string"
         },
-        "pagination": {
-          "$ref": "#/definitions/apiservicePagination"
+        "orderId": {
+          "type": "string"
         },
-        "totalFee": {
+        "rate": {
           "type": "number",
           "format": "float"
-        }
-      }
-    },
-    "apiserviceBusinessValidateBuyAdvertisePostRequest": {
-      "type": "object",
-      "properties": {
-        "UserId": {
+        },
+        "comment": {
           "type": "string"
         },
-        "categoryId": {
+        "serviceId": {
           "type": "string"
         },
-        "zipcode": {
+        "businessId": {
           "type": "string"
         }
       }
     },
-    "apiserviceBusinessValidateBuyAdvertisePostResponse": {
+    "apiserviceFeedbacksPostResponse": {
       "type": "object",
       "properties": {
         "code": {
@@ -4805,219 +7513,254 @@ var APISERVICE_JSON = `{
           "type": "boolean"
         },
         "data": {
-          "$ref": "#/definitions/apiserviceBusinessValidateBuyAdvertisePostResponseData"
+          "$ref": "#/definitions/apiserviceFeedbacksPostResponseData"
         }
       }
     },
-    "apiserviceBusinessValidateBuyAdvertisePostResponseData": {
-      "type": "object"
-    },
-    "apiserviceBusinessVerifyRefCodePutResponse": {
+    "apiserviceFeedbacksPostResponseData": {
       "type": "object",
       "properties": {
-        "code": {
-          "type": "integer",
-          "format": "int32"
-        },
-        "success": {
-          "type": "boolean"
-        },
-        "data": {
-          "$ref": "#/definitions/apiserviceBusinessVerifyRefCodePutResponseData"
+        "feedback": {
+          "$ref": "#/definitions/apiserviceFeedback"
         }
       }
     },
-    "apiserviceBusinessVerifyRefCodePutResponseData": {
-      "type": "object"
-    },
-    "apiserviceBusinessesAlreadyOrderedGetResponse": {
+    "apiserviceGroup": {
       "type": "object",
       "properties": {
-        "code": {
-          "type": "integer",
-          "format": "int32"
+        "id": {
+          "type": "string"
         },
-        "success": {
-          "type": "boolean"
+        "name": {
+          "type": "string"
         },
-        "data": {
-          "$ref": "#/definitions/apiserviceBusinessesAlreadyOrderedGetResponseData"
+        "fee": {
+          "type": "number",
+          "format": "float"
+        },
+        "serviceInfo": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/apiserviceServiceGroup"
+          }
         }
       }
     },
-    "apiserviceBusinessesAlreadyOrderedGetResponseData": {
+    "apiserviceJobAnswer": {
       "type": "object",
       "properties": {
-        "businessId": {
+        "key": {
+          "type": "string"
+        },
+        "values": {
           "type": "array",
           "items": {
             "type": "string"
           }
         }
-      }
+      },
+      "description": "JobAnswer answers the question with key. Only PHOTOS questions take more\nthan one value."
     },
-    "apiserviceBusinessesGetResponse": {
+    "apiserviceJobDetail": {
       "type": "object",
       "properties": {
-        "code": {
-          "type": "integer",
-          "format": "int32"
+        "key": {
+          "type": "string"
         },
-        "success": {
-          "type": "boolean"
+        "label": {
+          "type": "string"
         },
-        "data": {
-          "$ref": "#/definitions/apiserviceBusinessesGetResponseData"
-        }
-      }
-    },
-    "apiserviceBusinessesGetResponseData": {
-      "type": "object",
-      "properties": {
-        "result": {
+        "type": {
+          "$ref": "#/definitions/constQUESTION_TYPE"
+        },
+        "values": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/apiserviceBusinessRating"
+            "type": "string"
           }
-        },
-        "pagination": {
-          "$ref": "#/definitions/apiservicePagination"
         }
-      }
+      },
+      "description": "JobDetail is an answered question as stored on an order."
     },
-    "apiserviceCancelProjectPostRequest": {
+    "apiserviceLeadMatch": {
       "type": "object",
       "properties": {
-        "UserId": {
+        "orderId": {
           "type": "string"
         },
-        "zipcode": {
+        "businessId": {
           "type": "string"
         },
-        "categoryId": {
+        "businessName": {
+          "type": "string"
+        },
+        "reason": {
           "type": "string"
         }
-      }
+      },
+      "description": "LeadMatch is a business picked for an order the customer asked quotes for."
     },
-    "apiserviceCancelProjectPostResponse": {
+    "apiserviceMember": {
       "type": "object",
       "properties": {
-        "code": {
-          "type": "integer",
-          "format": "int32"
+        "id": {
+          "type": "string"
         },
-        "success": {
-          "type": "boolean"
+        "userId": {
+          "type": "string"
         },
-        "data": {
-          "$ref": "#/definitions/apiserviceCancelProjectPostResponseData"
-        }
-      }
-    },
-    "apiserviceCancelProjectPostResponseData": {
-      "type": "object"
-    },
-    "apiserviceCategoriesGetResponse": {
-      "type": "object",
-      "properties": {
-        "code": {
-          "type": "integer",
-          "format": "int32"
+        "mail": {
+          "type": "string"
         },
-        "success": {
-          "type": "boolean"
+        "role": {
+          "$ref": "#/definitions/constBUSINESS_ROLE"
         },
-        "data": {
-          "$ref": "#/definitions/apiserviceCategoriesGetResponseData"
-        }
-      }
-    },
-    "apiserviceCategoriesGetResponseData": {
-      "type": "object",
-      "properties": {
-        "result": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/apiserviceCategory"
-          }
+        "accepted": {
+          "type": "boolean"
         },
-        "pagination": {
-          "$ref": "#/definitions/apiservicePagination"
+        "createdAt": {
+          "type": "string",
+          "format": "int64"
         }
       }
     },
-    "apiserviceCategory": {
+    "apiserviceOrder": {
       "type": "object",
       "properties": {
         "id": {
           "type": "string"
         },
-        "name": {
+        "customerId": {
           "type": "string"
         },
-        "totalProvider": {
+        "businessId": {
+          "type": "string"
+        },
+        "conversationId": {
+          "type": "string"
+        },
+        "serviceId": {
+          "type": "string"
+        },
+        "startDate": {
           "type": "string",
           "format": "int64"
         },
+        "endDate": {
+          "type": "string",
+          "format": "int64"
+        },
+        "status": {
+          "$ref": "#/definitions/constORDER_STATUS"
+        },
+        "customerPhone": {
+          "type": "string"
+        },
+        "customerZipcode": {
+          "type": "string"
+        },
+        "customerMessage": {
+          "type": "string"
+        },
+        "serviceName": {
+          "type": "string"
+        },
+        "image": {
+          "type": "string"
+        },
         "fee": {
           "type": "number",
           "format": "float"
         },
-        "image": {
-          "type": "string"
-        }
-      }
-    },
-    "apiserviceCategoryGetResponse": {
-      "type": "object",
-      "properties": {
-        "code": {
-          "type": "integer",
-          "format": "int32"
+        "businessLogo": {
+          "type": "string"
+        },
+        "businessBanner": {
+          "type": "string"
+        },
+        "businessName": {
+          "type": "string"
+        },
+        "customerName": {
+          "type": "string"
+        },
+        "isReviewed": {
+          "type": "boolean"
+        },
+        "categoryId": {
+          "type": "string"
+        },
+        "customerMail": {
+          "type": "string"
+        },
+        "handymanMail": {
+          "type": "string"
+        },
+        "urgency": {
+          "$ref": "#/definitions/constORDER_URGENCY"
+        },
+        "preferredDate": {
+          "type": "string",
+          "format": "int64"
+        },
+        "timeWindows": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/apiserviceTimeWindow"
+          }
+        },
+        "slotProposal": {
+          "$ref": "#/definitions/apiserviceSlotProposal"
+        },
+        "details": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/apiserviceJobDetail"
+          }
+        },
+        "attachments": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/apiserviceAttachment"
+          }
         },
-        "success": {
-          "type": "boolean"
+        "matchReason": {
+          "type": "string",
+          "title": "matchReason is why the business was picked for an order it was matched\nto, empty when the customer picked it"
         },
-        "data": {
-          "$ref": "#/definitions/apiserviceCategoryGetResponseData"
-        }
-      }
-    },
-    "apiserviceCategoryGetResponseData": {
-      "type": "object",
-      "properties": {
-        "category": {
-          "$ref": "#/definitions/apiserviceCategory"
+        "completion": {
+          "$ref": "#/definitions/apiserviceCompletion"
         }
       }
     },
-    "apiserviceContact": {
+    "apiserviceOrderDisputePostRequest": {
       "type": "object",
       "properties": {
-        "id": {
-          "type": "string"
-        },
-        "zipcode": {
+        "UserId": {
           "type": "string"
         },
-        "address1": {
+        "orderId": {
           "type": "string"
         },
-        "address2": {
-          "type": "string"
+        "reason": {
+          "$ref": "#/definitions/constDISPUTE_REASON"
         },
-        "state": {
+        "note": {
           "type": "string"
         },
-        "city": {
-          "type": "string"
+        "attachments": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "stateId": {
+        "UploaderId": {
           "type": "string"
         }
-      }
+      },
+      "description": "OrderDisputePostRequest disputes the fee of a connected order. attachments\nare keys of ORDER_ATTACHMENT uploads of _uploaderId backing the note."
     },
-    "apiserviceContactGetResponse": {
+    "apiserviceOrderDisputePostResponse": {
       "type": "object",
       "properties": {
         "code": {
@@ -5028,19 +7771,19 @@ var APISERVICE_JSON = `{
           "type": "boolean"
         },
         "data": {
-          "$ref": "#/definitions/apiserviceContactGetResponseData"
+          "$ref": "#/definitions/apiserviceOrderDisputePostResponseData"
         }
       }
     },
-    "apiserviceContactGetResponseData": {
+    "apiserviceOrderDisputePostResponseData": {
       "type": "object",
       "properties": {
-        "contact": {
-          "$ref": "#/definitions/apiserviceContact"
+        "dispute": {
+          "$ref": "#/definitions/apiserviceDispute"
         }
       }
     },
-    "apiserviceContactPutResponse": {
+    "apiserviceOrderDisputesGetResponse": {
       "type": "object",
       "properties": {
         "code": {
@@ -5051,51 +7794,85 @@ var APISERVICE_JSON = `{
           "type": "boolean"
         },
         "data": {
-          "$ref": "#/definitions/apiserviceContactPutResponseData"
+          "$ref": "#/definitions/apiserviceOrderDisputesGetResponseData"
         }
       }
     },
-    "apiserviceContactPutResponseData": {
+    "apiserviceOrderDisputesGetResponseData": {
       "type": "object",
       "properties": {
-        "contact": {
-          "$ref": "#/definitions/apiserviceContact"
+        "pagination": {
+          "$ref": "#/definitions/apiservicePagination"
+        },
+        "result": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/apiserviceDispute"
+          }
         }
       }
     },
-    "apiserviceConversation": {
+    "apiserviceOrderEvent": {
       "type": "object",
       "properties": {
         "id": {
           "type": "string"
         },
-        "lastChat": {
+        "orderId": {
+          "type": "string"
+        },
+        "actorId": {
+          "type": "string"
+        },
+        "actorRole": {
+          "$ref": "#/definitions/constROLE"
+        },
+        "oldStatus": {
+          "$ref": "#/definitions/constORDER_STATUS"
+        },
+        "newStatus": {
+          "$ref": "#/definitions/constORDER_STATUS"
+        },
+        "reason": {
+          "$ref": "#/definitions/constORDER_REASON"
+        },
+        "metadata": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "createdAt": {
           "type": "string",
           "format": "int64"
         },
-        "members": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/ConversationMember"
-          }
+        "initial": {
+          "type": "boolean",
+          "title": "initial marks the event of placing the order, oldStatus is unset"
+        },
+        "note": {
+          "type": "string"
         }
       }
     },
-    "apiserviceConversationPostRequest": {
+    "apiserviceOrderQuoteAcceptPostRequest": {
       "type": "object",
       "properties": {
         "UserId": {
           "type": "string"
         },
-        "conversationIds": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
+        "quoteId": {
+          "type": "string"
+        },
+        "Role": {
+          "$ref": "#/definitions/constROLE"
+        },
+        "ActorId": {
+          "type": "string"
         }
       }
     },
-    "apiserviceConversationPostResponse": {
+    "apiserviceOrderQuoteAcceptPostResponse": {
       "type": "object",
       "properties": {
         "code": {
@@ -5106,47 +7883,48 @@ var APISERVICE_JSON = `{
           "type": "boolean"
         },
         "data": {
-          "$ref": "#/definitions/apiserviceConversationPostResponseData"
+          "$ref": "#/definitions/apiserviceOrderQuoteAcceptPostResponseData"
         }
       }
     },
-    "apiserviceConversationPostResponseData": {
-      "type": "object",
-      "properties": {
-        "conversation": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/apiserviceConversation"
-          }
-        }
-      }
+    "apiserviceOrderQuoteAcceptPostResponseData": {
+      "type": "object"
     },
-    "apiserviceFeedback": {
+    "apiserviceOrderQuotePostRequest": {
       "type": "object",
       "properties": {
-        "rate": {
+        "UserId": {
+          "type": "string"
+        },
+        "orderId": {
+          "type": "string"
+        },
+        "priceMin": {
           "type": "number",
           "format": "float"
         },
-        "comment": {
-          "type": "string"
+        "priceMax": {
+          "type": "number",
+          "format": "float"
         },
-        "createdAt": {
-          "type": "string",
-          "format": "int64"
+        "visitFee": {
+          "type": "number",
+          "format": "float"
         },
-        "customerName": {
+        "message": {
           "type": "string"
         },
-        "image": {
-          "type": "string"
+        "validUntil": {
+          "type": "string",
+          "format": "int64"
         },
-        "serviceOrder": {
+        "ActorId": {
           "type": "string"
         }
-      }
+      },
+      "description": "OrderQuotePostRequest submits a quote for a pending order, replacing the\nopen quote of the handyman. validUntil defaults to a week from now."
     },
-    "apiserviceFeedbackGetResponse": {
+    "apiserviceOrderQuotePostResponse": {
       "type": "object",
       "properties": {
         "code": {
@@ -5157,19 +7935,19 @@ var APISERVICE_JSON = `{
           "type": "boolean"
         },
         "data": {
-          "$ref": "#/definitions/apiserviceFeedbackGetResponseData"
+          "$ref": "#/definitions/apiserviceOrderQuotePostResponseData"
         }
       }
     },
-    "apiserviceFeedbackGetResponseData": {
+    "apiserviceOrderQuotePostResponseData": {
       "type": "object",
       "properties": {
-        "feedback": {
-          "$ref": "#/definitions/apiserviceFeedback"
+        "quote": {
+          "$ref": "#/definitions/apiserviceQuote"
         }
       }
     },
-    "apiserviceFeedbackPutResponse": {
+    "apiserviceOrderQuotesGetResponse": {
       "type": "object",
       "properties": {
         "code": {
@@ -5180,14 +7958,22 @@ var APISERVICE_JSON = `{
           "type": "boolean"
         },
         "data": {
-          "$ref": "#/definitions/apiserviceFeedbackPutResponseData"
+          "$ref": "#/definitions/apiserviceOrderQuotesGetResponseData"
         }
       }
     },
-    "apiserviceFeedbackPutResponseData": {
-      "type": "object"
+    "apiserviceOrderQuotesGetResponseData": {
+      "type": "object",
+      "properties": {
+        "result": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/apiserviceQuote"
+          }
+        }
+      }
     },
-    "apiserviceFeedbacksPostRequest": {
+    "apiserviceOrderSlotProposePostRequest": {
       "type": "object",
       "properties": {
         "UserId": {
@@ -5196,22 +7982,15 @@ var APISERVICE_JSON = `{
         "orderId": {
           "type": "string"
         },
-        "rate": {
-          "type": "number",
-          "format": "float"
-        },
-        "comment": {
-          "type": "string"
-        },
-        "serviceId": {
-          "type": "string"
+        "slot": {
+          "$ref": "#/definitions/apiserviceTimeWindow"
         },
-        "businessId": {
+        "ActorId": {
           "type": "string"
         }
       }
     },
-    "apiserviceFeedbacksPostResponse": {
+    "apiserviceOrderSlotProposePostResponse": {
       "type": "object",
       "properties": {
         "code": {
@@ -5222,113 +8001,48 @@ var APISERVICE_JSON = `{
           "type": "boolean"
         },
         "data": {
-          "$ref": "#/definitions/apiserviceFeedbacksPostResponseData"
-        }
-      }
-    },
-    "apiserviceFeedbacksPostResponseData": {
-      "type": "object",
-      "properties": {
-        "feedback": {
-          "$ref": "#/definitions/apiserviceFeedback"
+          "$ref": "#/definitions/apiserviceOrderSlotProposePostResponseData"
         }
       }
     },
-    "apiserviceGroup": {
-      "type": "object",
-      "properties": {
-        "id": {
-          "type": "string"
-        },
-        "name": {
-          "type": "string"
-        },
-        "fee": {
-          "type": "number",
-          "format": "float"
-        },
-        "serviceInfo": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/apiserviceServiceGroup"
-          }
-        }
-      }
+    "apiserviceOrderSlotProposePostResponseData": {
+      "type": "object"
     },
-    "apiserviceOrder": {
+    "apiserviceOrderSlotRespondPostRequest": {
       "type": "object",
       "properties": {
-        "id": {
-          "type": "string"
-        },
-        "customerId": {
-          "type": "string"
-        },
-        "businessId": {
-          "type": "string"
-        },
-        "conversationId": {
-          "type": "string"
-        },
-        "serviceId": {
-          "type": "string"
-        },
-        "startDate": {
-          "type": "string",
-          "format": "int64"
-        },
-        "endDate": {
-          "type": "string",
-          "format": "int64"
-        },
-        "status": {
-          "$ref": "#/definitions/constORDER_STATUS"
-        },
-        "customerPhone": {
-          "type": "string"
-        },
-        "customerZipcode": {
-          "type": "string"
-        },
-        "customerMessage": {
-          "type": "string"
-        },
-        "serviceName": {
-          "type": "string"
-        },
-        "image": {
-          "type": "string"
-        },
-        "fee": {
-          "type": "number",
-          "format": "float"
-        },
-        "businessLogo": {
-          "type": "string"
-        },
-        "businessBanner": {
-          "type": "string"
-        },
-        "businessName": {
+        "UserId": {
           "type": "string"
         },
-        "customerName": {
+        "orderId": {
           "type": "string"
         },
-        "isReviewed": {
+        "accept": {
           "type": "boolean"
         },
-        "categoryId": {
+        "ActorId": {
           "type": "string"
+        }
+      }
+    },
+    "apiserviceOrderSlotRespondPostResponse": {
+      "type": "object",
+      "properties": {
+        "code": {
+          "type": "integer",
+          "format": "int32"
         },
-        "customerMail": {
-          "type": "string"
+        "success": {
+          "type": "boolean"
         },
-        "handymanMail": {
-          "type": "string"
+        "data": {
+          "$ref": "#/definitions/apiserviceOrderSlotRespondPostResponseData"
         }
       }
     },
+    "apiserviceOrderSlotRespondPostResponseData": {
+      "type": "object"
+    },
     "apiserviceOrdersGetResponse": {
       "type": "object",
       "properties": {
@@ -5365,7 +8079,8 @@ var APISERVICE_JSON = `{
           "type": "array",
           "items": {
             "type": "string"
-          }
+          },
+          "title": "businessIds left empty asks for quotes, the businesses are matched"
         },
         "zipcode": {
           "type": "string"
@@ -5375,6 +8090,33 @@ var APISERVICE_JSON = `{
         },
         "categoryId": {
           "type": "string"
+        },
+        "urgency": {
+          "$ref": "#/definitions/constORDER_URGENCY"
+        },
+        "preferredDate": {
+          "type": "string",
+          "format": "int64",
+          "title": "preferredDate defaults to the start of the first time window"
+        },
+        "timeWindows": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/apiserviceTimeWindow"
+          }
+        },
+        "answers": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/apiserviceJobAnswer"
+          }
+        },
+        "attachments": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "title": "attachments are keys of ORDER_ATTACHMENT uploads"
         }
       }
     },
@@ -5394,7 +8136,42 @@ var APISERVICE_JSON = `{
       }
     },
     "apiserviceOrdersPostResponseData": {
-      "type": "object"
+      "type": "object",
+      "properties": {
+        "matches": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/apiserviceLeadMatch"
+          },
+          "title": "matches are set when businesses were matched"
+        }
+      }
+    },
+    "apiserviceOrdersTimelineGetResponse": {
+      "type": "object",
+      "properties": {
+        "code": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "success": {
+          "type": "boolean"
+        },
+        "data": {
+          "$ref": "#/definitions/apiserviceOrdersTimelineGetResponseData"
+        }
+      }
+    },
+    "apiserviceOrdersTimelineGetResponseData": {
+      "type": "object",
+      "properties": {
+        "result": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/apiserviceOrderEvent"
+          }
+        }
+      }
     },
     "apiservicePagination": {
       "type": "object",
@@ -5441,6 +8218,17 @@ var APISERVICE_JSON = `{
         }
       }
     },
+    "apiservicePermission": {
+      "type": "object",
+      "properties": {
+        "code": {
+          "type": "string"
+        },
+        "description": {
+          "type": "string"
+        }
+      }
+    },
     "apiserviceProject": {
       "type": "object",
       "properties": {
@@ -5462,6 +8250,53 @@ var APISERVICE_JSON = `{
         }
       }
     },
+    "apiserviceQuote": {
+      "type": "object",
+      "properties": {
+        "id": {
+          "type": "string"
+        },
+        "orderId": {
+          "type": "string"
+        },
+        "businessId": {
+          "type": "string"
+        },
+        "businessName": {
+          "type": "string"
+        },
+        "businessLogo": {
+          "type": "string"
+        },
+        "priceMin": {
+          "type": "number",
+          "format": "float"
+        },
+        "priceMax": {
+          "type": "number",
+          "format": "float"
+        },
+        "visitFee": {
+          "type": "number",
+          "format": "float"
+        },
+        "message": {
+          "type": "string"
+        },
+        "validUntil": {
+          "type": "string",
+          "format": "int64"
+        },
+        "status": {
+          "$ref": "#/definitions/constQUOTE_STATUS"
+        },
+        "createdAt": {
+          "type": "string",
+          "format": "int64"
+        }
+      },
+      "description": "Quote is the price a handyman offers for a pending order. Prices are in\ndollars, validUntil in unix milliseconds."
+    },
     "apiserviceRating": {
       "type": "object",
       "properties": {
@@ -5479,6 +8314,50 @@ var APISERVICE_JSON = `{
         }
       }
     },
+    "apiserviceRole": {
+      "type": "object",
+      "properties": {
+        "role": {
+          "$ref": "#/definitions/constROLE"
+        },
+        "name": {
+          "type": "string"
+        },
+        "permissions": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "apiserviceSecurityEvent": {
+      "type": "object",
+      "properties": {
+        "id": {
+          "type": "string"
+        },
+        "type": {
+          "$ref": "#/definitions/constSECURITY_EVENT"
+        },
+        "ip": {
+          "type": "string"
+        },
+        "userAgent": {
+          "type": "string"
+        },
+        "success": {
+          "type": "boolean"
+        },
+        "reason": {
+          "type": "string"
+        },
+        "createdAt": {
+          "type": "string",
+          "format": "int64"
+        }
+      }
+    },
     "apiserviceService": {
       "type": "object",
       "properties": {
@@ -5520,6 +8399,18 @@ var APISERVICE_JSON = `{
         }
       }
     },
+    "apiserviceSlotProposal": {
+      "type": "object",
+      "properties": {
+        "slot": {
+          "$ref": "#/definitions/apiserviceTimeWindow"
+        },
+        "status": {
+          "$ref": "#/definitions/constSLOT_PROPOSAL_STATUS"
+        }
+      },
+      "description": "SlotProposal is the slot a handyman proposed instead of the schedule the\ncustomer asked for."
+    },
     "apiserviceState": {
       "type": "object",
       "properties": {
@@ -5691,6 +8582,42 @@ var APISERVICE_JSON = `{
     "apiserviceSubscribePostResponseData": {
       "type": "object"
     },
+    "apiserviceSuspension": {
+      "type": "object",
+      "properties": {
+        "reason": {
+          "$ref": "#/definitions/constSUSPENSION_REASON"
+        },
+        "note": {
+          "type": "string"
+        },
+        "expiresAt": {
+          "type": "string",
+          "format": "int64"
+        },
+        "issuedBy": {
+          "type": "string"
+        },
+        "createdAt": {
+          "type": "string",
+          "format": "int64"
+        }
+      }
+    },
+    "apiserviceTimeWindow": {
+      "type": "object",
+      "properties": {
+        "start": {
+          "type": "string",
+          "format": "int64"
+        },
+        "end": {
+          "type": "string",
+          "format": "int64"
+        }
+      },
+      "description": "TimeWindow is a span of time in unix milliseconds."
+    },
     "apiserviceTransaction": {
       "type": "object",
       "properties": {
@@ -5760,6 +8687,9 @@ var APISERVICE_JSON = `{
         },
         "Role": {
           "$ref": "#/definitions/constROLE"
+        },
+        "ActorId": {
+          "type": "string"
         }
       }
     },
@@ -5785,13 +8715,24 @@ var APISERVICE_JSON = `{
       "type": "object",
       "properties": {
         "UserId": {
-          "type": "string"
+          "type": "string",
+          "title": "_userId is the business or customer acting, _actorId the signed in\nuser recorded on the order events"
         },
         "orderId": {
           "type": "string"
         },
         "Role": {
           "$ref": "#/definitions/constROLE"
+        },
+        "reason": {
+          "type": "string",
+          "title": "reason is an ORDER_REASON name, required to reject"
+        },
+        "note": {
+          "type": "string"
+        },
+        "ActorId": {
+          "type": "string"
         }
       }
     },
@@ -5825,6 +8766,9 @@ var APISERVICE_JSON = `{
         "contentLength": {
           "type": "string",
           "format": "int64"
+        },
+        "purpose": {
+          "$ref": "#/definitions/constUPLOAD_PURPOSE"
         }
       }
     },
@@ -5918,6 +8862,9 @@ var APISERVICE_JSON = `{
         },
         "encryptedPrivateKey": {
           "type": "string"
+        },
+        "channel": {
+          "$ref": "#/definitions/constOTP_CHANNEL"
         }
       },
       "title": "UserPost(UserPostResquest) returns (UserPostResponse)"
@@ -6037,6 +8984,78 @@ var APISERVICE_JSON = `{
       ],
       "default": "ACTIVE"
     },
+    "constBUSINESS_ROLE": {
+      "type": "string",
+      "enum": [
+        "OWNER",
+        "MANAGER",
+        "STAFF"
+      ],
+      "default": "OWNER"
+    },
+    "constCOMPLETION_CONFIRMATION": {
+      "type": "string",
+      "enum": [
+        "UNCONFIRMED",
+        "CONFIRMED_BY_CUSTOMER",
+        "CONFIRMED_BY_HANDYMAN",
+        "AUTO_CONFIRMED"
+      ],
+      "default": "UNCONFIRMED",
+      "description": "COMPLETION_CONFIRMATION is how the completion of a job was settled, by the\nside that did not mark it done or AUTO_CONFIRMED when nobody answered in\ntime."
+    },
+    "constDISPUTE_REASON": {
+      "type": "string",
+      "enum": [
+        "SPAM_REQUEST",
+        "UNREACHABLE_CUSTOMER",
+        "OTHER_DISPUTE_REASON"
+      ],
+      "default": "SPAM_REQUEST",
+      "description": "DISPUTE_REASON is why a handyman disputes the fee of a connected order."
+    },
+    "constDISPUTE_RESOLUTION": {
+      "type": "string",
+      "enum": [
+        "NOT_RESOLVED",
+        "WAIVED",
+        "REFUNDED",
+        "CREDITED"
+      ],
+      "default": "NOT_RESOLVED",
+      "description": "DISPUTE_RESOLUTION is how an approved dispute gave the fee back. A fee not\nbilled yet is WAIVED, a billed one REFUNDED and a free contact CREDITED\nback to the business."
+    },
+    "constDISPUTE_STATUS": {
+      "type": "string",
+      "enum": [
+        "UNDER_REVIEW",
+        "APPROVED",
+        "DENIED"
+      ],
+      "default": "UNDER_REVIEW",
+      "description": "DISPUTE_STATUS is where the dispute of a connection fee is in its review."
+    },
+    "constORDER_REASON": {
+      "type": "string",
+      "enum": [
+        "NO_REASON",
+        "OUTSIDE_SERVICE_AREA",
+        "FULLY_BOOKED",
+        "SERVICE_NOT_OFFERED",
+        "CUSTOMER_UNRESPONSIVE",
+        "CHANGED_MIND",
+        "HIRED_ELSEWHERE",
+        "OTHER_REASON",
+        "EXPIRED",
+        "SUSPENDED",
+        "ACCOUNT_DELETED",
+        "QUOTE_ACCEPTED",
+        "COMPLETION_DISPUTED",
+        "CONFIRMATION_TIMEOUT"
+      ],
+      "default": "NO_REASON",
+      "description": "ORDER_REASON is why an order changed status. Handymen must give one when\nthey reject an order. Reasons after OTHER_REASON are only recorded by the\nplatform."
+    },
     "constORDER_STATUS": {
       "type": "string",
       "enum": [
@@ -6044,9 +9063,31 @@ var APISERVICE_JSON = `{
         "CONNECTED",
         "REJECTED",
         "CANCELED",
-        "COMPLETED"
+        "COMPLETED",
+        "EXPIRED_STATUS",
+        "AWAITING_CONFIRMATION"
       ],
-      "default": "PENDING"
+      "default": "PENDING",
+      "description": "ORDER_STATUS is where an order stands. EXPIRED_STATUS orders were left\npending past their end date, EXPIRED is taken by ORDER_REASON.\nAWAITING_CONFIRMATION orders were marked done by one side and wait for the\nother to confirm or dispute it."
+    },
+    "constORDER_URGENCY": {
+      "type": "string",
+      "enum": [
+        "FLEXIBLE",
+        "ASAP",
+        "WITHIN_WEEK",
+        "ON_DATE"
+      ],
+      "default": "FLEXIBLE",
+      "description": "ORDER_URGENCY is when a customer needs the job done. ON_DATE orders carry\na preferred date or time windows."
+    },
+    "constOTP_CHANNEL": {
+      "type": "string",
+      "enum": [
+        "MAIL",
+        "SMS"
+      ],
+      "default": "MAIL"
     },
     "constQUERY_CATEGORY_ADMIN": {
       "type": "string",
@@ -6057,6 +9098,29 @@ var APISERVICE_JSON = `{
       ],
       "default": "NO"
     },
+    "constQUESTION_TYPE": {
+      "type": "string",
+      "enum": [
+        "TEXT",
+        "YES_NO",
+        "CHOICE",
+        "NUMBER",
+        "PHOTOS"
+      ],
+      "default": "TEXT",
+      "description": "QUESTION_TYPE is the kind of answer a category question takes. CHOICE\nanswers are one of the options of the question, PHOTOS answers are urls."
+    },
+    "constQUOTE_STATUS": {
+      "type": "string",
+      "enum": [
+        "OPEN",
+        "WON",
+        "LOST",
+        "LAPSED"
+      ],
+      "default": "OPEN",
+      "description": "QUOTE_STATUS is where the quote of a handyman stands. Open quotes lapse\nwhen their validity ends and are lost when the customer accepts another one."
+    },
     "constREGISTRATION_PROCESS": {
       "type": "string",
       "enum": [
@@ -6072,10 +9136,29 @@ var APISERVICE_JSON = `{
       "enum": [
         "CUSTOMER",
         "HANDYMAN",
-        "ADMIN"
+        "ADMIN",
+        "SUPPORT",
+        "FINANCE",
+        "MODERATOR"
       ],
       "default": "CUSTOMER"
     },
+    "constSECURITY_EVENT": {
+      "type": "string",
+      "enum": [
+        "CREDENTIAL_FETCH",
+        "OTP_VERIFY",
+        "MAIL_AND_PASS_CHANGE",
+        "PASSWORD_RESET",
+        "BAN",
+        "UNBAN",
+        "DELETION_SCHEDULE",
+        "DELETION_CANCEL",
+        "API_KEY_CREATE",
+        "API_KEY_REVOKE"
+      ],
+      "default": "CREDENTIAL_FETCH"
+    },
     "constSERVICE_STATUS": {
       "type": "string",
       "enum": [
@@ -6084,6 +9167,16 @@ var APISERVICE_JSON = `{
       ],
       "default": "SERVICE_ACTIVE"
     },
+    "constSLOT_PROPOSAL_STATUS": {
+      "type": "string",
+      "enum": [
+        "PROPOSED",
+        "ACCEPTED",
+        "DECLINED"
+      ],
+      "default": "PROPOSED",
+      "description": "SLOT_PROPOSAL_STATUS is the answer of a customer to the slot a handyman\nproposed instead of the requested schedule."
+    },
     "constSORT_QUERY": {
       "type": "string",
       "enum": [
@@ -6115,6 +9208,27 @@ var APISERVICE_JSON = `{
       ],
       "default": "NONE"
     },
+    "constSUSPENSION_REASON": {
+      "type": "string",
+      "enum": [
+        "OTHER",
+        "FRAUD",
+        "ABUSE",
+        "SPAM",
+        "POLICY_VIOLATION",
+        "UNPAID_FEES"
+      ],
+      "default": "OTHER"
+    },
+    "constUPLOAD_PURPOSE": {
+      "type": "string",
+      "enum": [
+        "IMAGE",
+        "ORDER_ATTACHMENT"
+      ],
+      "default": "IMAGE",
+      "description": "UPLOAD_PURPOSE is what a file is uploaded for. IMAGE files are public,\nORDER_ATTACHMENT files, attached to orders or to disputes, are only read\nthrough short-lived links."
+    },
     "protobufAny": {
       "type": "object",
       "properties": {
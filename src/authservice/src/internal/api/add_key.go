@@ -0,0 +1,37 @@
+package api
+
+import (
+	"context"
+
+	"github.com/aqaurius6666/authservice/src/internal/lib"
+	"github.com/aqaurius6666/authservice/src/internal/var/c"
+	"github.com/aqaurius6666/authservice/src/internal/var/e"
+	"github.com/aqaurius6666/authservice/src/pb/authpb"
+	"github.com/aqaurius6666/go-utils/cryptography"
+	"go.opentelemetry.io/otel"
+	"golang.org/x/xerrors"
+)
+
+func (s *ApiServer) AddKey(ctx context.Context, req *authpb.AddKeyRequest) (*authpb.AddKeyResponse, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.AddKey))
+	defer span.End()
+	if req.PublicKey == "" {
+		err := xerrors.Errorf("%w", e.ErrInvalidPublicKey)
+		lib.RecordError(span, err)
+		panic(err)
+	}
+	if _, err := cryptography.Base64ToBytes(req.PublicKey); err != nil {
+		err = xerrors.Errorf("%w", e.ErrInvalidPublicKey)
+		lib.RecordError(span, err)
+		panic(err)
+	}
+	key, err := s.Model.AddUserKey(ctx, req.UserId, req.PublicKey, req.Label)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		panic(err)
+	}
+	return &authpb.AddKeyResponse{
+		Key: toUserKeyProto(key),
+	}, nil
+}
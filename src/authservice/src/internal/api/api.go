@@ -1,9 +1,13 @@
 package api
 
 import (
+	"time"
+
 	"github.com/aqaurius6666/authservice/src/internal/db"
+	"github.com/aqaurius6666/authservice/src/internal/lib/jwt"
 	"github.com/aqaurius6666/authservice/src/internal/model"
 	"github.com/aqaurius6666/authservice/src/pb/authpb"
+	"github.com/aqaurius6666/authservice/src/services/nonce"
 	"github.com/google/wire"
 	"github.com/sirupsen/logrus"
 )
@@ -19,4 +23,15 @@ type ApiServer struct {
 	Model                                 model.Server
 	Logger                                *logrus.Logger
 	Repo                                  db.ServerRepo
+	Nonce                                 nonce.Store
+	RequestWindow                         RequestWindow
+	DeletionGracePeriod                   DeletionGracePeriod
+	Signer                                *jwt.Signer
 }
+
+// RequestWindow is how far the _timestamp of a signed body may be from now.
+type RequestWindow time.Duration
+
+// DeletionGracePeriod is how long a self-deleted account stays recoverable
+// before it is erased.
+type DeletionGracePeriod time.Duration
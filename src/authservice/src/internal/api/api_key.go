@@ -0,0 +1,144 @@
+package api
+
+import (
+	"context"
+
+	"github.com/aqaurius6666/authservice/src/internal/db/apikey"
+	"github.com/aqaurius6666/authservice/src/internal/lib"
+	"github.com/aqaurius6666/authservice/src/internal/model"
+	"github.com/aqaurius6666/authservice/src/internal/var/c"
+	"github.com/aqaurius6666/authservice/src/internal/var/e"
+	"github.com/aqaurius6666/authservice/src/pb/authpb"
+	"github.com/aqaurius6666/go-utils/utils"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"golang.org/x/xerrors"
+)
+
+func (s *ApiServer) CreateApiKey(ctx context.Context, req *authpb.CreateApiKeyRequest) (*authpb.CreateApiKeyResponse, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.CreateApiKey))
+	defer span.End()
+	usr, err := s.Model.GetUserById(ctx, req.UserId)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		panic(err)
+	}
+	k, key, err := s.Model.CreateApiKey(ctx, usr, req.Name, req.Scopes, req.ExpiresAt, req.RateLimit, req.ForBusiness)
+	if err != nil {
+		s.audit(ctx, usr.ID, c.SECURITY_EVENT_API_KEY_CREATE, err)
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		panic(err)
+	}
+	s.audit(ctx, usr.ID, c.SECURITY_EVENT_API_KEY_CREATE, nil)
+	return &authpb.CreateApiKeyResponse{
+		ApiKey: apiKeyToPb(k),
+		Key:    key,
+	}, nil
+}
+
+func (s *ApiServer) ListApiKeys(ctx context.Context, req *authpb.ListApiKeysRequest) (*authpb.ListApiKeysResponse, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.ListApiKeys))
+	defer span.End()
+	usr, err := s.Model.GetUserById(ctx, req.UserId)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		panic(err)
+	}
+	keys, err := s.Model.ListApiKeys(ctx, usr)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		panic(err)
+	}
+	res := make([]*authpb.ApiKey, 0, len(keys))
+	for _, k := range keys {
+		res = append(res, apiKeyToPb(k))
+	}
+	return &authpb.ListApiKeysResponse{
+		ApiKeys: res,
+	}, nil
+}
+
+func (s *ApiServer) RevokeApiKey(ctx context.Context, req *authpb.RevokeApiKeyRequest) (*authpb.RevokeApiKeyResponse, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.RevokeApiKey))
+	defer span.End()
+	usr, err := s.Model.GetUserById(ctx, req.UserId)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		panic(err)
+	}
+	if err := s.Model.RevokeApiKey(ctx, usr, req.Id); err != nil {
+		s.audit(ctx, usr.ID, c.SECURITY_EVENT_API_KEY_REVOKE, err)
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		panic(err)
+	}
+	s.audit(ctx, usr.ID, c.SECURITY_EVENT_API_KEY_REVOKE, nil)
+	return &authpb.RevokeApiKeyResponse{}, nil
+}
+
+// CheckApiKey is CheckAuth for api keys. The key acts for its business only
+// while its user still belongs to it, and a key that is not bound to a
+// business acts for the user alone. Api keys carry scopes instead of the
+// permissions of the role.
+func (s *ApiServer) CheckApiKey(ctx context.Context, req *authpb.CheckApiKeyRequest) (*authpb.CheckApiKeyResponse, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.CheckApiKey))
+	defer span.End()
+	k, err := s.Model.UseApiKey(ctx, req.Key)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		panic(err)
+	}
+	usr, err := s.Model.GetUserById(ctx, k.UserId)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		panic(err)
+	}
+	if !utils.BoolVal(usr.IsActive) {
+		err = xerrors.Errorf("%w", s.inactiveError(ctx, usr))
+		lib.RecordError(span, err)
+		panic(err)
+	}
+	businessId, businessRole := s.businessOf(ctx, usr)
+	if k.BusinessId != uuid.Nil && businessId != k.BusinessId.String() {
+		err = xerrors.Errorf("%w", e.ErrApiKeyInvalid)
+		lib.RecordError(span, err)
+		panic(err)
+	}
+	if k.BusinessId == uuid.Nil && businessId != usr.ID.String() {
+		businessId, businessRole = "", 0
+	}
+	return &authpb.CheckApiKeyResponse{
+		Id:           usr.ID.String(),
+		Role:         c.ROLE(utils.IntVal(usr.Role.Code)),
+		Scopes:       model.ApiKeyScopes(k),
+		BusinessId:   businessId,
+		BusinessRole: businessRole,
+		KeyId:        k.ID.String(),
+	}, nil
+}
+
+func apiKeyToPb(k *apikey.ApiKey) *authpb.ApiKey {
+	businessId := ""
+	if k.BusinessId != uuid.Nil {
+		businessId = k.BusinessId.String()
+	}
+	return &authpb.ApiKey{
+		Id:         k.ID.String(),
+		Name:       utils.StrVal(k.Name),
+		Prefix:     utils.StrVal(k.Prefix),
+		Scopes:     model.ApiKeyScopes(k),
+		BusinessId: businessId,
+		RateLimit:  k.RateLimit,
+		ExpiresAt:  k.ExpiresAt,
+		LastUsedAt: k.LastUsedAt,
+		Revoked:    utils.BoolVal(k.IsRevoked),
+		CreatedAt:  k.CreatedAt,
+	}
+}
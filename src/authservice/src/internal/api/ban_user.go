@@ -14,17 +14,24 @@ import (
 func (s *ApiServer) BanUser(ctx context.Context, req *authpb.BanUserRequest) (*authpb.BanUserResponse, error) {
 	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.BanUser))
 	defer span.End()
-	if err := s.Model.BanUser(ctx, req.Id, req.Status); err != nil {
+	// Status is whether the account is active, so false bans it.
+	typ := c.SECURITY_EVENT_BAN
+	if req.Status {
+		typ = c.SECURITY_EVENT_UNBAN
+	}
+	usr, err := s.Model.GetUserById(ctx, req.Id)
+	if err != nil {
 		err = xerrors.Errorf("%w", err)
 		lib.RecordError(span, err)
 		panic(err)
 	}
-	usr, err := s.Model.GetUserById(ctx, req.Id)
-	if err != nil {
+	if err := s.Model.BanUser(ctx, req.Id, req.Status); err != nil {
 		err = xerrors.Errorf("%w", err)
 		lib.RecordError(span, err)
+		s.audit(ctx, usr.ID, typ, err)
 		panic(err)
 	}
+	s.audit(ctx, usr.ID, typ, nil)
 	return &authpb.BanUserResponse{
 		Id:     req.Id,
 		Status: req.Status,
@@ -28,6 +28,7 @@ func (s *ApiServer) ChangeMailAndPass(ctx context.Context, req *authpb.ChangeMai
 	if _, err = s.Model.GetUserByMail(ctx, req.Mail); err == nil {
 		err = xerrors.Errorf("%w", user.ErrEmailExisted)
 		lib.RecordError(span, err)
+		s.audit(ctx, u.ID, c.SECURITY_EVENT_MAIL_AND_PASS_CHANGE, err)
 		panic(err)
 	}
 	o, err := s.Model.CreateChangeMailAndPassOTP(ctx, req.UserId, &user.User{
@@ -41,8 +42,10 @@ func (s *ApiServer) ChangeMailAndPass(ctx context.Context, req *authpb.ChangeMai
 	if err != nil {
 		err = xerrors.Errorf("%w", err)
 		lib.RecordError(span, err)
+		s.audit(ctx, u.ID, c.SECURITY_EVENT_MAIL_AND_PASS_CHANGE, err)
 		panic(err)
 	}
+	s.audit(ctx, u.ID, c.SECURITY_EVENT_MAIL_AND_PASS_CHANGE, nil)
 	loopFunc := func(ctx context.Context, funcname string, o *otp.Otp) {
 		ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, funcname)
 		defer span.End()
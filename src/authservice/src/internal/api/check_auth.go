@@ -7,10 +7,13 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/aqaurius6666/authservice/src/internal/db/suspension"
+	"github.com/aqaurius6666/authservice/src/internal/db/user"
 	"github.com/aqaurius6666/authservice/src/internal/lib"
 	"github.com/aqaurius6666/authservice/src/internal/var/c"
 	"github.com/aqaurius6666/authservice/src/internal/var/e"
 	"github.com/aqaurius6666/authservice/src/pb/authpb"
+	"github.com/aqaurius6666/authservice/src/services/nonce"
 	"github.com/aqaurius6666/go-utils/cryptography"
 	"github.com/aqaurius6666/go-utils/utils"
 	"go.opentelemetry.io/otel"
@@ -23,6 +26,7 @@ type HeaderStruct struct {
 		ID        string `json:"id"`
 		Timestamp int64  `json:"timestamp"`
 		Exp       int64  `json:"exp"`
+		Totp      string `json:"totp,omitempty"`
 	} `json:"certificateInfo"`
 	PublicKey string `json:"publicKey"`
 }
@@ -35,15 +39,40 @@ type BodyStruct struct {
 type BaseBodyStruct struct {
 	ActionType string `json:"_actionType"`
 	Timestamp  int64  `json:"_timestamp"`
+	Nonce      string `json:"_nonce"`
 }
 
-var ADD_EXPIRE_DURATION time.Duration = 7 * 24 * time.Hour
-
 func (s *ApiServer) CheckAuth(ctx context.Context, req *authpb.CheckAuthRequest) (*authpb.CheckAuthResponse, error) {
 	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.CheckAuth))
 	defer span.End()
+	usr := s.authenticate(ctx, req.Header, req.Body, req.Method, false)
+	perms, err := s.Model.GetPermissions(ctx, usr.Role)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		panic(err)
+	}
+	businessId, businessRole := s.businessOf(ctx, usr)
+	return &authpb.CheckAuthResponse{
+		Id:                 usr.ID.String(),
+		Role:               c.ROLE(utils.IntVal(usr.Role.Code)),
+		TotpEnrollRequired: s.Model.IsTotpEnrollRequired(usr),
+		Permissions:        perms,
+		BusinessId:         businessId,
+		BusinessRole:       businessRole,
+	}, nil
+}
+
+// authenticate verifies a signed certificate and, for methods other than
+// GET, the signed body that comes with it. It returns the user the
+// certificate belongs to and panics if any check fails. With restore set,
+// an account scheduled for deletion is let through so the caller can
+// restore it.
+func (s *ApiServer) authenticate(ctx context.Context, reqHeader, reqBody []byte, method string, restore bool) *user.User {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.authenticate))
+	defer span.End()
 	header := HeaderStruct{}
-	if err := json.Unmarshal(req.Header, &header); err != nil {
+	if err := json.Unmarshal(reqHeader, &header); err != nil {
 		err = xerrors.Errorf("%w", e.ErrAuthParseModelFail)
 		lib.RecordError(span, err)
 		panic(err)
@@ -53,6 +82,12 @@ func (s *ApiServer) CheckAuth(ctx context.Context, req *authpb.CheckAuthRequest)
 		lib.RecordError(span, err)
 		panic(err)
 	}
+	// A certificate dated in the future would outlive a later RevokeSessions.
+	if header.CertificateInfo.Timestamp > time.Now().Add(time.Duration(s.RequestWindow)).UnixMilli() {
+		err := xerrors.Errorf("%w", e.ErrInvalidTimestamp)
+		lib.RecordError(span, err)
+		panic(err)
+	}
 	bHeaderSig, err := cryptography.Base64ToBytes(header.Signature)
 	if err != nil {
 		err = xerrors.Errorf("%w", err)
@@ -71,9 +106,9 @@ func (s *ApiServer) CheckAuth(ctx context.Context, req *authpb.CheckAuthRequest)
 		panic(err)
 	}
 
-	if req.Method != http.MethodGet {
+	if method != http.MethodGet {
 		body := BodyStruct{}
-		if err := json.Unmarshal(req.Body, &body); err != nil {
+		if err := json.Unmarshal(reqBody, &body); err != nil {
 			err = xerrors.Errorf("%w", e.ErrAuthParseModelFail)
 			lib.RecordError(span, err)
 			panic(err)
@@ -96,12 +131,18 @@ func (s *ApiServer) CheckAuth(ctx context.Context, req *authpb.CheckAuthRequest)
 			lib.RecordError(span, err)
 			panic(err)
 		}
-		if baseBody.Timestamp < time.Now().Add(-ADD_EXPIRE_DURATION).UnixMilli() {
-			fmt.Println(baseBody.Timestamp, time.Now().UnixMilli())
+		window := time.Duration(s.RequestWindow)
+		now := time.Now()
+		if baseBody.Timestamp < now.Add(-window).UnixMilli() || baseBody.Timestamp > now.Add(window).UnixMilli() {
 			err = xerrors.Errorf("%w", e.ErrInvalidTimestamp)
 			lib.RecordError(span, err)
 			panic(err)
 		}
+		if baseBody.Nonce == "" {
+			err = xerrors.Errorf("%w", e.ErrMissingNonce)
+			lib.RecordError(span, err)
+			panic(err)
+		}
 		bBodySig, err := cryptography.Base64ToBytes(body.Signature)
 		if err != nil {
 			err = xerrors.Errorf("%w", err)
@@ -113,21 +154,62 @@ func (s *ApiServer) CheckAuth(ctx context.Context, req *authpb.CheckAuthRequest)
 			lib.RecordError(span, err)
 			panic(err)
 		}
+		// The body timestamp is accepted up to one window either side of now,
+		// so the nonce has to be remembered for two windows.
+		if err := s.Nonce.Use(ctx, fmt.Sprintf("%s:%s", header.CertificateInfo.ID, baseBody.Nonce), 2*window); err != nil {
+			if xerrors.Is(err, nonce.ErrNonceUsed) {
+				err = e.ErrRequestReplayed
+			}
+			err = xerrors.Errorf("%w", err)
+			lib.RecordError(span, err)
+			panic(err)
+		}
 	}
 
-	usr, err := s.Model.GetUserByIdPk(ctx, header.CertificateInfo.ID, header.PublicKey)
+	usr, err := s.Model.GetUserByIdKey(ctx, header.CertificateInfo.ID, header.PublicKey)
 	if err != nil {
 		err = xerrors.Errorf("%w", err)
 		lib.RecordError(span, err)
 		panic(err)
 	}
-	if !*usr.IsActive {
-		err = xerrors.Errorf("%w", e.ErrUserInactive)
+	if !*usr.IsActive && !(restore && s.restorable(ctx, usr)) {
+		err = xerrors.Errorf("%w", s.inactiveError(ctx, usr))
 		lib.RecordError(span, err)
 		panic(err)
 	}
-	return &authpb.CheckAuthResponse{
-		Id:   usr.ID.String(),
-		Role: c.ROLE(utils.IntVal(usr.Role.Code)),
-	}, nil
+	if err := s.Model.CheckRevoked(ctx, usr, header.CertificateInfo.Timestamp, header.Signature); err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		panic(err)
+	}
+	// Users with TOTP enabled put the code valid at the issue time of the
+	// certificate into it, so the certificate is bound to the second factor.
+	if err := s.Model.CheckTotp(ctx, usr, header.CertificateInfo.Totp, time.UnixMilli(header.CertificateInfo.Timestamp)); err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		panic(err)
+	}
+	return usr
+}
+
+// restorable tells whether an inactive user may sign in to cancel its
+// scheduled deletion. A suspension in force still keeps it out.
+func (s *ApiServer) restorable(ctx context.Context, usr *user.User) bool {
+	if !s.Model.IsDeletionScheduled(usr) {
+		return false
+	}
+	_, err := s.Model.GetActiveSuspension(ctx, usr.ID)
+	return xerrors.Is(err, suspension.ErrNotFound)
+}
+
+// inactiveError is the error an inactive user authenticates with. A
+// suspended user is told why and for how long.
+func (s *ApiServer) inactiveError(ctx context.Context, usr *user.User) error {
+	if sus, err := s.Model.GetActiveSuspension(ctx, usr.ID); err == nil {
+		return &e.SuspendedError{
+			Reason:    c.SUSPENSION_REASON(utils.IntVal(sus.Reason)),
+			ExpiresAt: sus.ExpiresAt,
+		}
+	}
+	return e.ErrUserInactive
 }
@@ -0,0 +1,25 @@
+package api
+
+import (
+	"context"
+
+	"github.com/aqaurius6666/authservice/src/internal/lib"
+	"github.com/aqaurius6666/authservice/src/internal/var/c"
+	"github.com/aqaurius6666/authservice/src/pb/authpb"
+	"go.opentelemetry.io/otel"
+	"golang.org/x/xerrors"
+)
+
+func (s *ApiServer) ConfirmTotp(ctx context.Context, req *authpb.ConfirmTotpRequest) (*authpb.ConfirmTotpResponse, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.ConfirmTotp))
+	defer span.End()
+	codes, err := s.Model.ConfirmTotp(ctx, req.UserId, req.Code)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		panic(err)
+	}
+	return &authpb.ConfirmTotpResponse{
+		RecoveryCodes: codes,
+	}, nil
+}
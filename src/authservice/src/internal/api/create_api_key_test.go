@@ -0,0 +1,66 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aqaurius6666/authservice/src/pb/authpb"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+func TestCreateApiKey(t *testing.T) {
+	TEST_CASE := []map[string]string{
+		{
+			"userId": "010a10c7-a807-4d7f-9bd7-d082a66e1e01",
+			"scope":  "orders:read",
+		},
+	}
+	ctx := context.Background()
+	conn, err := grpc.Dial("localhost:50051", grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(otelgrpc.UnaryClientInterceptor()),
+	)
+	assert.Nil(t, err)
+	client := authpb.NewAuthServiceClient(conn)
+
+	for _, tc := range TEST_CASE {
+		res, err := client.CreateApiKey(ctx, &authpb.CreateApiKeyRequest{
+			UserId: tc["userId"],
+			Name:   "test",
+			Scopes: []string{tc["scope"]},
+		})
+		if err != nil {
+			stt, ok := status.FromError(err)
+			assert.True(t, ok)
+			t.Error(stt.Message())
+			continue
+		}
+		chk, err := client.CheckApiKey(ctx, &authpb.CheckApiKeyRequest{
+			Key: res.Key,
+		})
+		if err != nil {
+			stt, ok := status.FromError(err)
+			assert.True(t, ok)
+			t.Error(stt.Message())
+		} else {
+			assert.Equal(t, tc["userId"], chk.Id)
+			assert.Contains(t, chk.Scopes, tc["scope"])
+		}
+		_, err = client.RevokeApiKey(ctx, &authpb.RevokeApiKeyRequest{
+			UserId: tc["userId"],
+			Id:     res.ApiKey.Id,
+		})
+		if err != nil {
+			stt, ok := status.FromError(err)
+			assert.True(t, ok)
+			t.Error(stt.Message())
+		}
+		_, err = client.CheckApiKey(ctx, &authpb.CheckApiKeyRequest{
+			Key: res.Key,
+		})
+		assert.NotNil(t, err)
+	}
+}
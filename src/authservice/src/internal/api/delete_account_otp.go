@@ -0,0 +1,53 @@
+package api
+
+import (
+	"context"
+	"time"
+
+	"github.com/aqaurius6666/authservice/src/internal/lib"
+	"github.com/aqaurius6666/authservice/src/internal/var/c"
+	"github.com/aqaurius6666/authservice/src/internal/var/e"
+	"github.com/aqaurius6666/authservice/src/pb/authpb"
+	"github.com/aqaurius6666/go-utils/utils"
+	"go.opentelemetry.io/otel"
+	"golang.org/x/xerrors"
+)
+
+func (s *ApiServer) DeleteAccountOTP(ctx context.Context, req *authpb.DeleteAccountOTPRequest) (*authpb.DeleteAccountOTPResponse, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.DeleteAccountOTP))
+	defer span.End()
+	o, err := s.Model.GetValidOtpById(ctx, req.OtpId)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		panic(err)
+	}
+	if !utils.BoolVal(o.Commited) || c.OTP_TYPE(utils.IntVal(o.Type)) != c.OTP_TYPE_DELETE_ACCOUNT {
+		err = xerrors.Errorf("%w", e.ErrOTPInvalid)
+		lib.RecordError(span, err)
+		panic(err)
+	}
+	deleteAfter := time.Now().Add(time.Duration(s.DeletionGracePeriod))
+	if err = s.Model.ScheduleDeletion(ctx, o.UserId, deleteAfter); err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		panic(err)
+	}
+	if err := s.Model.DeleteOTPById(ctx, o.ID); err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		// panic(err) // SHOULDN'T PANIC HERE
+	}
+	s.audit(ctx, o.UserId, c.SECURITY_EVENT_DELETION_SCHEDULE, nil)
+	u, err := s.Model.GetUserById(ctx, o.UserId)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		panic(err)
+	}
+	return &authpb.DeleteAccountOTPResponse{
+		Id:          u.ID.String(),
+		Role:        c.ROLE(utils.IntVal(u.Role.Code)),
+		DeleteAfter: deleteAfter.UnixMilli(),
+	}, nil
+}
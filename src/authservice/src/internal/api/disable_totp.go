@@ -0,0 +1,24 @@
+package api
+
+import (
+	"context"
+
+	"github.com/aqaurius6666/authservice/src/internal/lib"
+	"github.com/aqaurius6666/authservice/src/internal/var/c"
+	"github.com/aqaurius6666/authservice/src/pb/authpb"
+	"go.opentelemetry.io/otel"
+	"golang.org/x/xerrors"
+)
+
+func (s *ApiServer) DisableTotp(ctx context.Context, req *authpb.DisableTotpRequest) (*authpb.DisableTotpResponse, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.DisableTotp))
+	defer span.End()
+	if err := s.Model.DisableTotp(ctx, req.UserId, req.Code); err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		panic(err)
+	}
+	return &authpb.DisableTotpResponse{
+		UserId: req.UserId,
+	}, nil
+}
@@ -0,0 +1,26 @@
+package api
+
+import (
+	"context"
+
+	"github.com/aqaurius6666/authservice/src/internal/lib"
+	"github.com/aqaurius6666/authservice/src/internal/var/c"
+	"github.com/aqaurius6666/authservice/src/pb/authpb"
+	"go.opentelemetry.io/otel"
+	"golang.org/x/xerrors"
+)
+
+func (s *ApiServer) EnrollTotp(ctx context.Context, req *authpb.EnrollTotpRequest) (*authpb.EnrollTotpResponse, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.EnrollTotp))
+	defer span.End()
+	secret, uri, err := s.Model.EnrollTotp(ctx, req.UserId)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		panic(err)
+	}
+	return &authpb.EnrollTotpResponse{
+		Secret: secret,
+		Uri:    uri,
+	}, nil
+}
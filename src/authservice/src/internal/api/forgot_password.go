@@ -4,9 +4,12 @@ import (
 	"context"
 
 	"github.com/aqaurius6666/authservice/src/internal/db/otp"
+	"github.com/aqaurius6666/authservice/src/internal/db/user"
 	"github.com/aqaurius6666/authservice/src/internal/lib"
 	"github.com/aqaurius6666/authservice/src/internal/var/c"
+	"github.com/aqaurius6666/authservice/src/internal/var/e"
 	"github.com/aqaurius6666/authservice/src/pb/authpb"
+	"github.com/aqaurius6666/go-utils/utils"
 	"go.opentelemetry.io/otel"
 	"golang.org/x/xerrors"
 )
@@ -14,13 +17,26 @@ import (
 func (s *ApiServer) ForgotPassword(ctx context.Context, req *authpb.ForgotPasswordRequest) (*authpb.ForgotPasswordResponse, error) {
 	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.ForgotPassword))
 	defer span.End()
-	usr, err := s.Model.GetUserByMail(ctx, req.Mail)
+	var (
+		usr     *user.User
+		err     error
+		channel = c.OTP_CHANNEL_MAIL
+	)
+	if req.Phone != "" {
+		channel = c.OTP_CHANNEL_SMS
+		usr, err = s.Model.GetUserByPhone(ctx, req.Phone)
+		if err == nil && !utils.BoolVal(usr.IsPhoneVerified) {
+			err = e.ErrPhoneNotVerified
+		}
+	} else {
+		usr, err = s.Model.GetUserByMail(ctx, req.Mail)
+	}
 	if err != nil {
 		err = xerrors.Errorf("%w", err)
 		lib.RecordError(span, err)
 		panic(err)
 	}
-	o, err := s.Model.CreateForgotOTP(ctx, usr)
+	o, err := s.Model.CreateForgotOTP(ctx, usr, channel)
 	if err != nil {
 		err = xerrors.Errorf("%w", err)
 		lib.RecordError(span, err)
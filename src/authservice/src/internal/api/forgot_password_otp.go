@@ -24,13 +24,16 @@ func (s *ApiServer) ForgotPasswordOTP(ctx context.Context, req *authpb.ForgotPas
 	if !utils.BoolVal(o.Commited) || c.OTP_TYPE(utils.IntVal(o.Type)) != c.OTP_TYPE_FORGOT_PASSWORD {
 		err = xerrors.Errorf("%w", e.ErrOTPInvalid)
 		lib.RecordError(span, err)
+		s.audit(ctx, o.UserId, c.SECURITY_EVENT_PASSWORD_RESET, err)
 		panic(err)
 	}
 	if err = s.Model.UpdateKey(ctx, o.UserId, req.PublicKey, req.EncryptedPrivateKey); err != nil {
 		err = xerrors.Errorf("%w", err)
 		lib.RecordError(span, err)
+		s.audit(ctx, o.UserId, c.SECURITY_EVENT_PASSWORD_RESET, err)
 		panic(err)
 	}
+	s.audit(ctx, o.UserId, c.SECURITY_EVENT_PASSWORD_RESET, nil)
 	if err := s.Model.DeleteOTPById(ctx, o.ID); err != nil {
 		err = xerrors.Errorf("%w", err)
 		lib.RecordError(span, err)
@@ -7,7 +7,9 @@ import (
 	"github.com/aqaurius6666/authservice/src/internal/lib"
 	"github.com/aqaurius6666/authservice/src/internal/lib/validate"
 	"github.com/aqaurius6666/authservice/src/internal/var/c"
+	"github.com/aqaurius6666/authservice/src/internal/var/e"
 	"github.com/aqaurius6666/authservice/src/pb/authpb"
+	"github.com/aqaurius6666/go-utils/utils"
 	"go.opentelemetry.io/otel"
 	"golang.org/x/xerrors"
 )
@@ -34,11 +36,37 @@ func (s *ApiServer) GetCredential(ctx context.Context, req *authpb.GetCredential
 			lib.RecordError(span, err)
 			panic(err)
 		}
+		// Only a phone proven by an sms otp can be used to sign in.
+		if !utils.BoolVal(usr.IsPhoneVerified) {
+			err = xerrors.Errorf("%w", e.ErrPhoneNotVerified)
+			lib.RecordError(span, err)
+			s.audit(ctx, usr.ID, c.SECURITY_EVENT_CREDENTIAL_FETCH, err)
+			panic(err)
+		}
 	} else {
 		err = xerrors.Errorf("%w", user.ErrNotFound)
 		lib.RecordError(span, err)
 		panic(err)
 	}
+	if err := s.Model.CheckTotpOrRecovery(ctx, usr, req.Totp); err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		s.audit(ctx, usr.ID, c.SECURITY_EVENT_CREDENTIAL_FETCH, err)
+		panic(err)
+	}
+	// The new device check has to run before this sign in is recorded.
+	newDevice, err := s.Model.IsNewDevice(ctx, usr.ID)
+	if err != nil {
+		s.Logger.Error(err)
+	}
+	s.audit(ctx, usr.ID, c.SECURITY_EVENT_CREDENTIAL_FETCH, nil)
+	if newDevice {
+		go func(ctx context.Context, usr *user.User, ua, ip string) {
+			if err := s.Model.SendNewDeviceMail(ctx, usr, ua, ip); err != nil {
+				s.Logger.Error(err)
+			}
+		}(context.Background(), usr, lib.UserAgent(ctx), lib.ClientIP(ctx))
+	}
 
 	return &authpb.GetCredentialResponse{
 		Credential: &authpb.Credential{
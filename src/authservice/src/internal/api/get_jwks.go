@@ -0,0 +1,30 @@
+package api
+
+import (
+	"context"
+
+	"github.com/aqaurius6666/authservice/src/internal/lib"
+	"github.com/aqaurius6666/authservice/src/internal/var/c"
+	"github.com/aqaurius6666/authservice/src/pb/authpb"
+	"go.opentelemetry.io/otel"
+)
+
+// GetJwks returns the public keys access tokens are signed with.
+func (s *ApiServer) GetJwks(ctx context.Context, req *authpb.GetJwksRequest) (*authpb.GetJwksResponse, error) {
+	_, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.GetJwks))
+	defer span.End()
+	k := s.Signer.JWK()
+	return &authpb.GetJwksResponse{
+		Keys: []*authpb.Jwk{
+			{
+				Kty: k.Kty,
+				Crv: k.Crv,
+				X:   k.X,
+				Y:   k.Y,
+				Kid: k.Kid,
+				Alg: k.Alg,
+				Use: k.Use,
+			},
+		},
+	}, nil
+}
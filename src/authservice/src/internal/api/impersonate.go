@@ -0,0 +1,85 @@
+package api
+
+import (
+	"context"
+	"time"
+
+	"github.com/aqaurius6666/authservice/src/internal/lib"
+	"github.com/aqaurius6666/authservice/src/internal/lib/jwt"
+	"github.com/aqaurius6666/authservice/src/internal/var/c"
+	"github.com/aqaurius6666/authservice/src/pb/authpb"
+	"github.com/aqaurius6666/go-utils/utils"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"golang.org/x/xerrors"
+)
+
+// Impersonate issues the admin with AdminId a read only access token of the
+// user with UserId. The token cannot be refreshed, names the admin as its
+// actor and its ID is the session every request made with it is audited
+// under.
+func (s *ApiServer) Impersonate(ctx context.Context, req *authpb.ImpersonateRequest) (*authpb.ImpersonateResponse, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.Impersonate))
+	defer span.End()
+	usr, sessionId, err := s.Model.StartImpersonation(ctx, req.AdminId, req.UserId, req.Reason)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		panic(err)
+	}
+	span.SetAttributes(
+		attribute.String("auth.impersonator_id", req.AdminId),
+		attribute.String("auth.impersonation_id", sessionId.String()),
+	)
+	perms, err := s.Model.GetPermissions(ctx, usr.Role)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		panic(err)
+	}
+	businessId, businessRole := s.businessOf(ctx, usr)
+	now := time.Now()
+	exp := now.Add(c.IMPERSONATION_TTL)
+	token, err := s.Signer.Sign(jwt.Claims{
+		Issuer:       c.SERVICE_NAME,
+		Subject:      usr.ID.String(),
+		Role:         int32(utils.IntVal(usr.Role.Code)),
+		Permissions:  perms,
+		BusinessId:   businessId,
+		BusinessRole: int32(businessRole),
+		IssuedAt:     now.Unix(),
+		ExpiresAt:    exp.Unix(),
+		ID:           sessionId.String(),
+		Actor:        &jwt.Actor{Subject: req.AdminId},
+		ReadOnly:     true,
+	})
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		panic(err)
+	}
+	s.Logger.WithField("impersonatorId", req.AdminId).Infof("impersonation %s of user %s started", sessionId, usr.ID)
+	return &authpb.ImpersonateResponse{
+		AccessToken: token,
+		ExpiresAt:   exp.Unix() * 1000,
+		SessionId:   sessionId.String(),
+	}, nil
+}
+
+// RecordImpersonationAction adds a request made with an impersonation token
+// to the audit trail of its session.
+func (s *ApiServer) RecordImpersonationAction(ctx context.Context, req *authpb.RecordImpersonationActionRequest) (*authpb.RecordImpersonationActionResponse, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.RecordImpersonationAction))
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("auth.impersonator_id", req.AdminId),
+		attribute.String("auth.impersonation_id", req.SessionId),
+	)
+	action := req.Method + " " + req.Path
+	if err := s.Model.RecordImpersonationAction(ctx, req.SessionId, req.AdminId, req.UserId, action, int(req.Status)); err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		panic(err)
+	}
+	return &authpb.RecordImpersonationActionResponse{}, nil
+}
@@ -0,0 +1,54 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aqaurius6666/authservice/src/internal/var/c"
+	"github.com/aqaurius6666/authservice/src/pb/authpb"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+func TestInviteMember(t *testing.T) {
+	TEST_CASE := []map[string]string{
+		{
+			"userId": "010a10c7-a807-4d7f-9bd7-d082a66e1e01",
+			"mail":   "dispatcher@mailinator.com",
+		},
+	}
+	ctx := context.Background()
+	conn, err := grpc.Dial("localhost:50051", grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(otelgrpc.UnaryClientInterceptor()),
+	)
+	assert.Nil(t, err)
+	client := authpb.NewAuthServiceClient(conn)
+
+	for _, tc := range TEST_CASE {
+		res, err := client.InviteMember(ctx, &authpb.InviteMemberRequest{
+			UserId:       tc["userId"],
+			Mail:         tc["mail"],
+			Role:         c.BUSINESS_ROLE_STAFF,
+			BusinessName: "test",
+		})
+		if err != nil {
+			stt, ok := status.FromError(err)
+			assert.True(t, ok)
+			t.Error(stt.Message())
+			continue
+		}
+		assert.False(t, res.Member.Accepted)
+		_, err = client.RemoveMember(ctx, &authpb.RemoveMemberRequest{
+			UserId:   tc["userId"],
+			MemberId: res.Member.Id,
+		})
+		if err != nil {
+			stt, ok := status.FromError(err)
+			assert.True(t, ok)
+			t.Error(stt.Message())
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package api
+
+import (
+	"context"
+	"time"
+
+	"github.com/aqaurius6666/authservice/src/internal/db/user"
+	"github.com/aqaurius6666/authservice/src/internal/lib"
+	"github.com/aqaurius6666/authservice/src/internal/lib/jwt"
+	"github.com/aqaurius6666/authservice/src/internal/var/c"
+	"github.com/aqaurius6666/authservice/src/pb/authpb"
+	"github.com/aqaurius6666/go-utils/utils"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"golang.org/x/xerrors"
+)
+
+func (s *ApiServer) IssueToken(ctx context.Context, req *authpb.IssueTokenRequest) (*authpb.IssueTokenResponse, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.IssueToken))
+	defer span.End()
+	usr := s.authenticate(ctx, req.Header, req.Body, req.Method, true)
+	// Scheduling a deletion revokes every certificate issued before it, so
+	// getting here means the user signed in again during the grace period.
+	deletionCanceled := s.Model.IsDeletionScheduled(usr)
+	if deletionCanceled {
+		if err := s.Model.CancelDeletion(ctx, usr.ID); err != nil {
+			err = xerrors.Errorf("%w", err)
+			lib.RecordError(span, err)
+			panic(err)
+		}
+		s.audit(ctx, usr.ID, c.SECURITY_EVENT_DELETION_CANCEL, nil)
+	}
+	accessToken, exp := s.signAccessToken(ctx, usr)
+	refreshToken, refreshExp, err := s.Model.CreateRefreshToken(ctx, usr)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		panic(err)
+	}
+	return &authpb.IssueTokenResponse{
+		AccessToken:      accessToken,
+		ExpiresAt:        exp,
+		RefreshToken:     refreshToken,
+		RefreshExpiresAt: refreshExp,
+		Id:               usr.ID.String(),
+		Role:             c.ROLE(utils.IntVal(usr.Role.Code)),
+		DeletionCanceled: deletionCanceled,
+	}, nil
+}
+
+// signAccessToken returns an access token for usr and the time in
+// milliseconds it expires at. Access tokens are checked by the other
+// services without asking authservice, so a revocation only takes effect
+// on them once they expire.
+func (s *ApiServer) signAccessToken(ctx context.Context, usr *user.User) (string, int64) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.signAccessToken))
+	defer span.End()
+	perms, err := s.Model.GetPermissions(ctx, usr.Role)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		panic(err)
+	}
+	businessId, businessRole := s.businessOf(ctx, usr)
+	now := time.Now()
+	exp := now.Add(c.ACCESS_TOKEN_TTL)
+	token, err := s.Signer.Sign(jwt.Claims{
+		Issuer:             c.SERVICE_NAME,
+		Subject:            usr.ID.String(),
+		Role:               int32(utils.IntVal(usr.Role.Code)),
+		TotpEnrollRequired: s.Model.IsTotpEnrollRequired(usr),
+		Permissions:        perms,
+		BusinessId:         businessId,
+		BusinessRole:       int32(businessRole),
+		IssuedAt:           now.Unix(),
+		ExpiresAt:          exp.Unix(),
+		ID:                 uuid.NewString(),
+	})
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		panic(err)
+	}
+	return token, exp.Unix() * 1000
+}
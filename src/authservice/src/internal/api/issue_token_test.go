@@ -0,0 +1,50 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aqaurius6666/authservice/src/internal/db/suspension"
+	"github.com/aqaurius6666/authservice/src/internal/db/user"
+	"github.com/aqaurius6666/authservice/src/internal/model"
+	"github.com/aqaurius6666/authservice/src/internal/var/c"
+	"github.com/aqaurius6666/authservice/src/internal/var/e"
+	"github.com/aqaurius6666/go-utils/utils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/xerrors"
+)
+
+// restoreModel answers the deletion and suspension questions authenticate
+// asks about an inactive user.
+type restoreModel struct {
+	model.Server
+	sus *suspension.Suspension
+}
+
+func (m restoreModel) IsDeletionScheduled(u *user.User) bool {
+	return utils.Int64Val(u.DeleteAfter) != 0
+}
+
+func (m restoreModel) GetActiveSuspension(ctx context.Context, id uuid.UUID) (*suspension.Suspension, error) {
+	if m.sus == nil {
+		return nil, xerrors.Errorf("%w", suspension.ErrNotFound)
+	}
+	return m.sus, nil
+}
+
+func TestIssueTokenRestore(t *testing.T) {
+	ctx := context.Background()
+	scheduled := &user.User{IsActive: utils.BoolPtr(false), DeleteAfter: utils.Int64Ptr(1)}
+
+	s := &ApiServer{Model: restoreModel{}}
+	assert.True(t, s.restorable(ctx, scheduled))
+	assert.False(t, s.restorable(ctx, &user.User{IsActive: utils.BoolPtr(false), DeleteAfter: utils.Int64Ptr(0)}))
+
+	// deletion scheduled, then suspended: /token must not restore the account
+	s = &ApiServer{Model: restoreModel{sus: &suspension.Suspension{Reason: utils.IntPtr(int(c.SUSPENSION_REASON_SPAM))}}}
+	assert.False(t, s.restorable(ctx, scheduled))
+	var suspended *e.SuspendedError
+	assert.True(t, xerrors.As(s.inactiveError(ctx, scheduled), &suspended))
+	assert.Equal(t, c.SUSPENSION_REASON_SPAM, suspended.Reason)
+}
@@ -0,0 +1,35 @@
+package api
+
+import (
+	"context"
+
+	"github.com/aqaurius6666/authservice/src/internal/lib"
+	"github.com/aqaurius6666/authservice/src/internal/var/c"
+	"github.com/aqaurius6666/authservice/src/pb/authpb"
+	"github.com/aqaurius6666/go-utils/utils"
+	"go.opentelemetry.io/otel"
+	"golang.org/x/xerrors"
+)
+
+// ListDueDeletions returns the accounts whose deletion grace period is over,
+// so the cleanup job can erase their data before calling DeleteUser.
+func (s *ApiServer) ListDueDeletions(ctx context.Context, req *authpb.ListDueDeletionsRequest) (*authpb.ListDueDeletionsResponse, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.ListDueDeletions))
+	defer span.End()
+	users, err := s.Model.ListDueDeletions(ctx, int(req.Limit))
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		panic(err)
+	}
+	res := make([]*authpb.DueDeletion, 0, len(users))
+	for _, u := range users {
+		res = append(res, &authpb.DueDeletion{
+			Id:   u.ID.String(),
+			Role: c.ROLE(utils.IntVal(u.Role.Code)),
+		})
+	}
+	return &authpb.ListDueDeletionsResponse{
+		Users: res,
+	}, nil
+}
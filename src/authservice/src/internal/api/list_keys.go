@@ -0,0 +1,42 @@
+package api
+
+import (
+	"context"
+
+	"github.com/aqaurius6666/authservice/src/internal/db/userkey"
+	"github.com/aqaurius6666/authservice/src/internal/lib"
+	"github.com/aqaurius6666/authservice/src/internal/var/c"
+	"github.com/aqaurius6666/authservice/src/pb/authpb"
+	"github.com/aqaurius6666/go-utils/utils"
+	"go.opentelemetry.io/otel"
+	"golang.org/x/xerrors"
+)
+
+func (s *ApiServer) ListKeys(ctx context.Context, req *authpb.ListKeysRequest) (*authpb.ListKeysResponse, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.ListKeys))
+	defer span.End()
+	keys, err := s.Model.ListUserKeys(ctx, req.UserId)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		panic(err)
+	}
+	res := make([]*authpb.UserKey, 0, len(keys))
+	for _, k := range keys {
+		res = append(res, toUserKeyProto(k))
+	}
+	return &authpb.ListKeysResponse{
+		Keys: res,
+	}, nil
+}
+
+func toUserKeyProto(k *userkey.UserKey) *authpb.UserKey {
+	return &authpb.UserKey{
+		Id:         k.ID.String(),
+		PublicKey:  utils.StrVal(k.PublicKey),
+		Label:      utils.StrVal(k.Label),
+		LastUsedAt: k.LastUsedAt,
+		CreatedAt:  k.CreatedAt,
+		IsActive:   utils.BoolVal(k.IsActive),
+	}
+}
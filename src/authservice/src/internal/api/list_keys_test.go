@@ -0,0 +1,39 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aqaurius6666/authservice/src/pb/authpb"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+func TestListKeys(t *testing.T) {
+	TEST_CASE := []map[string]string{
+		{
+			"id": "72f048be-f355-4521-b89f-958c997b4d48",
+		},
+	}
+	ctx := context.Background()
+	conn, err := grpc.Dial("localhost:50051", grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(otelgrpc.UnaryClientInterceptor()),
+	)
+	assert.Nil(t, err)
+	client := authpb.NewAuthServiceClient(conn)
+
+	for _, c := range TEST_CASE {
+		res, err := client.ListKeys(ctx, &authpb.ListKeysRequest{
+			UserId: c["id"],
+		})
+		if err != nil {
+			stt, ok := status.FromError(err)
+			assert.True(t, ok)
+			t.Error(stt.Message())
+		}
+		t.Log(res)
+	}
+}
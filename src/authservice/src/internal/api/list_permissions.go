@@ -0,0 +1,33 @@
+package api
+
+import (
+	"context"
+
+	"github.com/aqaurius6666/authservice/src/internal/lib"
+	"github.com/aqaurius6666/authservice/src/internal/var/c"
+	"github.com/aqaurius6666/authservice/src/pb/authpb"
+	"github.com/aqaurius6666/go-utils/utils"
+	"go.opentelemetry.io/otel"
+	"golang.org/x/xerrors"
+)
+
+func (s *ApiServer) ListPermissions(ctx context.Context, req *authpb.ListPermissionsRequest) (*authpb.ListPermissionsResponse, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.ListPermissions))
+	defer span.End()
+	perms, err := s.Model.ListPermissions(ctx)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		panic(err)
+	}
+	res := make([]*authpb.Permission, 0, len(perms))
+	for _, p := range perms {
+		res = append(res, &authpb.Permission{
+			Code:        utils.StrVal(p.Code),
+			Description: utils.StrVal(p.Description),
+		})
+	}
+	return &authpb.ListPermissionsResponse{
+		Permissions: res,
+	}, nil
+}
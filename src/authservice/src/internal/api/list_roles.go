@@ -0,0 +1,40 @@
+package api
+
+import (
+	"context"
+
+	"github.com/aqaurius6666/authservice/src/internal/lib"
+	"github.com/aqaurius6666/authservice/src/internal/var/c"
+	"github.com/aqaurius6666/authservice/src/pb/authpb"
+	"github.com/aqaurius6666/go-utils/utils"
+	"go.opentelemetry.io/otel"
+	"golang.org/x/xerrors"
+)
+
+func (s *ApiServer) ListRoles(ctx context.Context, req *authpb.ListRolesRequest) (*authpb.ListRolesResponse, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.ListRoles))
+	defer span.End()
+	roles, err := s.Model.ListRoles(ctx)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		panic(err)
+	}
+	res := make([]*authpb.Role, 0, len(roles))
+	for _, r := range roles {
+		perms, err := s.Model.GetPermissions(ctx, r)
+		if err != nil {
+			err = xerrors.Errorf("%w", err)
+			lib.RecordError(span, err)
+			panic(err)
+		}
+		res = append(res, &authpb.Role{
+			Role:        c.ROLE(utils.IntVal(r.Code)),
+			Name:        utils.StrVal(r.Name),
+			Permissions: perms,
+		})
+	}
+	return &authpb.ListRolesResponse{
+		Roles: res,
+	}, nil
+}
@@ -0,0 +1,52 @@
+package api
+
+import (
+	"context"
+
+	"github.com/aqaurius6666/authservice/src/internal/lib"
+	"github.com/aqaurius6666/authservice/src/internal/var/c"
+	"github.com/aqaurius6666/authservice/src/pb/authpb"
+	"github.com/aqaurius6666/go-utils/utils"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"golang.org/x/xerrors"
+)
+
+func (s *ApiServer) ListSecurityEvents(ctx context.Context, req *authpb.ListSecurityEventsRequest) (*authpb.ListSecurityEventsResponse, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.ListSecurityEvents))
+	defer span.End()
+	events, total, err := s.Model.ListSecurityEvents(ctx, req.UserId, int(req.Limit), int(req.Offset))
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		panic(err)
+	}
+	res := make([]*authpb.SecurityEvent, 0, len(events))
+	for _, ev := range events {
+		res = append(res, &authpb.SecurityEvent{
+			Id:        ev.ID.String(),
+			Type:      c.SECURITY_EVENT(utils.IntVal(ev.Type)),
+			Ip:        utils.StrVal(ev.Ip),
+			UserAgent: utils.StrVal(ev.UserAgent),
+			Success:   utils.BoolVal(ev.Success),
+			Reason:    utils.StrVal(ev.Reason),
+			CreatedAt: ev.CreatedAt,
+		})
+	}
+	return &authpb.ListSecurityEventsResponse{
+		Events: res,
+		Total:  total,
+	}, nil
+}
+
+// audit records a security event for the user with id, outcome being the
+// error the request fails with. Events of unknown users are dropped, and a
+// failure to record is only logged so it never fails the request itself.
+func (s *ApiServer) audit(ctx context.Context, id uuid.UUID, typ c.SECURITY_EVENT, outcome error) {
+	if id == uuid.Nil {
+		return
+	}
+	if err := s.Model.RecordSecurityEvent(ctx, id, typ, outcome); err != nil {
+		s.Logger.Error(err)
+	}
+}
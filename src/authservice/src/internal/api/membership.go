@@ -0,0 +1,153 @@
+package api
+
+import (
+	"context"
+
+	"github.com/aqaurius6666/authservice/src/internal/db/membership"
+	"github.com/aqaurius6666/authservice/src/internal/db/otp"
+	"github.com/aqaurius6666/authservice/src/internal/db/user"
+	"github.com/aqaurius6666/authservice/src/internal/lib"
+	"github.com/aqaurius6666/authservice/src/internal/var/c"
+	"github.com/aqaurius6666/authservice/src/internal/var/e"
+	"github.com/aqaurius6666/authservice/src/pb/authpb"
+	"github.com/aqaurius6666/go-utils/utils"
+	"go.opentelemetry.io/otel"
+	"golang.org/x/xerrors"
+)
+
+func (s *ApiServer) InviteMember(ctx context.Context, req *authpb.InviteMemberRequest) (*authpb.InviteMemberResponse, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.InviteMember))
+	defer span.End()
+	usr, err := s.Model.GetUserById(ctx, req.UserId)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		panic(err)
+	}
+	m, o, err := s.Model.InviteMember(ctx, usr, req.Mail, req.Role, req.BusinessName)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		panic(err)
+	}
+	loopFunc := func(ctx context.Context, funcname string, o *otp.Otp) {
+		ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, funcname)
+		defer span.End()
+		var err error
+		limit := 4
+		for i := 0; i < limit; i++ {
+			if err = s.Model.SendOTP(ctx, o); err == nil {
+				return
+			}
+			s.Logger.Error(err)
+		}
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+	}
+	go loopFunc(context.Background(), lib.GetFunctionName(loopFunc), o)
+	return &authpb.InviteMemberResponse{
+		Member: memberToPb(m),
+	}, nil
+}
+
+// AcceptInvitation makes a user a member of the business that invited it,
+// once the otp of the invitation is verified.
+func (s *ApiServer) AcceptInvitation(ctx context.Context, req *authpb.AcceptInvitationRequest) (*authpb.AcceptInvitationResponse, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.AcceptInvitation))
+	defer span.End()
+	o, err := s.Model.GetValidOtpById(ctx, req.OtpId)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		panic(err)
+	}
+	if !utils.BoolVal(o.Commited) || c.OTP_TYPE(utils.IntVal(o.Type)) != c.OTP_TYPE_BUSINESS_INVITE {
+		err = xerrors.Errorf("%w", e.ErrOTPInvalid)
+		lib.RecordError(span, err)
+		panic(err)
+	}
+	usr, err := s.Model.GetUserById(ctx, req.UserId)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		panic(err)
+	}
+	m, err := s.Model.AcceptInvitation(ctx, usr, o)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		panic(err)
+	}
+	if err := s.Model.DeleteOTPById(ctx, o.ID); err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		// panic(err) // SHOULDN'T PANIC HERE
+	}
+	return &authpb.AcceptInvitationResponse{
+		BusinessId: m.BusinessId.String(),
+		Role:       c.BUSINESS_ROLE(utils.IntVal(m.Role)),
+	}, nil
+}
+
+func (s *ApiServer) ListMembers(ctx context.Context, req *authpb.ListMembersRequest) (*authpb.ListMembersResponse, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.ListMembers))
+	defer span.End()
+	ms, err := s.Model.ListMembers(ctx, req.BusinessId)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		panic(err)
+	}
+	members := make([]*authpb.Member, 0, len(ms))
+	for _, m := range ms {
+		members = append(members, memberToPb(m))
+	}
+	return &authpb.ListMembersResponse{
+		Members: members,
+	}, nil
+}
+
+func (s *ApiServer) RemoveMember(ctx context.Context, req *authpb.RemoveMemberRequest) (*authpb.RemoveMemberResponse, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.RemoveMember))
+	defer span.End()
+	usr, err := s.Model.GetUserById(ctx, req.UserId)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		panic(err)
+	}
+	if err := s.Model.RemoveMember(ctx, usr, req.MemberId); err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		panic(err)
+	}
+	return &authpb.RemoveMemberResponse{}, nil
+}
+
+// businessOf returns the business usr acts for and its role there, or an
+// empty id when it acts for none.
+func (s *ApiServer) businessOf(ctx context.Context, usr *user.User) (string, c.BUSINESS_ROLE) {
+	bid, role, err := s.Model.GetMembership(ctx, usr)
+	if err != nil {
+		if xerrors.Is(err, e.ErrNotMember) {
+			return "", 0
+		}
+		panic(xerrors.Errorf("%w", err))
+	}
+	return bid.String(), role
+}
+
+func memberToPb(m *membership.Membership) *authpb.Member {
+	uid := ""
+	if m.AcceptedAt != 0 {
+		uid = m.UserId.String()
+	}
+	return &authpb.Member{
+		Id:        m.ID.String(),
+		UserId:    uid,
+		Mail:      utils.StrVal(m.Mail),
+		Role:      c.BUSINESS_ROLE(utils.IntVal(m.Role)),
+		Accepted:  m.AcceptedAt != 0,
+		CreatedAt: m.CreatedAt,
+	}
+}
@@ -0,0 +1,38 @@
+package api
+
+import (
+	"context"
+
+	"github.com/aqaurius6666/authservice/src/internal/lib"
+	"github.com/aqaurius6666/authservice/src/internal/var/c"
+	"github.com/aqaurius6666/authservice/src/pb/authpb"
+	"go.opentelemetry.io/otel"
+	"golang.org/x/xerrors"
+)
+
+// RefreshToken rotates a refresh token. The caller still has to present a
+// signed certificate of the same user, the refresh token alone is not enough
+// to get a new access token.
+func (s *ApiServer) RefreshToken(ctx context.Context, req *authpb.RefreshTokenRequest) (*authpb.RefreshTokenResponse, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.RefreshToken))
+	defer span.End()
+	usr := s.authenticate(ctx, req.Header, req.Body, req.Method, false)
+	if err := s.Model.UseRefreshToken(ctx, usr, req.RefreshToken); err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		panic(err)
+	}
+	accessToken, exp := s.signAccessToken(ctx, usr)
+	refreshToken, refreshExp, err := s.Model.CreateRefreshToken(ctx, usr)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		panic(err)
+	}
+	return &authpb.RefreshTokenResponse{
+		AccessToken:      accessToken,
+		ExpiresAt:        exp,
+		RefreshToken:     refreshToken,
+		RefreshExpiresAt: refreshExp,
+	}, nil
+}
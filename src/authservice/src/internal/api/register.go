@@ -6,7 +6,9 @@ import (
 	"github.com/aqaurius6666/authservice/src/internal/db/otp"
 	"github.com/aqaurius6666/authservice/src/internal/db/user"
 	"github.com/aqaurius6666/authservice/src/internal/lib"
+	"github.com/aqaurius6666/authservice/src/internal/lib/validate"
 	"github.com/aqaurius6666/authservice/src/internal/var/c"
+	"github.com/aqaurius6666/authservice/src/internal/var/e"
 	"github.com/aqaurius6666/authservice/src/pb/authpb"
 	"go.opentelemetry.io/otel"
 	"golang.org/x/xerrors"
@@ -34,12 +36,17 @@ func (s *ApiServer) Register(ctx context.Context, req *authpb.RegisterRequest) (
 		usr.RefCode = &req.RefCode
 	}
 
+	if req.Channel == c.OTP_CHANNEL_SMS && !validate.IsPhone(req.Phone) {
+		err = xerrors.Errorf("%w", e.ErrInvalidPhone)
+		lib.RecordError(span, err)
+		panic(err)
+	}
 	if err := s.Model.CheckUserExisted(ctx, usr); err != nil {
 		err = xerrors.Errorf("%w", err)
 		lib.RecordError(span, err)
 		panic(err)
 	}
-	o, err := s.Model.CreateRegisterOTP(ctx, usr)
+	o, err := s.Model.CreateRegisterOTP(ctx, usr, req.Channel)
 	if err != nil {
 		err = xerrors.Errorf("%w", err)
 		lib.RecordError(span, err)
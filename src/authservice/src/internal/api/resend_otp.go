@@ -2,12 +2,12 @@ package api
 
 import (
 	"context"
-	"time"
 
 	"github.com/aqaurius6666/authservice/src/internal/lib"
+	"github.com/aqaurius6666/authservice/src/internal/model"
 	"github.com/aqaurius6666/authservice/src/internal/var/c"
-	"github.com/aqaurius6666/authservice/src/internal/var/e"
 	"github.com/aqaurius6666/authservice/src/pb/authpb"
+	"github.com/aqaurius6666/go-utils/utils"
 	"go.opentelemetry.io/otel"
 	"golang.org/x/xerrors"
 )
@@ -21,8 +21,8 @@ func (s *ApiServer) ResendOTP(ctx context.Context, req *authpb.ResendOTPRequest)
 		lib.RecordError(span, err)
 		panic(err)
 	}
-	if o.UpdatedAt+c.OTP_SPAM_TIME.Milliseconds() > time.Now().UnixMilli() {
-		err = xerrors.Errorf("%w", e.ErrOTPSpam)
+	if ok, err := s.Model.ShouldSend(ctx, model.OtpDestination(o), c.OTP_TYPE(utils.IntVal(o.Type))); err != nil || !ok {
+		err = xerrors.Errorf("%w", err)
 		lib.RecordError(span, err)
 		panic(err)
 	}
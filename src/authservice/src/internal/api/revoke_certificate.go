@@ -0,0 +1,57 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/aqaurius6666/authservice/src/internal/lib"
+	"github.com/aqaurius6666/authservice/src/internal/var/c"
+	"github.com/aqaurius6666/authservice/src/internal/var/e"
+	"github.com/aqaurius6666/authservice/src/pb/authpb"
+	"github.com/aqaurius6666/go-utils/cryptography"
+	"go.opentelemetry.io/otel"
+	"golang.org/x/xerrors"
+)
+
+func (s *ApiServer) RevokeCertificate(ctx context.Context, req *authpb.RevokeCertificateRequest) (*authpb.RevokeCertificateResponse, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.RevokeCertificate))
+	defer span.End()
+	header := HeaderStruct{}
+	if err := json.Unmarshal(req.Header, &header); err != nil {
+		err = xerrors.Errorf("%w", e.ErrAuthParseModelFail)
+		lib.RecordError(span, err)
+		panic(err)
+	}
+	bHeaderSig, err := cryptography.Base64ToBytes(header.Signature)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		panic(err)
+	}
+	bPublicKey, err := cryptography.Base64ToBytes(header.PublicKey)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		panic(err)
+	}
+	if ok, err := cryptography.VerifySignature(header.CertificateInfo, bHeaderSig, bPublicKey); err != nil || !ok {
+		err = xerrors.Errorf("%w", e.ErrAuthVerifySignatureFail)
+		lib.RecordError(span, err)
+		panic(err)
+	}
+	usr, err := s.Model.GetUserByIdKey(ctx, header.CertificateInfo.ID, header.PublicKey)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		panic(err)
+	}
+	exp := header.CertificateInfo.Timestamp + header.CertificateInfo.Exp
+	if err := s.Model.RevokeCertificate(ctx, usr.ID, header.Signature, exp); err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		panic(err)
+	}
+	return &authpb.RevokeCertificateResponse{
+		Id: usr.ID.String(),
+	}, nil
+}
@@ -0,0 +1,24 @@
+package api
+
+import (
+	"context"
+
+	"github.com/aqaurius6666/authservice/src/internal/lib"
+	"github.com/aqaurius6666/authservice/src/internal/var/c"
+	"github.com/aqaurius6666/authservice/src/pb/authpb"
+	"go.opentelemetry.io/otel"
+	"golang.org/x/xerrors"
+)
+
+func (s *ApiServer) RevokeKey(ctx context.Context, req *authpb.RevokeKeyRequest) (*authpb.RevokeKeyResponse, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.RevokeKey))
+	defer span.End()
+	if err := s.Model.RevokeUserKey(ctx, req.UserId, req.Id); err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		panic(err)
+	}
+	return &authpb.RevokeKeyResponse{
+		Id: req.Id,
+	}, nil
+}
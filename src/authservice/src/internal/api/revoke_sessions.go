@@ -0,0 +1,26 @@
+package api
+
+import (
+	"context"
+
+	"github.com/aqaurius6666/authservice/src/internal/lib"
+	"github.com/aqaurius6666/authservice/src/internal/var/c"
+	"github.com/aqaurius6666/authservice/src/pb/authpb"
+	"go.opentelemetry.io/otel"
+	"golang.org/x/xerrors"
+)
+
+func (s *ApiServer) RevokeSessions(ctx context.Context, req *authpb.RevokeSessionsRequest) (*authpb.RevokeSessionsResponse, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.RevokeSessions))
+	defer span.End()
+	notBefore, err := s.Model.RevokeSessions(ctx, req.Id)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		panic(err)
+	}
+	return &authpb.RevokeSessionsResponse{
+		Id:        req.Id,
+		NotBefore: notBefore,
+	}, nil
+}
@@ -0,0 +1,26 @@
+package api
+
+import (
+	"context"
+
+	"github.com/aqaurius6666/authservice/src/internal/lib"
+	"github.com/aqaurius6666/authservice/src/internal/var/c"
+	"github.com/aqaurius6666/authservice/src/pb/authpb"
+	"go.opentelemetry.io/otel"
+	"golang.org/x/xerrors"
+)
+
+func (s *ApiServer) SetRolePermissions(ctx context.Context, req *authpb.SetRolePermissionsRequest) (*authpb.SetRolePermissionsResponse, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.SetRolePermissions))
+	defer span.End()
+	perms, err := s.Model.SetRolePermissions(ctx, int(req.Role), req.Permissions)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		panic(err)
+	}
+	return &authpb.SetRolePermissionsResponse{
+		Role:        req.Role,
+		Permissions: perms,
+	}, nil
+}
@@ -0,0 +1,90 @@
+package api
+
+import (
+	"context"
+
+	"github.com/aqaurius6666/authservice/src/internal/lib"
+	"github.com/aqaurius6666/authservice/src/internal/var/c"
+	"github.com/aqaurius6666/authservice/src/pb/authpb"
+	"github.com/aqaurius6666/go-utils/utils"
+	"go.opentelemetry.io/otel"
+	"golang.org/x/xerrors"
+)
+
+func (s *ApiServer) SuspendUser(ctx context.Context, req *authpb.SuspendUserRequest) (*authpb.SuspendUserResponse, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.SuspendUser))
+	defer span.End()
+	usr, err := s.Model.GetUserById(ctx, req.Id)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		panic(err)
+	}
+	sus, err := s.Model.SuspendUser(ctx, usr, req.AdminId, req.Reason, req.Note, req.ExpiresAt)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		s.audit(ctx, usr.ID, c.SECURITY_EVENT_BAN, err)
+		panic(err)
+	}
+	s.audit(ctx, usr.ID, c.SECURITY_EVENT_BAN, nil)
+	return &authpb.SuspendUserResponse{
+		Id:   req.Id,
+		Role: c.ROLE(utils.IntVal(usr.Role.Code)),
+		Suspension: &authpb.Suspension{
+			Id:        sus.ID.String(),
+			Reason:    c.SUSPENSION_REASON(utils.IntVal(sus.Reason)),
+			Note:      utils.StrVal(sus.Note),
+			ExpiresAt: sus.ExpiresAt,
+			IssuedBy:  sus.IssuedBy.String(),
+			CreatedAt: sus.CreatedAt,
+		},
+	}, nil
+}
+
+// LiftSuspension ends the suspension of a user. AdminId is empty when the
+// cleanup job lifts an expired suspension.
+func (s *ApiServer) LiftSuspension(ctx context.Context, req *authpb.LiftSuspensionRequest) (*authpb.LiftSuspensionResponse, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.LiftSuspension))
+	defer span.End()
+	usr, err := s.Model.GetUserById(ctx, req.Id)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		panic(err)
+	}
+	if err := s.Model.LiftSuspension(ctx, usr, req.AdminId); err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		s.audit(ctx, usr.ID, c.SECURITY_EVENT_UNBAN, err)
+		panic(err)
+	}
+	s.audit(ctx, usr.ID, c.SECURITY_EVENT_UNBAN, nil)
+	return &authpb.LiftSuspensionResponse{
+		Id:   req.Id,
+		Role: c.ROLE(utils.IntVal(usr.Role.Code)),
+	}, nil
+}
+
+// ListExpiredSuspensions returns the users whose suspension is over, so the
+// cleanup job can lift it and reactivate their profile.
+func (s *ApiServer) ListExpiredSuspensions(ctx context.Context, req *authpb.ListExpiredSuspensionsRequest) (*authpb.ListExpiredSuspensionsResponse, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.ListExpiredSuspensions))
+	defer span.End()
+	users, err := s.Model.ListExpiredSuspensions(ctx, int(req.Limit))
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		panic(err)
+	}
+	res := make([]*authpb.ExpiredSuspension, 0, len(users))
+	for _, u := range users {
+		res = append(res, &authpb.ExpiredSuspension{
+			Id:   u.ID.String(),
+			Role: c.ROLE(utils.IntVal(u.Role.Code)),
+		})
+	}
+	return &authpb.ListExpiredSuspensionsResponse{
+		Users: res,
+	}, nil
+}
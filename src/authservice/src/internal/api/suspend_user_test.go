@@ -0,0 +1,53 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aqaurius6666/authservice/src/internal/var/c"
+	"github.com/aqaurius6666/authservice/src/pb/authpb"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+func TestSuspendUser(t *testing.T) {
+	TEST_CASE := []map[string]string{
+		{
+			"id":      "010a10c7-a807-4d7f-9bd7-d082a66e1e01",
+			"adminId": "72f048be-f355-4521-b89f-958c997b4d48",
+		},
+	}
+	ctx := context.Background()
+	conn, err := grpc.Dial("localhost:50051", grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(otelgrpc.UnaryClientInterceptor()),
+	)
+	assert.Nil(t, err)
+	client := authpb.NewAuthServiceClient(conn)
+
+	for _, tc := range TEST_CASE {
+		res, err := client.SuspendUser(ctx, &authpb.SuspendUserRequest{
+			Id:      tc["id"],
+			AdminId: tc["adminId"],
+			Reason:  c.SUSPENSION_REASON_SPAM,
+			Note:    "test",
+		})
+		if err != nil {
+			stt, ok := status.FromError(err)
+			assert.True(t, ok)
+			t.Error(stt.Message())
+		}
+		t.Log(res)
+		_, err = client.LiftSuspension(ctx, &authpb.LiftSuspensionRequest{
+			Id:      tc["id"],
+			AdminId: tc["adminId"],
+		})
+		if err != nil {
+			stt, ok := status.FromError(err)
+			assert.True(t, ok)
+			t.Error(stt.Message())
+		}
+	}
+}
@@ -28,9 +28,27 @@ func (s *ApiServer) VerifyOTP(ctx context.Context, req *authpb.VerifyOTPRequest)
 			OtpId: o.ID.String(),
 		}, nil
 	}
+	attempts, err := s.Model.CountOtpAttempt(ctx, o)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		panic(err)
+	}
+	if attempts > c.OTP_MAX_ATTEMPTS {
+		err = xerrors.Errorf("%w", e.ErrOTPAttemptsExceeded)
+		lib.RecordError(span, err)
+		s.audit(ctx, o.UserId, c.SECURITY_EVENT_OTP_VERIFY, err)
+		panic(err)
+	}
 	if match := s.Model.IsMatch(ctx, o, req.Otp); !match {
-		err = xerrors.Errorf("%w", e.ErrOTPNotMatch)
+		// The last allowed attempt failed, the code cannot be used anymore.
+		if attempts == c.OTP_MAX_ATTEMPTS {
+			err = xerrors.Errorf("%w", e.ErrOTPAttemptsExceeded)
+		} else {
+			err = xerrors.Errorf("%w", e.ErrOTPNotMatch)
+		}
 		lib.RecordError(span, err)
+		s.audit(ctx, o.UserId, c.SECURITY_EVENT_OTP_VERIFY, err)
 		panic(err)
 	}
 
@@ -39,6 +57,7 @@ func (s *ApiServer) VerifyOTP(ctx context.Context, req *authpb.VerifyOTPRequest)
 		lib.RecordError(span, err)
 		panic(err)
 	}
+	s.audit(ctx, o.UserId, c.SECURITY_EVENT_OTP_VERIFY, nil)
 	return &authpb.VerifyOTPResponse{
 		Ok:    true,
 		Type:  c.OTP_TYPE(utils.IntVal(o.Type)),
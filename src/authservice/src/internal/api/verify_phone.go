@@ -0,0 +1,66 @@
+package api
+
+import (
+	"context"
+
+	"github.com/aqaurius6666/authservice/src/internal/db/otp"
+	"github.com/aqaurius6666/authservice/src/internal/db/user"
+	"github.com/aqaurius6666/authservice/src/internal/lib"
+	"github.com/aqaurius6666/authservice/src/internal/lib/validate"
+	"github.com/aqaurius6666/authservice/src/internal/var/c"
+	"github.com/aqaurius6666/authservice/src/internal/var/e"
+	"github.com/aqaurius6666/authservice/src/pb/authpb"
+	"github.com/aqaurius6666/go-utils/database"
+	"go.opentelemetry.io/otel"
+	"golang.org/x/xerrors"
+)
+
+func (s *ApiServer) VerifyPhone(ctx context.Context, req *authpb.VerifyPhoneRequest) (*authpb.VerifyPhoneResponse, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.VerifyPhone))
+	defer span.End()
+	if !validate.IsPhone(req.Phone) {
+		err := xerrors.Errorf("%w", e.ErrInvalidPhone)
+		lib.RecordError(span, err)
+		panic(err)
+	}
+	u, err := s.Model.GetUserById(ctx, req.UserId)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		panic(err)
+	}
+	if other, err := s.Model.GetUserByPhone(ctx, req.Phone); err == nil && other.ID != u.ID {
+		err = xerrors.Errorf("%w", user.ErrPhoneExisted)
+		lib.RecordError(span, err)
+		panic(err)
+	}
+	o, err := s.Model.CreateVerifyPhoneOTP(ctx, &user.User{
+		Phone: &req.Phone,
+		BaseModel: database.BaseModel{
+			ID: u.ID,
+		},
+	})
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		panic(err)
+	}
+	loopFunc := func(ctx context.Context, funcname string, o *otp.Otp) {
+		ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, funcname)
+		defer span.End()
+		var err error
+		limit := 4
+		for i := 0; i < limit; i++ {
+			if err = s.Model.SendOTP(ctx, o); err == nil {
+				return
+			}
+			s.Logger.Error(err)
+		}
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+	}
+	go loopFunc(context.Background(), lib.GetFunctionName(loopFunc), o)
+	return &authpb.VerifyPhoneResponse{
+		OtpId: o.ID.String(),
+	}, nil
+}
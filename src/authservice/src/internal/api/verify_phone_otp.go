@@ -0,0 +1,56 @@
+package api
+
+import (
+	"context"
+
+	"github.com/aqaurius6666/authservice/src/internal/db/user"
+	"github.com/aqaurius6666/authservice/src/internal/lib"
+	"github.com/aqaurius6666/authservice/src/internal/var/c"
+	"github.com/aqaurius6666/authservice/src/internal/var/e"
+	"github.com/aqaurius6666/authservice/src/pb/authpb"
+	"github.com/aqaurius6666/go-utils/utils"
+	"go.opentelemetry.io/otel"
+	"golang.org/x/xerrors"
+)
+
+func (s *ApiServer) VerifyPhoneOTP(ctx context.Context, req *authpb.VerifyPhoneOTPRequest) (*authpb.VerifyPhoneOTPResponse, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.VerifyPhoneOTP))
+	defer span.End()
+	o, err := s.Model.GetValidOtpById(ctx, req.OtpId)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		panic(err)
+	}
+	if !utils.BoolVal(o.Commited) || c.OTP_TYPE(utils.IntVal(o.Type)) != c.OTP_TYPE_VERIFY_PHONE {
+		err = xerrors.Errorf("%w", e.ErrOTPInvalid)
+		lib.RecordError(span, err)
+		panic(err)
+	}
+	if other, err := s.Model.GetUserByPhone(ctx, *o.Phone); err == nil && other.ID != o.UserId {
+		err = xerrors.Errorf("%w", user.ErrPhoneExisted)
+		lib.RecordError(span, err)
+		panic(err)
+	}
+	if err = s.Model.UpdateVerifiedPhone(ctx, o.UserId, *o.Phone); err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		panic(err)
+	}
+	if err := s.Model.DeleteOTPById(ctx, o.ID); err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		// panic(err) // SHOULDN'T PANIC HERE
+	}
+	u, err := s.Model.GetUserById(ctx, o.UserId)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		panic(err)
+	}
+	return &authpb.VerifyPhoneOTPResponse{
+		Id:    u.ID.String(),
+		Phone: *u.Phone,
+		Role:  c.ROLE(utils.IntVal(u.Role.Code)),
+	}, nil
+}
@@ -0,0 +1,32 @@
+package apikey
+
+import (
+	"github.com/aqaurius6666/go-utils/database"
+	"github.com/google/uuid"
+)
+
+// ApiKey lets a partner or a script call apiservice as UserId, limited to
+// Scopes, which are space separated. When BusinessId is set the key acts
+// for that business, as long as UserId is still a member of it. Only the
+// sha256 hash of the key is stored, Prefix is its first characters so it
+// can be told apart from the other keys. RateLimit is in requests per
+// minute, times are in milliseconds and ExpiresAt is 0 for a key that never
+// expires.
+type ApiKey struct {
+	database.BaseModel
+	UserId     uuid.UUID `gorm:"type:uuid;index"`
+	BusinessId uuid.UUID `gorm:"type:uuid"`
+	Name       *string
+	Prefix     *string
+	KeyHash    *string `gorm:"index:unique"`
+	Scopes     *string
+	RateLimit  int64
+	ExpiresAt  int64 `gorm:"default:0"`
+	LastUsedAt int64 `gorm:"default:0"`
+	IsRevoked  *bool `gorm:"default:false"`
+}
+
+type Search struct {
+	database.DefaultSearchModel
+	ApiKey
+}
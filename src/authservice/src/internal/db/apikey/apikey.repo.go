@@ -0,0 +1,11 @@
+package apikey
+
+import "context"
+
+type ApiKeyRepo interface {
+	SelectApiKey(context.Context, *Search) (*ApiKey, error)
+	ListApiKeys(context.Context, *Search) ([]*ApiKey, error)
+	InsertApiKey(context.Context, *ApiKey) (*ApiKey, error)
+	UpdateApiKey(context.Context, *Search, *ApiKey) error
+	DeleteApiKeys(context.Context, *Search) error
+}
@@ -0,0 +1,112 @@
+package cockroach
+
+import (
+	"context"
+	"time"
+
+	"github.com/aqaurius6666/authservice/src/internal/db/apikey"
+	"github.com/aqaurius6666/authservice/src/internal/lib"
+	"github.com/aqaurius6666/authservice/src/internal/var/c"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"golang.org/x/xerrors"
+	"gorm.io/gorm"
+)
+
+func applySearchApiKey(db *gorm.DB, search *apikey.Search) *gorm.DB {
+	if search.ID != uuid.Nil {
+		db = db.Where(apikey.ApiKey{
+			BaseModel: search.BaseModel,
+		})
+	}
+	if search.UserId != uuid.Nil {
+		db = db.Where(apikey.ApiKey{
+			UserId: search.UserId,
+		})
+	}
+	if search.KeyHash != nil {
+		db = db.Where(apikey.ApiKey{
+			KeyHash: search.KeyHash,
+		})
+	}
+	return db
+}
+
+func (u *ServerCDBRepo) SelectApiKey(ctx context.Context, search *apikey.Search) (*apikey.ApiKey, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(u.SelectApiKey))
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, time.Second*2)
+	defer cancel()
+	r := apikey.ApiKey{}
+	if err := applySearchApiKey(u.Db, search).WithContext(ctx).First(&r).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			err = apikey.ErrNotFound
+		}
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return nil, err
+	}
+	return &r, nil
+}
+
+// ListApiKeys returns the keys newest first.
+func (u *ServerCDBRepo) ListApiKeys(ctx context.Context, search *apikey.Search) ([]*apikey.ApiKey, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(u.ListApiKeys))
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, time.Second*2)
+	defer cancel()
+	db := applySearchApiKey(u.Db, search)
+	if search.Skip != 0 {
+		db = db.Offset(search.Skip)
+	}
+	if search.Limit != 0 {
+		db = db.Limit(search.Limit)
+	}
+	r := make([]*apikey.ApiKey, 0)
+	if err := db.WithContext(ctx).Order("created_at desc").Find(&r).Error; err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return nil, err
+	}
+	return r, nil
+}
+
+func (u *ServerCDBRepo) InsertApiKey(ctx context.Context, value *apikey.ApiKey) (*apikey.ApiKey, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(u.InsertApiKey))
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, time.Second*2)
+	defer cancel()
+	if err := u.Db.WithContext(ctx).Create(value).Error; err != nil {
+		err = xerrors.Errorf("%w", apikey.ErrInsertFail)
+		lib.RecordError(span, err)
+		return nil, err
+	}
+	return value, nil
+}
+
+func (u *ServerCDBRepo) UpdateApiKey(ctx context.Context, search *apikey.Search, value *apikey.ApiKey) error {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(u.UpdateApiKey))
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, time.Second*2)
+	defer cancel()
+	if err := applySearchApiKey(u.Db, search).WithContext(ctx).Model(&apikey.ApiKey{}).Updates(value).Error; err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return err
+	}
+	return nil
+}
+
+func (u *ServerCDBRepo) DeleteApiKeys(ctx context.Context, search *apikey.Search) error {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(u.DeleteApiKeys))
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, time.Second*2)
+	defer cancel()
+	var r apikey.ApiKey
+	if err := applySearchApiKey(u.Db, search).WithContext(ctx).Delete(&r).Error; err != nil {
+		err = xerrors.Errorf("%w", apikey.ErrDeleteFail)
+		lib.RecordError(span, err)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,25 @@
+package cockroach
+
+import (
+	"context"
+	"time"
+
+	"github.com/aqaurius6666/authservice/src/internal/db/impersonationaudit"
+	"github.com/aqaurius6666/authservice/src/internal/lib"
+	"github.com/aqaurius6666/authservice/src/internal/var/c"
+	"go.opentelemetry.io/otel"
+	"golang.org/x/xerrors"
+)
+
+func (u *ServerCDBRepo) InsertImpersonationAudit(ctx context.Context, value *impersonationaudit.ImpersonationAudit) (*impersonationaudit.ImpersonationAudit, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(u.InsertImpersonationAudit))
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, time.Second*2)
+	defer cancel()
+	if err := u.Db.WithContext(ctx).Create(value).Error; err != nil {
+		err = xerrors.Errorf("%w", impersonationaudit.ErrInsertFail)
+		lib.RecordError(span, err)
+		return nil, err
+	}
+	return value, nil
+}
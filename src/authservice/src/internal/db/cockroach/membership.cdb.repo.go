@@ -0,0 +1,121 @@
+package cockroach
+
+import (
+	"context"
+	"time"
+
+	"github.com/aqaurius6666/authservice/src/internal/db/membership"
+	"github.com/aqaurius6666/authservice/src/internal/lib"
+	"github.com/aqaurius6666/authservice/src/internal/var/c"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"golang.org/x/xerrors"
+	"gorm.io/gorm"
+)
+
+func applySearchMembership(db *gorm.DB, search *membership.Search) *gorm.DB {
+	if search.ID != uuid.Nil {
+		db = db.Where(membership.Membership{
+			BaseModel: search.BaseModel,
+		})
+	}
+	if search.BusinessId != uuid.Nil {
+		db = db.Where(membership.Membership{
+			BusinessId: search.BusinessId,
+		})
+	}
+	if search.UserId != uuid.Nil {
+		db = db.Where(membership.Membership{
+			UserId: search.UserId,
+		})
+	}
+	if search.Mail != nil {
+		db = db.Where("lower(mail) = lower(?)", *search.Mail)
+	}
+	if search.Accepted {
+		db = db.Where("accepted_at > 0")
+	}
+	if search.Pending {
+		db = db.Where("accepted_at = 0")
+	}
+	return db
+}
+
+func (u *ServerCDBRepo) SelectMembership(ctx context.Context, search *membership.Search) (*membership.Membership, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(u.SelectMembership))
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, time.Second*2)
+	defer cancel()
+	r := membership.Membership{}
+	if err := applySearchMembership(u.Db, search).WithContext(ctx).First(&r).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			err = membership.ErrNotFound
+		}
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return nil, err
+	}
+	return &r, nil
+}
+
+// ListMemberships returns the memberships oldest first.
+func (u *ServerCDBRepo) ListMemberships(ctx context.Context, search *membership.Search) ([]*membership.Membership, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(u.ListMemberships))
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, time.Second*2)
+	defer cancel()
+	db := applySearchMembership(u.Db, search)
+	if search.Skip != 0 {
+		db = db.Offset(search.Skip)
+	}
+	if search.Limit != 0 {
+		db = db.Limit(search.Limit)
+	}
+	r := make([]*membership.Membership, 0)
+	if err := db.WithContext(ctx).Order("created_at asc").Find(&r).Error; err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return nil, err
+	}
+	return r, nil
+}
+
+func (u *ServerCDBRepo) InsertMembership(ctx context.Context, value *membership.Membership) (*membership.Membership, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(u.InsertMembership))
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, time.Second*2)
+	defer cancel()
+	if err := u.Db.WithContext(ctx).Create(value).Error; err != nil {
+		err = xerrors.Errorf("%w", membership.ErrInsertFail)
+		lib.RecordError(span, err)
+		return nil, err
+	}
+	return value, nil
+}
+
+func (u *ServerCDBRepo) UpdateMembership(ctx context.Context, search *membership.Search, value *membership.Membership) error {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(u.UpdateMembership))
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, time.Second*2)
+	defer cancel()
+	if err := applySearchMembership(u.Db, search).WithContext(ctx).Model(&membership.Membership{}).Updates(value).Error; err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return err
+	}
+	return nil
+}
+
+func (u *ServerCDBRepo) DeleteMemberships(ctx context.Context, search *membership.Search) error {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(u.DeleteMemberships))
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, time.Second*2)
+	defer cancel()
+	var r membership.Membership
+	if err := applySearchMembership(u.Db, search).WithContext(ctx).Delete(&r).Error; err != nil {
+		err = xerrors.Errorf("%w", membership.ErrDeleteFail)
+		lib.RecordError(span, err)
+		return err
+	}
+	return nil
+}
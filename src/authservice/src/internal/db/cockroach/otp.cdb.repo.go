@@ -12,6 +12,7 @@ import (
 	"go.opentelemetry.io/otel"
 	"golang.org/x/xerrors"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 func applySearchOtp(db *gorm.DB, search *otp.Search) *gorm.DB {
@@ -87,3 +88,25 @@ func (u *ServerCDBRepo) UpdateOTP(ctx context.Context, search *otp.Search, value
 	}
 	return nil
 }
+
+func (u *ServerCDBRepo) IncreaseOtpAttempts(ctx context.Context, search *otp.Search) (int, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(u.IncreaseOtpAttempts))
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, time.Second*2)
+	defer cancel()
+	r := otp.Otp{}
+	tx := applySearchOtp(u.Db, search).WithContext(ctx).Model(&r).
+		Clauses(clause.Returning{Columns: []clause.Column{{Name: "attempts"}}}).
+		UpdateColumn("attempts", gorm.Expr("attempts + 1"))
+	if err := tx.Error; err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return 0, err
+	}
+	if tx.RowsAffected == 0 {
+		err := xerrors.Errorf("%w", otp.ErrNotFound)
+		lib.RecordError(span, err)
+		return 0, err
+	}
+	return *r.Attempts, nil
+}
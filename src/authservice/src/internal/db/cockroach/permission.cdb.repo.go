@@ -0,0 +1,52 @@
+package cockroach
+
+import (
+	"context"
+	"time"
+
+	"github.com/aqaurius6666/authservice/src/internal/db/permission"
+	"github.com/aqaurius6666/authservice/src/internal/lib"
+	"github.com/aqaurius6666/authservice/src/internal/var/c"
+	"github.com/aqaurius6666/go-utils/database"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"golang.org/x/xerrors"
+	"gorm.io/gorm"
+)
+
+func applySearchPermission(db *gorm.DB, search *permission.Search) *gorm.DB {
+	if search.ID != uuid.Nil {
+		db = db.Where(permission.Permission{
+			BaseModel: database.BaseModel{
+				ID: search.ID,
+			},
+		})
+	}
+	if search.Code != nil {
+		db = db.Where(permission.Permission{
+			Code: search.Code,
+		})
+	}
+	if search.Codes != nil {
+		db = db.Where("permissions.code IN ?", search.Codes)
+	}
+	if search.RoleId != uuid.Nil {
+		db = db.Joins("JOIN role_permissions ON role_permissions.permission_id = permissions.id").
+			Where("role_permissions.role_id = ?", search.RoleId)
+	}
+	return db
+}
+
+func (u *ServerCDBRepo) ListPermissions(ctx context.Context, search *permission.Search) ([]*permission.Permission, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(u.ListPermissions))
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, time.Second*2)
+	defer cancel()
+	r := make([]*permission.Permission, 0)
+	if err := applySearchPermission(u.Db, search).WithContext(ctx).Order("permissions.code").Find(&r).Error; err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return nil, err
+	}
+	return r, nil
+}
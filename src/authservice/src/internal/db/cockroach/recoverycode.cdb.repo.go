@@ -0,0 +1,98 @@
+package cockroach
+
+import (
+	"context"
+	"time"
+
+	"github.com/aqaurius6666/authservice/src/internal/db/recoverycode"
+	"github.com/aqaurius6666/authservice/src/internal/lib"
+	"github.com/aqaurius6666/authservice/src/internal/var/c"
+	"github.com/aqaurius6666/go-utils/database"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"golang.org/x/xerrors"
+	"gorm.io/gorm"
+)
+
+func applySearchRecoveryCode(db *gorm.DB, search *recoverycode.Search) *gorm.DB {
+	if search.ID != uuid.Nil {
+		db = db.Where(recoverycode.RecoveryCode{
+			BaseModel: database.BaseModel{
+				ID: search.ID,
+			},
+		})
+	}
+	if search.UserId != uuid.Nil {
+		db = db.Where(recoverycode.RecoveryCode{
+			UserId: search.UserId,
+		})
+	}
+	if search.CodeHash != nil {
+		db = db.Where(recoverycode.RecoveryCode{
+			CodeHash: search.CodeHash,
+		})
+	}
+	if search.IsUsed != nil {
+		db = db.Where(recoverycode.RecoveryCode{
+			IsUsed: search.IsUsed,
+		})
+	}
+	return db
+}
+
+func (u *ServerCDBRepo) SelectRecoveryCode(ctx context.Context, search *recoverycode.Search) (*recoverycode.RecoveryCode, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(u.SelectRecoveryCode))
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, time.Second*2)
+	defer cancel()
+	r := recoverycode.RecoveryCode{}
+	if err := applySearchRecoveryCode(u.Db, search).WithContext(ctx).First(&r).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			err = recoverycode.ErrNotFound
+		}
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return nil, err
+	}
+	return &r, nil
+}
+
+func (u *ServerCDBRepo) InsertRecoveryCodes(ctx context.Context, values []*recoverycode.RecoveryCode) ([]*recoverycode.RecoveryCode, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(u.InsertRecoveryCodes))
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, time.Second*2)
+	defer cancel()
+	if err := u.Db.WithContext(ctx).Create(values).Error; err != nil {
+		err = xerrors.Errorf("%w", recoverycode.ErrInsertFail)
+		lib.RecordError(span, err)
+		return nil, err
+	}
+	return values, nil
+}
+
+func (u *ServerCDBRepo) UpdateRecoveryCode(ctx context.Context, search *recoverycode.Search, value *recoverycode.RecoveryCode) error {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(u.UpdateRecoveryCode))
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, time.Second*2)
+	defer cancel()
+	if err := applySearchRecoveryCode(u.Db, search).WithContext(ctx).Updates(value).Error; err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return err
+	}
+	return nil
+}
+
+func (u *ServerCDBRepo) DeleteRecoveryCodes(ctx context.Context, search *recoverycode.Search) error {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(u.DeleteRecoveryCodes))
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, time.Second*2)
+	defer cancel()
+	var r recoverycode.RecoveryCode
+	if err := applySearchRecoveryCode(u.Db, search).WithContext(ctx).Delete(&r).Error; err != nil {
+		err = xerrors.Errorf("%w", recoverycode.ErrDeleteFail)
+		lib.RecordError(span, err)
+		return err
+	}
+	return nil
+}
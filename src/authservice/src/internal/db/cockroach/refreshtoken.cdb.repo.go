@@ -0,0 +1,93 @@
+package cockroach
+
+import (
+	"context"
+	"time"
+
+	"github.com/aqaurius6666/authservice/src/internal/db/refreshtoken"
+	"github.com/aqaurius6666/authservice/src/internal/lib"
+	"github.com/aqaurius6666/authservice/src/internal/var/c"
+	"github.com/aqaurius6666/go-utils/database"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"golang.org/x/xerrors"
+	"gorm.io/gorm"
+)
+
+func applySearchRefreshToken(db *gorm.DB, search *refreshtoken.Search) *gorm.DB {
+	if search.ID != uuid.Nil {
+		db = db.Where(refreshtoken.RefreshToken{
+			BaseModel: database.BaseModel{
+				ID: search.ID,
+			},
+		})
+	}
+	if search.UserId != uuid.Nil {
+		db = db.Where(refreshtoken.RefreshToken{
+			UserId: search.UserId,
+		})
+	}
+	if search.TokenHash != nil {
+		db = db.Where(refreshtoken.RefreshToken{
+			TokenHash: search.TokenHash,
+		})
+	}
+	if search.IsRevoked != nil {
+		db = db.Where(refreshtoken.RefreshToken{
+			IsRevoked: search.IsRevoked,
+		})
+	}
+	return db
+}
+
+func (u *ServerCDBRepo) SelectRefreshToken(ctx context.Context, search *refreshtoken.Search) (*refreshtoken.RefreshToken, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(u.SelectRefreshToken))
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, time.Second*2)
+	defer cancel()
+	r := refreshtoken.RefreshToken{}
+	if err := applySearchRefreshToken(u.Db, search).WithContext(ctx).First(&r).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			err = refreshtoken.ErrNotFound
+		}
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return nil, err
+	}
+	return &r, nil
+}
+
+func (u *ServerCDBRepo) InsertRefreshToken(ctx context.Context, value *refreshtoken.RefreshToken) (*refreshtoken.RefreshToken, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(u.InsertRefreshToken))
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, time.Second*2)
+	defer cancel()
+	if err := u.Db.WithContext(ctx).Create(value).Error; err != nil {
+		err = xerrors.Errorf("%w", refreshtoken.ErrInsertFail)
+		lib.RecordError(span, err)
+		return nil, err
+	}
+	return value, nil
+}
+
+// UpdateRefreshToken returns refreshtoken.ErrNotFound if no row matched, so a
+// conditional update like revoking a token that is not revoked yet can tell
+// whether it won.
+func (u *ServerCDBRepo) UpdateRefreshToken(ctx context.Context, search *refreshtoken.Search, value *refreshtoken.RefreshToken) error {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(u.UpdateRefreshToken))
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, time.Second*2)
+	defer cancel()
+	tx := applySearchRefreshToken(u.Db, search).WithContext(ctx).Model(&refreshtoken.RefreshToken{}).Updates(value)
+	if err := tx.Error; err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return err
+	}
+	if tx.RowsAffected == 0 {
+		err := xerrors.Errorf("%w", refreshtoken.ErrNotFound)
+		lib.RecordError(span, err)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package cockroach
+
+import (
+	"context"
+	"time"
+
+	"github.com/aqaurius6666/authservice/src/internal/db/revocation"
+	"github.com/aqaurius6666/authservice/src/internal/lib"
+	"github.com/aqaurius6666/authservice/src/internal/var/c"
+	"github.com/aqaurius6666/go-utils/database"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"golang.org/x/xerrors"
+	"gorm.io/gorm"
+)
+
+func applySearchRevocation(db *gorm.DB, search *revocation.Search) *gorm.DB {
+	if search.ID != uuid.Nil {
+		db = db.Where(revocation.Revocation{
+			BaseModel: database.BaseModel{
+				ID: search.ID,
+			},
+		})
+	}
+	if search.UserId != uuid.Nil {
+		db = db.Where(revocation.Revocation{
+			UserId: search.UserId,
+		})
+	}
+	if search.Signature != nil {
+		db = db.Where(revocation.Revocation{
+			Signature: search.Signature,
+		})
+	}
+	return db
+}
+
+func (u *ServerCDBRepo) SelectRevocation(ctx context.Context, search *revocation.Search) (*revocation.Revocation, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(u.SelectRevocation))
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, time.Second*2)
+	defer cancel()
+	r := revocation.Revocation{}
+	if err := applySearchRevocation(u.Db, search).WithContext(ctx).First(&r).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			err = revocation.ErrNotFound
+		}
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return nil, err
+	}
+	return &r, nil
+}
+
+func (u *ServerCDBRepo) InsertRevocation(ctx context.Context, value *revocation.Revocation) (*revocation.Revocation, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(u.InsertRevocation))
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, time.Second*2)
+	defer cancel()
+	if err := u.Db.WithContext(ctx).Create(value).Error; err != nil {
+		err = xerrors.Errorf("%w", revocation.ErrInsertFail)
+		lib.RecordError(span, err)
+		return nil, err
+	}
+	return value, nil
+}
+
+func (u *ServerCDBRepo) DeleteRevocation(ctx context.Context, search *revocation.Search) error {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(u.DeleteRevocation))
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, time.Second*2)
+	defer cancel()
+	var r revocation.Revocation
+	if err := applySearchRevocation(u.Db, search).WithContext(ctx).Delete(&r).Error; err != nil {
+		err = xerrors.Errorf("%w", revocation.ErrDeleteFail)
+		lib.RecordError(span, err)
+		return err
+	}
+	return nil
+}
@@ -59,3 +59,17 @@ func (u *ServerCDBRepo) InsertRole(ctx context.Context, value *role.Role) (*role
 	}
 	return value, nil
 }
+
+func (u *ServerCDBRepo) ListRoles(ctx context.Context, search *role.Search) ([]*role.Role, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(u.ListRoles))
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, time.Second*2)
+	defer cancel()
+	r := make([]*role.Role, 0)
+	if err := applySearchRole(u.Db, search).WithContext(ctx).Order("code").Find(&r).Error; err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return nil, err
+	}
+	return r, nil
+}
@@ -0,0 +1,63 @@
+package cockroach
+
+import (
+	"context"
+	"time"
+
+	"github.com/aqaurius6666/authservice/src/internal/db/rolepermission"
+	"github.com/aqaurius6666/authservice/src/internal/lib"
+	"github.com/aqaurius6666/authservice/src/internal/var/c"
+	"github.com/aqaurius6666/go-utils/database"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"golang.org/x/xerrors"
+	"gorm.io/gorm"
+)
+
+func applySearchRolePermission(db *gorm.DB, search *rolepermission.Search) *gorm.DB {
+	if search.ID != uuid.Nil {
+		db = db.Where(rolepermission.RolePermission{
+			BaseModel: database.BaseModel{
+				ID: search.ID,
+			},
+		})
+	}
+	if search.RoleId != uuid.Nil {
+		db = db.Where(rolepermission.RolePermission{
+			RoleId: search.RoleId,
+		})
+	}
+	if search.PermissionId != uuid.Nil {
+		db = db.Where(rolepermission.RolePermission{
+			PermissionId: search.PermissionId,
+		})
+	}
+	return db
+}
+
+func (u *ServerCDBRepo) InsertRolePermissions(ctx context.Context, values []*rolepermission.RolePermission) ([]*rolepermission.RolePermission, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(u.InsertRolePermissions))
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, time.Second*2)
+	defer cancel()
+	if err := u.Db.WithContext(ctx).Create(values).Error; err != nil {
+		err = xerrors.Errorf("%w", rolepermission.ErrInsertFail)
+		lib.RecordError(span, err)
+		return nil, err
+	}
+	return values, nil
+}
+
+func (u *ServerCDBRepo) DeleteRolePermissions(ctx context.Context, search *rolepermission.Search) error {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(u.DeleteRolePermissions))
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, time.Second*2)
+	defer cancel()
+	var r rolepermission.RolePermission
+	if err := applySearchRolePermission(u.Db, search).WithContext(ctx).Delete(&r).Error; err != nil {
+		err = xerrors.Errorf("%w", rolepermission.ErrDeleteFail)
+		lib.RecordError(span, err)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+package cockroach
+
+import (
+	"context"
+	"time"
+
+	"github.com/aqaurius6666/authservice/src/internal/db/securityevent"
+	"github.com/aqaurius6666/authservice/src/internal/lib"
+	"github.com/aqaurius6666/authservice/src/internal/var/c"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"golang.org/x/xerrors"
+	"gorm.io/gorm"
+)
+
+func applySearchSecurityEvent(db *gorm.DB, search *securityevent.Search) *gorm.DB {
+	if search.UserId != uuid.Nil {
+		db = db.Where(securityevent.SecurityEvent{
+			UserId: search.UserId,
+		})
+	}
+	if search.Type != nil {
+		db = db.Where(securityevent.SecurityEvent{
+			Type: search.Type,
+		})
+	}
+	if search.UserAgent != nil {
+		db = db.Where(securityevent.SecurityEvent{
+			UserAgent: search.UserAgent,
+		})
+	}
+	if search.Success != nil {
+		db = db.Where(securityevent.SecurityEvent{
+			Success: search.Success,
+		})
+	}
+	return db
+}
+
+// ListSecurityEvents returns the newest events first.
+func (u *ServerCDBRepo) ListSecurityEvents(ctx context.Context, search *securityevent.Search) ([]*securityevent.SecurityEvent, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(u.ListSecurityEvents))
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, time.Second*2)
+	defer cancel()
+	db := applySearchSecurityEvent(u.Db, search)
+	if search.Skip != 0 {
+		db = db.Offset(search.Skip)
+	}
+	if search.Limit != 0 {
+		db = db.Limit(search.Limit)
+	}
+	r := make([]*securityevent.SecurityEvent, 0)
+	if err := db.WithContext(ctx).Order("created_at desc").Find(&r).Error; err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return nil, err
+	}
+	return r, nil
+}
+
+func (u *ServerCDBRepo) TotalSecurityEvents(ctx context.Context, search *securityevent.Search) (*int64, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(u.TotalSecurityEvents))
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, time.Second*2)
+	defer cancel()
+	var r int64
+	if err := applySearchSecurityEvent(u.Db, search).WithContext(ctx).Model(&securityevent.SecurityEvent{}).Count(&r).Error; err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return nil, err
+	}
+	return &r, nil
+}
+
+func (u *ServerCDBRepo) InsertSecurityEvent(ctx context.Context, value *securityevent.SecurityEvent) (*securityevent.SecurityEvent, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(u.InsertSecurityEvent))
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, time.Second*2)
+	defer cancel()
+	if err := u.Db.WithContext(ctx).Create(value).Error; err != nil {
+		err = xerrors.Errorf("%w", securityevent.ErrInsertFail)
+		lib.RecordError(span, err)
+		return nil, err
+	}
+	return value, nil
+}
+
+func (u *ServerCDBRepo) DeleteSecurityEvents(ctx context.Context, search *securityevent.Search) error {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(u.DeleteSecurityEvents))
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, time.Second*2)
+	defer cancel()
+	var r securityevent.SecurityEvent
+	if err := applySearchSecurityEvent(u.Db, search).WithContext(ctx).Delete(&r).Error; err != nil {
+		err = xerrors.Errorf("%w", securityevent.ErrDeleteFail)
+		lib.RecordError(span, err)
+		return err
+	}
+	return nil
+}
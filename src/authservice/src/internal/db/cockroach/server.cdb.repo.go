@@ -1,9 +1,20 @@
 package cockroach
 
 import (
+	"github.com/aqaurius6666/authservice/src/internal/db/apikey"
+	"github.com/aqaurius6666/authservice/src/internal/db/impersonationaudit"
+	"github.com/aqaurius6666/authservice/src/internal/db/membership"
 	"github.com/aqaurius6666/authservice/src/internal/db/otp"
+	"github.com/aqaurius6666/authservice/src/internal/db/permission"
+	"github.com/aqaurius6666/authservice/src/internal/db/recoverycode"
+	"github.com/aqaurius6666/authservice/src/internal/db/refreshtoken"
+	"github.com/aqaurius6666/authservice/src/internal/db/revocation"
 	"github.com/aqaurius6666/authservice/src/internal/db/role"
+	"github.com/aqaurius6666/authservice/src/internal/db/rolepermission"
+	"github.com/aqaurius6666/authservice/src/internal/db/securityevent"
+	"github.com/aqaurius6666/authservice/src/internal/db/suspension"
 	"github.com/aqaurius6666/authservice/src/internal/db/user"
+	"github.com/aqaurius6666/authservice/src/internal/db/userkey"
 	"github.com/aqaurius6666/go-utils/database/cockroach"
 	"github.com/google/wire"
 )
@@ -18,6 +29,17 @@ func InterfacesProvider() cockroach.DBInterfaces {
 		user.User{},
 		role.Role{},
 		otp.Otp{},
+		revocation.Revocation{},
+		userkey.UserKey{},
+		recoverycode.RecoveryCode{},
+		refreshtoken.RefreshToken{},
+		permission.Permission{},
+		rolepermission.RolePermission{},
+		securityevent.SecurityEvent{},
+		impersonationaudit.ImpersonationAudit{},
+		suspension.Suspension{},
+		membership.Membership{},
+		apikey.ApiKey{},
 	}
 }
 
@@ -0,0 +1,114 @@
+package cockroach
+
+import (
+	"context"
+	"time"
+
+	"github.com/aqaurius6666/authservice/src/internal/db/suspension"
+	"github.com/aqaurius6666/authservice/src/internal/lib"
+	"github.com/aqaurius6666/authservice/src/internal/var/c"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"golang.org/x/xerrors"
+	"gorm.io/gorm"
+)
+
+func applySearchSuspension(db *gorm.DB, search *suspension.Search) *gorm.DB {
+	if search.ID != uuid.Nil {
+		db = db.Where(suspension.Suspension{
+			BaseModel: search.BaseModel,
+		})
+	}
+	if search.UserId != uuid.Nil {
+		db = db.Where(suspension.Suspension{
+			UserId: search.UserId,
+		})
+	}
+	if search.InForce {
+		db = db.Where("lifted_at = 0")
+	}
+	if search.ExpiredBefore != 0 {
+		db = db.Where("expires_at > 0 AND expires_at <= ?", search.ExpiredBefore)
+	}
+	return db
+}
+
+// SelectSuspension returns the newest matching suspension.
+func (u *ServerCDBRepo) SelectSuspension(ctx context.Context, search *suspension.Search) (*suspension.Suspension, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(u.SelectSuspension))
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, time.Second*2)
+	defer cancel()
+	r := suspension.Suspension{}
+	if err := applySearchSuspension(u.Db, search).WithContext(ctx).Order("created_at desc").First(&r).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			err = suspension.ErrNotFound
+		}
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return nil, err
+	}
+	return &r, nil
+}
+
+// ListSuspensions returns the suspensions ordered by expiry, earliest first.
+func (u *ServerCDBRepo) ListSuspensions(ctx context.Context, search *suspension.Search) ([]*suspension.Suspension, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(u.ListSuspensions))
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, time.Second*2)
+	defer cancel()
+	db := applySearchSuspension(u.Db, search)
+	if search.Skip != 0 {
+		db = db.Offset(search.Skip)
+	}
+	if search.Limit != 0 {
+		db = db.Limit(search.Limit)
+	}
+	r := make([]*suspension.Suspension, 0)
+	if err := db.WithContext(ctx).Order("expires_at asc").Find(&r).Error; err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return nil, err
+	}
+	return r, nil
+}
+
+func (u *ServerCDBRepo) InsertSuspension(ctx context.Context, value *suspension.Suspension) (*suspension.Suspension, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(u.InsertSuspension))
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, time.Second*2)
+	defer cancel()
+	if err := u.Db.WithContext(ctx).Create(value).Error; err != nil {
+		err = xerrors.Errorf("%w", suspension.ErrInsertFail)
+		lib.RecordError(span, err)
+		return nil, err
+	}
+	return value, nil
+}
+
+func (u *ServerCDBRepo) UpdateSuspension(ctx context.Context, search *suspension.Search, value *suspension.Suspension) error {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(u.UpdateSuspension))
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, time.Second*2)
+	defer cancel()
+	if err := applySearchSuspension(u.Db, search).WithContext(ctx).Model(&suspension.Suspension{}).Updates(value).Error; err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return err
+	}
+	return nil
+}
+
+func (u *ServerCDBRepo) DeleteSuspensions(ctx context.Context, search *suspension.Search) error {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(u.DeleteSuspensions))
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, time.Second*2)
+	defer cancel()
+	var r suspension.Suspension
+	if err := applySearchSuspension(u.Db, search).WithContext(ctx).Delete(&r).Error; err != nil {
+		err = xerrors.Errorf("%w", suspension.ErrDeleteFail)
+		lib.RecordError(span, err)
+		return err
+	}
+	return nil
+}
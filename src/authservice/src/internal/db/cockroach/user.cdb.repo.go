@@ -42,6 +42,12 @@ func applySearchUser(db *gorm.DB, search *user.Search) *gorm.DB {
 			Mail: search.Mail,
 		})
 	}
+	if search.DueBefore != 0 {
+		db = db.Where(`"users"."delete_after" > 0 AND "users"."delete_after" <= ?`, search.DueBefore)
+	}
+	if search.Limit != 0 {
+		db = db.Limit(search.Limit)
+	}
 	return db
 }
 
@@ -92,7 +98,7 @@ func (u *ServerCDBRepo) ListUsers(ctx context.Context, search *user.Search) ([]*
 	ctx, cancel := context.WithTimeout(ctx, time.Second*2)
 	defer cancel()
 	r := make([]*user.User, 0)
-	if err := applySearchUser(u.Db, search).WithContext(ctx).Find(&r).Error; err != nil {
+	if err := applySearchUser(u.Db, search).WithContext(ctx).Joins("Role").Find(&r).Error; err != nil {
 		err = xerrors.Errorf("%w", err)
 		lib.RecordError(span, err)
 		return nil, err
@@ -0,0 +1,98 @@
+package cockroach
+
+import (
+	"context"
+	"time"
+
+	"github.com/aqaurius6666/authservice/src/internal/db/userkey"
+	"github.com/aqaurius6666/authservice/src/internal/lib"
+	"github.com/aqaurius6666/authservice/src/internal/var/c"
+	"github.com/aqaurius6666/go-utils/database"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"golang.org/x/xerrors"
+	"gorm.io/gorm"
+)
+
+func applySearchUserKey(db *gorm.DB, search *userkey.Search) *gorm.DB {
+	if search.ID != uuid.Nil {
+		db = db.Where(userkey.UserKey{
+			BaseModel: database.BaseModel{
+				ID: search.ID,
+			},
+		})
+	}
+	if search.UserId != uuid.Nil {
+		db = db.Where(userkey.UserKey{
+			UserId: search.UserId,
+		})
+	}
+	if search.PublicKey != nil {
+		db = db.Where(userkey.UserKey{
+			PublicKey: search.PublicKey,
+		})
+	}
+	if search.IsActive != nil {
+		db = db.Where(userkey.UserKey{
+			IsActive: search.IsActive,
+		})
+	}
+	return db
+}
+
+func (u *ServerCDBRepo) SelectUserKey(ctx context.Context, search *userkey.Search) (*userkey.UserKey, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(u.SelectUserKey))
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, time.Second*2)
+	defer cancel()
+	r := userkey.UserKey{}
+	if err := applySearchUserKey(u.Db, search).WithContext(ctx).First(&r).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			err = userkey.ErrNotFound
+		}
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return nil, err
+	}
+	return &r, nil
+}
+
+func (u *ServerCDBRepo) ListUserKeys(ctx context.Context, search *userkey.Search) ([]*userkey.UserKey, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(u.ListUserKeys))
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, time.Second*2)
+	defer cancel()
+	r := make([]*userkey.UserKey, 0)
+	if err := applySearchUserKey(u.Db, search).WithContext(ctx).Order("created_at").Find(&r).Error; err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return nil, err
+	}
+	return r, nil
+}
+
+func (u *ServerCDBRepo) InsertUserKey(ctx context.Context, value *userkey.UserKey) (*userkey.UserKey, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(u.InsertUserKey))
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, time.Second*2)
+	defer cancel()
+	if err := u.Db.WithContext(ctx).Create(value).Error; err != nil {
+		err = xerrors.Errorf("%w", userkey.ErrInsertFail)
+		lib.RecordError(span, err)
+		return nil, err
+	}
+	return value, nil
+}
+
+func (u *ServerCDBRepo) UpdateUserKey(ctx context.Context, search *userkey.Search, value *userkey.UserKey) error {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(u.UpdateUserKey))
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, time.Second*2)
+	defer cancel()
+	if err := applySearchUserKey(u.Db, search).WithContext(ctx).Updates(value).Error; err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return err
+	}
+	return nil
+}
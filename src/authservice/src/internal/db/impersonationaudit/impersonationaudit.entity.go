@@ -0,0 +1,26 @@
+package impersonationaudit
+
+import (
+	"github.com/aqaurius6666/go-utils/database"
+	"github.com/google/uuid"
+)
+
+// ImpersonationAudit is one entry of the audit trail of an impersonation
+// session. The entry with Action c.IMPERSONATION_ISSUE records the grant
+// and its Reason, every other entry one request made with the session, as
+// "<method> <path>" with the HTTP status it got.
+type ImpersonationAudit struct {
+	database.BaseModel
+	SessionId uuid.UUID `gorm:"type:uuid;index"`
+	AdminId   uuid.UUID `gorm:"type:uuid;index"`
+	UserId    uuid.UUID `gorm:"type:uuid;index"`
+	Action    *string
+	Status    *int
+	Reason    *string
+	Ip        *string
+}
+
+type Search struct {
+	database.DefaultSearchModel
+	ImpersonationAudit
+}
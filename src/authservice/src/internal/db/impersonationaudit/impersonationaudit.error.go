@@ -0,0 +1,8 @@
+package impersonationaudit
+
+import "golang.org/x/xerrors"
+
+var (
+	prefix        = "impersonationaudit"
+	ErrInsertFail = xerrors.Errorf("%s: insert failed", prefix)
+)
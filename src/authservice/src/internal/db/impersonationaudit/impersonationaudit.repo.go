@@ -0,0 +1,7 @@
+package impersonationaudit
+
+import "context"
+
+type ImpersonationAuditRepo interface {
+	InsertImpersonationAudit(context.Context, *ImpersonationAudit) (*ImpersonationAudit, error)
+}
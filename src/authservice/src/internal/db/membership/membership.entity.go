@@ -0,0 +1,30 @@
+package membership
+
+import (
+	"github.com/aqaurius6666/go-utils/database"
+	"github.com/google/uuid"
+)
+
+// Membership lets a user act for a business. It starts as an invitation
+// sent to Mail, and UserId is set once the invitation is accepted. Role is
+// a c.BUSINESS_ROLE, never OWNER since the owner of a business is the
+// handyman account it belongs to. AcceptedAt is in milliseconds and 0 while
+// the invitation is pending.
+type Membership struct {
+	database.BaseModel
+	BusinessId uuid.UUID `gorm:"type:uuid;index"`
+	UserId     uuid.UUID `gorm:"type:uuid;index"`
+	Mail       *string
+	Role       *int
+	InvitedBy  uuid.UUID `gorm:"type:uuid"`
+	AcceptedAt int64     `gorm:"default:0"`
+}
+
+// Search matches on the set fields of Membership. Accepted restricts it to
+// accepted invitations and Pending to the others.
+type Search struct {
+	database.DefaultSearchModel
+	Membership
+	Accepted bool
+	Pending  bool
+}
@@ -0,0 +1,11 @@
+package membership
+
+import "context"
+
+type MembershipRepo interface {
+	SelectMembership(context.Context, *Search) (*Membership, error)
+	ListMemberships(context.Context, *Search) ([]*Membership, error)
+	InsertMembership(context.Context, *Membership) (*Membership, error)
+	UpdateMembership(context.Context, *Search, *Membership) error
+	DeleteMemberships(context.Context, *Search) error
+}
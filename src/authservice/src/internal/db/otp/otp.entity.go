@@ -15,7 +15,10 @@ type Otp struct {
 	UserId     uuid.UUID `gorm:"type:uuid"`
 	// User       *user.User `gorm:"foreignKey:UserId"`
 	Mail     *string
+	Phone    *string
+	Channel  *int  `gorm:"default:0"`
 	Commited *bool `gorm:"default:false"`
+	Attempts *int  `gorm:"default:0"`
 }
 
 type Search struct {
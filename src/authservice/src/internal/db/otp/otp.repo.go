@@ -7,4 +7,5 @@ type OtpRepo interface {
 	InsertOtp(context.Context, *Otp) (*Otp, error)
 	DeleteOTP(context.Context, *Search) error
 	UpdateOTP(context.Context, *Search, *Otp) error
+	IncreaseOtpAttempts(context.Context, *Search) (int, error)
 }
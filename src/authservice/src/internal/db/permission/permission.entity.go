@@ -0,0 +1,22 @@
+package permission
+
+import (
+	"github.com/aqaurius6666/go-utils/database"
+	"github.com/google/uuid"
+)
+
+// Permission is a single action a role can be allowed to do, like
+// "users.read". Codes are what apiservice checks in Require.
+type Permission struct {
+	database.BaseModel
+	Code        *string `gorm:"index:unique"`
+	Description *string
+}
+
+type Search struct {
+	database.DefaultSearchModel
+	Permission
+	// RoleId limits the search to permissions assigned to the role.
+	RoleId uuid.UUID
+	Codes  []string
+}
@@ -0,0 +1,8 @@
+package permission
+
+import "golang.org/x/xerrors"
+
+var (
+	prefix      = "permission"
+	ErrNotFound = xerrors.Errorf("%s: record not found", prefix)
+)
@@ -0,0 +1,7 @@
+package permission
+
+import "context"
+
+type PermissionRepo interface {
+	ListPermissions(context.Context, *Search) ([]*Permission, error)
+}
@@ -0,0 +1,20 @@
+package recoverycode
+
+import (
+	"github.com/aqaurius6666/go-utils/database"
+	"github.com/google/uuid"
+)
+
+// RecoveryCode is a single use code that stands in for a TOTP code. Only the
+// sha256 hash of the code is stored.
+type RecoveryCode struct {
+	database.BaseModel
+	UserId   uuid.UUID `gorm:"type:uuid;index"`
+	CodeHash *string
+	IsUsed   *bool `gorm:"default:false"`
+}
+
+type Search struct {
+	database.DefaultSearchModel
+	RecoveryCode
+}
@@ -0,0 +1,10 @@
+package recoverycode
+
+import "context"
+
+type RecoveryCodeRepo interface {
+	SelectRecoveryCode(context.Context, *Search) (*RecoveryCode, error)
+	InsertRecoveryCodes(context.Context, []*RecoveryCode) ([]*RecoveryCode, error)
+	UpdateRecoveryCode(context.Context, *Search, *RecoveryCode) error
+	DeleteRecoveryCodes(context.Context, *Search) error
+}
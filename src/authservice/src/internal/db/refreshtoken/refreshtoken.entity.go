@@ -0,0 +1,22 @@
+package refreshtoken
+
+import (
+	"github.com/aqaurius6666/go-utils/database"
+	"github.com/google/uuid"
+)
+
+// RefreshToken lets the holder of a signed certificate get a new access
+// token. Only the sha256 hash of the token is stored, and a token is revoked
+// as soon as it is used.
+type RefreshToken struct {
+	database.BaseModel
+	UserId     uuid.UUID `gorm:"type:uuid;index"`
+	TokenHash  *string   `gorm:"index:unique"`
+	ExpireTime int64
+	IsRevoked  *bool `gorm:"default:false"`
+}
+
+type Search struct {
+	database.DefaultSearchModel
+	RefreshToken
+}
@@ -0,0 +1,9 @@
+package refreshtoken
+
+import "golang.org/x/xerrors"
+
+var (
+	prefix        = "refreshtoken"
+	ErrNotFound   = xerrors.Errorf("%s: record not found", prefix)
+	ErrInsertFail = xerrors.Errorf("%s: insert failed", prefix)
+)
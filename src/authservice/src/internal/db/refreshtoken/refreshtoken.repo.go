@@ -0,0 +1,9 @@
+package refreshtoken
+
+import "context"
+
+type RefreshTokenRepo interface {
+	SelectRefreshToken(context.Context, *Search) (*RefreshToken, error)
+	InsertRefreshToken(context.Context, *RefreshToken) (*RefreshToken, error)
+	UpdateRefreshToken(context.Context, *Search, *RefreshToken) error
+}
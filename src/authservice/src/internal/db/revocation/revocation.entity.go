@@ -0,0 +1,20 @@
+package revocation
+
+import (
+	"github.com/aqaurius6666/go-utils/database"
+	"github.com/google/uuid"
+)
+
+// Revocation is a single certificate that must no longer be accepted,
+// identified by its header signature.
+type Revocation struct {
+	database.BaseModel
+	UserId     uuid.UUID `gorm:"type:uuid;index"`
+	Signature  *string   `gorm:"index:unique"`
+	ExpireTime int64
+}
+
+type Search struct {
+	database.DefaultSearchModel
+	Revocation
+}
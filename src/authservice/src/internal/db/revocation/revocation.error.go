@@ -0,0 +1,10 @@
+package revocation
+
+import "golang.org/x/xerrors"
+
+var (
+	prefix        = "revocation"
+	ErrNotFound   = xerrors.Errorf("%s: record not found", prefix)
+	ErrInsertFail = xerrors.Errorf("%s: insert failed", prefix)
+	ErrDeleteFail = xerrors.Errorf("%s: delete failed", prefix)
+)
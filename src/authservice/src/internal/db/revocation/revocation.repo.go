@@ -0,0 +1,9 @@
+package revocation
+
+import "context"
+
+type RevocationRepo interface {
+	SelectRevocation(context.Context, *Search) (*Revocation, error)
+	InsertRevocation(context.Context, *Revocation) (*Revocation, error)
+	DeleteRevocation(context.Context, *Search) error
+}
@@ -5,4 +5,5 @@ import "context"
 type RoleRepo interface {
 	SelectRole(context.Context, *Search) (*Role, error)
 	InsertRole(context.Context, *Role) (*Role, error)
+	ListRoles(context.Context, *Search) ([]*Role, error)
 }
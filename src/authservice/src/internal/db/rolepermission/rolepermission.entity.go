@@ -0,0 +1,18 @@
+package rolepermission
+
+import (
+	"github.com/aqaurius6666/go-utils/database"
+	"github.com/google/uuid"
+)
+
+// RolePermission assigns a permission to a role.
+type RolePermission struct {
+	database.BaseModel
+	RoleId       uuid.UUID `gorm:"type:uuid;index"`
+	PermissionId uuid.UUID `gorm:"type:uuid;index"`
+}
+
+type Search struct {
+	database.DefaultSearchModel
+	RolePermission
+}
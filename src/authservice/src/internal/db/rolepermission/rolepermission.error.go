@@ -0,0 +1,9 @@
+package rolepermission
+
+import "golang.org/x/xerrors"
+
+var (
+	prefix        = "rolepermission"
+	ErrInsertFail = xerrors.Errorf("%s: insert failed", prefix)
+	ErrDeleteFail = xerrors.Errorf("%s: delete failed", prefix)
+)
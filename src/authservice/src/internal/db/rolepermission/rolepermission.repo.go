@@ -0,0 +1,8 @@
+package rolepermission
+
+import "context"
+
+type RolePermissionRepo interface {
+	InsertRolePermissions(context.Context, []*RolePermission) ([]*RolePermission, error)
+	DeleteRolePermissions(context.Context, *Search) error
+}
@@ -0,0 +1,23 @@
+package securityevent
+
+import (
+	"github.com/aqaurius6666/go-utils/database"
+	"github.com/google/uuid"
+)
+
+// SecurityEvent records a security relevant action on an account. Type is a
+// c.SECURITY_EVENT and Reason holds the error of a failed attempt.
+type SecurityEvent struct {
+	database.BaseModel
+	UserId    uuid.UUID `gorm:"type:uuid;index"`
+	Type      *int
+	Ip        *string
+	UserAgent *string
+	Success   *bool
+	Reason    *string
+}
+
+type Search struct {
+	database.DefaultSearchModel
+	SecurityEvent
+}
@@ -0,0 +1,9 @@
+package securityevent
+
+import "golang.org/x/xerrors"
+
+var (
+	prefix        = "securityevent"
+	ErrInsertFail = xerrors.Errorf("%s: insert failed", prefix)
+	ErrDeleteFail = xerrors.Errorf("%s: delete failed", prefix)
+)
@@ -0,0 +1,10 @@
+package securityevent
+
+import "context"
+
+type SecurityEventRepo interface {
+	ListSecurityEvents(context.Context, *Search) ([]*SecurityEvent, error)
+	TotalSecurityEvents(context.Context, *Search) (*int64, error)
+	InsertSecurityEvent(context.Context, *SecurityEvent) (*SecurityEvent, error)
+	DeleteSecurityEvents(context.Context, *Search) error
+}
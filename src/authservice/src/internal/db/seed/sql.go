@@ -4,10 +4,35 @@ var SQL = []string{
 	
 		`;`,
 	
+		`insert into public.permissions (id, updated_at, created_at, deleted_at, code, description)
+values  ('2f217c47-0e83-4ba6-919e-d669b81d3ce1', 11, 1, 0, 'users.read', 'View users and businesses'),
+        ('a132f478-fa29-46cc-a102-fb99811db0c1', 11, 1, 0, 'users.write', 'Ban, unban and delete users and businesses'),
+        ('b037fd47-c9c8-4957-9c38-19c0c07b2cea', 11, 1, 0, 'conversations.read', 'View conversations'),
+        ('22962329-75ee-4faa-883d-cc601baaff88', 11, 1, 0, 'fees.write', 'Change fees, groups and promotion packages'),
+        ('5f6b43f1-1d78-4a21-8e68-8bc3efd95e8c', 11, 1, 0, 'transactions.read', 'View transactions'),
+        ('b2e9ca69-355b-406c-9dd9-478abc88394a', 11, 1, 0, 'refunds.write', 'Issue refunds'),
+        ('da2548e3-ce75-4706-a159-a26aad7511bc', 11, 1, 0, 'content.moderate', 'Manage categories and user content'),
+        ('9e2c0317-508b-4134-a746-28aff088d0a4', 11, 1, 0, 'roles.manage', 'Assign permissions to roles'),
+        ('0c7f6f2e-5d3b-4f0e-9a61-3be2c8d41a57', 11, 1, 0, 'users.impersonate', 'View the app as a customer or handyman, read only'),
+        ('f35e35b9-085a-4f6a-9f32-cc6ac14480e6', 11, 1, 0, 'orders.read', 'View orders and their history');`,
+	
+		`insert into public.role_permissions (id, updated_at, created_at, deleted_at, role_id, permission_id)
+values  ('b525aee6-b7b3-419c-ad7e-64c768162733', 11, 1, 0, '8e8867a1-92d0-4cd0-a261-a25e3dfd1fd9', '2f217c47-0e83-4ba6-919e-d669b81d3ce1'),
+        ('760cc070-d9f1-4acf-8953-7b5a22bf9224', 11, 1, 0, '8e8867a1-92d0-4cd0-a261-a25e3dfd1fd9', 'b037fd47-c9c8-4957-9c38-19c0c07b2cea'),
+        ('19e2c57e-402c-4783-97c8-7d88ab0b83d4', 11, 1, 0, 'db05f937-d008-4560-b858-2deaa00d2413', '5f6b43f1-1d78-4a21-8e68-8bc3efd95e8c'),
+        ('86dd613f-6aba-43a1-8e03-d9322dd8f004', 11, 1, 0, 'db05f937-d008-4560-b858-2deaa00d2413', 'b2e9ca69-355b-406c-9dd9-478abc88394a'),
+        ('dfeb91e1-3b47-4d0d-a0e1-380248d4f31f', 11, 1, 0, 'db05f937-d008-4560-b858-2deaa00d2413', '22962329-75ee-4faa-883d-cc601baaff88'),
+        ('9b1796da-470d-4690-8786-d7dd14230d68', 11, 1, 0, 'b83d921d-ec00-4eb7-9522-6b9efa114546', '2f217c47-0e83-4ba6-919e-d669b81d3ce1'),
+        ('8fa900cb-f07d-4fe5-970d-87604c5be43a', 11, 1, 0, 'b83d921d-ec00-4eb7-9522-6b9efa114546', 'da2548e3-ce75-4706-a159-a26aad7511bc'),
+        ('4f11acdc-836b-4aa1-addb-c5cdc9a6c874', 11, 1, 0, '8e8867a1-92d0-4cd0-a261-a25e3dfd1fd9', 'f35e35b9-085a-4f6a-9f32-cc6ac14480e6');`,
+	
 		`insert into public.roles (id, updated_at, created_at, deleted_at, name, code)
 values  ('39a5635c-be8a-4ccd-b7a6-35b2c9f58819', 11, 1, 0, 'admin', 2),
         ('aace9864-752f-4959-ad22-5a8263046bff', 11, 1, 0, 'customer', 0),
-        ('bb2438b6-256b-48b0-b1ad-807324fed0cc', 11, 1, 0, 'handyman', 1);`,
+        ('bb2438b6-256b-48b0-b1ad-807324fed0cc', 11, 1, 0, 'handyman', 1),
+        ('8e8867a1-92d0-4cd0-a261-a25e3dfd1fd9', 11, 1, 0, 'support', 3),
+        ('db05f937-d008-4560-b858-2deaa00d2413', 11, 1, 0, 'finance', 4),
+        ('b83d921d-ec00-4eb7-9522-6b9efa114546', 11, 1, 0, 'moderator', 5);`,
 	
 		`insert into public.users (id, updated_at, created_at, deleted_at, username, public_key, encrypted_private_key, mail, phone, role_id, is_active)
 values  ('11526c9b-b56a-4d79-9f7b-4e1162360f0f', 1644034535393, 1644034535393, 0, null, null, null, null, null, 'bb2438b6-256b-48b0-b1ad-807324fed0cc', true),
@@ -0,0 +1,11 @@
+insert into public.permissions (id, updated_at, created_at, deleted_at, code, description)
+values  ('2f217c47-0e83-4ba6-919e-d669b81d3ce1', 11, 1, 0, 'users.read', 'View users and businesses'),
+        ('a132f478-fa29-46cc-a102-fb99811db0c1', 11, 1, 0, 'users.write', 'Ban, unban and delete users and businesses'),
+        ('b037fd47-c9c8-4957-9c38-19c0c07b2cea', 11, 1, 0, 'conversations.read', 'View conversations'),
+        ('22962329-75ee-4faa-883d-cc601baaff88', 11, 1, 0, 'fees.write', 'Change fees, groups and promotion packages'),
+        ('5f6b43f1-1d78-4a21-8e68-8bc3efd95e8c', 11, 1, 0, 'transactions.read', 'View transactions'),
+        ('b2e9ca69-355b-406c-9dd9-478abc88394a', 11, 1, 0, 'refunds.write', 'Issue refunds'),
+        ('da2548e3-ce75-4706-a159-a26aad7511bc', 11, 1, 0, 'content.moderate', 'Manage categories and user content'),
+        ('9e2c0317-508b-4134-a746-28aff088d0a4', 11, 1, 0, 'roles.manage', 'Assign permissions to roles'),
+        ('0c7f6f2e-5d3b-4f0e-9a61-3be2c8d41a57', 11, 1, 0, 'users.impersonate', 'View the app as a customer or handyman, read only'),
+        ('f35e35b9-085a-4f6a-9f32-cc6ac14480e6', 11, 1, 0, 'orders.read', 'View orders and their history');
\ No newline at end of file
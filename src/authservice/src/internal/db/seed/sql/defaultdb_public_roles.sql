@@ -1,4 +1,7 @@
 insert into public.roles (id, updated_at, created_at, deleted_at, name, code)
 values  ('39a5635c-be8a-4ccd-b7a6-35b2c9f58819', 11, 1, 0, 'admin', 2),
         ('aace9864-752f-4959-ad22-5a8263046bff', 11, 1, 0, 'customer', 0),
-        ('bb2438b6-256b-48b0-b1ad-807324fed0cc', 11, 1, 0, 'handyman', 1);
\ No newline at end of file
+        ('bb2438b6-256b-48b0-b1ad-807324fed0cc', 11, 1, 0, 'handyman', 1),
+        ('8e8867a1-92d0-4cd0-a261-a25e3dfd1fd9', 11, 1, 0, 'support', 3),
+        ('db05f937-d008-4560-b858-2deaa00d2413', 11, 1, 0, 'finance', 4),
+        ('b83d921d-ec00-4eb7-9522-6b9efa114546', 11, 1, 0, 'moderator', 5);
\ No newline at end of file
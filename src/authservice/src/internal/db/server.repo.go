@@ -1,9 +1,20 @@
 package db
 
 import (
+	"github.com/aqaurius6666/authservice/src/internal/db/apikey"
+	"github.com/aqaurius6666/authservice/src/internal/db/impersonationaudit"
+	"github.com/aqaurius6666/authservice/src/internal/db/membership"
 	"github.com/aqaurius6666/authservice/src/internal/db/otp"
+	"github.com/aqaurius6666/authservice/src/internal/db/permission"
+	"github.com/aqaurius6666/authservice/src/internal/db/recoverycode"
+	"github.com/aqaurius6666/authservice/src/internal/db/refreshtoken"
+	"github.com/aqaurius6666/authservice/src/internal/db/revocation"
 	"github.com/aqaurius6666/authservice/src/internal/db/role"
+	"github.com/aqaurius6666/authservice/src/internal/db/rolepermission"
+	"github.com/aqaurius6666/authservice/src/internal/db/securityevent"
+	"github.com/aqaurius6666/authservice/src/internal/db/suspension"
 	"github.com/aqaurius6666/authservice/src/internal/db/user"
+	"github.com/aqaurius6666/authservice/src/internal/db/userkey"
 	"github.com/aqaurius6666/go-utils/database"
 )
 
@@ -14,4 +25,15 @@ type ServerRepo interface {
 	user.UserRepo
 	role.RoleRepo
 	otp.OtpRepo
+	revocation.RevocationRepo
+	userkey.UserKeyRepo
+	recoverycode.RecoveryCodeRepo
+	refreshtoken.RefreshTokenRepo
+	permission.PermissionRepo
+	rolepermission.RolePermissionRepo
+	securityevent.SecurityEventRepo
+	impersonationaudit.ImpersonationAuditRepo
+	suspension.SuspensionRepo
+	membership.MembershipRepo
+	apikey.ApiKeyRepo
 }
@@ -0,0 +1,31 @@
+package suspension
+
+import (
+	"github.com/aqaurius6666/go-utils/database"
+	"github.com/google/uuid"
+)
+
+// Suspension keeps a user from signing in until ExpiresAt, or until it is
+// lifted when ExpiresAt is 0. Reason is a c.SUSPENSION_REASON and Note is
+// only shown to staff. Times are in milliseconds, LiftedAt is 0 while the
+// suspension is in force and LiftedBy is nil when it expired.
+type Suspension struct {
+	database.BaseModel
+	UserId    uuid.UUID `gorm:"type:uuid;index"`
+	Reason    *int
+	Note      *string
+	ExpiresAt int64     `gorm:"default:0"`
+	IssuedBy  uuid.UUID `gorm:"type:uuid"`
+	LiftedAt  int64     `gorm:"default:0;index"`
+	LiftedBy  uuid.UUID `gorm:"type:uuid"`
+}
+
+// Search matches on the set fields of Suspension. InForce restricts it to
+// suspensions not lifted yet and ExpiredBefore to those with an expiry
+// before it.
+type Search struct {
+	database.DefaultSearchModel
+	Suspension
+	InForce       bool
+	ExpiredBefore int64
+}
@@ -0,0 +1,10 @@
+package suspension
+
+import "golang.org/x/xerrors"
+
+var (
+	prefix        = "suspension"
+	ErrNotFound   = xerrors.Errorf("%s: record not found", prefix)
+	ErrInsertFail = xerrors.Errorf("%s: insert failed", prefix)
+	ErrDeleteFail = xerrors.Errorf("%s: delete failed", prefix)
+)
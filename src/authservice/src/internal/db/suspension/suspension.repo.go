@@ -0,0 +1,11 @@
+package suspension
+
+import "context"
+
+type SuspensionRepo interface {
+	SelectSuspension(context.Context, *Search) (*Suspension, error)
+	ListSuspensions(context.Context, *Search) ([]*Suspension, error)
+	InsertSuspension(context.Context, *Suspension) (*Suspension, error)
+	UpdateSuspension(context.Context, *Search, *Suspension) error
+	DeleteSuspensions(context.Context, *Search) error
+}
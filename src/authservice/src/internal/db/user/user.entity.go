@@ -18,9 +18,16 @@ type User struct {
 	IsActive            *bool      `gorm:"default:true"`
 	IsDefaultPassword   *bool      `gorm:"type:bool;default:false"`
 	RefCode             *string    `gorm:"-:migration;-"`
+	NotBefore           int64      `gorm:"default:0"`
+	TotpSecret          *string    `json:"-"`
+	IsTotpEnabled       *bool      `gorm:"type:bool;default:false"`
+	IsPhoneVerified     *bool      `gorm:"type:bool;default:false"`
+	DeleteAfter         *int64     `gorm:"default:0"`
 }
 
 type Search struct {
 	database.DefaultSearchModel
 	User
+	// DueBefore matches users whose scheduled deletion is at or before it.
+	DueBefore int64
 }
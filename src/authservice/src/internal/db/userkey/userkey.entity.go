@@ -0,0 +1,22 @@
+package userkey
+
+import (
+	"github.com/aqaurius6666/go-utils/database"
+	"github.com/google/uuid"
+)
+
+// UserKey is a public key registered by one device of a user, in addition to
+// the key stored on the user itself.
+type UserKey struct {
+	database.BaseModel
+	UserId     uuid.UUID `gorm:"type:uuid;index"`
+	PublicKey  *string   `gorm:"index:unique"`
+	Label      *string
+	LastUsedAt int64
+	IsActive   *bool `gorm:"default:true"`
+}
+
+type Search struct {
+	database.DefaultSearchModel
+	UserKey
+}
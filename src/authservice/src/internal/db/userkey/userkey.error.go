@@ -0,0 +1,9 @@
+package userkey
+
+import "golang.org/x/xerrors"
+
+var (
+	prefix        = "userkey"
+	ErrNotFound   = xerrors.Errorf("%s: record not found", prefix)
+	ErrInsertFail = xerrors.Errorf("%s: insert failed", prefix)
+)
@@ -0,0 +1,10 @@
+package userkey
+
+import "context"
+
+type UserKeyRepo interface {
+	SelectUserKey(context.Context, *Search) (*UserKey, error)
+	ListUserKeys(context.Context, *Search) ([]*UserKey, error)
+	InsertUserKey(context.Context, *UserKey) (*UserKey, error)
+	UpdateUserKey(context.Context, *Search, *UserKey) error
+}
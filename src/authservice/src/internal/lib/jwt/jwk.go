@@ -0,0 +1,89 @@
+package jwt
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+)
+
+// JWK is an EC public key in JSON Web Key format.
+type JWK struct {
+	Kty string `json:"kty"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+	Kid string `json:"kid"`
+	Alg string `json:"alg"`
+	Use string `json:"use"`
+}
+
+func NewJWK(pub *ecdsa.PublicKey) JWK {
+	x := make([]byte, size)
+	y := make([]byte, size)
+	pub.X.FillBytes(x)
+	pub.Y.FillBytes(y)
+	k := JWK{
+		Kty: "EC",
+		Crv: "P-256",
+		X:   encoding.EncodeToString(x),
+		Y:   encoding.EncodeToString(y),
+		Alg: ALG,
+		Use: "sig",
+	}
+	k.Kid = k.Thumbprint()
+	return k
+}
+
+// Thumbprint is the RFC 7638 thumbprint of the key, used as its kid.
+func (k JWK) Thumbprint() string {
+	sum := sha256.Sum256([]byte(fmt.Sprintf(`{"crv":"%s","kty":"%s","x":"%s","y":"%s"}`, k.Crv, k.Kty, k.X, k.Y)))
+	return encoding.EncodeToString(sum[:])
+}
+
+func (k JWK) PublicKey() (*ecdsa.PublicKey, error) {
+	if k.Kty != "EC" || k.Crv != "P-256" {
+		return nil, ErrInvalidKey
+	}
+	x, err := encoding.DecodeString(k.X)
+	if err != nil || len(x) != size {
+		return nil, ErrInvalidKey
+	}
+	y, err := encoding.DecodeString(k.Y)
+	if err != nil || len(y) != size {
+		return nil, ErrInvalidKey
+	}
+	pub := &ecdsa.PublicKey{
+		Curve: elliptic.P256(),
+		X:     new(big.Int).SetBytes(x),
+		Y:     new(big.Int).SetBytes(y),
+	}
+	if !pub.Curve.IsOnCurve(pub.X, pub.Y) {
+		return nil, ErrInvalidKey
+	}
+	return pub, nil
+}
+
+// ParsePrivateKey reads a P-256 private key from PEM, either SEC 1
+// ("EC PRIVATE KEY") or PKCS #8 ("PRIVATE KEY").
+func ParsePrivateKey(bz []byte) (*ecdsa.PrivateKey, error) {
+	block, _ := pem.Decode(bz)
+	if block == nil {
+		return nil, ErrInvalidKey
+	}
+	if key, err := x509.ParseECPrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, ErrInvalidKey
+	}
+	ecKey, ok := key.(*ecdsa.PrivateKey)
+	if !ok {
+		return nil, ErrInvalidKey
+	}
+	return ecKey, nil
+}
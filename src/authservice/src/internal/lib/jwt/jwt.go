@@ -0,0 +1,161 @@
+// Package jwt implements the subset of JSON Web Tokens (RFC 7519) needed for
+// access tokens: compact JWS signed with ES256 and public keys published as
+// JWK (RFC 7517).
+package jwt
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"strings"
+	"time"
+
+	"golang.org/x/xerrors"
+)
+
+const (
+	ALG  = "ES256"
+	TYP  = "JWT"
+	size = 32
+)
+
+var (
+	prefix              = "jwt"
+	ErrMalformed        = xerrors.Errorf("%s: malformed token", prefix)
+	ErrUnsupportedAlg   = xerrors.Errorf("%s: unsupported algorithm", prefix)
+	ErrUnknownKey       = xerrors.Errorf("%s: unknown key", prefix)
+	ErrInvalidSignature = xerrors.Errorf("%s: invalid signature", prefix)
+	ErrExpired          = xerrors.Errorf("%s: token expired", prefix)
+	ErrInvalidKey       = xerrors.Errorf("%s: invalid key", prefix)
+)
+
+var encoding = base64.RawURLEncoding
+
+// Claims are the claims of an access token. Role is the c.ROLE code of the
+// user, TotpEnrollRequired, Permissions, BusinessId and BusinessRole mirror
+// CheckAuthResponse. A ReadOnly token may only be used for requests that change nothing.
+type Claims struct {
+	Issuer             string   `json:"iss"`
+	Subject            string   `json:"sub"`
+	Role               int32    `json:"role"`
+	TotpEnrollRequired bool     `json:"ter,omitempty"`
+	Permissions        []string `json:"perms,omitempty"`
+	BusinessId         string   `json:"bid,omitempty"`
+	BusinessRole       int32    `json:"brole,omitempty"`
+	IssuedAt           int64    `json:"iat"`
+	ExpiresAt          int64    `json:"exp"`
+	ID                 string   `json:"jti"`
+	Actor              *Actor   `json:"act,omitempty"`
+	ReadOnly           bool     `json:"ro,omitempty"`
+}
+
+// Actor is who acts on behalf of the subject (RFC 8693). It is set on
+// impersonation tokens to the admin using them, and the ID of such a token
+// is the id of the impersonation session.
+type Actor struct {
+	Subject string `json:"sub"`
+}
+
+type header struct {
+	Alg string `json:"alg"`
+	Typ string `json:"typ"`
+	Kid string `json:"kid"`
+}
+
+// Signer signs tokens with a P-256 private key.
+type Signer struct {
+	key *ecdsa.PrivateKey
+	kid string
+}
+
+func NewSigner(key *ecdsa.PrivateKey) (*Signer, error) {
+	if key == nil || key.Curve != elliptic.P256() {
+		return nil, ErrInvalidKey
+	}
+	return &Signer{
+		key: key,
+		kid: NewJWK(&key.PublicKey).Thumbprint(),
+	}, nil
+}
+
+// Kid is the id of the signing key, put into the header of every token.
+func (s *Signer) Kid() string {
+	return s.kid
+}
+
+// JWK returns the public half of the signing key.
+func (s *Signer) JWK() JWK {
+	return NewJWK(&s.key.PublicKey)
+}
+
+// Sign returns the compact serialization of claims.
+func (s *Signer) Sign(claims Claims) (string, error) {
+	bHeader, err := json.Marshal(header{Alg: ALG, Typ: TYP, Kid: s.kid})
+	if err != nil {
+		return "", err
+	}
+	bClaims, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+	input := encoding.EncodeToString(bHeader) + "." + encoding.EncodeToString(bClaims)
+	digest := sha256.Sum256([]byte(input))
+	r, ss, err := ecdsa.Sign(rand.Reader, s.key, digest[:])
+	if err != nil {
+		return "", err
+	}
+	sig := make([]byte, 2*size)
+	r.FillBytes(sig[:size])
+	ss.FillBytes(sig[size:])
+	return input + "." + encoding.EncodeToString(sig), nil
+}
+
+// Verify checks the signature of token against the key keyFn returns for its
+// kid and that the token has not expired at now.
+func Verify(token string, keyFn func(kid string) (*ecdsa.PublicKey, error), now time.Time) (*Claims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, ErrMalformed
+	}
+	bHeader, err := encoding.DecodeString(parts[0])
+	if err != nil {
+		return nil, ErrMalformed
+	}
+	h := header{}
+	if err := json.Unmarshal(bHeader, &h); err != nil {
+		return nil, ErrMalformed
+	}
+	if h.Alg != ALG {
+		return nil, ErrUnsupportedAlg
+	}
+	pub, err := keyFn(h.Kid)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := encoding.DecodeString(parts[2])
+	if err != nil || len(sig) != 2*size {
+		return nil, ErrInvalidSignature
+	}
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	r := new(big.Int).SetBytes(sig[:size])
+	s := new(big.Int).SetBytes(sig[size:])
+	if !ecdsa.Verify(pub, digest[:], r, s) {
+		return nil, ErrInvalidSignature
+	}
+	bClaims, err := encoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, ErrMalformed
+	}
+	claims := Claims{}
+	if err := json.Unmarshal(bClaims, &claims); err != nil {
+		return nil, ErrMalformed
+	}
+	if claims.ExpiresAt <= now.Unix() {
+		return nil, ErrExpired
+	}
+	return &claims, nil
+}
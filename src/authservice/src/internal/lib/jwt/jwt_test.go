@@ -0,0 +1,119 @@
+package jwt
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newSigner(t *testing.T) *Signer {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.Nil(t, err)
+	s, err := NewSigner(key)
+	assert.Nil(t, err)
+	return s
+}
+
+func keyFnOf(s *Signer) func(string) (*ecdsa.PublicKey, error) {
+	return func(kid string) (*ecdsa.PublicKey, error) {
+		if kid != s.Kid() {
+			return nil, ErrUnknownKey
+		}
+		return s.JWK().PublicKey()
+	}
+}
+
+func TestSignVerify(t *testing.T) {
+	s := newSigner(t)
+	now := time.Now()
+	token, err := s.Sign(Claims{
+		Subject:   "user",
+		Role:      2,
+		IssuedAt:  now.Unix(),
+		ExpiresAt: now.Add(time.Minute).Unix(),
+	})
+	assert.Nil(t, err)
+
+	claims, err := Verify(token, keyFnOf(s), now)
+	assert.Nil(t, err)
+	assert.Equal(t, "user", claims.Subject)
+	assert.Equal(t, int32(2), claims.Role)
+
+	_, err = Verify(token, keyFnOf(s), now.Add(time.Minute))
+	assert.ErrorIs(t, err, ErrExpired)
+
+	_, err = Verify(token, keyFnOf(newSigner(t)), now)
+	assert.ErrorIs(t, err, ErrUnknownKey)
+}
+
+func TestVerifyTampered(t *testing.T) {
+	s := newSigner(t)
+	now := time.Now()
+	token, err := s.Sign(Claims{Subject: "user", ExpiresAt: now.Add(time.Minute).Unix()})
+	assert.Nil(t, err)
+	other, err := s.Sign(Claims{Subject: "admin", ExpiresAt: now.Add(time.Minute).Unix()})
+	assert.Nil(t, err)
+
+	parts := strings.Split(token, ".")
+	forged := parts[0] + "." + strings.Split(other, ".")[1] + "." + parts[2]
+	_, err = Verify(forged, keyFnOf(s), now)
+	assert.ErrorIs(t, err, ErrInvalidSignature)
+
+	_, err = Verify("abc", keyFnOf(s), now)
+	assert.ErrorIs(t, err, ErrMalformed)
+}
+
+func TestJWKRoundTrip(t *testing.T) {
+	s := newSigner(t)
+	k := s.JWK()
+	assert.Equal(t, s.Kid(), k.Kid)
+	pub, err := k.PublicKey()
+	assert.Nil(t, err)
+	assert.Equal(t, s.key.PublicKey.X, pub.X)
+	assert.Equal(t, s.key.PublicKey.Y, pub.Y)
+}
+
+func TestParsePrivateKey(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.Nil(t, err)
+	bz, err := x509.MarshalECPrivateKey(key)
+	assert.Nil(t, err)
+	parsed, err := ParsePrivateKey(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: bz}))
+	assert.Nil(t, err)
+	assert.True(t, key.Equal(parsed))
+
+	_, err = ParsePrivateKey([]byte("not a key"))
+	assert.ErrorIs(t, err, ErrInvalidKey)
+}
+
+func TestImpersonationClaims(t *testing.T) {
+	s := newSigner(t)
+	now := time.Now()
+	token, err := s.Sign(Claims{
+		Subject:   "user",
+		ExpiresAt: now.Add(time.Minute).Unix(),
+		ID:        "session",
+		Actor:     &Actor{Subject: "admin"},
+		ReadOnly:  true,
+	})
+	assert.Nil(t, err)
+
+	claims, err := Verify(token, keyFnOf(s), now)
+	assert.Nil(t, err)
+	assert.Equal(t, "admin", claims.Actor.Subject)
+	assert.True(t, claims.ReadOnly)
+
+	token, err = s.Sign(Claims{Subject: "user", ExpiresAt: now.Add(time.Minute).Unix()})
+	assert.Nil(t, err)
+	claims, err = Verify(token, keyFnOf(s), now)
+	assert.Nil(t, err)
+	assert.Nil(t, claims.Actor)
+	assert.False(t, claims.ReadOnly)
+}
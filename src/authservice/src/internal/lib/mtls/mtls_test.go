@@ -0,0 +1,75 @@
+package mtls
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+func TestPolicyAllowed(t *testing.T) {
+	policy := Policy{
+		"/pkg.Service/*":      {"api-service"},
+		"/pkg.Service/Delete": {"cronjob-service"},
+	}
+	assert.Equal(t, []string{"api-service"}, policy.allowed("/pkg.Service/Get"))
+	assert.Equal(t, []string{"cronjob-service"}, policy.allowed("/pkg.Service/Delete"))
+	assert.Nil(t, policy.allowed("/pkg.Other/Get"))
+}
+
+func TestDevCredentials(t *testing.T) {
+	dir := t.TempDir()
+	server, err := New(Config{Mode: ModeDev, DevDir: dir, Identity: "auth-service"})
+	assert.Nil(t, err)
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err)
+	srv := grpc.NewServer(server.ServerOptions(Policy{
+		"/grpc.health.v1.Health/Check": {"api-service"},
+		"/grpc.health.v1.Health/Watch": {Anyone},
+	})...)
+	healthpb.RegisterHealthServer(srv, health.NewServer())
+	go func() { _ = srv.Serve(lis) }()
+	defer srv.Stop()
+
+	TEST_CASE := []struct {
+		identity string
+		code     codes.Code
+	}{
+		{"api-service", codes.OK},
+		{"mail-service", codes.PermissionDenied},
+	}
+	ctx := context.Background()
+	for _, tc := range TEST_CASE {
+		client, err := New(Config{Mode: ModeDev, DevDir: dir, Identity: tc.identity})
+		assert.Nil(t, err)
+		conn, err := grpc.Dial(lis.Addr().String(), client.DialOption())
+		assert.Nil(t, err)
+		_, err = healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{})
+		assert.Equal(t, tc.code, status.Code(err), tc.identity)
+		_ = conn.Close()
+	}
+
+	// a client without a certificate only reaches methods open to anyone
+	anonymous := &Credentials{pool: server.pool}
+	conn, err := grpc.Dial(lis.Addr().String(), anonymous.DialOption())
+	assert.Nil(t, err)
+	_, err = healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_ = conn.Close()
+
+	// a client with another CA fails the handshake
+	other, err := New(Config{Mode: ModeDev, DevDir: t.TempDir(), Identity: "api-service"})
+	assert.Nil(t, err)
+	conn, err = grpc.Dial(lis.Addr().String(), other.DialOption())
+	assert.Nil(t, err)
+	_, err = healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{})
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	_ = conn.Close()
+}
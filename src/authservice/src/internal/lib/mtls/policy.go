@@ -0,0 +1,77 @@
+package mtls
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// Anyone allows a method to be called without a client certificate.
+const Anyone = "*"
+
+// Policy maps a full method name, or "/package.Service/*" for every method of
+// a service, to the identities allowed to call it. An exact method takes
+// precedence over its service; methods matching neither are denied.
+type Policy map[string][]string
+
+func (p Policy) allowed(method string) []string {
+	if ids, ok := p[method]; ok {
+		return ids
+	}
+	if i := strings.LastIndex(method, "/"); i > 0 {
+		return p[method[:i+1]+"*"]
+	}
+	return nil
+}
+
+func (p Policy) authorize(ctx context.Context, method string) error {
+	ids := p.allowed(method)
+	for _, id := range ids {
+		if id == Anyone {
+			return nil
+		}
+	}
+	caller, ok := Identity(ctx)
+	if !ok {
+		return status.Errorf(codes.Unauthenticated, "%s requires a client certificate", method)
+	}
+	for _, id := range ids {
+		if id == caller {
+			return nil
+		}
+	}
+	return status.Errorf(codes.PermissionDenied, "%s is not allowed to call %s", caller, method)
+}
+
+func (p Policy) UnaryServerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := p.authorize(ctx, info.FullMethod); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func (p Policy) StreamServerInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := p.authorize(ss.Context(), info.FullMethod); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
+
+// Identity returns the common name of the verified client certificate of the
+// caller.
+func Identity(ctx context.Context) (string, bool) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return "", false
+	}
+	info, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(info.State.VerifiedChains) == 0 || len(info.State.VerifiedChains[0]) == 0 {
+		return "", false
+	}
+	return info.State.VerifiedChains[0][0].Subject.CommonName, true
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"text/template"
+	"time"
 
 	"github.com/aqaurius6666/authservice/src/internal/var/c"
 	"github.com/google/uuid"
@@ -39,6 +40,65 @@ var (
 			ButtonText:   "Active",
 			Subject:      "[AnygoNow] Active account",
 		},
+		c.OTP_TYPE_DELETE_ACCOUNT: {
+			EmailSender:  "no-reply@anygonow.com",
+			Text1:        "We got a request to delete your Anygonow account.",
+			Text2:        "Please click this button to confirm. You can still restore your account by signing in again before it is erased.",
+			CompanyEmail: "support@anygonow.com",
+			ButtonText:   "Delete account",
+			Subject:      "[AnygoNow] Confirm account deletion",
+		},
+		c.OTP_TYPE_BUSINESS_INVITE: {
+			EmailSender:  "no-reply@anygonow.com",
+			Text2:        "Please click this button to join. The invitation expires if it is not accepted in time.",
+			CompanyEmail: "support@anygonow.com",
+			ButtonText:   "Join",
+			Subject:      "[AnygoNow] You are invited to join a business",
+		},
+	}
+)
+
+var (
+	NEW_DEVICE_TEMPLATE_VAR = TemplateVar{
+		EmailSender:  "no-reply@anygonow.com",
+		Text2:        "If this was not you, please reset your password right away.",
+		CompanyEmail: "support@anygonow.com",
+		ButtonText:   "Review activity",
+		Subject:      "[AnygoNow] New sign-in to your account",
+	}
+)
+
+var (
+	SUSPENDED_TEMPLATE_VAR = TemplateVar{
+		EmailSender:  "no-reply@anygonow.com",
+		CompanyEmail: "support@anygonow.com",
+		ButtonText:   "Contact support",
+		Subject:      "[AnygoNow] Your account has been suspended",
+	}
+	SUSPENSION_LIFTED_TEMPLATE_VAR = TemplateVar{
+		EmailSender:  "no-reply@anygonow.com",
+		Text1:        "The suspension of your AnygoNow account has been lifted.",
+		Text2:        "You can sign in again.",
+		CompanyEmail: "support@anygonow.com",
+		ButtonText:   "Sign in",
+		Subject:      "[AnygoNow] Your account is active again",
+	}
+	SUSPENSION_REASON_TEXTS = map[c.SUSPENSION_REASON]string{
+		c.SUSPENSION_REASON_OTHER:            "a breach of our terms of service",
+		c.SUSPENSION_REASON_FRAUD:            "suspected fraud",
+		c.SUSPENSION_REASON_ABUSE:            "abusive behaviour towards other users",
+		c.SUSPENSION_REASON_SPAM:             "spam",
+		c.SUSPENSION_REASON_POLICY_VIOLATION: "a violation of our policies",
+		c.SUSPENSION_REASON_UNPAID_FEES:      "unpaid fees",
+	}
+)
+
+var (
+	SMS_TEXTS = map[c.OTP_TYPE]string{
+		c.OTP_TYPE_REGISTER:        "Your AnygoNow verification code is %s. Do not share it with anyone.",
+		c.OTP_TYPE_FORGOT_PASSWORD: "Your AnygoNow password reset code is %s. Do not share it with anyone.",
+		c.OTP_TYPE_VERIFY_PHONE:    "Your AnygoNow phone verification code is %s. Do not share it with anyone.",
+		c.OTP_TYPE_DELETE_ACCOUNT:  "Your AnygoNow account deletion code is %s. Do not share it with anyone.",
 	}
 )
 
@@ -116,6 +176,110 @@ func ChangeMailAndPassTemplate(to string, otp, otpId string) []byte {
 	return body.Bytes()
 }
 
+func DeleteAccountMailTemplate(to string, otp, otpId string) []byte {
+	var err error
+	link := fmt.Sprintf("%s/verify/delete-account?otp=%s&otpId=%s", frontend, otp, otpId)
+	temp := template.New("mail")
+	temp, err = temp.Parse(mail)
+	if err != nil {
+		return []byte("error mail")
+	}
+	body := new(bytes.Buffer)
+	err = temp.Execute(body, TEMPLATE_VARS[c.OTP_TYPE_DELETE_ACCOUNT].WithLink(link).WithUserEmail(to).WithBoundary(uuid.NewString()))
+	if err != nil {
+		return []byte("error mail")
+	}
+	return body.Bytes()
+}
+
+// BusinessInviteMailTemplate invites to to act for the business named
+// businessName.
+func BusinessInviteMailTemplate(to, businessName, otp, otpId string) []byte {
+	var err error
+	link := fmt.Sprintf("%s/verify/business-invite?otp=%s&otpId=%s", frontend, otp, otpId)
+	temp := template.New("mail")
+	temp, err = temp.Parse(mail)
+	if err != nil {
+		return []byte("error mail")
+	}
+	vars := TEMPLATE_VARS[c.OTP_TYPE_BUSINESS_INVITE]
+	vars.Text1 = fmt.Sprintf("%s invited you to work with them on AnygoNow.", businessName)
+	body := new(bytes.Buffer)
+	err = temp.Execute(body, vars.WithLink(link).WithUserEmail(to).WithBoundary(uuid.NewString()))
+	if err != nil {
+		return []byte("error mail")
+	}
+	return body.Bytes()
+}
+
+// NewDeviceMailTemplate warns to that their account was signed in from a
+// device it was not used from before.
+func NewDeviceMailTemplate(to, userAgent, ip string, at time.Time) []byte {
+	var err error
+	link := fmt.Sprintf("%s/account/activity", frontend)
+	temp := template.New("mail")
+	temp, err = temp.Parse(mail)
+	if err != nil {
+		return []byte("error mail")
+	}
+	vars := NEW_DEVICE_TEMPLATE_VAR
+	vars.Text1 = fmt.Sprintf("Your AnygoNow account was signed in from a new device (%s, %s) on %s.", userAgent, ip, at.UTC().Format(time.RFC1123))
+	body := new(bytes.Buffer)
+	err = temp.Execute(body, vars.WithLink(link).WithUserEmail(to).WithBoundary(uuid.NewString()))
+	if err != nil {
+		return []byte("error mail")
+	}
+	return body.Bytes()
+}
+
+// SuspendedMailTemplate tells to that their account is suspended for reason
+// until expiresAt, in milliseconds, or until further notice when it is 0.
+func SuspendedMailTemplate(to string, reason c.SUSPENSION_REASON, expiresAt int64) []byte {
+	var err error
+	link := fmt.Sprintf("%s/support", frontend)
+	temp := template.New("mail")
+	temp, err = temp.Parse(mail)
+	if err != nil {
+		return []byte("error mail")
+	}
+	vars := SUSPENDED_TEMPLATE_VAR
+	vars.Text1 = fmt.Sprintf("Your AnygoNow account has been suspended because of %s.", SUSPENSION_REASON_TEXTS[reason])
+	vars.Text2 = "The suspension lasts until further notice. If you think this is a mistake, please contact our support."
+	if expiresAt != 0 {
+		vars.Text2 = fmt.Sprintf("The suspension ends on %s. If you think this is a mistake, please contact our support.", time.UnixMilli(expiresAt).UTC().Format(time.RFC1123))
+	}
+	body := new(bytes.Buffer)
+	err = temp.Execute(body, vars.WithLink(link).WithUserEmail(to).WithBoundary(uuid.NewString()))
+	if err != nil {
+		return []byte("error mail")
+	}
+	return body.Bytes()
+}
+
+func SuspensionLiftedMailTemplate(to string) []byte {
+	var err error
+	link := fmt.Sprintf("%s/login", frontend)
+	temp := template.New("mail")
+	temp, err = temp.Parse(mail)
+	if err != nil {
+		return []byte("error mail")
+	}
+	body := new(bytes.Buffer)
+	err = temp.Execute(body, SUSPENSION_LIFTED_TEMPLATE_VAR.WithLink(link).WithUserEmail(to).WithBoundary(uuid.NewString()))
+	if err != nil {
+		return []byte("error mail")
+	}
+	return body.Bytes()
+}
+
+func SMSTemplate(typ c.OTP_TYPE, otp string) (string, error) {
+	text, ok := SMS_TEXTS[typ]
+	if !ok {
+		return "", fmt.Errorf("no sms template for %s", typ)
+	}
+	return fmt.Sprintf(text, otp), nil
+}
+
 var mail = `From: Anygonow <{{ .EmailSender }}>
 Subject: {{ .Subject }}
 To: {{ .UserEmail }}
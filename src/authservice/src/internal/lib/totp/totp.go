@@ -0,0 +1,84 @@
+// Package totp implements time-based one-time passwords as described in
+// RFC 6238, using HMAC-SHA1, 6 digits and a 30 second step.
+package totp
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	STEP        = 30 * time.Second
+	DIGITS      = 6
+	SECRET_SIZE = 20
+)
+
+var encoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// GenerateSecret returns a random base32 encoded secret.
+func GenerateSecret() (string, error) {
+	bz := make([]byte, SECRET_SIZE)
+	if _, err := rand.Read(bz); err != nil {
+		return "", err
+	}
+	return encoding.EncodeToString(bz), nil
+}
+
+// Code returns the code of secret for the step t falls in.
+func Code(secret string, t time.Time) (string, error) {
+	key, err := encoding.DecodeString(strings.ToUpper(strings.TrimRight(secret, "=")))
+	if err != nil {
+		return "", err
+	}
+	return code(key, uint64(t.Unix()/int64(STEP/time.Second))), nil
+}
+
+// Validate reports whether c is the code of secret at t, or at up to skew
+// steps before or after it.
+func Validate(secret, c string, t time.Time, skew int) bool {
+	if len(c) != DIGITS {
+		return false
+	}
+	key, err := encoding.DecodeString(strings.ToUpper(strings.TrimRight(secret, "=")))
+	if err != nil {
+		return false
+	}
+	counter := t.Unix() / int64(STEP/time.Second)
+	for i := -skew; i <= skew; i++ {
+		if counter+int64(i) < 0 {
+			continue
+		}
+		expected := code(key, uint64(counter+int64(i)))
+		if subtle.ConstantTimeCompare([]byte(expected), []byte(c)) == 1 {
+			return true
+		}
+	}
+	return false
+}
+
+// URI returns the otpauth URI understood by authenticator apps.
+func URI(issuer, account, secret string) string {
+	v := url.Values{}
+	v.Set("secret", secret)
+	v.Set("issuer", issuer)
+	return fmt.Sprintf("otpauth://totp/%s:%s?%s", url.PathEscape(issuer), url.PathEscape(account), v.Encode())
+}
+
+func code(key []byte, counter uint64) string {
+	msg := make([]byte, 8)
+	binary.BigEndian.PutUint64(msg, counter)
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg)
+	sum := mac.Sum(nil)
+	offset := sum[len(sum)-1] & 0x0f
+	bin := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	return fmt.Sprintf("%0*d", DIGITS, bin%1000000)
+}
@@ -0,0 +1,43 @@
+package totp
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// The secret and codes come from RFC 6238 appendix B, truncated to 6 digits.
+const rfcSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func TestCode(t *testing.T) {
+	TEST_CASE := map[int64]string{
+		59:         "287082",
+		1111111109: "081804",
+		1111111111: "050471",
+		1234567890: "005924",
+		2000000000: "279037",
+	}
+	for ts, want := range TEST_CASE {
+		got, err := Code(rfcSecret, time.Unix(ts, 0))
+		assert.Nil(t, err)
+		assert.Equal(t, want, got)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	at := time.Unix(1111111109, 0)
+	assert.True(t, Validate(rfcSecret, "081804", at, 0))
+	assert.True(t, Validate(rfcSecret, "081804", at.Add(STEP), 1))
+	assert.False(t, Validate(rfcSecret, "081804", at.Add(2*STEP), 1))
+	assert.False(t, Validate(rfcSecret, "000000", at, 1))
+	assert.False(t, Validate(rfcSecret, "81804", at, 1))
+}
+
+func TestGenerateSecret(t *testing.T) {
+	secret, err := GenerateSecret()
+	assert.Nil(t, err)
+	c, err := Code(secret, time.Now())
+	assert.Nil(t, err)
+	assert.True(t, Validate(secret, c, time.Now(), 1))
+}
@@ -1,10 +1,43 @@
 package lib
 
 import (
+	"context"
+
 	"github.com/aqaurius6666/authservice/src/internal/var/e"
 	"github.com/google/uuid"
+	"google.golang.org/grpc/metadata"
+)
+
+// CLIENT_IP_KEY and USER_AGENT_KEY are the metadata keys gateways use to
+// forward the address and user agent of the end user.
+const (
+	CLIENT_IP_KEY  = "x-client-ip"
+	USER_AGENT_KEY = "x-user-agent"
 )
 
+// ClientIP returns the end user address forwarded in the incoming metadata,
+// or an empty string when the caller did not set one.
+func ClientIP(ctx context.Context) string {
+	return incomingMetadata(ctx, CLIENT_IP_KEY)
+}
+
+// UserAgent returns the end user agent forwarded in the incoming metadata, or
+// an empty string when the caller did not set one.
+func UserAgent(ctx context.Context) string {
+	return incomingMetadata(ctx, USER_AGENT_KEY)
+}
+
+func incomingMetadata(ctx context.Context, key string) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	if v := md.Get(key); len(v) > 0 {
+		return v[0]
+	}
+	return ""
+}
+
 func ToUUID(id interface{}) (uuid.UUID, error) {
 	switch tmp := id.(type) {
 	case uuid.UUID:
@@ -1,12 +1,31 @@
 package validate
 
-func IsPhone(str string) bool {
+import (
+	"net/mail"
+	"regexp"
+	"strings"
+
+	"github.com/aqaurius6666/authservice/src/internal/var/c"
+)
+
+// phoneRegex matches E.164 numbers and the 10 digit national numbers the
+// apiservice collects at registration.
+var phoneRegex = regexp.MustCompile(`^(\+[1-9][0-9]{7,14}|[0-9]{10})$`)
 
-	return false
+func IsPhone(str string) bool {
+	return phoneRegex.MatchString(str)
+}
 
+// ToE164 prefixes a national number with c.SMS_COUNTRY_CODE so it can be used
+// for sms delivery. Numbers already in E.164 are returned as is.
+func ToE164(phone string) string {
+	if strings.HasPrefix(phone, "+") {
+		return phone
+	}
+	return c.SMS_COUNTRY_CODE + phone
 }
 
 func IsMail(str string) bool {
-
-	return true
+	addr, err := mail.ParseAddress(str)
+	return err == nil && addr.Address == str
 }
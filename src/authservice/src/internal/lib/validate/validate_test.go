@@ -0,0 +1,29 @@
+package validate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsPhone(t *testing.T) {
+	assert.True(t, IsPhone("+84384511909"))
+	assert.True(t, IsPhone("+14155552671"))
+	assert.True(t, IsPhone("0384511909"))
+	assert.False(t, IsPhone("038451190"))
+	assert.False(t, IsPhone("+0384511909"))
+	assert.False(t, IsPhone("+8438451190a"))
+	assert.False(t, IsPhone("aqaurius6666@gmail.com"))
+}
+
+func TestToE164(t *testing.T) {
+	assert.Equal(t, "+84384511909", ToE164("+84384511909"))
+	assert.Equal(t, "+14155552671", ToE164("4155552671"))
+}
+
+func TestIsMail(t *testing.T) {
+	assert.True(t, IsMail("aqaurius6666@gmail.com"))
+	assert.False(t, IsMail("+84384511909"))
+	assert.False(t, IsMail("Name <aqaurius6666@gmail.com>"))
+	assert.False(t, IsMail(""))
+}
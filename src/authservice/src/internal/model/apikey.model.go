@@ -0,0 +1,226 @@
+package model
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/aqaurius6666/authservice/src/internal/db/apikey"
+	"github.com/aqaurius6666/authservice/src/internal/db/user"
+	"github.com/aqaurius6666/authservice/src/internal/lib"
+	"github.com/aqaurius6666/authservice/src/internal/var/c"
+	"github.com/aqaurius6666/authservice/src/internal/var/e"
+	"github.com/aqaurius6666/go-utils/database"
+	"github.com/aqaurius6666/go-utils/utils"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"golang.org/x/xerrors"
+)
+
+var (
+	_ ApiKeyModel = (*ServerModel)(nil)
+)
+
+type ApiKeyModel interface {
+	CreateApiKey(ctx context.Context, u *user.User, name string, scopes []string, expiresAt, rateLimit int64, forBusiness bool) (*apikey.ApiKey, string, error)
+	ListApiKeys(ctx context.Context, u *user.User) ([]*apikey.ApiKey, error)
+	RevokeApiKey(ctx context.Context, u *user.User, id interface{}) error
+	UseApiKey(ctx context.Context, key string) (*apikey.ApiKey, error)
+}
+
+// CreateApiKey returns a new api key of u and the key itself, which is not
+// stored and cannot be shown again. A key for a business can only be made by
+// its owner or a manager. rateLimit is in requests per minute, 0 means
+// c.API_KEY_RATE_LIMIT.
+func (s *ServerModel) CreateApiKey(ctx context.Context, u *user.User, name string, scopes []string, expiresAt, rateLimit int64, forBusiness bool) (*apikey.ApiKey, string, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.CreateApiKey))
+	defer span.End()
+
+	if len(scopes) == 0 {
+		err := xerrors.Errorf("%w", e.ErrInvalidScope)
+		lib.RecordError(span, err)
+		return nil, "", err
+	}
+	for _, scope := range scopes {
+		if !isApiKeyScope(scope) {
+			err := xerrors.Errorf("%w", e.ErrInvalidScope)
+			lib.RecordError(span, err)
+			return nil, "", err
+		}
+	}
+	if expiresAt != 0 && expiresAt <= time.Now().UnixMilli() {
+		err := xerrors.Errorf("%w", e.ErrInvalidExpiry)
+		lib.RecordError(span, err)
+		return nil, "", err
+	}
+	if rateLimit == 0 {
+		rateLimit = c.API_KEY_RATE_LIMIT
+	}
+	if rateLimit < 0 || rateLimit > c.API_KEY_MAX_RATE {
+		err := xerrors.Errorf("%w", e.ErrInvalidRateLimit)
+		lib.RecordError(span, err)
+		return nil, "", err
+	}
+	businessId := uuid.Nil
+	if forBusiness {
+		bid, role, err := s.GetMembership(ctx, u)
+		if err != nil {
+			err = xerrors.Errorf("%w", err)
+			lib.RecordError(span, err)
+			return nil, "", err
+		}
+		if role != c.BUSINESS_ROLE_OWNER && role != c.BUSINESS_ROLE_MANAGER {
+			err := xerrors.Errorf("%w", e.ErrNoPermission)
+			lib.RecordError(span, err)
+			return nil, "", err
+		}
+		businessId = bid
+	}
+
+	bz := make([]byte, 32)
+	if _, err := rand.Read(bz); err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return nil, "", err
+	}
+	key := c.API_KEY_PREFIX + base64.RawURLEncoding.EncodeToString(bz)
+	k, err := s.Repo.InsertApiKey(ctx, &apikey.ApiKey{
+		UserId:     u.ID,
+		BusinessId: businessId,
+		Name:       utils.StrPtr(name),
+		Prefix:     utils.StrPtr(key[:len(c.API_KEY_PREFIX)+6]),
+		KeyHash:    utils.StrPtr(hashApiKey(key)),
+		Scopes:     utils.StrPtr(strings.Join(scopes, " ")),
+		RateLimit:  rateLimit,
+		ExpiresAt:  expiresAt,
+		IsRevoked:  utils.BoolPtr(false),
+	})
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return nil, "", err
+	}
+	return k, key, nil
+}
+
+func (s *ServerModel) ListApiKeys(ctx context.Context, u *user.User) ([]*apikey.ApiKey, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.ListApiKeys))
+	defer span.End()
+
+	keys, err := s.Repo.ListApiKeys(ctx, &apikey.Search{
+		ApiKey: apikey.ApiKey{UserId: u.ID},
+	})
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return nil, err
+	}
+	return keys, nil
+}
+
+func (s *ServerModel) RevokeApiKey(ctx context.Context, u *user.User, id interface{}) error {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.RevokeApiKey))
+	defer span.End()
+
+	kid, err := lib.ToUUID(id)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return err
+	}
+	search := &apikey.Search{
+		ApiKey: apikey.ApiKey{
+			BaseModel: database.BaseModel{ID: kid},
+			UserId:    u.ID,
+		},
+	}
+	if _, err := s.Repo.SelectApiKey(ctx, search); err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return err
+	}
+	if err := s.Repo.UpdateApiKey(ctx, search, &apikey.ApiKey{
+		IsRevoked: utils.BoolPtr(true),
+	}); err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return err
+	}
+	return nil
+}
+
+// UseApiKey returns the api key matching key if it is live and under its
+// rate limit. When the limit is hit it returns an *e.ThrottledError wrapping
+// e.ErrApiKeyRateLimited. The last use of a key is recorded at most once per
+// c.API_KEY_TOUCH_TIME.
+func (s *ServerModel) UseApiKey(ctx context.Context, key string) (*apikey.ApiKey, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.UseApiKey))
+	defer span.End()
+
+	if !strings.HasPrefix(key, c.API_KEY_PREFIX) {
+		err := xerrors.Errorf("%w", e.ErrApiKeyInvalid)
+		lib.RecordError(span, err)
+		return nil, err
+	}
+	k, err := s.Repo.SelectApiKey(ctx, &apikey.Search{
+		ApiKey: apikey.ApiKey{KeyHash: utils.StrPtr(hashApiKey(key))},
+	})
+	if err != nil {
+		if xerrors.Is(err, apikey.ErrNotFound) {
+			err = e.ErrApiKeyInvalid
+		}
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return nil, err
+	}
+	now := time.Now()
+	if utils.BoolVal(k.IsRevoked) || (k.ExpiresAt != 0 && k.ExpiresAt <= now.UnixMilli()) {
+		err := xerrors.Errorf("%w", e.ErrApiKeyInvalid)
+		lib.RecordError(span, err)
+		return nil, err
+	}
+	retry, err := s.Limiter.Allow(ctx, "apikey:"+k.ID.String(), k.RateLimit, c.API_KEY_RATE_WINDOW)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return nil, err
+	}
+	if retry > 0 {
+		err := &e.ThrottledError{Err: e.ErrApiKeyRateLimited, RetryAfter: retry}
+		lib.RecordError(span, err)
+		return nil, err
+	}
+	if k.LastUsedAt < now.Add(-c.API_KEY_TOUCH_TIME).UnixMilli() {
+		if err := s.Repo.UpdateApiKey(ctx, &apikey.Search{
+			ApiKey: apikey.ApiKey{BaseModel: database.BaseModel{ID: k.ID}},
+		}, &apikey.ApiKey{
+			LastUsedAt: now.UnixMilli(),
+		}); err != nil {
+			s.Logger.Error(err)
+		}
+	}
+	return k, nil
+}
+
+// ApiKeyScopes returns the scopes of k.
+func ApiKeyScopes(k *apikey.ApiKey) []string {
+	return strings.Fields(utils.StrVal(k.Scopes))
+}
+
+func isApiKeyScope(scope string) bool {
+	for _, s := range c.API_KEY_SCOPES {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+func hashApiKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
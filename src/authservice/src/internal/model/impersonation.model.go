@@ -0,0 +1,108 @@
+package model
+
+import (
+	"context"
+
+	"github.com/aqaurius6666/authservice/src/internal/db/impersonationaudit"
+	"github.com/aqaurius6666/authservice/src/internal/db/user"
+	"github.com/aqaurius6666/authservice/src/internal/lib"
+	"github.com/aqaurius6666/authservice/src/internal/var/c"
+	"github.com/aqaurius6666/authservice/src/internal/var/e"
+	"github.com/aqaurius6666/go-utils/utils"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"golang.org/x/xerrors"
+)
+
+type ImpersonationModel interface {
+	StartImpersonation(ctx context.Context, adminId, userId interface{}, reason string) (*user.User, uuid.UUID, error)
+	RecordImpersonationAction(ctx context.Context, sessionId, adminId, userId interface{}, action string, status int) error
+}
+
+// StartImpersonation checks that the admin with adminId may impersonate the
+// user with userId and records the grant. It returns the user and the id of
+// the new session. Only active customers and handymen can be impersonated,
+// so a session never carries staff permissions.
+func (s *ServerModel) StartImpersonation(ctx context.Context, adminId, userId interface{}, reason string) (*user.User, uuid.UUID, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.StartImpersonation))
+	defer span.End()
+
+	admin, err := s.GetUserById(ctx, adminId)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return nil, uuid.Nil, err
+	}
+	perms, err := s.GetPermissions(ctx, admin.Role)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return nil, uuid.Nil, err
+	}
+	granted := false
+	for _, p := range perms {
+		granted = granted || p == c.PERM_USERS_IMPERSONATE
+	}
+	if !*admin.IsActive || !granted {
+		err = xerrors.Errorf("%w", e.ErrNoPermission)
+		lib.RecordError(span, err)
+		return nil, uuid.Nil, err
+	}
+	usr, err := s.GetUserById(ctx, userId)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return nil, uuid.Nil, err
+	}
+	role := c.ROLE(utils.IntVal(usr.Role.Code))
+	if usr.ID == admin.ID || !*usr.IsActive || (role != c.ROLE_CUSTOMER && role != c.ROLE_HANDYMAN) {
+		err = xerrors.Errorf("%w", e.ErrCannotImpersonate)
+		lib.RecordError(span, err)
+		return nil, uuid.Nil, err
+	}
+	sessionId := uuid.New()
+	if _, err := s.Repo.InsertImpersonationAudit(ctx, &impersonationaudit.ImpersonationAudit{
+		SessionId: sessionId,
+		AdminId:   admin.ID,
+		UserId:    usr.ID,
+		Action:    utils.StrPtr(c.IMPERSONATION_ISSUE),
+		Reason:    utils.StrPtr(reason),
+		Ip:        utils.StrPtr(lib.ClientIP(ctx)),
+	}); err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return nil, uuid.Nil, err
+	}
+	return usr, sessionId, nil
+}
+
+// RecordImpersonationAction adds a request made in an impersonation session
+// to its audit trail.
+func (s *ServerModel) RecordImpersonationAction(ctx context.Context, sessionId, adminId, userId interface{}, action string, status int) error {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.RecordImpersonationAction))
+	defer span.End()
+
+	ids := make([]uuid.UUID, 0, 3)
+	for _, id := range []interface{}{sessionId, adminId, userId} {
+		uid, err := lib.ToUUID(id)
+		if err != nil {
+			err = xerrors.Errorf("%w", err)
+			lib.RecordError(span, err)
+			return err
+		}
+		ids = append(ids, uid)
+	}
+	if _, err := s.Repo.InsertImpersonationAudit(ctx, &impersonationaudit.ImpersonationAudit{
+		SessionId: ids[0],
+		AdminId:   ids[1],
+		UserId:    ids[2],
+		Action:    utils.StrPtr(action),
+		Status:    utils.IntPtr(status),
+		Ip:        utils.StrPtr(lib.ClientIP(ctx)),
+	}); err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,259 @@
+package model
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/aqaurius6666/authservice/src/internal/db/membership"
+	"github.com/aqaurius6666/authservice/src/internal/db/otp"
+	"github.com/aqaurius6666/authservice/src/internal/db/user"
+	"github.com/aqaurius6666/authservice/src/internal/lib"
+	"github.com/aqaurius6666/authservice/src/internal/var/c"
+	"github.com/aqaurius6666/authservice/src/internal/var/e"
+	"github.com/aqaurius6666/go-utils/database"
+	"github.com/aqaurius6666/go-utils/utils"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"golang.org/x/xerrors"
+)
+
+type MembershipModel interface {
+	GetMembership(ctx context.Context, u *user.User) (uuid.UUID, c.BUSINESS_ROLE, error)
+	InviteMember(ctx context.Context, inviter *user.User, mail string, role c.BUSINESS_ROLE, businessName string) (*membership.Membership, *otp.Otp, error)
+	AcceptInvitation(ctx context.Context, u *user.User, o *otp.Otp) (*membership.Membership, error)
+	ListMembers(ctx context.Context, businessId interface{}) ([]*membership.Membership, error)
+	RemoveMember(ctx context.Context, actor *user.User, memberId interface{}) error
+}
+
+// invitation is the metadata of a business invite otp.
+type invitation struct {
+	MembershipId string `json:"membershipId"`
+	BusinessName string `json:"businessName"`
+}
+
+// GetMembership returns the business u acts for and its role there. A
+// handyman owns the business that has its id, anyone else acts for the
+// business whose invitation it accepted.
+func (s *ServerModel) GetMembership(ctx context.Context, u *user.User) (uuid.UUID, c.BUSINESS_ROLE, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.GetMembership))
+	defer span.End()
+
+	if c.ROLE(utils.IntVal(u.Role.Code)) == c.ROLE_HANDYMAN {
+		return u.ID, c.BUSINESS_ROLE_OWNER, nil
+	}
+	m, err := s.Repo.SelectMembership(ctx, &membership.Search{
+		Membership: membership.Membership{UserId: u.ID},
+		Accepted:   true,
+	})
+	if err != nil {
+		if xerrors.Is(err, membership.ErrNotFound) {
+			err = e.ErrNotMember
+		}
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return uuid.Nil, 0, err
+	}
+	return m.BusinessId, c.BUSINESS_ROLE(utils.IntVal(m.Role)), nil
+}
+
+// InviteMember invites mail to act for the business of inviter with role.
+// Owners invite managers and staff, managers invite staff. It returns the
+// otp that has to be sent to mail, a pending invitation of the same mail is
+// replaced.
+func (s *ServerModel) InviteMember(ctx context.Context, inviter *user.User, mail string, role c.BUSINESS_ROLE, businessName string) (*membership.Membership, *otp.Otp, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.InviteMember))
+	defer span.End()
+
+	if role != c.BUSINESS_ROLE_MANAGER && role != c.BUSINESS_ROLE_STAFF {
+		err := xerrors.Errorf("%w", e.ErrInvalidBusinessRole)
+		lib.RecordError(span, err)
+		return nil, nil, err
+	}
+	bid, inviterRole, err := s.GetMembership(ctx, inviter)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return nil, nil, err
+	}
+	if !canManage(inviterRole, role) {
+		err := xerrors.Errorf("%w", e.ErrNoPermission)
+		lib.RecordError(span, err)
+		return nil, nil, err
+	}
+	if err := s.Repo.DeleteMemberships(ctx, &membership.Search{
+		Membership: membership.Membership{BusinessId: bid, Mail: &mail},
+		Pending:    true,
+	}); err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return nil, nil, err
+	}
+	m, err := s.Repo.InsertMembership(ctx, &membership.Membership{
+		BusinessId: bid,
+		Mail:       &mail,
+		Role:       utils.IntPtr(int(role)),
+		InvitedBy:  inviter.ID,
+	})
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return nil, nil, err
+	}
+	bz, err := json.Marshal(invitation{
+		MembershipId: m.ID.String(),
+		BusinessName: businessName,
+	})
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return nil, nil, err
+	}
+	mt := string(bz)
+	o, err := s.createOTP(ctx, uuid.Nil, c.OTP_TYPE_BUSINESS_INVITE, c.OTP_CHANNEL_MAIL, &mt, &mail, nil)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return nil, nil, err
+	}
+	return m, o, nil
+}
+
+// AcceptInvitation makes u a member of the business that sent the
+// invitation of o. The otp proves u reads the invited mail, so it does not
+// have to be the mail of u. A user acts for one business at most.
+func (s *ServerModel) AcceptInvitation(ctx context.Context, u *user.User, o *otp.Otp) (*membership.Membership, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.AcceptInvitation))
+	defer span.End()
+
+	if c.ROLE(utils.IntVal(u.Role.Code)) != c.ROLE_CUSTOMER {
+		err := xerrors.Errorf("%w", e.ErrAlreadyMember)
+		lib.RecordError(span, err)
+		return nil, err
+	}
+	if _, _, err := s.GetMembership(ctx, u); err == nil {
+		err = xerrors.Errorf("%w", e.ErrAlreadyMember)
+		lib.RecordError(span, err)
+		return nil, err
+	} else if !xerrors.Is(err, e.ErrNotMember) {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return nil, err
+	}
+	var inv invitation
+	if err := json.Unmarshal([]byte(utils.StrVal(o.Metadata)), &inv); err != nil {
+		err = xerrors.Errorf("%w", e.ErrOTPInvalid)
+		lib.RecordError(span, err)
+		return nil, err
+	}
+	mid, err := lib.ToUUID(inv.MembershipId)
+	if err != nil {
+		err = xerrors.Errorf("%w", e.ErrOTPInvalid)
+		lib.RecordError(span, err)
+		return nil, err
+	}
+	search := &membership.Search{
+		Membership: membership.Membership{BaseModel: database.BaseModel{ID: mid}},
+		Pending:    true,
+	}
+	m, err := s.Repo.SelectMembership(ctx, search)
+	if err != nil {
+		// The invitation was replaced or removed after the otp was sent.
+		if xerrors.Is(err, membership.ErrNotFound) {
+			err = e.ErrOTPInvalid
+		}
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return nil, err
+	}
+	m.UserId = u.ID
+	m.AcceptedAt = time.Now().UnixMilli()
+	if err := s.Repo.UpdateMembership(ctx, search, &membership.Membership{
+		UserId:     m.UserId,
+		AcceptedAt: m.AcceptedAt,
+	}); err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return nil, err
+	}
+	return m, nil
+}
+
+// ListMembers returns the members and pending invitations of a business.
+// Its owner is not part of them.
+func (s *ServerModel) ListMembers(ctx context.Context, businessId interface{}) ([]*membership.Membership, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.ListMembers))
+	defer span.End()
+
+	bid, err := lib.ToUUID(businessId)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return nil, err
+	}
+	ms, err := s.Repo.ListMemberships(ctx, &membership.Search{
+		Membership: membership.Membership{BusinessId: bid},
+	})
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return nil, err
+	}
+	return ms, nil
+}
+
+// RemoveMember removes a member or a pending invitation from the business
+// of actor. Members may leave by removing themselves, otherwise the same
+// rules as for inviting apply.
+func (s *ServerModel) RemoveMember(ctx context.Context, actor *user.User, memberId interface{}) error {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.RemoveMember))
+	defer span.End()
+
+	mid, err := lib.ToUUID(memberId)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return err
+	}
+	bid, role, err := s.GetMembership(ctx, actor)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return err
+	}
+	search := &membership.Search{
+		Membership: membership.Membership{
+			BaseModel:  database.BaseModel{ID: mid},
+			BusinessId: bid,
+		},
+	}
+	m, err := s.Repo.SelectMembership(ctx, search)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return err
+	}
+	if m.UserId != actor.ID && !canManage(role, c.BUSINESS_ROLE(utils.IntVal(m.Role))) {
+		err := xerrors.Errorf("%w", e.ErrNoPermission)
+		lib.RecordError(span, err)
+		return err
+	}
+	if err := s.Repo.DeleteMemberships(ctx, search); err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return err
+	}
+	return nil
+}
+
+// canManage reports whether a member with role may invite or remove members
+// with role other.
+func canManage(role, other c.BUSINESS_ROLE) bool {
+	switch role {
+	case c.BUSINESS_ROLE_OWNER:
+		return true
+	case c.BUSINESS_ROLE_MANAGER:
+		return other == c.BUSINESS_ROLE_STAFF
+	}
+	return false
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/aqaurius6666/authservice/src/internal/db"
 	"github.com/aqaurius6666/authservice/src/services/mailservice"
+	"github.com/aqaurius6666/authservice/src/services/ratelimit"
 	"github.com/google/wire"
 	"github.com/sirupsen/logrus"
 )
@@ -17,13 +18,24 @@ type Server interface {
 	UserModel
 	OtpModel
 	RoleModel
+	RevocationModel
+	UserKeyModel
+	TotpModel
+	TokenModel
+	PermissionModel
+	SecurityEventModel
+	ImpersonationModel
+	SuspensionModel
+	MembershipModel
+	ApiKeyModel
 }
 
 type ServerModel struct {
-	Ctx    context.Context
-	Logger *logrus.Logger
-	Repo   db.ServerRepo
-	Mail   mailservice.Service
+	Ctx     context.Context
+	Logger  *logrus.Logger
+	Repo    db.ServerRepo
+	Mail    mailservice.Service
+	Limiter ratelimit.Limiter
 }
 
 var ServerModelSet = wire.NewSet(wire.Bind(new(Server), new(*ServerModel)), wire.Struct(new(ServerModel), "*"))
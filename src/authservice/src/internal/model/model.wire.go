@@ -7,7 +7,9 @@ import (
 	"context"
 
 	"github.com/aqaurius6666/authservice/src/internal/db"
+	"github.com/aqaurius6666/authservice/src/internal/lib/mtls"
 	"github.com/aqaurius6666/authservice/src/services/mailservice"
+	"github.com/aqaurius6666/authservice/src/services/ratelimit"
 	"github.com/google/wire"
 	"github.com/sirupsen/logrus"
 )
@@ -15,14 +17,17 @@ import (
 type ModelMockOptions struct {
 	DBDsn    db.DBDsn
 	MailAddr mailservice.MailServiceAddr
+	Creds    *mtls.Credentials
 }
 
 func ModelMock(ctx context.Context, logger *logrus.Logger, opts ModelMockOptions) (Server, error) {
 
 	wire.Build(
-		wire.FieldsOf(&opts, "DBDsn", "MailAddr"),
+		wire.FieldsOf(&opts, "DBDsn", "MailAddr", "Creds"),
 		db.ServerRepoSet,
 		mailservice.MailServiceSet,
+		ratelimit.NewMemoryLimiter,
+		wire.Bind(new(ratelimit.Limiter), new(*ratelimit.MemoryLimiter)),
 		wire.Struct(new(ServerModel), "*"),
 		wire.Bind(new(Server), new(*ServerModel)),
 	)
@@ -29,6 +29,12 @@ func TestShouldSend(t *testing.T) {
 			"expected": true,
 			"err":      nil,
 		},
+		{
+			"mail":     "aqaurius6666@gmail.com",
+			"type":     c.OTP_TYPE_CHANGE_MAIL,
+			"expected": false,
+			"err":      nil,
+		},
 	}
 
 	for _, tc := range testCase {
@@ -70,7 +76,7 @@ func TestCreateRegisterOTP(t *testing.T) {
 	for _, tc := range testCase {
 		user := tc["user"].(*user.User)
 		exp := tc["expected"].(bool)
-		ok, err := model.CreateRegisterOTP(ctx, user)
+		ok, err := model.CreateRegisterOTP(ctx, user, c.OTP_CHANNEL_MAIL)
 		if e, okk := tc["err"].(error); okk && e != nil {
 			assert.Equal(t, e.Error(), err.Error())
 		} else {
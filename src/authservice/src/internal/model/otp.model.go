@@ -2,6 +2,7 @@ package model
 
 import (
 	"context"
+	"crypto/subtle"
 	"encoding/json"
 	"fmt"
 	"math/rand"
@@ -11,6 +12,7 @@ import (
 	"github.com/aqaurius6666/authservice/src/internal/db/user"
 	"github.com/aqaurius6666/authservice/src/internal/lib"
 	"github.com/aqaurius6666/authservice/src/internal/lib/template"
+	"github.com/aqaurius6666/authservice/src/internal/lib/validate"
 	"github.com/aqaurius6666/authservice/src/internal/var/c"
 	"github.com/aqaurius6666/authservice/src/internal/var/e"
 	"github.com/aqaurius6666/go-utils/database"
@@ -22,8 +24,10 @@ import (
 
 type OtpModel interface {
 	GetValidOtpById(ctx context.Context, id interface{}) (*otp.Otp, error)
-	CreateRegisterOTP(ctx context.Context, u *user.User) (*otp.Otp, error)
-	CreateForgotOTP(ctx context.Context, u *user.User) (*otp.Otp, error)
+	CreateRegisterOTP(ctx context.Context, u *user.User, channel c.OTP_CHANNEL) (*otp.Otp, error)
+	CreateForgotOTP(ctx context.Context, u *user.User, channel c.OTP_CHANNEL) (*otp.Otp, error)
+	CreateVerifyPhoneOTP(ctx context.Context, u *user.User) (*otp.Otp, error)
+	CreateDeleteAccountOTP(ctx context.Context, u *user.User) (*otp.Otp, error)
 	CreateChangeMailOTP(ctx context.Context, id interface{}, u *user.User) (*otp.Otp, error)
 	CreateChangeMailAndPassOTP(ctx context.Context, id interface{}, u *user.User) (*otp.Otp, error)
 	GenCode() *string
@@ -34,31 +38,71 @@ type OtpModel interface {
 	HandleRegisterMetadata(ctx context.Context, mt string) (*user.User, error)
 	ChangeMailAndPassMetadata(ctx context.Context, mt string) (*user.User, error)
 	CommitOTP(ctx context.Context, o *otp.Otp) error
-	ShouldSend(ctx context.Context, mail *string, typ c.OTP_TYPE) (bool, error)
+	ShouldSend(ctx context.Context, to *string, typ c.OTP_TYPE) (bool, error)
+	CountOtpAttempt(ctx context.Context, o *otp.Otp) (int, error)
 
 	genTemplateEmail(o *otp.Otp) ([]byte, error)
-	createOTP(ctx context.Context, id uuid.UUID, _type c.OTP_TYPE, metadata *string, mail *string) (*otp.Otp, error)
+	deliverOTP(ctx context.Context, o *otp.Otp) error
+	createOTP(ctx context.Context, id uuid.UUID, _type c.OTP_TYPE, channel c.OTP_CHANNEL, metadata *string, mail, phone *string) (*otp.Otp, error)
 }
 
-func (s *ServerModel) ShouldSend(ctx context.Context, mail *string, typ c.OTP_TYPE) (bool, error) {
+// OtpDestination returns the mail or phone number o is delivered to.
+func OtpDestination(o *otp.Otp) *string {
+	if c.OTP_CHANNEL(utils.IntVal(o.Channel)) == c.OTP_CHANNEL_SMS {
+		return o.Phone
+	}
+	return o.Mail
+}
+
+// ShouldSend applies the send throttles: a cooldown of OTP_SPAM_TIME per
+// destination and otp type, then hourly caps per destination and per client
+// address. to is the mail or phone number the otp goes to. When a limit is hit
+// it returns an *e.ThrottledError wrapping e.ErrOTPSpam.
+func (s *ServerModel) ShouldSend(ctx context.Context, to *string, typ c.OTP_TYPE) (bool, error) {
 	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.ShouldSend))
 	defer span.End()
+	type limit struct {
+		key    string
+		max    int64
+		window time.Duration
+	}
+	limits := []limit{
+		{fmt.Sprintf("otp:cooldown:%d:%s", typ, utils.StrVal(to)), 1, c.OTP_SPAM_TIME},
+		{fmt.Sprintf("otp:to:%s", utils.StrVal(to)), int64(c.OTP_MAIL_LIMIT), c.OTP_LIMIT_WINDOW},
+	}
+	if ip := lib.ClientIP(ctx); ip != "" {
+		limits = append(limits, limit{fmt.Sprintf("otp:ip:%s", ip), int64(c.OTP_IP_LIMIT), c.OTP_LIMIT_WINDOW})
+	}
+	for _, l := range limits {
+		retry, err := s.Limiter.Allow(ctx, l.key, l.max, l.window)
+		if err != nil {
+			err = xerrors.Errorf("%w", err)
+			lib.RecordError(span, err)
+			return false, err
+		}
+		if retry > 0 {
+			err = &e.ThrottledError{Err: e.ErrOTPSpam, RetryAfter: retry}
+			lib.RecordError(span, err)
+			return false, err
+		}
+	}
 	return true, nil
-	o, err := s.Repo.SelectOtp(ctx, &otp.Search{
-		Otp: otp.Otp{
-			Type:     utils.IntPtr(int(typ)),
-			Mail:     mail,
-			Commited: utils.BoolPtr(false),
-		},
+}
+
+// CountOtpAttempt records a verification attempt against o and returns the
+// number of attempts made so far, including this one.
+func (s *ServerModel) CountOtpAttempt(ctx context.Context, o *otp.Otp) (int, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.CountOtpAttempt))
+	defer span.End()
+	n, err := s.Repo.IncreaseOtpAttempts(ctx, &otp.Search{
+		Otp: otp.Otp{BaseModel: database.BaseModel{ID: o.ID}},
 	})
 	if err != nil {
-		return true, nil
-	}
-	if o.ExpireTime < time.Now().Unix() {
-		return true, nil
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return 0, err
 	}
-
-	return false, e.ErrOTPSpam
+	return n, nil
 }
 
 func (s *ServerModel) ResendOTP(ctx context.Context, o *otp.Otp) error {
@@ -66,13 +110,8 @@ func (s *ServerModel) ResendOTP(ctx context.Context, o *otp.Otp) error {
 	defer span.End()
 	o.Code = s.GenCode()
 	o.ExpireTime = time.Now().Add(c.OTP_EXPIRE_TIME).UnixMilli()
-	bz, err := s.genTemplateEmail(o)
-	if err != nil {
-		err = xerrors.Errorf("%w", err)
-		lib.RecordError(span, err)
-		return err
-	}
-	if err := s.Mail.SendMail(ctx, *o.Mail, bz); err != nil {
+	o.Attempts = utils.IntPtr(0)
+	if err := s.deliverOTP(ctx, o); err != nil {
 		err = xerrors.Errorf("%w", err)
 		lib.RecordError(span, err)
 		return err
@@ -97,7 +136,7 @@ func (s *ServerModel) CreateChangeMailOTP(ctx context.Context, id interface{}, u
 		return nil, err
 	}
 	mt := string(bz)
-	return s.createOTP(ctx, u.ID, c.OTP_TYPE_CHANGE_MAIL, &mt, u.Mail)
+	return s.createOTP(ctx, u.ID, c.OTP_TYPE_CHANGE_MAIL, c.OTP_CHANNEL_MAIL, &mt, u.Mail, nil)
 }
 
 func (s *ServerModel) CreateChangeMailAndPassOTP(ctx context.Context, id interface{}, u *user.User) (*otp.Otp, error) {
@@ -110,10 +149,10 @@ func (s *ServerModel) CreateChangeMailAndPassOTP(ctx context.Context, id interfa
 		return nil, err
 	}
 	mt := string(bz)
-	return s.createOTP(ctx, u.ID, c.OTP_TYPE_CHANGE_MAIL_AND_PASS, &mt, u.Mail)
+	return s.createOTP(ctx, u.ID, c.OTP_TYPE_CHANGE_MAIL_AND_PASS, c.OTP_CHANNEL_MAIL, &mt, u.Mail, nil)
 }
 
-func (s *ServerModel) CreateForgotOTP(ctx context.Context, u *user.User) (*otp.Otp, error) {
+func (s *ServerModel) CreateForgotOTP(ctx context.Context, u *user.User, channel c.OTP_CHANNEL) (*otp.Otp, error) {
 	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.CreateForgotOTP))
 	defer span.End()
 	bz, err := json.Marshal(u)
@@ -123,7 +162,32 @@ func (s *ServerModel) CreateForgotOTP(ctx context.Context, u *user.User) (*otp.O
 		return nil, err
 	}
 	mt := string(bz)
-	return s.createOTP(ctx, u.ID, c.OTP_TYPE_FORGOT_PASSWORD, &mt, u.Mail)
+	return s.createOTP(ctx, u.ID, c.OTP_TYPE_FORGOT_PASSWORD, channel, &mt, u.Mail, u.Phone)
+}
+
+func (s *ServerModel) CreateVerifyPhoneOTP(ctx context.Context, u *user.User) (*otp.Otp, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.CreateVerifyPhoneOTP))
+	defer span.End()
+	bz, err := json.Marshal(u)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return nil, err
+	}
+	mt := string(bz)
+	return s.createOTP(ctx, u.ID, c.OTP_TYPE_VERIFY_PHONE, c.OTP_CHANNEL_SMS, &mt, nil, u.Phone)
+}
+
+// CreateDeleteAccountOTP creates the otp that confirms u wants its account
+// deleted. It goes to the mail of u, or to its phone when u has no mail.
+func (s *ServerModel) CreateDeleteAccountOTP(ctx context.Context, u *user.User) (*otp.Otp, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.CreateDeleteAccountOTP))
+	defer span.End()
+	channel := c.OTP_CHANNEL_MAIL
+	if utils.StrVal(u.Mail) == "" {
+		channel = c.OTP_CHANNEL_SMS
+	}
+	return s.createOTP(ctx, u.ID, c.OTP_TYPE_DELETE_ACCOUNT, channel, nil, u.Mail, u.Phone)
 }
 
 func (s *ServerModel) DeleteOTPById(ctx context.Context, id interface{}) error {
@@ -166,6 +230,7 @@ func (s *ServerModel) HandleRegisterMetadata(ctx context.Context, mt string) (*u
 		Mail:                usr.Mail,
 		Phone:               usr.Phone,
 		RoleID:              usr.RoleID,
+		IsPhoneVerified:     usr.IsPhoneVerified,
 	})
 	if err != nil {
 		err = xerrors.Errorf("%w", err)
@@ -191,7 +256,7 @@ func (s *ServerModel) ChangeMailAndPassMetadata(ctx context.Context, mt string)
 func (s *ServerModel) IsMatch(ctx context.Context, expected *otp.Otp, actual string) bool {
 	_, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.IsMatch))
 	defer span.End()
-	matched := *expected.Code == actual
+	matched := subtle.ConstantTimeCompare([]byte(*expected.Code), []byte(actual)) == 1
 	return matched
 }
 
@@ -237,24 +302,37 @@ func (s *ServerModel) GetValidOtpById(ctx context.Context, id interface{}) (*otp
 		lib.RecordError(span, err)
 		return nil, err
 	}
+	if !utils.BoolVal(o.Commited) && utils.IntVal(o.Attempts) >= c.OTP_MAX_ATTEMPTS {
+		err = xerrors.Errorf("%w", e.ErrOTPAttemptsExceeded)
+		lib.RecordError(span, err)
+		return nil, err
+	}
 	return o, nil
 }
-func (s *ServerModel) createOTP(ctx context.Context, id uuid.UUID, _type c.OTP_TYPE, metadata *string, mail *string) (*otp.Otp, error) {
+func (s *ServerModel) createOTP(ctx context.Context, id uuid.UUID, _type c.OTP_TYPE, channel c.OTP_CHANNEL, metadata *string, mail, phone *string) (*otp.Otp, error) {
 	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.createOTP))
 	defer span.End()
-	if ok, err := s.ShouldSend(ctx, mail, _type); err != nil || !ok {
-		err = xerrors.Errorf("%w", err)
+	if channel == c.OTP_CHANNEL_SMS && utils.StrVal(phone) == "" {
+		err := xerrors.Errorf("%w", e.ErrPhoneRequired)
 		lib.RecordError(span, err)
 		return nil, err
 	}
-	o, err := s.Repo.InsertOtp(ctx, &otp.Otp{
+	o := &otp.Otp{
 		Type:       utils.IntPtr(int(_type)),
 		UserId:     id,
 		ExpireTime: time.Now().Add(c.OTP_REGISTER_TIME).UnixMilli(),
 		Code:       s.GenCode(),
 		Metadata:   metadata,
 		Mail:       mail,
-	})
+		Phone:      phone,
+		Channel:    utils.IntPtr(int(channel)),
+	}
+	if ok, err := s.ShouldSend(ctx, OtpDestination(o), _type); err != nil || !ok {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return nil, err
+	}
+	o, err := s.Repo.InsertOtp(ctx, o)
 	if err != nil {
 		err = xerrors.Errorf("%w", err)
 		lib.RecordError(span, err)
@@ -264,24 +342,47 @@ func (s *ServerModel) createOTP(ctx context.Context, id uuid.UUID, _type c.OTP_T
 }
 
 func (s *ServerModel) SendOTP(ctx context.Context, o *otp.Otp) error {
-	_, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.SendOTP))
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.SendOTP))
 	defer span.End()
-	bz, err := s.genTemplateEmail(o)
-	if err != nil {
+	if err := s.deliverOTP(ctx, o); err != nil {
 		err = xerrors.Errorf("%w", err)
 		lib.RecordError(span, err)
+		return err
 	}
-	err = s.Mail.SendMail(ctx, *o.Mail, bz)
-	if err != nil {
-		err = xerrors.Errorf("%w", err)
-		lib.RecordError(span, err)
+	return nil
+}
+
+// deliverOTP sends the code of o over the channel it was created for.
+func (s *ServerModel) deliverOTP(ctx context.Context, o *otp.Otp) error {
+	switch c.OTP_CHANNEL(utils.IntVal(o.Channel)) {
+	case c.OTP_CHANNEL_SMS:
+		msg, err := template.SMSTemplate(c.OTP_TYPE(utils.IntVal(o.Type)), *o.Code)
+		if err != nil {
+			return xerrors.Errorf("%w", err)
+		}
+		if err := s.Mail.SendSMS(ctx, validate.ToE164(utils.StrVal(o.Phone)), msg); err != nil {
+			return xerrors.Errorf("%w", err)
+		}
+	default:
+		bz, err := s.genTemplateEmail(o)
+		if err != nil {
+			return xerrors.Errorf("%w", err)
+		}
+		if err := s.Mail.SendMail(ctx, utils.StrVal(o.Mail), bz); err != nil {
+			return xerrors.Errorf("%w", err)
+		}
 	}
 	return nil
 }
 
-func (s *ServerModel) CreateRegisterOTP(ctx context.Context, u *user.User) (*otp.Otp, error) {
+// CreateRegisterOTP creates the otp that confirms a registration. When it is
+// delivered by sms, confirming it also verifies the phone number.
+func (s *ServerModel) CreateRegisterOTP(ctx context.Context, u *user.User, channel c.OTP_CHANNEL) (*otp.Otp, error) {
 	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.CreateRegisterOTP))
 	defer span.End()
+	if channel == c.OTP_CHANNEL_SMS {
+		u.IsPhoneVerified = utils.BoolPtr(true)
+	}
 	bz, err := json.Marshal(u)
 	if err != nil {
 		err = xerrors.Errorf("%w", err)
@@ -289,7 +390,7 @@ func (s *ServerModel) CreateRegisterOTP(ctx context.Context, u *user.User) (*otp
 		return nil, err
 	}
 	mt := string(bz)
-	return s.createOTP(ctx, uuid.Nil, c.OTP_TYPE_REGISTER, &mt, u.Mail)
+	return s.createOTP(ctx, uuid.Nil, c.OTP_TYPE_REGISTER, channel, &mt, u.Mail, u.Phone)
 }
 
 func (s *ServerModel) GenCode() *string {
@@ -308,6 +409,14 @@ func (s *ServerModel) genTemplateEmail(o *otp.Otp) ([]byte, error) {
 		return template.RegisterMailTemplate(*o.Mail, *o.Code, o.ID.String()), nil
 	case c.OTP_TYPE_CHANGE_MAIL_AND_PASS:
 		return template.ChangeMailAndPassTemplate(*o.Mail, *o.Code, o.ID.String()), nil
+	case c.OTP_TYPE_DELETE_ACCOUNT:
+		return template.DeleteAccountMailTemplate(*o.Mail, *o.Code, o.ID.String()), nil
+	case c.OTP_TYPE_BUSINESS_INVITE:
+		var inv invitation
+		if err := json.Unmarshal([]byte(utils.StrVal(o.Metadata)), &inv); err != nil {
+			return nil, xerrors.Errorf("%w", err)
+		}
+		return template.BusinessInviteMailTemplate(*o.Mail, inv.BusinessName, *o.Code, o.ID.String()), nil
 	default:
 		return nil, xerrors.Errorf("invalid type")
 	}
@@ -15,7 +15,7 @@ import (
 
 func TestServerModel_SendOTP(t *testing.T) {
 	ctx := context.Background()
-	client, err := mailservice.ConnectClient(ctx, "localhost:50052")
+	client, err := mailservice.ConnectClient(ctx, "localhost:50052", nil)
 	assert.Nil(t, err)
 	mailClient := mailservice.ServiceGRPC{Ctx: ctx, Client: client}
 	type fields struct {
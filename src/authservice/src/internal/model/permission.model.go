@@ -0,0 +1,153 @@
+package model
+
+import (
+	"context"
+
+	"github.com/aqaurius6666/authservice/src/internal/db/permission"
+	"github.com/aqaurius6666/authservice/src/internal/db/role"
+	"github.com/aqaurius6666/authservice/src/internal/db/rolepermission"
+	"github.com/aqaurius6666/authservice/src/internal/lib"
+	"github.com/aqaurius6666/authservice/src/internal/var/c"
+	"github.com/aqaurius6666/authservice/src/internal/var/e"
+	"github.com/aqaurius6666/go-utils/utils"
+	"go.opentelemetry.io/otel"
+	"golang.org/x/xerrors"
+)
+
+type PermissionModel interface {
+	ListPermissions(ctx context.Context) ([]*permission.Permission, error)
+	ListRoles(ctx context.Context) ([]*role.Role, error)
+	GetPermissions(ctx context.Context, r *role.Role) ([]string, error)
+	SetRolePermissions(ctx context.Context, code int, codes []string) ([]string, error)
+}
+
+func (s *ServerModel) ListPermissions(ctx context.Context) ([]*permission.Permission, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.ListPermissions))
+	defer span.End()
+
+	perms, err := s.Repo.ListPermissions(ctx, &permission.Search{})
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return nil, err
+	}
+	return perms, nil
+}
+
+func (s *ServerModel) ListRoles(ctx context.Context) ([]*role.Role, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.ListRoles))
+	defer span.End()
+
+	roles, err := s.Repo.ListRoles(ctx, &role.Search{})
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return nil, err
+	}
+	return roles, nil
+}
+
+// GetPermissions returns the permission codes of r. Admins have every
+// permission, whatever is assigned to their role.
+func (s *ServerModel) GetPermissions(ctx context.Context, r *role.Role) ([]string, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.GetPermissions))
+	defer span.End()
+
+	if r == nil {
+		return []string{}, nil
+	}
+	search := &permission.Search{RoleId: r.ID}
+	if utils.IntVal(r.Code) == int(c.ROLE_ADMIN) {
+		search = &permission.Search{}
+	}
+	perms, err := s.Repo.ListPermissions(ctx, search)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return nil, err
+	}
+	return permissionCodes(perms), nil
+}
+
+// SetRolePermissions replaces the permissions of the role with the given
+// code. The admin role cannot be changed since it has every permission.
+func (s *ServerModel) SetRolePermissions(ctx context.Context, code int, codes []string) ([]string, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.SetRolePermissions))
+	defer span.End()
+
+	if code == int(c.ROLE_ADMIN) {
+		err := xerrors.Errorf("%w", e.ErrRoleNotEditable)
+		lib.RecordError(span, err)
+		return nil, err
+	}
+	r, err := s.GetRoleByCode(ctx, code)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return nil, err
+	}
+	perms, err := s.Repo.ListPermissions(ctx, &permission.Search{Codes: codes})
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return nil, err
+	}
+	if len(perms) != len(uniqueStrings(codes)) {
+		err = xerrors.Errorf("%w", e.ErrPermissionNotFound)
+		lib.RecordError(span, err)
+		return nil, err
+	}
+	if err := s.Repo.DeleteRolePermissions(ctx, &rolepermission.Search{
+		RolePermission: rolepermission.RolePermission{RoleId: r.ID},
+	}); err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return nil, err
+	}
+	if len(perms) == 0 {
+		return []string{}, nil
+	}
+	rps := make([]*rolepermission.RolePermission, 0, len(perms))
+	for _, p := range perms {
+		rps = append(rps, &rolepermission.RolePermission{
+			RoleId:       r.ID,
+			PermissionId: p.ID,
+		})
+	}
+	if _, err := s.Repo.InsertRolePermissions(ctx, rps); err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return nil, err
+	}
+	return permissionCodes(perms), nil
+}
+
+// isStaff reports whether r is one of the roles that run the platform
+// rather than use it.
+func isStaff(r c.ROLE) bool {
+	switch r {
+	case c.ROLE_ADMIN, c.ROLE_SUPPORT, c.ROLE_FINANCE, c.ROLE_MODERATOR:
+		return true
+	}
+	return false
+}
+
+func permissionCodes(perms []*permission.Permission) []string {
+	codes := make([]string, 0, len(perms))
+	for _, p := range perms {
+		codes = append(codes, utils.StrVal(p.Code))
+	}
+	return codes
+}
+
+func uniqueStrings(ss []string) []string {
+	seen := make(map[string]bool, len(ss))
+	r := make([]string, 0, len(ss))
+	for _, s := range ss {
+		if !seen[s] {
+			seen[s] = true
+			r = append(r, s)
+		}
+	}
+	return r
+}
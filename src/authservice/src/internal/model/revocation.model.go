@@ -0,0 +1,116 @@
+package model
+
+import (
+	"context"
+	"time"
+
+	"github.com/aqaurius6666/authservice/src/internal/db/revocation"
+	"github.com/aqaurius6666/authservice/src/internal/db/user"
+	"github.com/aqaurius6666/authservice/src/internal/lib"
+	"github.com/aqaurius6666/authservice/src/internal/var/c"
+	"github.com/aqaurius6666/authservice/src/internal/var/e"
+	"github.com/aqaurius6666/go-utils/database"
+	"github.com/aqaurius6666/go-utils/utils"
+	"go.opentelemetry.io/otel"
+	"golang.org/x/xerrors"
+)
+
+type RevocationModel interface {
+	RevokeSessions(ctx context.Context, id interface{}) (int64, error)
+	RevokeCertificate(ctx context.Context, id interface{}, signature string, exp int64) error
+	CheckRevoked(ctx context.Context, u *user.User, issuedAt int64, signature string) error
+}
+
+// RevokeSessions moves the not-before epoch of the user to now, so every
+// certificate issued until now stops being accepted.
+func (s *ServerModel) RevokeSessions(ctx context.Context, id interface{}) (int64, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.RevokeSessions))
+	defer span.End()
+
+	uid, err := lib.ToUUID(id)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return 0, err
+	}
+	notBefore := time.Now().UnixMilli()
+	if err := s.Repo.UpdateUser(ctx, &user.Search{
+		User: user.User{
+			BaseModel: database.BaseModel{ID: uid},
+		},
+	}, &user.User{
+		NotBefore: notBefore,
+	}); err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return 0, err
+	}
+	return notBefore, nil
+}
+
+// RevokeCertificate puts a single certificate on the revocation list. exp is
+// the time the certificate would expire anyway, after which the entry is
+// useless.
+func (s *ServerModel) RevokeCertificate(ctx context.Context, id interface{}, signature string, exp int64) error {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.RevokeCertificate))
+	defer span.End()
+
+	uid, err := lib.ToUUID(id)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return err
+	}
+	_, err = s.Repo.SelectRevocation(ctx, &revocation.Search{
+		Revocation: revocation.Revocation{
+			Signature: utils.StrPtr(signature),
+		},
+	})
+	if err == nil {
+		return nil
+	}
+	if !xerrors.Is(err, revocation.ErrNotFound) {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return err
+	}
+	if _, err := s.Repo.InsertRevocation(ctx, &revocation.Revocation{
+		UserId:     uid,
+		Signature:  utils.StrPtr(signature),
+		ExpireTime: exp,
+	}); err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return err
+	}
+	return nil
+}
+
+// CheckRevoked returns e.ErrCertificateRevoked if the certificate was issued
+// before the not-before epoch of u or is on the revocation list.
+func (s *ServerModel) CheckRevoked(ctx context.Context, u *user.User, issuedAt int64, signature string) error {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.CheckRevoked))
+	defer span.End()
+
+	if issuedAt < u.NotBefore {
+		err := xerrors.Errorf("%w", e.ErrCertificateRevoked)
+		lib.RecordError(span, err)
+		return err
+	}
+	_, err := s.Repo.SelectRevocation(ctx, &revocation.Search{
+		Revocation: revocation.Revocation{
+			Signature: utils.StrPtr(signature),
+		},
+	})
+	if err == nil {
+		err = xerrors.Errorf("%w", e.ErrCertificateRevoked)
+		lib.RecordError(span, err)
+		return err
+	}
+	if !xerrors.Is(err, revocation.ErrNotFound) {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return err
+	}
+	return nil
+}
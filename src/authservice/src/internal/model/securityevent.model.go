@@ -0,0 +1,140 @@
+package model
+
+import (
+	"context"
+	"time"
+
+	"github.com/aqaurius6666/authservice/src/internal/db/securityevent"
+	"github.com/aqaurius6666/authservice/src/internal/db/user"
+	"github.com/aqaurius6666/authservice/src/internal/lib"
+	"github.com/aqaurius6666/authservice/src/internal/lib/template"
+	"github.com/aqaurius6666/authservice/src/internal/var/c"
+	"github.com/aqaurius6666/go-utils/database"
+	"github.com/aqaurius6666/go-utils/utils"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"golang.org/x/xerrors"
+)
+
+type SecurityEventModel interface {
+	RecordSecurityEvent(ctx context.Context, id uuid.UUID, typ c.SECURITY_EVENT, outcome error) error
+	ListSecurityEvents(ctx context.Context, id interface{}, limit, offset int) ([]*securityevent.SecurityEvent, int64, error)
+	IsNewDevice(ctx context.Context, id uuid.UUID) (bool, error)
+	SendNewDeviceMail(ctx context.Context, u *user.User, userAgent, ip string) error
+}
+
+// RecordSecurityEvent stores an event of typ for the user with id, taking the
+// client address and user agent from ctx. A nil outcome is a success.
+func (s *ServerModel) RecordSecurityEvent(ctx context.Context, id uuid.UUID, typ c.SECURITY_EVENT, outcome error) error {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.RecordSecurityEvent))
+	defer span.End()
+
+	ev := &securityevent.SecurityEvent{
+		UserId:    id,
+		Type:      utils.IntPtr(int(typ)),
+		Ip:        utils.StrPtr(lib.ClientIP(ctx)),
+		UserAgent: utils.StrPtr(lib.UserAgent(ctx)),
+		Success:   utils.BoolPtr(outcome == nil),
+	}
+	if outcome != nil {
+		ev.Reason = utils.StrPtr(outcome.Error())
+	}
+	if _, err := s.Repo.InsertSecurityEvent(ctx, ev); err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return err
+	}
+	return nil
+}
+
+// ListSecurityEvents returns a page of the events of the user with id, newest
+// first, and the total number of events.
+func (s *ServerModel) ListSecurityEvents(ctx context.Context, id interface{}, limit, offset int) ([]*securityevent.SecurityEvent, int64, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.ListSecurityEvents))
+	defer span.End()
+
+	uid, err := lib.ToUUID(id)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return nil, 0, err
+	}
+	search := &securityevent.Search{
+		SecurityEvent: securityevent.SecurityEvent{
+			UserId: uid,
+		},
+	}
+	total, err := s.Repo.TotalSecurityEvents(ctx, search)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return nil, 0, err
+	}
+	search.DefaultSearchModel = database.DefaultSearchModel{
+		Skip:  offset,
+		Limit: limit,
+	}
+	events, err := s.Repo.ListSecurityEvents(ctx, search)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return nil, 0, err
+	}
+	return events, utils.Int64Val(total), nil
+}
+
+// IsNewDevice reports whether the user agent in ctx never fetched the
+// credential of the user with id. The first sign in of an account is not
+// counted as a new device, nor is a request without a user agent.
+func (s *ServerModel) IsNewDevice(ctx context.Context, id uuid.UUID) (bool, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.IsNewDevice))
+	defer span.End()
+
+	ua := lib.UserAgent(ctx)
+	if ua == "" {
+		return false, nil
+	}
+	search := &securityevent.Search{
+		SecurityEvent: securityevent.SecurityEvent{
+			UserId:  id,
+			Type:    utils.IntPtr(int(c.SECURITY_EVENT_CREDENTIAL_FETCH)),
+			Success: utils.BoolPtr(true),
+		},
+	}
+	logins, err := s.Repo.TotalSecurityEvents(ctx, search)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return false, err
+	}
+	if utils.Int64Val(logins) == 0 {
+		return false, nil
+	}
+	search.UserAgent = &ua
+	known, err := s.Repo.TotalSecurityEvents(ctx, search)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return false, err
+	}
+	return utils.Int64Val(known) == 0, nil
+}
+
+// SendNewDeviceMail tells u that their account was signed in from the device
+// with userAgent and ip.
+func (s *ServerModel) SendNewDeviceMail(ctx context.Context, u *user.User, userAgent, ip string) error {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.SendNewDeviceMail))
+	defer span.End()
+
+	to := utils.StrVal(u.Mail)
+	if to == "" {
+		return nil
+	}
+	bz := template.NewDeviceMailTemplate(to, userAgent, ip, time.Now())
+	if err := s.Mail.SendMail(ctx, to, bz); err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return err
+	}
+	return nil
+}
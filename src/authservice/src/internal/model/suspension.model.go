@@ -0,0 +1,170 @@
+package model
+
+import (
+	"context"
+	"time"
+
+	"github.com/aqaurius6666/authservice/src/internal/db/suspension"
+	"github.com/aqaurius6666/authservice/src/internal/db/user"
+	"github.com/aqaurius6666/authservice/src/internal/lib"
+	"github.com/aqaurius6666/authservice/src/internal/lib/template"
+	"github.com/aqaurius6666/authservice/src/internal/var/c"
+	"github.com/aqaurius6666/authservice/src/internal/var/e"
+	"github.com/aqaurius6666/go-utils/database"
+	"github.com/aqaurius6666/go-utils/utils"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"golang.org/x/xerrors"
+)
+
+type SuspensionModel interface {
+	SuspendUser(ctx context.Context, u *user.User, adminId interface{}, reason c.SUSPENSION_REASON, note string, expiresAt int64) (*suspension.Suspension, error)
+	LiftSuspension(ctx context.Context, u *user.User, adminId string) error
+	GetActiveSuspension(ctx context.Context, id uuid.UUID) (*suspension.Suspension, error)
+	ListExpiredSuspensions(ctx context.Context, limit int) ([]*user.User, error)
+}
+
+// SuspendUser suspends u until expiresAt, in milliseconds, or until it is
+// lifted when expiresAt is 0, and tells them by mail. A suspension already
+// in force is replaced.
+func (s *ServerModel) SuspendUser(ctx context.Context, u *user.User, adminId interface{}, reason c.SUSPENSION_REASON, note string, expiresAt int64) (*suspension.Suspension, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.SuspendUser))
+	defer span.End()
+
+	aid, err := lib.ToUUID(adminId)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return nil, err
+	}
+	now := time.Now().UnixMilli()
+	if expiresAt != 0 && expiresAt <= now {
+		err := xerrors.Errorf("%w", e.ErrInvalidExpiry)
+		lib.RecordError(span, err)
+		return nil, err
+	}
+	if err := s.Repo.UpdateSuspension(ctx, &suspension.Search{
+		Suspension: suspension.Suspension{UserId: u.ID},
+		InForce:    true,
+	}, &suspension.Suspension{
+		LiftedAt: now,
+		LiftedBy: aid,
+	}); err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return nil, err
+	}
+	sus, err := s.Repo.InsertSuspension(ctx, &suspension.Suspension{
+		UserId:    u.ID,
+		Reason:    utils.IntPtr(int(reason)),
+		Note:      utils.StrPtr(note),
+		ExpiresAt: expiresAt,
+		IssuedBy:  aid,
+	})
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return nil, err
+	}
+	if err := s.BanUser(ctx, u.ID, false); err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return nil, err
+	}
+	if to := utils.StrVal(u.Mail); to != "" {
+		if err := s.Mail.SendMail(ctx, to, template.SuspendedMailTemplate(to, reason, expiresAt)); err != nil {
+			s.Logger.Error(xerrors.Errorf("%w", err))
+		}
+	}
+	return sus, nil
+}
+
+// LiftSuspension ends the suspension of u and tells them by mail. adminId
+// is empty when the suspension expired.
+func (s *ServerModel) LiftSuspension(ctx context.Context, u *user.User, adminId string) error {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.LiftSuspension))
+	defer span.End()
+
+	aid := uuid.Nil
+	if adminId != "" {
+		var err error
+		if aid, err = lib.ToUUID(adminId); err != nil {
+			err = xerrors.Errorf("%w", err)
+			lib.RecordError(span, err)
+			return err
+		}
+	}
+	if err := s.Repo.UpdateSuspension(ctx, &suspension.Search{
+		Suspension: suspension.Suspension{UserId: u.ID},
+		InForce:    true,
+	}, &suspension.Suspension{
+		LiftedAt: time.Now().UnixMilli(),
+		LiftedBy: aid,
+	}); err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return err
+	}
+	// An account waiting for deletion stays inactive.
+	if s.IsDeletionScheduled(u) {
+		return nil
+	}
+	if err := s.BanUser(ctx, u.ID, true); err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return err
+	}
+	if to := utils.StrVal(u.Mail); to != "" {
+		if err := s.Mail.SendMail(ctx, to, template.SuspensionLiftedMailTemplate(to)); err != nil {
+			s.Logger.Error(xerrors.Errorf("%w", err))
+		}
+	}
+	return nil
+}
+
+// GetActiveSuspension returns the suspension in force for the user with id,
+// or suspension.ErrNotFound.
+func (s *ServerModel) GetActiveSuspension(ctx context.Context, id uuid.UUID) (*suspension.Suspension, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.GetActiveSuspension))
+	defer span.End()
+
+	sus, err := s.Repo.SelectSuspension(ctx, &suspension.Search{
+		Suspension: suspension.Suspension{UserId: id},
+		InForce:    true,
+	})
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return nil, err
+	}
+	return sus, nil
+}
+
+// ListExpiredSuspensions returns up to limit users whose suspension is over
+// but not lifted yet.
+func (s *ServerModel) ListExpiredSuspensions(ctx context.Context, limit int) ([]*user.User, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.ListExpiredSuspensions))
+	defer span.End()
+
+	sus, err := s.Repo.ListSuspensions(ctx, &suspension.Search{
+		DefaultSearchModel: database.DefaultSearchModel{Limit: limit},
+		InForce:            true,
+		ExpiredBefore:      time.Now().UnixMilli(),
+	})
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return nil, err
+	}
+	users := make([]*user.User, 0, len(sus))
+	for _, su := range sus {
+		u, err := s.GetUserById(ctx, su.UserId)
+		if err != nil {
+			err = xerrors.Errorf("%w", err)
+			lib.RecordError(span, err)
+			return nil, err
+		}
+		users = append(users, u)
+	}
+	return users, nil
+}
@@ -0,0 +1,132 @@
+package model
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"time"
+
+	"github.com/aqaurius6666/authservice/src/internal/db/refreshtoken"
+	"github.com/aqaurius6666/authservice/src/internal/db/user"
+	"github.com/aqaurius6666/authservice/src/internal/lib"
+	"github.com/aqaurius6666/authservice/src/internal/var/c"
+	"github.com/aqaurius6666/authservice/src/internal/var/e"
+	"github.com/aqaurius6666/go-utils/database"
+	"github.com/aqaurius6666/go-utils/utils"
+	"go.opentelemetry.io/otel"
+	"golang.org/x/xerrors"
+)
+
+type TokenModel interface {
+	CreateRefreshToken(ctx context.Context, u *user.User) (token string, exp int64, err error)
+	UseRefreshToken(ctx context.Context, u *user.User, token string) error
+}
+
+// CreateRefreshToken returns a new refresh token for u and the time in
+// milliseconds it expires at.
+func (s *ServerModel) CreateRefreshToken(ctx context.Context, u *user.User) (string, int64, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.CreateRefreshToken))
+	defer span.End()
+
+	bz := make([]byte, 32)
+	if _, err := rand.Read(bz); err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return "", 0, err
+	}
+	token := base64.RawURLEncoding.EncodeToString(bz)
+	exp := time.Now().Add(c.REFRESH_TOKEN_TTL).UnixMilli()
+	if _, err := s.Repo.InsertRefreshToken(ctx, &refreshtoken.RefreshToken{
+		UserId:     u.ID,
+		TokenHash:  utils.StrPtr(hashRefreshToken(token)),
+		ExpireTime: exp,
+		IsRevoked:  utils.BoolPtr(false),
+	}); err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return "", 0, err
+	}
+	return token, exp, nil
+}
+
+// UseRefreshToken revokes token if it is a live refresh token of u. A token
+// can only be used once: presenting a revoked token again means it leaked,
+// so every refresh token of u is revoked. Tokens created before the
+// not-before epoch of u are rejected, so RevokeSessions covers them too.
+func (s *ServerModel) UseRefreshToken(ctx context.Context, u *user.User, token string) error {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.UseRefreshToken))
+	defer span.End()
+
+	rt, err := s.Repo.SelectRefreshToken(ctx, &refreshtoken.Search{
+		RefreshToken: refreshtoken.RefreshToken{
+			TokenHash: utils.StrPtr(hashRefreshToken(token)),
+		},
+	})
+	if err != nil {
+		if xerrors.Is(err, refreshtoken.ErrNotFound) {
+			err = e.ErrRefreshTokenInvalid
+		}
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return err
+	}
+	if rt.UserId != u.ID {
+		err := xerrors.Errorf("%w", e.ErrRefreshTokenInvalid)
+		lib.RecordError(span, err)
+		return err
+	}
+	if utils.BoolVal(rt.IsRevoked) {
+		if err := s.revokeRefreshTokens(ctx, u); err != nil {
+			err = xerrors.Errorf("%w", err)
+			lib.RecordError(span, err)
+			return err
+		}
+		err := xerrors.Errorf("%w", e.ErrRefreshTokenInvalid)
+		lib.RecordError(span, err)
+		return err
+	}
+	if rt.ExpireTime < time.Now().UnixMilli() || rt.CreatedAt < u.NotBefore {
+		err := xerrors.Errorf("%w", e.ErrRefreshTokenInvalid)
+		lib.RecordError(span, err)
+		return err
+	}
+	// Only one of two concurrent uses of the same token gets past this update.
+	if err := s.Repo.UpdateRefreshToken(ctx, &refreshtoken.Search{
+		RefreshToken: refreshtoken.RefreshToken{
+			BaseModel: database.BaseModel{ID: rt.ID},
+			IsRevoked: utils.BoolPtr(false),
+		},
+	}, &refreshtoken.RefreshToken{
+		IsRevoked: utils.BoolPtr(true),
+	}); err != nil {
+		if xerrors.Is(err, refreshtoken.ErrNotFound) {
+			err = e.ErrRefreshTokenInvalid
+		}
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return err
+	}
+	return nil
+}
+
+func (s *ServerModel) revokeRefreshTokens(ctx context.Context, u *user.User) error {
+	err := s.Repo.UpdateRefreshToken(ctx, &refreshtoken.Search{
+		RefreshToken: refreshtoken.RefreshToken{
+			UserId:    u.ID,
+			IsRevoked: utils.BoolPtr(false),
+		},
+	}, &refreshtoken.RefreshToken{
+		IsRevoked: utils.BoolPtr(true),
+	})
+	if err != nil && !xerrors.Is(err, refreshtoken.ErrNotFound) {
+		return err
+	}
+	return nil
+}
+
+func hashRefreshToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
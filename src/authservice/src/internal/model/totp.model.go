@@ -0,0 +1,292 @@
+package model
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/aqaurius6666/authservice/src/internal/db/recoverycode"
+	"github.com/aqaurius6666/authservice/src/internal/db/user"
+	"github.com/aqaurius6666/authservice/src/internal/lib"
+	"github.com/aqaurius6666/authservice/src/internal/lib/totp"
+	"github.com/aqaurius6666/authservice/src/internal/var/c"
+	"github.com/aqaurius6666/authservice/src/internal/var/e"
+	"github.com/aqaurius6666/go-utils/database"
+	"github.com/aqaurius6666/go-utils/utils"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"golang.org/x/xerrors"
+)
+
+type TotpModel interface {
+	EnrollTotp(ctx context.Context, id interface{}) (secret string, uri string, err error)
+	ConfirmTotp(ctx context.Context, id interface{}, code string) ([]string, error)
+	DisableTotp(ctx context.Context, id interface{}, code string) error
+	CheckTotp(ctx context.Context, u *user.User, code string, at time.Time) error
+	CheckTotpOrRecovery(ctx context.Context, u *user.User, code string) error
+	IsTotpEnrollRequired(u *user.User) bool
+}
+
+// EnrollTotp generates a new secret for the user. It is not enforced until
+// ConfirmTotp is called with a code generated from it.
+func (s *ServerModel) EnrollTotp(ctx context.Context, id interface{}) (string, string, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.EnrollTotp))
+	defer span.End()
+
+	usr, err := s.GetUserById(ctx, id)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return "", "", err
+	}
+	if utils.BoolVal(usr.IsTotpEnabled) {
+		err = xerrors.Errorf("%w", e.ErrTotpEnabled)
+		lib.RecordError(span, err)
+		return "", "", err
+	}
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return "", "", err
+	}
+	if err := s.Repo.UpdateUser(ctx, &user.Search{
+		User: user.User{
+			BaseModel: database.BaseModel{ID: usr.ID},
+		},
+	}, &user.User{
+		TotpSecret: utils.StrPtr(secret),
+	}); err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return "", "", err
+	}
+	account := utils.StrVal(usr.Mail)
+	if account == "" {
+		account = usr.ID.String()
+	}
+	return secret, totp.URI(c.TOTP_ISSUER, account, secret), nil
+}
+
+// ConfirmTotp enables TOTP once the user proves the secret was stored, and
+// returns a fresh set of recovery codes.
+func (s *ServerModel) ConfirmTotp(ctx context.Context, id interface{}, code string) ([]string, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.ConfirmTotp))
+	defer span.End()
+
+	usr, err := s.GetUserById(ctx, id)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return nil, err
+	}
+	if utils.BoolVal(usr.IsTotpEnabled) {
+		err = xerrors.Errorf("%w", e.ErrTotpEnabled)
+		lib.RecordError(span, err)
+		return nil, err
+	}
+	if utils.StrVal(usr.TotpSecret) == "" {
+		err = xerrors.Errorf("%w", e.ErrTotpNotEnrolled)
+		lib.RecordError(span, err)
+		return nil, err
+	}
+	if !totp.Validate(*usr.TotpSecret, code, time.Now(), c.TOTP_SKEW) {
+		err = xerrors.Errorf("%w", e.ErrTotpInvalid)
+		lib.RecordError(span, err)
+		return nil, err
+	}
+	codes, err := s.resetRecoveryCodes(ctx, usr.ID)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return nil, err
+	}
+	if err := s.Repo.UpdateUser(ctx, &user.Search{
+		User: user.User{
+			BaseModel: database.BaseModel{ID: usr.ID},
+		},
+	}, &user.User{
+		IsTotpEnabled: utils.BoolPtr(true),
+	}); err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return nil, err
+	}
+	return codes, nil
+}
+
+func (s *ServerModel) DisableTotp(ctx context.Context, id interface{}, code string) error {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.DisableTotp))
+	defer span.End()
+
+	usr, err := s.GetUserById(ctx, id)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return err
+	}
+	if !utils.BoolVal(usr.IsTotpEnabled) {
+		err = xerrors.Errorf("%w", e.ErrTotpNotEnabled)
+		lib.RecordError(span, err)
+		return err
+	}
+	if isTotpEnforced(usr) {
+		err = xerrors.Errorf("%w", e.ErrTotpEnforced)
+		lib.RecordError(span, err)
+		return err
+	}
+	if !totp.Validate(*usr.TotpSecret, code, time.Now(), c.TOTP_SKEW) {
+		if err := s.useRecoveryCode(ctx, usr.ID, code); err != nil {
+			err = xerrors.Errorf("%w", err)
+			lib.RecordError(span, err)
+			return err
+		}
+	}
+	if err := s.clearTotp(ctx, usr.ID); err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return err
+	}
+	return nil
+}
+
+// CheckTotp verifies code against the TOTP secret of u at the given time. It
+// does nothing for users without TOTP.
+func (s *ServerModel) CheckTotp(ctx context.Context, u *user.User, code string, at time.Time) error {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.CheckTotp))
+	defer span.End()
+
+	if !utils.BoolVal(u.IsTotpEnabled) {
+		return nil
+	}
+	if code == "" {
+		err := xerrors.Errorf("%w", e.ErrTotpRequired)
+		lib.RecordError(span, err)
+		return err
+	}
+	if !totp.Validate(utils.StrVal(u.TotpSecret), code, at, c.TOTP_SKEW) {
+		err := xerrors.Errorf("%w", e.ErrTotpInvalid)
+		lib.RecordError(span, err)
+		return err
+	}
+	return nil
+}
+
+// CheckTotpOrRecovery accepts either a current TOTP code or a recovery code.
+// A recovery code means the authenticator is lost, so TOTP is turned off and
+// has to be enrolled again.
+func (s *ServerModel) CheckTotpOrRecovery(ctx context.Context, u *user.User, code string) error {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.CheckTotpOrRecovery))
+	defer span.End()
+
+	err := s.CheckTotp(ctx, u, code, time.Now())
+	if err == nil || !xerrors.Is(err, e.ErrTotpInvalid) {
+		return err
+	}
+	if err := s.useRecoveryCode(ctx, u.ID, code); err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return err
+	}
+	if err := s.clearTotp(ctx, u.ID); err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return err
+	}
+	return nil
+}
+
+// IsTotpEnrollRequired reports whether u may only use TOTP enrollment until
+// it has TOTP enabled.
+func (s *ServerModel) IsTotpEnrollRequired(u *user.User) bool {
+	return isTotpEnforced(u) && !utils.BoolVal(u.IsTotpEnabled)
+}
+
+func isTotpEnforced(u *user.User) bool {
+	return u.Role != nil && isStaff(c.ROLE(utils.IntVal(u.Role.Code)))
+}
+
+func (s *ServerModel) useRecoveryCode(ctx context.Context, uid uuid.UUID, code string) error {
+	rc, err := s.Repo.SelectRecoveryCode(ctx, &recoverycode.Search{
+		RecoveryCode: recoverycode.RecoveryCode{
+			UserId:   uid,
+			CodeHash: utils.StrPtr(hashRecoveryCode(code)),
+			IsUsed:   utils.BoolPtr(false),
+		},
+	})
+	if err != nil {
+		if xerrors.Is(err, recoverycode.ErrNotFound) {
+			return e.ErrTotpInvalid
+		}
+		return err
+	}
+	return s.Repo.UpdateRecoveryCode(ctx, &recoverycode.Search{
+		RecoveryCode: recoverycode.RecoveryCode{
+			BaseModel: database.BaseModel{ID: rc.ID},
+		},
+	}, &recoverycode.RecoveryCode{
+		IsUsed: utils.BoolPtr(true),
+	})
+}
+
+func (s *ServerModel) resetRecoveryCodes(ctx context.Context, uid uuid.UUID) ([]string, error) {
+	if err := s.Repo.DeleteRecoveryCodes(ctx, &recoverycode.Search{
+		RecoveryCode: recoverycode.RecoveryCode{UserId: uid},
+	}); err != nil {
+		return nil, err
+	}
+	codes := make([]string, 0, c.RECOVERY_CODE_COUNT)
+	rcs := make([]*recoverycode.RecoveryCode, 0, c.RECOVERY_CODE_COUNT)
+	for i := 0; i < c.RECOVERY_CODE_COUNT; i++ {
+		code, err := genRecoveryCode()
+		if err != nil {
+			return nil, err
+		}
+		codes = append(codes, code)
+		rcs = append(rcs, &recoverycode.RecoveryCode{
+			UserId:   uid,
+			CodeHash: utils.StrPtr(hashRecoveryCode(code)),
+			IsUsed:   utils.BoolPtr(false),
+		})
+	}
+	if _, err := s.Repo.InsertRecoveryCodes(ctx, rcs); err != nil {
+		return nil, err
+	}
+	return codes, nil
+}
+
+func (s *ServerModel) clearTotp(ctx context.Context, uid uuid.UUID) error {
+	if err := s.Repo.UpdateUser(ctx, &user.Search{
+		User: user.User{
+			BaseModel: database.BaseModel{ID: uid},
+		},
+	}, &user.User{
+		TotpSecret:    utils.StrPtr(""),
+		IsTotpEnabled: utils.BoolPtr(false),
+	}); err != nil {
+		return err
+	}
+	return s.Repo.DeleteRecoveryCodes(ctx, &recoverycode.Search{
+		RecoveryCode: recoverycode.RecoveryCode{UserId: uid},
+	})
+}
+
+// genRecoveryCode returns a code like "abcd-efgh".
+func genRecoveryCode() (string, error) {
+	bz := make([]byte, 5)
+	if _, err := rand.Read(bz); err != nil {
+		return "", err
+	}
+	code := strings.ToLower(base32.StdEncoding.EncodeToString(bz))
+	return code[:4] + "-" + code[4:], nil
+}
+
+func hashRecoveryCode(code string) string {
+	code = strings.ToLower(strings.ReplaceAll(strings.TrimSpace(code), "-", ""))
+	sum := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,166 @@
+package model
+
+import (
+	"context"
+	"time"
+
+	"github.com/aqaurius6666/authservice/src/internal/db/user"
+	"github.com/aqaurius6666/authservice/src/internal/db/userkey"
+	"github.com/aqaurius6666/authservice/src/internal/lib"
+	"github.com/aqaurius6666/authservice/src/internal/var/c"
+	"github.com/aqaurius6666/go-utils/database"
+	"github.com/aqaurius6666/go-utils/utils"
+	"go.opentelemetry.io/otel"
+	"golang.org/x/xerrors"
+)
+
+// LAST_USED_RESOLUTION limits how often the last used time of a key is
+// written, so CheckAuth does not update the row on every request.
+const LAST_USED_RESOLUTION = time.Minute
+
+type UserKeyModel interface {
+	GetUserByIdKey(ctx context.Context, id interface{}, pubkey string) (*user.User, error)
+	AddUserKey(ctx context.Context, id interface{}, pubkey, label string) (*userkey.UserKey, error)
+	ListUserKeys(ctx context.Context, id interface{}) ([]*userkey.UserKey, error)
+	RevokeUserKey(ctx context.Context, id interface{}, keyId interface{}) error
+}
+
+// GetUserByIdKey returns the user if pubkey is either the key of the user
+// itself or one of its active device keys.
+func (s *ServerModel) GetUserByIdKey(ctx context.Context, id interface{}, pubkey string) (*user.User, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.GetUserByIdKey))
+	defer span.End()
+
+	uid, err := lib.ToUUID(id)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return nil, err
+	}
+	usr, err := s.Repo.SelectUser(ctx, &user.Search{
+		User: user.User{
+			BaseModel: database.BaseModel{ID: uid},
+		},
+	})
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return nil, err
+	}
+	if usr.PublicKey != nil && *usr.PublicKey == pubkey {
+		return usr, nil
+	}
+	key, err := s.Repo.SelectUserKey(ctx, &userkey.Search{
+		UserKey: userkey.UserKey{
+			UserId:    usr.ID,
+			PublicKey: utils.StrPtr(pubkey),
+			IsActive:  utils.BoolPtr(true),
+		},
+	})
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return nil, err
+	}
+	now := time.Now()
+	if now.Sub(time.UnixMilli(key.LastUsedAt)) > LAST_USED_RESOLUTION {
+		if err := s.Repo.UpdateUserKey(ctx, &userkey.Search{
+			UserKey: userkey.UserKey{
+				BaseModel: database.BaseModel{ID: key.ID},
+			},
+		}, &userkey.UserKey{
+			LastUsedAt: now.UnixMilli(),
+		}); err != nil {
+			err = xerrors.Errorf("%w", err)
+			lib.RecordError(span, err)
+			return nil, err
+		}
+	}
+	return usr, nil
+}
+
+func (s *ServerModel) AddUserKey(ctx context.Context, id interface{}, pubkey, label string) (*userkey.UserKey, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.AddUserKey))
+	defer span.End()
+
+	uid, err := lib.ToUUID(id)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return nil, err
+	}
+	key, err := s.Repo.InsertUserKey(ctx, &userkey.UserKey{
+		UserId:    uid,
+		PublicKey: utils.StrPtr(pubkey),
+		Label:     utils.StrPtr(label),
+		IsActive:  utils.BoolPtr(true),
+	})
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return nil, err
+	}
+	return key, nil
+}
+
+func (s *ServerModel) ListUserKeys(ctx context.Context, id interface{}) ([]*userkey.UserKey, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.ListUserKeys))
+	defer span.End()
+
+	uid, err := lib.ToUUID(id)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return nil, err
+	}
+	keys, err := s.Repo.ListUserKeys(ctx, &userkey.Search{
+		UserKey: userkey.UserKey{
+			UserId: uid,
+		},
+	})
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return nil, err
+	}
+	return keys, nil
+}
+
+// RevokeUserKey deactivates a device key. Certificates signed by it are
+// rejected from then on.
+func (s *ServerModel) RevokeUserKey(ctx context.Context, id interface{}, keyId interface{}) error {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.RevokeUserKey))
+	defer span.End()
+
+	uid, err := lib.ToUUID(id)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return err
+	}
+	kid, err := lib.ToUUID(keyId)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return err
+	}
+	search := &userkey.Search{
+		UserKey: userkey.UserKey{
+			BaseModel: database.BaseModel{ID: kid},
+			UserId:    uid,
+		},
+	}
+	if _, err := s.Repo.SelectUserKey(ctx, search); err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return err
+	}
+	if err := s.Repo.UpdateUserKey(ctx, search, &userkey.UserKey{
+		IsActive: utils.BoolPtr(false),
+	}); err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return err
+	}
+	return nil
+}
@@ -3,7 +3,12 @@ package model
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"github.com/aqaurius6666/authservice/src/internal/db/apikey"
+	"github.com/aqaurius6666/authservice/src/internal/db/membership"
+	"github.com/aqaurius6666/authservice/src/internal/db/securityevent"
+	"github.com/aqaurius6666/authservice/src/internal/db/suspension"
 	"github.com/aqaurius6666/authservice/src/internal/db/user"
 	"github.com/aqaurius6666/authservice/src/internal/lib"
 	"github.com/aqaurius6666/authservice/src/internal/var/c"
@@ -22,11 +27,16 @@ type UserModel interface {
 	GetUserByMail(ctx context.Context, email string) (*user.User, error)
 	UpdateKey(ctx context.Context, id interface{}, pubkey, epk string) error
 	UpdateMail(ctx context.Context, id interface{}, mail string) error
+	UpdateVerifiedPhone(ctx context.Context, id interface{}, phone string) error
 	CreateUser(ctx context.Context, u *user.User) (*user.User, error)
 	CheckUserExisted(ctx context.Context, u *user.User) error
 	BanUser(ctx context.Context, id interface{}, status bool) error
 	DeleteUser(ctx context.Context, id interface{}) error
 	UpdateMailAndKey(ctx context.Context, id interface{}, mail, pubkey, epk string) error
+	ScheduleDeletion(ctx context.Context, id interface{}, at time.Time) error
+	CancelDeletion(ctx context.Context, id interface{}) error
+	ListDueDeletions(ctx context.Context, limit int) ([]*user.User, error)
+	IsDeletionScheduled(u *user.User) bool
 }
 
 func (s *ServerModel) DeleteUser(ctx context.Context, id interface{}) error {
@@ -48,10 +58,51 @@ func (s *ServerModel) DeleteUser(ctx context.Context, id interface{}) error {
 		lib.RecordError(span, err)
 		return err
 	}
+	// Security events keep the ip and user agent of the user.
+	if err := s.Repo.DeleteSecurityEvents(ctx, &securityevent.Search{
+		SecurityEvent: securityevent.SecurityEvent{UserId: uid},
+	}); err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return err
+	}
+	if err := s.Repo.DeleteSuspensions(ctx, &suspension.Search{
+		Suspension: suspension.Suspension{UserId: uid},
+	}); err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return err
+	}
+	// The user leaves the business it works for, and the members of the
+	// business it owns lose access to it.
+	if err := s.Repo.DeleteMemberships(ctx, &membership.Search{
+		Membership: membership.Membership{UserId: uid},
+	}); err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return err
+	}
+	if err := s.Repo.DeleteMemberships(ctx, &membership.Search{
+		Membership: membership.Membership{BusinessId: uid},
+	}); err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return err
+	}
+	if err := s.Repo.DeleteApiKeys(ctx, &apikey.Search{
+		ApiKey: apikey.ApiKey{UserId: uid},
+	}); err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return err
+	}
 	return nil
 }
-func (s *ServerModel) BanUser(ctx context.Context, id interface{}, status bool) error {
-	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.BanUser))
+
+// ScheduleDeletion deactivates the account of id right away and marks it to
+// be erased at at. Sessions opened before the call stop working.
+func (s *ServerModel) ScheduleDeletion(ctx context.Context, id interface{}, at time.Time) error {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.ScheduleDeletion))
 	defer span.End()
 
 	uid, err := lib.ToUUID(id)
@@ -65,7 +116,9 @@ func (s *ServerModel) BanUser(ctx context.Context, id interface{}, status bool)
 			BaseModel: database.BaseModel{ID: uid},
 		},
 	}, &user.User{
-		IsActive: utils.BoolPtr(status),
+		IsActive:    utils.BoolPtr(false),
+		NotBefore:   time.Now().UnixMilli(),
+		DeleteAfter: utils.Int64Ptr(at.UnixMilli()),
 	}); err != nil {
 		err = xerrors.Errorf("%w", err)
 		lib.RecordError(span, err)
@@ -74,6 +127,92 @@ func (s *ServerModel) BanUser(ctx context.Context, id interface{}, status bool)
 	return nil
 }
 
+// CancelDeletion restores an account scheduled for deletion. An account
+// suspended meanwhile stays inactive until the suspension is lifted.
+func (s *ServerModel) CancelDeletion(ctx context.Context, id interface{}) error {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.CancelDeletion))
+	defer span.End()
+
+	uid, err := lib.ToUUID(id)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return err
+	}
+	value := &user.User{
+		DeleteAfter: utils.Int64Ptr(0),
+	}
+	_, err = s.GetActiveSuspension(ctx, uid)
+	switch {
+	case xerrors.Is(err, suspension.ErrNotFound):
+		value.IsActive = utils.BoolPtr(true)
+	case err != nil:
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return err
+	}
+	if err := s.Repo.UpdateUser(ctx, &user.Search{
+		User: user.User{
+			BaseModel: database.BaseModel{ID: uid},
+		},
+	}, value); err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return err
+	}
+	return nil
+}
+
+// ListDueDeletions returns up to limit users whose grace period is over.
+func (s *ServerModel) ListDueDeletions(ctx context.Context, limit int) ([]*user.User, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.ListDueDeletions))
+	defer span.End()
+
+	u, err := s.Repo.ListUsers(ctx, &user.Search{
+		DefaultSearchModel: database.DefaultSearchModel{Limit: limit},
+		DueBefore:          time.Now().UnixMilli(),
+	})
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return nil, err
+	}
+	return u, nil
+}
+
+func (s *ServerModel) IsDeletionScheduled(u *user.User) bool {
+	return utils.Int64Val(u.DeleteAfter) != 0
+}
+
+func (s *ServerModel) BanUser(ctx context.Context, id interface{}, status bool) error {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.BanUser))
+	defer span.End()
+
+	uid, err := lib.ToUUID(id)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return err
+	}
+	value := &user.User{
+		IsActive: utils.BoolPtr(status),
+	}
+	if !status {
+		// A banned user must not keep the sessions opened before the ban.
+		value.NotBefore = time.Now().UnixMilli()
+	}
+	if err := s.Repo.UpdateUser(ctx, &user.Search{
+		User: user.User{
+			BaseModel: database.BaseModel{ID: uid},
+		},
+	}, value); err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return err
+	}
+	return nil
+}
+
 func (s *ServerModel) UpdateMail(ctx context.Context, id interface{}, mail string) error {
 	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.UpdateMail))
 	defer span.End()
@@ -98,6 +237,31 @@ func (s *ServerModel) UpdateMail(ctx context.Context, id interface{}, mail strin
 	return nil
 }
 
+func (s *ServerModel) UpdateVerifiedPhone(ctx context.Context, id interface{}, phone string) error {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.UpdateVerifiedPhone))
+	defer span.End()
+
+	uid, err := lib.ToUUID(id)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return err
+	}
+	if err := s.Repo.UpdateUser(ctx, &user.Search{
+		User: user.User{
+			BaseModel: database.BaseModel{ID: uid},
+		},
+	}, &user.User{
+		Phone:           &phone,
+		IsPhoneVerified: utils.BoolPtr(true),
+	}); err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return err
+	}
+	return nil
+}
+
 func (s *ServerModel) UpdateMailAndKey(ctx context.Context, id interface{}, mail, pubkey, epk string) error {
 	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.UpdateMailAndKey))
 	defer span.End()
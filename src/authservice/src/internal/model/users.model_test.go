@@ -0,0 +1,52 @@
+package model
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aqaurius6666/authservice/src/internal/db"
+	"github.com/aqaurius6666/authservice/src/internal/db/suspension"
+	"github.com/aqaurius6666/authservice/src/internal/db/user"
+	"github.com/aqaurius6666/go-utils/utils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/xerrors"
+)
+
+// deletionRepo records the update CancelDeletion makes to a user who may be
+// suspended.
+type deletionRepo struct {
+	db.ServerRepo
+	suspended bool
+	updated   *user.User
+}
+
+func (r *deletionRepo) SelectSuspension(ctx context.Context, search *suspension.Search) (*suspension.Suspension, error) {
+	if !r.suspended {
+		return nil, xerrors.Errorf("%w", suspension.ErrNotFound)
+	}
+	return &suspension.Suspension{UserId: search.UserId}, nil
+}
+
+func (r *deletionRepo) UpdateUser(ctx context.Context, search *user.Search, value *user.User) error {
+	r.updated = value
+	return nil
+}
+
+func TestCancelDeletion(t *testing.T) {
+	ctx := context.Background()
+
+	repo := &deletionRepo{}
+	s := &ServerModel{Repo: repo}
+	assert.Nil(t, s.CancelDeletion(ctx, uuid.New()))
+	assert.Equal(t, int64(0), utils.Int64Val(repo.updated.DeleteAfter))
+	assert.True(t, utils.BoolVal(repo.updated.IsActive))
+
+	// suspended during the grace period: the deletion is canceled but the
+	// account stays inactive
+	repo = &deletionRepo{suspended: true}
+	s = &ServerModel{Repo: repo}
+	assert.Nil(t, s.CancelDeletion(ctx, uuid.New()))
+	assert.Equal(t, int64(0), utils.Int64Val(repo.updated.DeleteAfter))
+	assert.Nil(t, repo.updated.IsActive)
+}
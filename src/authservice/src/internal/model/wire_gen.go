@@ -10,7 +10,9 @@ import (
 	"context"
 	"github.com/aqaurius6666/authservice/src/internal/db"
 	"github.com/aqaurius6666/authservice/src/internal/db/cockroach"
+	"github.com/aqaurius6666/authservice/src/internal/lib/mtls"
 	"github.com/aqaurius6666/authservice/src/services/mailservice"
+	"github.com/aqaurius6666/authservice/src/services/ratelimit"
 	cockroach2 "github.com/aqaurius6666/go-utils/database/cockroach"
 	"github.com/sirupsen/logrus"
 )
@@ -34,7 +36,8 @@ func ModelMock(ctx context.Context, logger *logrus.Logger, opts ModelMockOptions
 		CDBRepository: cdbRepository,
 	}
 	mailServiceAddr := opts.MailAddr
-	mailServiceClient, err := mailservice.ConnectClient(ctx, mailServiceAddr)
+	credentials := opts.Creds
+	mailServiceClient, err := mailservice.ConnectClient(ctx, mailServiceAddr, credentials)
 	if err != nil {
 		return nil, err
 	}
@@ -42,11 +45,13 @@ func ModelMock(ctx context.Context, logger *logrus.Logger, opts ModelMockOptions
 		Ctx:    ctx,
 		Client: mailServiceClient,
 	}
+	memoryLimiter := ratelimit.NewMemoryLimiter()
 	serverModel := &ServerModel{
-		Ctx:    ctx,
-		Logger: logger,
-		Repo:   serverCDBRepo,
-		Mail:   serviceGRPC,
+		Ctx:     ctx,
+		Logger:  logger,
+		Repo:    serverCDBRepo,
+		Mail:    serviceGRPC,
+		Limiter: memoryLimiter,
 	}
 	return serverModel, nil
 }
@@ -56,4 +61,5 @@ func ModelMock(ctx context.Context, logger *logrus.Logger, opts ModelMockOptions
 type ModelMockOptions struct {
 	DBDsn    db.DBDsn
 	MailAddr mailservice.MailServiceAddr
+	Creds    *mtls.Credentials
 }
@@ -2,8 +2,10 @@ package main
 
 import (
 	"os"
+	"path/filepath"
 	"time"
 
+	"github.com/aqaurius6666/authservice/src/internal/lib/mtls"
 	"github.com/aqaurius6666/authservice/src/internal/var/c"
 	cli2 "github.com/aqaurius6666/go-utils/cli"
 	"github.com/sirupsen/logrus"
@@ -34,20 +36,20 @@ func makeApp() *cli.App {
 			},
 		},
 		Action: runMain,
-		Flags:  makeFlags(cli2.GormFlag, cli2.CommonServerFlag, cli2.LoggerFlag, CustomFlag, cli2.FeatureToggleFlag, cli2.PrometheusFlag),
+		Flags:  makeFlags(cli2.GormFlag, cli2.CommonServerFlag, cli2.LoggerFlag, CustomFlag, cli2.FeatureToggleFlag, cli2.PrometheusFlag, RedisFlag, TLSFlag),
 		Commands: []*cli.Command{
 			{
 				Name:    "serve",
 				Aliases: []string{"s"},
 				Usage:   "run server",
 				Action:  runMain,
-				Flags:   makeFlags(cli2.GormFlag, cli2.CommonServerFlag, cli2.LoggerFlag, CustomFlag, cli2.FeatureToggleFlag, cli2.PrometheusFlag),
+				Flags:   makeFlags(cli2.GormFlag, cli2.CommonServerFlag, cli2.LoggerFlag, CustomFlag, cli2.FeatureToggleFlag, cli2.PrometheusFlag, RedisFlag, TLSFlag),
 			},
 			{
 				Name:   "seed",
 				Usage:  "seed data",
 				Action: seedData,
-				Flags: makeFlags(cli2.GormFlag, cli2.LoggerFlag, []cli.Flag{
+				Flags: makeFlags(cli2.GormFlag, cli2.LoggerFlag, RedisFlag, []cli.Flag{
 					&cli.BoolFlag{
 						Name:  "clean",
 						Usage: "clean before seed",
@@ -59,7 +61,7 @@ func makeApp() *cli.App {
 				Name:   "clean",
 				Usage:  "clean database",
 				Action: clean,
-				Flags: makeFlags(cli2.GormFlag, cli2.LoggerFlag, []cli.Flag{
+				Flags: makeFlags(cli2.GormFlag, cli2.LoggerFlag, RedisFlag, []cli.Flag{
 					&cli.BoolFlag{
 						Name:  "clean",
 						Usage: "clean before seed",
@@ -89,6 +91,73 @@ var (
 			Name:    "otel-address",
 			EnvVars: []string{"CONFIG_OTEL_ADDRESS"},
 		},
+		&cli.DurationFlag{
+			Name:    "request-window",
+			Usage:   "how far a signed body timestamp may drift from server time",
+			EnvVars: []string{"CONFIG_REQUEST_WINDOW"},
+			Value:   5 * time.Minute,
+		},
+		&cli.DurationFlag{
+			Name:    "deletion-grace-period",
+			Usage:   "how long a self-deleted account can be restored by signing in again",
+			EnvVars: []string{"CONFIG_DELETION_GRACE_PERIOD"},
+			Value:   30 * 24 * time.Hour,
+		},
+		&cli.StringFlag{
+			Name:    "jwt-signing-key",
+			Usage:   "PEM encoded P-256 private key access tokens are signed with",
+			EnvVars: []string{"CONFIG_JWT_SIGNING_KEY"},
+		},
+	}
+)
+
+var (
+	RedisFlag = []cli.Flag{
+		&cli.StringFlag{
+			Name:     "redis-uri",
+			EnvVars:  []string{"CONFIG_REDIS_URI"},
+			Required: true,
+		},
+		&cli.StringFlag{
+			Name:    "redis-pass",
+			EnvVars: []string{"CONFIG_REDIS_PASS"},
+		},
+		&cli.StringFlag{
+			Name:    "redis-user",
+			EnvVars: []string{"CONFIG_REDIS_USER"},
+		},
+	}
+)
+
+var (
+	TLSFlag = []cli.Flag{
+		&cli.StringFlag{
+			Name:    "tls-mode",
+			Usage:   "transport security of internal gRPC servers and clients: disabled, dev or mtls",
+			EnvVars: []string{"CONFIG_TLS_MODE"},
+			Value:   mtls.ModeDisabled,
+		},
+		&cli.StringFlag{
+			Name:    "tls-ca-file",
+			Usage:   "PEM encoded CA that signs the certificates of every service",
+			EnvVars: []string{"CONFIG_TLS_CA_FILE"},
+		},
+		&cli.StringFlag{
+			Name:    "tls-cert-file",
+			Usage:   "PEM encoded certificate of this service, its common name is the service identity",
+			EnvVars: []string{"CONFIG_TLS_CERT_FILE"},
+		},
+		&cli.StringFlag{
+			Name:    "tls-key-file",
+			Usage:   "PEM encoded private key of tls-cert-file",
+			EnvVars: []string{"CONFIG_TLS_KEY_FILE"},
+		},
+		&cli.StringFlag{
+			Name:    "tls-dev-dir",
+			Usage:   "where dev mode keeps the local CA shared by services on this machine",
+			EnvVars: []string{"CONFIG_TLS_DEV_DIR"},
+			Value:   filepath.Join(os.TempDir(), "grpc-dev-ca"),
+		},
 	}
 )
 
@@ -2,22 +2,31 @@ package main
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
 	"errors"
 	"fmt"
 	"net"
 	"net/http"
 	"os"
 	"os/signal"
+	"strconv"
 	"sync"
 	"syscall"
 	"time"
 
+	"github.com/aqaurius6666/authservice/src/internal/api"
 	"github.com/aqaurius6666/authservice/src/internal/db"
 	"github.com/aqaurius6666/authservice/src/internal/lib"
+	"github.com/aqaurius6666/authservice/src/internal/lib/jwt"
+	"github.com/aqaurius6666/authservice/src/internal/lib/mtls"
 	"github.com/aqaurius6666/authservice/src/internal/lib/unleash"
 	"github.com/aqaurius6666/authservice/src/internal/var/c"
+	"github.com/aqaurius6666/authservice/src/internal/var/e"
 	"github.com/aqaurius6666/authservice/src/pb/authpb"
 	"github.com/aqaurius6666/authservice/src/services/mailservice"
+	"github.com/aqaurius6666/authservice/src/services/redis"
 	commongrpc "github.com/aqaurius6666/go-utils/common_grpc"
 	commonpb "github.com/aqaurius6666/go-utils/common_grpc/pb"
 	"github.com/aqaurius6666/go-utils/utils"
@@ -33,11 +42,14 @@ import (
 	"go.opentelemetry.io/otel/sdk/resource"
 	tracesdk "go.opentelemetry.io/otel/sdk/trace"
 	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+	"golang.org/x/xerrors"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
 var (
@@ -73,9 +85,26 @@ func runMain(appCtx *cli.Context) error {
 		logger.Fatal(err)
 		return err
 	}
+	signer, err := newTokenSigner(appCtx.String("jwt-signing-key"))
+	if err != nil {
+		logger.Fatal(err)
+		return err
+	}
+	creds, err := newTransportCredentials(appCtx)
+	if err != nil {
+		logger.Fatal(err)
+		return err
+	}
 	mainServer, err := InitMainServer(ctx, logger, ServerOptions{
-		DBDsn:    db.DBDsn(appCtx.String("db-uri")),
-		MailAddr: mailservice.MailServiceAddr(appCtx.String("mailservice-address")),
+		DBDsn:               db.DBDsn(appCtx.String("db-uri")),
+		MailAddr:            mailservice.MailServiceAddr(appCtx.String("mailservice-address")),
+		RedisUri:            redis.REDIS_URI(appCtx.String("redis-uri")),
+		RedisUser:           redis.REDIS_USER(appCtx.String("redis-user")),
+		RedisPass:           redis.REDIS_PASS(appCtx.String("redis-pass")),
+		RequestWindow:       api.RequestWindow(appCtx.Duration("request-window")),
+		DeletionGracePeriod: api.DeletionGracePeriod(appCtx.Duration("deletion-grace-period")),
+		Signer:              signer,
+		Creds:               creds,
 	})
 	if err != nil {
 		logger.Fatal(err)
@@ -104,12 +133,12 @@ func runMain(appCtx *cli.Context) error {
 		defer wg.Done()
 		// if appCtx.Bool("disable-stats") {
 		// 	logger.Info("Stats disabled.")
-		srv = grpc.NewServer(
+		srv = grpc.NewServer(append(creds.ServerOptions(grpcPolicy),
 			grpc.ChainUnaryInterceptor(
 				otelgrpc.UnaryServerInterceptor(),
 				grpc_recovery.UnaryServerInterceptor(grpc_recovery.WithRecoveryHandler(MyLogger)),
 				lib.UnaryServerLogRequestInterceptor,
-			))
+			))...)
 		// } else {
 		// 	logger.Info("Stats enabled.")
 		// 	// srv = grpc.NewServer(grpc.StatsHandler(&ocgrpc.ServerHandler{}))
@@ -275,6 +304,14 @@ func MyLogger(p interface{}) error {
 	var err error
 	switch t := p.(type) {
 	case error:
+		var throttled *e.ThrottledError
+		if xerrors.As(t, &throttled) {
+			return throttledStatus(throttled)
+		}
+		var suspended *e.SuspendedError
+		if xerrors.As(t, &suspended) {
+			return suspendedStatus(suspended)
+		}
 		err = t
 		err = utils.Unwrap(err)
 	case string:
@@ -285,3 +322,72 @@ func MyLogger(p interface{}) error {
 	logger.Errorf("%+v", err)
 	return status.Error(codes.Internal, err.Error())
 }
+
+// throttledStatus reports a rate limit hit as ResourceExhausted with a
+// RetryInfo detail so callers can tell the user when to try again.
+func throttledStatus(t *e.ThrottledError) error {
+	stt := status.New(codes.ResourceExhausted, utils.Unwrap(t.Err).Error())
+	if withDetails, err := stt.WithDetails(&errdetails.RetryInfo{
+		RetryDelay: durationpb.New(t.RetryAfter),
+	}); err == nil {
+		stt = withDetails
+	}
+	return stt.Err()
+}
+
+// suspendedStatus reports a suspended user as PermissionDenied with an
+// ErrorInfo detail carrying the reason and expiry of the suspension.
+func suspendedStatus(s *e.SuspendedError) error {
+	stt := status.New(codes.PermissionDenied, s.Error())
+	if withDetails, err := stt.WithDetails(&errdetails.ErrorInfo{
+		Reason: c.USER_SUSPENDED,
+		Domain: c.SERVICE_NAME,
+		Metadata: map[string]string{
+			"reason":    s.Reason.String(),
+			"expiresAt": strconv.FormatInt(s.ExpiresAt, 10),
+		},
+	}); err == nil {
+		stt = withDetails
+	}
+	return stt.Err()
+}
+
+// newTokenSigner parses the key access tokens are signed with. Without one a
+// key is generated at startup, which only works for a single replica and
+// invalidates every access token on restart.
+func newTokenSigner(pemKey string) (*jwt.Signer, error) {
+	if pemKey == "" {
+		logger.Warn("jwt-signing-key not set, generating a temporary key")
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		if err != nil {
+			return nil, xerrors.Errorf("%w", err)
+		}
+		return jwt.NewSigner(key)
+	}
+	key, err := jwt.ParsePrivateKey([]byte(pemKey))
+	if err != nil {
+		return nil, xerrors.Errorf("%w", err)
+	}
+	return jwt.NewSigner(key)
+}
+
+// newTransportCredentials loads the certificate this service presents to its
+// callers and to the services it dials. Without one internal gRPC traffic is
+// plaintext and any pod can call any RPC.
+func newTransportCredentials(appCtx *cli.Context) (*mtls.Credentials, error) {
+	creds, err := mtls.New(mtls.Config{
+		Mode:     appCtx.String("tls-mode"),
+		CAFile:   appCtx.String("tls-ca-file"),
+		CertFile: appCtx.String("tls-cert-file"),
+		KeyFile:  appCtx.String("tls-key-file"),
+		DevDir:   appCtx.String("tls-dev-dir"),
+		Identity: c.SERVICE_NAME,
+	})
+	if err != nil {
+		return nil, xerrors.Errorf("%w", err)
+	}
+	if creds == nil {
+		logger.Warn("tls-mode disabled, internal gRPC traffic is not authenticated")
+	}
+	return creds, nil
+}
@@ -0,0 +1,27 @@
+package main
+
+import "github.com/aqaurius6666/authservice/src/internal/lib/mtls"
+
+// Identities are the common names of the certificates services present to
+// each other.
+const (
+	apiService     = "api-service"
+	chatService    = "chat-service"
+	cronjobService = "cronjob-service"
+)
+
+// grpcPolicy lists which services may call each RPC when tls-mode is not
+// disabled. AuthService backs the public api, so api-service may call every
+// RPC; the others only get what they use.
+var grpcPolicy = mtls.Policy{
+	"/grpc.health.v1.Health/*":                           {mtls.Anyone},
+	"/common_grpc.Common/Echo":                           {mtls.Anyone},
+	"/authservice.AuthService/*":                         {apiService},
+	"/authservice.AuthService/CheckAuth":                 {apiService, chatService},
+	"/authservice.AuthService/GetJwks":                   {apiService, chatService},
+	"/authservice.AuthService/RecordImpersonationAction": {apiService, chatService},
+	"/authservice.AuthService/DeleteUser":                {apiService, cronjobService},
+	"/authservice.AuthService/LiftSuspension":            {apiService, cronjobService},
+	"/authservice.AuthService/ListDueDeletions":          {cronjobService},
+	"/authservice.AuthService/ListExpiredSuspensions":    {cronjobService},
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/aqaurius6666/authservice/src/internal/db/role"
 	"github.com/aqaurius6666/authservice/src/internal/db/seed"
 	"github.com/aqaurius6666/authservice/src/services/mailservice"
+	"github.com/aqaurius6666/authservice/src/services/redis"
 	"github.com/urfave/cli/v2"
 )
 
@@ -16,8 +17,11 @@ func seedData(appCtx *cli.Context) error {
 	ctx, cancelFn := context.WithTimeout(context.Background(), 3*time.Minute)
 	defer cancelFn()
 	mainServer, err := InitMainServer(ctx, logger, ServerOptions{
-		DBDsn:    db.DBDsn(appCtx.String("db-uri")),
-		MailAddr: mailservice.MailServiceAddr(appCtx.String("mailservice-address")),
+		DBDsn:     db.DBDsn(appCtx.String("db-uri")),
+		MailAddr:  mailservice.MailServiceAddr(appCtx.String("mailservice-address")),
+		RedisUri:  redis.REDIS_URI(appCtx.String("redis-uri")),
+		RedisUser: redis.REDIS_USER(appCtx.String("redis-user")),
+		RedisPass: redis.REDIS_PASS(appCtx.String("redis-pass")),
 	})
 	defer func(db db.ServerRepo) {
 		e := db.Close()
@@ -57,8 +61,11 @@ func clean(appCtx *cli.Context) error {
 	ctx, cancelFn := context.WithTimeout(context.Background(), 3*time.Minute)
 	defer cancelFn()
 	mainServer, err := InitMainServer(ctx, logger, ServerOptions{
-		DBDsn:    db.DBDsn(appCtx.String("db-uri")),
-		MailAddr: mailservice.MailServiceAddr(appCtx.String("mailservice-address")),
+		DBDsn:     db.DBDsn(appCtx.String("db-uri")),
+		MailAddr:  mailservice.MailServiceAddr(appCtx.String("mailservice-address")),
+		RedisUri:  redis.REDIS_URI(appCtx.String("redis-uri")),
+		RedisUser: redis.REDIS_USER(appCtx.String("redis-user")),
+		RedisPass: redis.REDIS_PASS(appCtx.String("redis-pass")),
 	})
 	defer func(db db.ServerRepo) {
 		e := db.Close()
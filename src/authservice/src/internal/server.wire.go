@@ -8,8 +8,13 @@ import (
 
 	"github.com/aqaurius6666/authservice/src/internal/api"
 	"github.com/aqaurius6666/authservice/src/internal/db"
+	"github.com/aqaurius6666/authservice/src/internal/lib/jwt"
+	"github.com/aqaurius6666/authservice/src/internal/lib/mtls"
 	"github.com/aqaurius6666/authservice/src/internal/model"
 	"github.com/aqaurius6666/authservice/src/services/mailservice"
+	"github.com/aqaurius6666/authservice/src/services/nonce"
+	"github.com/aqaurius6666/authservice/src/services/ratelimit"
+	"github.com/aqaurius6666/authservice/src/services/redis"
 	"github.com/google/wire"
 	"github.com/sirupsen/logrus"
 )
@@ -20,18 +25,28 @@ type Server struct {
 }
 
 type ServerOptions struct {
-	DBDsn    db.DBDsn
-	MailAddr mailservice.MailServiceAddr
+	DBDsn               db.DBDsn
+	MailAddr            mailservice.MailServiceAddr
+	RedisUri            redis.REDIS_URI
+	RedisUser           redis.REDIS_USER
+	RedisPass           redis.REDIS_PASS
+	RequestWindow       api.RequestWindow
+	DeletionGracePeriod api.DeletionGracePeriod
+	Signer              *jwt.Signer
+	Creds               *mtls.Credentials
 }
 
 func InitMainServer(ctx context.Context, logger *logrus.Logger, opts ServerOptions) (*Server, error) {
 
 	wire.Build(
-		wire.FieldsOf(&opts, "DBDsn", "MailAddr"),
+		wire.FieldsOf(&opts, "DBDsn", "MailAddr", "RedisUri", "RedisUser", "RedisPass", "RequestWindow", "DeletionGracePeriod", "Signer", "Creds"),
 		db.ServerRepoSet,
 		api.ApiServerSet,
 		model.ServerModelSet,
 		mailservice.MailServiceSet,
+		redis.Set,
+		nonce.Set,
+		ratelimit.Set,
 		wire.Struct(new(Server), "*"),
 	)
 	return &Server{}, nil
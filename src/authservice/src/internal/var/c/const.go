@@ -9,4 +9,40 @@ var (
 	SERVICE_NAME        = "auth-service"
 	ID            int64 = 1
 	OTP_REGISTER_TIME = 365 * 24 * time.Hour
+	TOTP_ISSUER         = "AnyGoNow"
+	TOTP_SKEW           = 1
+	RECOVERY_CODE_COUNT = 10
+	OTP_MAX_ATTEMPTS    = 5
+	OTP_MAIL_LIMIT      = 10
+	OTP_IP_LIMIT        = 30
+	OTP_LIMIT_WINDOW    = time.Hour
+	SMS_COUNTRY_CODE    = "+1"
+	ACCESS_TOKEN_TTL    = 15 * time.Minute
+	REFRESH_TOKEN_TTL   = 30 * 24 * time.Hour
+	IMPERSONATION_TTL   = 30 * time.Minute
+	API_KEY_RATE_LIMIT  = int64(60)
+	API_KEY_MAX_RATE    = int64(600)
+	API_KEY_RATE_WINDOW = time.Minute
+	API_KEY_TOUCH_TIME  = time.Minute
+)
+
+// API_KEY_SCOPES are the scopes an api key can be given, checked by Scope in
+// apiservice.
+var API_KEY_SCOPES = []string{
+	"orders:read",
+	"orders:write",
+	"businesses:write",
+	"conversations:read",
+}
+
+const (
+	// PERM_USERS_IMPERSONATE lets staff get a read only token of a customer
+	// or handyman.
+	PERM_USERS_IMPERSONATE = "users.impersonate"
+	// IMPERSONATION_ISSUE is the audit action of granting an impersonation.
+	IMPERSONATION_ISSUE = "issue"
+	// USER_SUSPENDED is the ErrorInfo reason of a suspended user.
+	USER_SUSPENDED = "USER_SUSPENDED"
+	// API_KEY_PREFIX starts every api key so leaked keys are easy to spot.
+	API_KEY_PREFIX = "hk_"
 )
@@ -1,6 +1,10 @@
 package e
 
 import (
+	"fmt"
+	"time"
+
+	"github.com/aqaurius6666/authservice/src/internal/var/c"
 	"golang.org/x/xerrors"
 )
 
@@ -19,4 +23,62 @@ var (
 	ErrDeleteActiveUser        = xerrors.Errorf("%s: delete active user", prefix)
 	ErrUserInactive            = xerrors.Errorf("%s: user inactive", prefix)
 	ErrOTPSpam                 = xerrors.Errorf("%s: spam", prefix)
+	ErrOTPAttemptsExceeded     = xerrors.Errorf("%s: otp attempts exceeded", prefix)
+	ErrPhoneRequired           = xerrors.Errorf("%s: phone required", prefix)
+	ErrInvalidPhone            = xerrors.Errorf("%s: invalid phone", prefix)
+	ErrPhoneNotVerified        = xerrors.Errorf("%s: phone not verified", prefix)
+	ErrMissingNonce            = xerrors.Errorf("%s: missing nonce", prefix)
+	ErrRequestReplayed         = xerrors.Errorf("%s: request replayed", prefix)
+	ErrCertificateRevoked      = xerrors.Errorf("%s: certificate revoked", prefix)
+	ErrInvalidPublicKey        = xerrors.Errorf("%s: invalid public key", prefix)
+	ErrTotpRequired            = xerrors.Errorf("%s: totp required", prefix)
+	ErrTotpInvalid             = xerrors.Errorf("%s: totp invalid", prefix)
+	ErrTotpEnabled             = xerrors.Errorf("%s: totp already enabled", prefix)
+	ErrTotpNotEnrolled         = xerrors.Errorf("%s: totp not enrolled", prefix)
+	ErrTotpNotEnabled          = xerrors.Errorf("%s: totp not enabled", prefix)
+	ErrTotpEnforced            = xerrors.Errorf("%s: totp cannot be disabled for this role", prefix)
+	ErrRefreshTokenInvalid     = xerrors.Errorf("%s: refresh token invalid", prefix)
+	ErrPermissionNotFound      = xerrors.Errorf("%s: permission not found", prefix)
+	ErrRoleNotEditable         = xerrors.Errorf("%s: role cannot be edited", prefix)
+	ErrNoPermission            = xerrors.Errorf("%s: no permission", prefix)
+	ErrCannotImpersonate       = xerrors.Errorf("%s: user cannot be impersonated", prefix)
+	ErrUserSuspended           = xerrors.Errorf("%s: user suspended", prefix)
+	ErrInvalidExpiry           = xerrors.Errorf("%s: invalid expiry", prefix)
+	ErrNotMember               = xerrors.Errorf("%s: not a member of a business", prefix)
+	ErrAlreadyMember           = xerrors.Errorf("%s: user already belongs to a business", prefix)
+	ErrInvalidBusinessRole     = xerrors.Errorf("%s: invalid business role", prefix)
+	ErrApiKeyInvalid           = xerrors.Errorf("%s: api key invalid", prefix)
+	ErrApiKeyRateLimited       = xerrors.Errorf("%s: api key rate limit exceeded", prefix)
+	ErrInvalidScope            = xerrors.Errorf("%s: invalid scope", prefix)
+	ErrInvalidRateLimit        = xerrors.Errorf("%s: invalid rate limit", prefix)
 )
+
+// ThrottledError is returned when a caller hits a rate limit. RetryAfter is
+// the time left until the limit resets.
+type ThrottledError struct {
+	Err        error
+	RetryAfter time.Duration
+}
+
+func (t *ThrottledError) Error() string {
+	return fmt.Sprintf("%s (retry after %s)", t.Err.Error(), t.RetryAfter)
+}
+
+func (t *ThrottledError) Unwrap() error {
+	return t.Err
+}
+
+// SuspendedError is returned when a suspended user authenticates. ExpiresAt
+// is in milliseconds, 0 when the suspension lasts until it is lifted.
+type SuspendedError struct {
+	Reason    c.SUSPENSION_REASON
+	ExpiresAt int64
+}
+
+func (s *SuspendedError) Error() string {
+	return ErrUserSuspended.Error()
+}
+
+func (s *SuspendedError) Unwrap() error {
+	return ErrUserSuspended
+}
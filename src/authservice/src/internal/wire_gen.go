@@ -11,8 +11,13 @@ import (
 	"github.com/aqaurius6666/authservice/src/internal/api"
 	"github.com/aqaurius6666/authservice/src/internal/db"
 	"github.com/aqaurius6666/authservice/src/internal/db/cockroach"
+	"github.com/aqaurius6666/authservice/src/internal/lib/jwt"
+	"github.com/aqaurius6666/authservice/src/internal/lib/mtls"
 	"github.com/aqaurius6666/authservice/src/internal/model"
 	"github.com/aqaurius6666/authservice/src/services/mailservice"
+	"github.com/aqaurius6666/authservice/src/services/nonce"
+	"github.com/aqaurius6666/authservice/src/services/ratelimit"
+	"github.com/aqaurius6666/authservice/src/services/redis"
 	cockroach2 "github.com/aqaurius6666/go-utils/database/cockroach"
 	"github.com/sirupsen/logrus"
 )
@@ -36,7 +41,8 @@ func InitMainServer(ctx context.Context, logger2 *logrus.Logger, opts ServerOpti
 		CDBRepository: cdbRepository,
 	}
 	mailServiceAddr := opts.MailAddr
-	mailServiceClient, err := mailservice.ConnectClient(ctx, mailServiceAddr)
+	credentials := opts.Creds
+	mailServiceClient, err := mailservice.ConnectClient(ctx, mailServiceAddr, credentials)
 	if err != nil {
 		return nil, err
 	}
@@ -44,16 +50,41 @@ func InitMainServer(ctx context.Context, logger2 *logrus.Logger, opts ServerOpti
 		Ctx:    ctx,
 		Client: mailServiceClient,
 	}
-	serverModel := &model.ServerModel{
-		Ctx:    ctx,
+	redis_URI := opts.RedisUri
+	redis_USER := opts.RedisUser
+	redis_PASS := opts.RedisPass
+	client, err := redis.NewRedisClient(ctx, redis_URI, redis_USER, redis_PASS)
+	if err != nil {
+		return nil, err
+	}
+	redisImpl := &redis.RedisImpl{
 		Logger: logger2,
-		Repo:   serverCDBRepo,
-		Mail:   serviceGRPC,
+		Client: client,
 	}
+	redisLimiter := &ratelimit.RedisLimiter{
+		Redis: redisImpl,
+	}
+	serverModel := &model.ServerModel{
+		Ctx:     ctx,
+		Logger:  logger2,
+		Repo:    serverCDBRepo,
+		Mail:    serviceGRPC,
+		Limiter: redisLimiter,
+	}
+	redisStore := &nonce.RedisStore{
+		Redis: redisImpl,
+	}
+	requestWindow := opts.RequestWindow
+	deletionGracePeriod := opts.DeletionGracePeriod
+	signer := opts.Signer
 	apiServer := &api.ApiServer{
-		Model:  serverModel,
-		Logger: logger2,
-		Repo:   serverCDBRepo,
+		Model:               serverModel,
+		Logger:              logger2,
+		Repo:                serverCDBRepo,
+		Nonce:               redisStore,
+		RequestWindow:       requestWindow,
+		DeletionGracePeriod: deletionGracePeriod,
+		Signer:              signer,
 	}
 	server := &Server{
 		ApiServer: apiServer,
@@ -70,6 +101,13 @@ type Server struct {
 }
 
 type ServerOptions struct {
-	DBDsn    db.DBDsn
-	MailAddr mailservice.MailServiceAddr
+	DBDsn               db.DBDsn
+	MailAddr            mailservice.MailServiceAddr
+	RedisUri            redis.REDIS_URI
+	RedisUser           redis.REDIS_USER
+	RedisPass           redis.REDIS_PASS
+	RequestWindow       api.RequestWindow
+	DeletionGracePeriod api.DeletionGracePeriod
+	Signer              *jwt.Signer
+	Creds               *mtls.Credentials
 }
@@ -24,6 +24,11 @@ const _ = grpc.SupportPackageIsVersion7
 type MailServiceClient interface {
 	SendMail(ctx context.Context, in *SendMailRequest, opts ...grpc.CallOption) (*SendMailResponse, error)
 	SendMails(ctx context.Context, in *SendMailsRequest, opts ...grpc.CallOption) (*SendMailsResponse, error)
+	SubscribeNotification(ctx context.Context, in *SubscribeNotificationRequest, opts ...grpc.CallOption) (*SubscribeNotificationResponse, error)
+	UnsubscribeNotification(ctx context.Context, in *UnsubscribeNotificationRequest, opts ...grpc.CallOption) (*UnsubscribeNotificationResponse, error)
+	SendNotification(ctx context.Context, in *SendNotificationRequest, opts ...grpc.CallOption) (*SendNotificationResponse, error)
+	SendSMS(ctx context.Context, in *SendSMSRequest, opts ...grpc.CallOption) (*SendSMSResponse, error)
+	DeleteUserDevices(ctx context.Context, in *DeleteUserDevicesRequest, opts ...grpc.CallOption) (*DeleteUserDevicesResponse, error)
 }
 
 type mailServiceClient struct {
@@ -52,12 +57,62 @@ func (c *mailServiceClient) SendMails(ctx context.Context, in *SendMailsRequest,
 	return out, nil
 }
 
+func (c *mailServiceClient) SubscribeNotification(ctx context.Context, in *SubscribeNotificationRequest, opts ...grpc.CallOption) (*SubscribeNotificationResponse, error) {
+	out := new(SubscribeNotificationResponse)
+	err := c.cc.Invoke(ctx, "/mailservice.MailService/SubscribeNotification", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mailServiceClient) UnsubscribeNotification(ctx context.Context, in *UnsubscribeNotificationRequest, opts ...grpc.CallOption) (*UnsubscribeNotificationResponse, error) {
+	out := new(UnsubscribeNotificationResponse)
+	err := c.cc.Invoke(ctx, "/mailservice.MailService/UnsubscribeNotification", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mailServiceClient) SendNotification(ctx context.Context, in *SendNotificationRequest, opts ...grpc.CallOption) (*SendNotificationResponse, error) {
+	out := new(SendNotificationResponse)
+	err := c.cc.Invoke(ctx, "/mailservice.MailService/SendNotification", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mailServiceClient) SendSMS(ctx context.Context, in *SendSMSRequest, opts ...grpc.CallOption) (*SendSMSResponse, error) {
+	out := new(SendSMSResponse)
+	err := c.cc.Invoke(ctx, "/mailservice.MailService/SendSMS", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mailServiceClient) DeleteUserDevices(ctx context.Context, in *DeleteUserDevicesRequest, opts ...grpc.CallOption) (*DeleteUserDevicesResponse, error) {
+	out := new(DeleteUserDevicesResponse)
+	err := c.cc.Invoke(ctx, "/mailservice.MailService/DeleteUserDevices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MailServiceServer is the server API for MailService service.
 // All implementations must embed UnimplementedMailServiceServer
 // for forward compatibility
 type MailServiceServer interface {
 	SendMail(context.Context, *SendMailRequest) (*SendMailResponse, error)
 	SendMails(context.Context, *SendMailsRequest) (*SendMailsResponse, error)
+	SubscribeNotification(context.Context, *SubscribeNotificationRequest) (*SubscribeNotificationResponse, error)
+	UnsubscribeNotification(context.Context, *UnsubscribeNotificationRequest) (*UnsubscribeNotificationResponse, error)
+	SendNotification(context.Context, *SendNotificationRequest) (*SendNotificationResponse, error)
+	SendSMS(context.Context, *SendSMSRequest) (*SendSMSResponse, error)
+	DeleteUserDevices(context.Context, *DeleteUserDevicesRequest) (*DeleteUserDevicesResponse, error)
 	mustEmbedUnimplementedMailServiceServer()
 }
 
@@ -71,6 +126,21 @@ func (UnimplementedMailServiceServer) SendMail(context.Context, *SendMailRequest
 func (UnimplementedMailServiceServer) SendMails(context.Context, *SendMailsRequest) (*SendMailsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendMails not implemented")
 }
+func (UnimplementedMailServiceServer) SubscribeNotification(context.Context, *SubscribeNotificationRequest) (*SubscribeNotificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubscribeNotification not implemented")
+}
+func (UnimplementedMailServiceServer) UnsubscribeNotification(context.Context, *UnsubscribeNotificationRequest) (*UnsubscribeNotificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnsubscribeNotification not implemented")
+}
+func (UnimplementedMailServiceServer) SendNotification(context.Context, *SendNotificationRequest) (*SendNotificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendNotification not implemented")
+}
+func (UnimplementedMailServiceServer) SendSMS(context.Context, *SendSMSRequest) (*SendSMSResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendSMS not implemented")
+}
+func (UnimplementedMailServiceServer) DeleteUserDevices(context.Context, *DeleteUserDevicesRequest) (*DeleteUserDevicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUserDevices not implemented")
+}
 func (UnimplementedMailServiceServer) mustEmbedUnimplementedMailServiceServer() {}
 
 // UnsafeMailServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -120,6 +190,96 @@ func _MailService_SendMails_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MailService_SubscribeNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubscribeNotificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MailServiceServer).SubscribeNotification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mailservice.MailService/SubscribeNotification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MailServiceServer).SubscribeNotification(ctx, req.(*SubscribeNotificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MailService_UnsubscribeNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsubscribeNotificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MailServiceServer).UnsubscribeNotification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mailservice.MailService/UnsubscribeNotification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MailServiceServer).UnsubscribeNotification(ctx, req.(*UnsubscribeNotificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MailService_SendNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendNotificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MailServiceServer).SendNotification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mailservice.MailService/SendNotification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MailServiceServer).SendNotification(ctx, req.(*SendNotificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MailService_SendSMS_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendSMSRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MailServiceServer).SendSMS(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mailservice.MailService/SendSMS",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MailServiceServer).SendSMS(ctx, req.(*SendSMSRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MailService_DeleteUserDevices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserDevicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MailServiceServer).DeleteUserDevices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mailservice.MailService/DeleteUserDevices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MailServiceServer).DeleteUserDevices(ctx, req.(*DeleteUserDevicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MailService_ServiceDesc is the grpc.ServiceDesc for MailService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -135,6 +295,26 @@ var MailService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SendMails",
 			Handler:    _MailService_SendMails_Handler,
 		},
+		{
+			MethodName: "SubscribeNotification",
+			Handler:    _MailService_SubscribeNotification_Handler,
+		},
+		{
+			MethodName: "UnsubscribeNotification",
+			Handler:    _MailService_UnsubscribeNotification_Handler,
+		},
+		{
+			MethodName: "SendNotification",
+			Handler:    _MailService_SendNotification_Handler,
+		},
+		{
+			MethodName: "SendSMS",
+			Handler:    _MailService_SendSMS_Handler,
+		},
+		{
+			MethodName: "DeleteUserDevices",
+			Handler:    _MailService_DeleteUserDevices_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "mailservice.proto",
@@ -11,4 +11,5 @@ var MailServiceSet = wire.NewSet(wire.Bind(new(Service), new(ServiceGRPC)), wire
 type Service interface {
 	SendMail(ctx context.Context, to string, msg []byte) error
 	SendMails(ctx context.Context, to []string, msg []byte) error
+	SendSMS(ctx context.Context, to string, msg string) error
 }
@@ -4,10 +4,10 @@ import (
 	"context"
 	"time"
 
+	"github.com/aqaurius6666/authservice/src/internal/lib/mtls"
 	"github.com/aqaurius6666/authservice/src/pb/mailpb"
 	"golang.org/x/xerrors"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/status"
 )
 
@@ -23,10 +23,10 @@ type ServiceGRPC struct {
 	Client mailpb.MailServiceClient
 }
 
-func ConnectClient(ctx context.Context, addr MailServiceAddr) (mailpb.MailServiceClient, error) {
+func ConnectClient(ctx context.Context, addr MailServiceAddr, creds *mtls.Credentials) (mailpb.MailServiceClient, error) {
 	nctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
-	conn, err := grpc.DialContext(nctx, string(addr), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	conn, err := grpc.DialContext(nctx, string(addr), creds.DialOption())
 	if err != nil {
 		return nil, xerrors.Errorf("%w", err)
 	}
@@ -68,3 +68,21 @@ func (s ServiceGRPC) SendMails(ctx context.Context, to []string, msg []byte) err
 	}
 	return nil
 }
+
+func (s ServiceGRPC) SendSMS(ctx context.Context, to string, msg string) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	_, err := s.Client.SendSMS(ctx, &mailpb.SendSMSRequest{
+		To:  to,
+		Msg: msg,
+	})
+
+	if err != nil {
+		stt, ok := status.FromError(err)
+		if !ok {
+			return xerrors.Errorf("%w", err)
+		}
+		return xerrors.Errorf("%w", xerrors.Errorf("%w", stt.Err()))
+	}
+	return nil
+}
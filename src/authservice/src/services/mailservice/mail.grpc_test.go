@@ -3,7 +3,7 @@ package mailservice
 import "context"
 
 func NewMailService() (Service, error) {
-	client, err := ConnectClient(context.Background(), MailServiceAddr("localhost:50052"))
+	client, err := ConnectClient(context.Background(), MailServiceAddr("localhost:50052"), nil)
 	if err != nil {
 		return nil, err
 	}
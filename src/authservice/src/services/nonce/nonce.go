@@ -0,0 +1,24 @@
+package nonce
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/wire"
+	"golang.org/x/xerrors"
+)
+
+var Set = wire.NewSet(wire.Struct(new(RedisStore), "*"), wire.Bind(new(Store), new(*RedisStore)))
+
+var (
+	prefix       = "nonce"
+	ErrNonceUsed = xerrors.Errorf("%s: nonce already used", prefix)
+)
+
+// Store remembers nonces for a bounded time so a signed request can only be
+// accepted once.
+type Store interface {
+	// Use records nonce for ttl. It returns ErrNonceUsed if the nonce was
+	// already recorded and has not expired yet.
+	Use(ctx context.Context, nonce string, ttl time.Duration) error
+}
@@ -0,0 +1,40 @@
+package nonce
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+var _ Store = (*MemoryStore)(nil)
+
+// MemoryStore keeps nonces in process memory. It is meant for tests and
+// single instance setups, nonces are not shared between replicas.
+type MemoryStore struct {
+	mu      sync.Mutex
+	expires map[string]time.Time
+	now     func() time.Time
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		expires: make(map[string]time.Time),
+		now:     time.Now,
+	}
+}
+
+func (s *MemoryStore) Use(ctx context.Context, nonce string, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := s.now()
+	for k, exp := range s.expires {
+		if !exp.After(now) {
+			delete(s.expires, k)
+		}
+	}
+	if _, ok := s.expires[nonce]; ok {
+		return ErrNonceUsed
+	}
+	s.expires[nonce] = now.Add(ttl)
+	return nil
+}
@@ -0,0 +1,28 @@
+package nonce
+
+import (
+	"context"
+	"time"
+
+	"github.com/aqaurius6666/authservice/src/services/redis"
+	"golang.org/x/xerrors"
+)
+
+const KEY_PREFIX = "nonce:"
+
+var _ Store = (*RedisStore)(nil)
+
+type RedisStore struct {
+	Redis redis.Redis
+}
+
+func (s *RedisStore) Use(ctx context.Context, nonce string, ttl time.Duration) error {
+	ok, err := s.Redis.SetKeyNX(ctx, KEY_PREFIX+nonce, "1", ttl)
+	if err != nil {
+		return xerrors.Errorf("%w", err)
+	}
+	if !ok {
+		return ErrNonceUsed
+	}
+	return nil
+}
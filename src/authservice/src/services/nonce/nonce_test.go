@@ -0,0 +1,39 @@
+package nonce
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemoryStoreUse(t *testing.T) {
+	ctx := context.Background()
+	s := NewMemoryStore()
+
+	err := s.Use(ctx, "user:abc", time.Minute)
+	assert.Nil(t, err)
+	err = s.Use(ctx, "user:abc", time.Minute)
+	assert.ErrorIs(t, err, ErrNonceUsed)
+	err = s.Use(ctx, "user:def", time.Minute)
+	assert.Nil(t, err)
+}
+
+func TestMemoryStoreExpire(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	s := NewMemoryStore()
+	s.now = func() time.Time { return now }
+
+	err := s.Use(ctx, "user:abc", time.Minute)
+	assert.Nil(t, err)
+
+	now = now.Add(30 * time.Second)
+	err = s.Use(ctx, "user:abc", time.Minute)
+	assert.ErrorIs(t, err, ErrNonceUsed)
+
+	now = now.Add(time.Minute)
+	err = s.Use(ctx, "user:abc", time.Minute)
+	assert.Nil(t, err)
+}
@@ -0,0 +1,18 @@
+package ratelimit
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/wire"
+)
+
+var Set = wire.NewSet(wire.Struct(new(RedisLimiter), "*"), wire.Bind(new(Limiter), new(*RedisLimiter)))
+
+// Limiter counts hits per key in fixed windows.
+type Limiter interface {
+	// Allow records a hit for key. It returns zero while the key has been hit
+	// at most limit times in the current window, otherwise the time left
+	// until the window resets.
+	Allow(ctx context.Context, key string, limit int64, window time.Duration) (time.Duration, error)
+}
@@ -0,0 +1,45 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+var _ Limiter = (*MemoryLimiter)(nil)
+
+// MemoryLimiter keeps counters in process memory. It is meant for tests and
+// single instance setups, counters are not shared between replicas.
+type MemoryLimiter struct {
+	mu      sync.Mutex
+	windows map[string]*window
+	now     func() time.Time
+}
+
+type window struct {
+	count   int64
+	expires time.Time
+}
+
+func NewMemoryLimiter() *MemoryLimiter {
+	return &MemoryLimiter{
+		windows: make(map[string]*window),
+		now:     time.Now,
+	}
+}
+
+func (s *MemoryLimiter) Allow(ctx context.Context, key string, limit int64, d time.Duration) (time.Duration, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := s.now()
+	w, ok := s.windows[key]
+	if !ok || !w.expires.After(now) {
+		w = &window{expires: now.Add(d)}
+		s.windows[key] = w
+	}
+	w.count++
+	if w.count > limit {
+		return w.expires.Sub(now), nil
+	}
+	return 0, nil
+}
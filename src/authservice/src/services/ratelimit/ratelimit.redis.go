@@ -0,0 +1,28 @@
+package ratelimit
+
+import (
+	"context"
+	"time"
+
+	"github.com/aqaurius6666/authservice/src/services/redis"
+	"golang.org/x/xerrors"
+)
+
+const KEY_PREFIX = "ratelimit:"
+
+var _ Limiter = (*RedisLimiter)(nil)
+
+type RedisLimiter struct {
+	Redis redis.Redis
+}
+
+func (s *RedisLimiter) Allow(ctx context.Context, key string, limit int64, window time.Duration) (time.Duration, error) {
+	n, left, err := s.Redis.Incr(ctx, KEY_PREFIX+key, window)
+	if err != nil {
+		return 0, xerrors.Errorf("%w", err)
+	}
+	if n > limit {
+		return left, nil
+	}
+	return 0, nil
+}
@@ -0,0 +1,39 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemoryLimiterAllow(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	s := NewMemoryLimiter()
+	s.now = func() time.Time { return now }
+
+	for i := 0; i < 3; i++ {
+		retry, err := s.Allow(ctx, "mail:a", 3, time.Minute)
+		assert.Nil(t, err)
+		assert.Zero(t, retry)
+	}
+	retry, err := s.Allow(ctx, "mail:a", 3, time.Minute)
+	assert.Nil(t, err)
+	assert.Equal(t, time.Minute, retry)
+
+	retry, err = s.Allow(ctx, "mail:b", 3, time.Minute)
+	assert.Nil(t, err)
+	assert.Zero(t, retry)
+
+	now = now.Add(20 * time.Second)
+	retry, err = s.Allow(ctx, "mail:a", 3, time.Minute)
+	assert.Nil(t, err)
+	assert.Equal(t, 40*time.Second, retry)
+
+	now = now.Add(40 * time.Second)
+	retry, err = s.Allow(ctx, "mail:a", 3, time.Minute)
+	assert.Nil(t, err)
+	assert.Zero(t, retry)
+}
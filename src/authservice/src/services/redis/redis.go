@@ -0,0 +1,104 @@
+package redis
+
+import (
+	"context"
+	"time"
+
+	redis "github.com/go-redis/redis/v8"
+	"github.com/google/wire"
+	"github.com/sirupsen/logrus"
+)
+
+type REDIS_URI string
+type REDIS_USER string
+type REDIS_PASS string
+
+var Set = wire.NewSet(wire.Struct(new(RedisImpl), "*"), wire.Bind(new(Redis), new(*RedisImpl)), NewRedisClient)
+
+type Redis interface {
+	GetKey(ctx context.Context, key string) (string, error)
+	SetKey(ctx context.Context, key string, value string, ttl time.Duration) error
+	SetKeyNX(ctx context.Context, key string, value string, ttl time.Duration) (bool, error)
+	Incr(ctx context.Context, key string, ttl time.Duration) (int64, time.Duration, error)
+	Clear(ctx context.Context, keys ...string) error
+}
+
+type RedisImpl struct {
+	Logger *logrus.Logger
+	Client *redis.Client
+}
+
+func NewRedisClient(ctx context.Context, uri REDIS_URI, user REDIS_USER, pass REDIS_PASS) (*redis.Client, error) {
+
+	client := redis.NewClient(&redis.Options{
+		Addr:     string(uri),
+		Username: string(user),
+		Password: string(pass),
+	})
+
+	if err := client.Ping(ctx).Err(); err != nil {
+		return nil, err
+	}
+
+	return client, nil
+}
+
+func (r *RedisImpl) GetKey(ctx context.Context, key string) (string, error) {
+	v, err := r.Client.Get(ctx, key).Result()
+	if err != nil {
+		if err == redis.Nil {
+			return "", ErrKeyNotFound
+		}
+		return "", ErrInternal
+	}
+	return v, nil
+}
+
+// Implement SetKey
+func (r *RedisImpl) SetKey(ctx context.Context, key string, value string, ttl time.Duration) error {
+	return r.Client.Set(ctx, key, value, ttl).Err()
+}
+
+// Implement SetKeyNX, reports false when the key already exists
+func (r *RedisImpl) SetKeyNX(ctx context.Context, key string, value string, ttl time.Duration) (bool, error) {
+	ok, err := r.Client.SetNX(ctx, key, value, ttl).Result()
+	if err != nil {
+		return false, ErrInternal
+	}
+	return ok, nil
+}
+
+// Implement Incr, the key expires ttl after its first increment. It returns
+// the new value and the time left before the key expires.
+func (r *RedisImpl) Incr(ctx context.Context, key string, ttl time.Duration) (int64, time.Duration, error) {
+	n, err := r.Client.Incr(ctx, key).Result()
+	if err != nil {
+		return 0, 0, ErrInternal
+	}
+	if n == 1 {
+		if err := r.Client.Expire(ctx, key, ttl).Err(); err != nil {
+			return 0, 0, ErrInternal
+		}
+		return n, ttl, nil
+	}
+	left, err := r.Client.TTL(ctx, key).Result()
+	if err != nil {
+		return 0, 0, ErrInternal
+	}
+	if left < 0 {
+		// The expiry was never set, e.g. the first increment raced a crash.
+		if err := r.Client.Expire(ctx, key, ttl).Err(); err != nil {
+			return 0, 0, ErrInternal
+		}
+		left = ttl
+	}
+	return n, left, nil
+}
+
+// Implement Clear
+func (r *RedisImpl) Clear(ctx context.Context, keys ...string) error {
+	if len(keys) == 0 {
+		return r.Client.FlushAll(ctx).Err()
+	}
+	return r.Client.Del(ctx, keys...).Err()
+}
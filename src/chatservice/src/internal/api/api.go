@@ -49,7 +49,7 @@ func (s *ApiServer) RegisterEndpoint() {
 	chat.POST("/conversation", s.Mid.CheckAuth, s.HTTP.HandlePostConversation)
 	chat.GET("/notification", s.Mid.CheckAuth, s.HTTP.HandleGetNotification)
 	chat.PUT("/notification", s.Mid.CheckAuth, s.HTTP.HandlePutNotification)
-	chat.POST("/:id/fetch", s.Mid.CheckAuth, s.HTTP.HandleFetchByIdPost)
+	chat.POST("/:id/fetch", s.Mid.AllowImpersonation, s.Mid.CheckAuth, s.HTTP.HandleFetchByIdPost)
 	chat.POST("/:id/send", s.Mid.CheckAuth, s.HTTP.HandleSendPost)
 
 }
@@ -318,3 +318,18 @@ func (s *ApiServer) CloseConversation(ctx context.Context, req *chatpb.CloseConv
 	}
 	return &chatpb.CloseConversationResponse{}, nil
 }
+
+func (s *ApiServer) DeleteUserData(ctx context.Context, req *chatpb.DeleteUserDataRequest) (*chatpb.DeleteUserDataResponse, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.DeleteUserData))
+	defer span.End()
+
+	userId := uuid.MustParse(req.UserId)
+
+	err := s.Model.DeleteUserData(ctx, userId)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		panic(err)
+	}
+	return &chatpb.DeleteUserDataResponse{}, nil
+}
@@ -70,7 +70,7 @@ func (s *HTTPController) HandleFetchByIdPost(g *gin.Context) {
 		return
 	}
 	req.Id = g.Param("id")
-	req.XUserId = g.GetString("userId")
+	req.XUserId = lib.GetActingId(g)
 
 	res, err := s.S.FetchChatById(g.Request.Context(), &req)
 	if err != nil {
@@ -87,7 +87,7 @@ func (s *HTTPController) HandleSendPost(g *gin.Context) {
 		return
 	}
 	req.Id = g.Param("id")
-	req.XUserId = g.GetString("userId")
+	req.XUserId = lib.GetActingId(g)
 
 	res, err := s.S.SendChat(g.Request.Context(), &req)
 	if err != nil {
@@ -103,7 +103,7 @@ func (s *HTTPController) HandlePostConversation(g *gin.Context) {
 		lib.BadRequest(g, err)
 		return
 	}
-	req.XUserId = g.GetString("userId")
+	req.XUserId = lib.GetActingId(g)
 
 	res, err := s.S.GetConversation(g.Request.Context(), &req)
 	if err != nil {
@@ -116,7 +116,7 @@ func (s *HTTPController) HandlePostConversation(g *gin.Context) {
 func (s *HTTPController) HandleGetNotification(g *gin.Context) {
 	req := chatpb.NotificationGetRequest{}
 
-	req.XUserId = g.GetString("userId")
+	req.XUserId = lib.GetActingId(g)
 
 	res, err := s.S.GetNotification(g.Request.Context(), &req)
 	if err != nil {
@@ -132,7 +132,7 @@ func (s *HTTPController) HandlePutNotification(g *gin.Context) {
 		lib.BadRequest(g, err)
 		return
 	}
-	req.XUserId = g.GetString("userId")
+	req.XUserId = lib.GetActingId(g)
 
 	res, err := s.S.SeenNotification(g.Request.Context(), &req)
 	if err != nil {
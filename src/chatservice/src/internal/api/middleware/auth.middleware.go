@@ -1,19 +1,27 @@
 package middleware
 
 import (
+	"context"
 	"net/http"
+	"strings"
 
 	"github.com/aqaurius6666/chatservice/src/internal/lib"
+	"github.com/aqaurius6666/chatservice/src/internal/lib/jwt"
 	"github.com/aqaurius6666/chatservice/src/internal/lib/unleash"
 	"github.com/aqaurius6666/chatservice/src/internal/var/c"
 	"github.com/aqaurius6666/chatservice/src/internal/var/e"
 	"github.com/gin-gonic/gin"
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/xerrors"
 )
 
+const BEARER_PREFIX = "Bearer "
+
 type Auth interface {
 	CheckAuth(g *gin.Context)
+	AllowImpersonation(g *gin.Context)
 }
 
 func (s *MiddlewareV1) CheckAuth(g *gin.Context) {
@@ -40,6 +48,24 @@ func (s *MiddlewareV1) checkAuth(g *gin.Context) {
 		lib.Unauthorized(g, xerrors.Errorf("%w", e.ErrMissingCertificate))
 		return
 	}
+	// Bearer tokens are verified here, everything else is a signed
+	// certificate that only authservice can check.
+	if token := strings.TrimPrefix(string(auth), BEARER_PREFIX); token != string(auth) {
+		claims, err := s.Keys.Verify(ctx, token)
+		if err != nil {
+			lib.Unauthorized(g, xerrors.Errorf("%w", err))
+			return
+		}
+		if claims.Actor != nil {
+			s.impersonate(ctx, g, claims)
+			return
+		}
+		g.Set("userId", claims.Subject)
+		g.Set("role", c.ROLE(claims.Role))
+		g.Set("businessId", claims.BusinessId)
+		g.Next()
+		return
+	}
 	if method != http.MethodGet {
 		rawBody, ok := g.Get("rawBody")
 		if !ok {
@@ -48,13 +74,48 @@ func (s *MiddlewareV1) checkAuth(g *gin.Context) {
 		}
 		body = rawBody.([]byte)
 	}
-	id, role, err := s.Auth.CheckAuth(ctx, auth, body, method)
+	id, role, businessId, err := s.Auth.CheckAuth(ctx, auth, body, method)
 	if err != nil {
 		lib.Unauthorized(g, xerrors.Errorf("%w", err))
 		return
 	}
 	g.Set("userId", id)
 	g.Set("role", role)
+	g.Set("businessId", businessId)
+	g.Next()
+}
+
+// impersonate serves a request made with an impersonation token. Read only
+// tokens are refused on anything but GET unless the route allows
+// impersonation, and every request, refused or not, is tagged with the admin
+// in traces and logs and added to the audit trail of the session.
+func (s *MiddlewareV1) impersonate(ctx context.Context, g *gin.Context, claims *jwt.Claims) {
+	adminId := claims.Actor.Subject
+	trace.SpanFromContext(g.Request.Context()).SetAttributes(
+		attribute.String("auth.impersonator_id", adminId),
+		attribute.String("auth.impersonation_id", claims.ID),
+	)
+	g.Set("impersonatorId", adminId)
+	g.Set("impersonationId", claims.ID)
+	defer func() {
+		if err := s.Auth.RecordImpersonationAction(ctx, claims.ID, adminId, claims.Subject, g.Request.Method, g.Request.URL.Path, int32(g.Writer.Status())); err != nil {
+			s.Logger.WithField("impersonatorId", adminId).Error(err)
+		}
+	}()
+	if claims.ReadOnly && g.Request.Method != http.MethodGet && !g.GetBool("impersonationAllowed") {
+		lib.Unauthorized(g, xerrors.Errorf("%w", e.ErrReadOnlyToken))
+		return
+	}
+	g.Set("userId", claims.Subject)
+	g.Set("role", c.ROLE(claims.Role))
+	g.Set("businessId", claims.BusinessId)
+	g.Next()
+}
+
+// AllowImpersonation lets read only impersonation tokens through a route
+// that is not a GET but changes nothing. It has to come before CheckAuth.
+func (s *MiddlewareV1) AllowImpersonation(g *gin.Context) {
+	g.Set("impersonationAllowed", true)
 	g.Next()
 }
 
@@ -0,0 +1,114 @@
+package middleware
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/aqaurius6666/chatservice/src/internal/lib/jwt"
+	"github.com/aqaurius6666/chatservice/src/services/authservice"
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+type action struct {
+	sessionId, adminId, userId, method, path string
+	code                                     int32
+}
+
+type auditAuth struct {
+	authservice.Service
+	actions []action
+}
+
+func (a *auditAuth) RecordImpersonationAction(ctx context.Context, sessionId, adminId, userId, method, path string, code int32) error {
+	a.actions = append(a.actions, action{sessionId, adminId, userId, method, path, code})
+	return nil
+}
+
+// sign signs claims with key the way authservice does.
+func sign(t *testing.T, key *ecdsa.PrivateKey, claims jwt.Claims) string {
+	enc := base64.RawURLEncoding
+	h, err := json.Marshal(map[string]string{"alg": jwt.ALG, "typ": "JWT", "kid": "k1"})
+	assert.Nil(t, err)
+	b, err := json.Marshal(claims)
+	assert.Nil(t, err)
+	signed := enc.EncodeToString(h) + "." + enc.EncodeToString(b)
+	digest := sha256.Sum256([]byte(signed))
+	r, s, err := ecdsa.Sign(rand.Reader, key, digest[:])
+	assert.Nil(t, err)
+	sig := make([]byte, 64)
+	r.FillBytes(sig[:32])
+	s.FillBytes(sig[32:])
+	return signed + "." + enc.EncodeToString(sig)
+}
+
+func TestCheckAuthImpersonation(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.Nil(t, err)
+	jwk := jwt.JWK{
+		Kty: "EC",
+		Crv: "P-256",
+		X:   base64.RawURLEncoding.EncodeToString(key.X.FillBytes(make([]byte, 32))),
+		Y:   base64.RawURLEncoding.EncodeToString(key.Y.FillBytes(make([]byte, 32))),
+		Kid: "k1",
+	}
+	auth := &auditAuth{}
+	s := &MiddlewareV1{
+		Logger: logrus.New(),
+		Auth:   auth,
+		Keys: jwt.NewKeySet(func(ctx context.Context) ([]jwt.JWK, error) {
+			return []jwt.JWK{jwk}, nil
+		}),
+	}
+	served := 0
+	handle := func(g *gin.Context) {
+		served++
+		g.Status(http.StatusOK)
+	}
+	r := gin.New()
+	r.POST("/:id/send", s.checkAuth, handle)
+	r.PUT("/notification", s.checkAuth, handle)
+	r.GET("/notification", s.checkAuth, handle)
+	r.POST("/:id/fetch", s.AllowImpersonation, s.checkAuth, handle)
+
+	token := sign(t, key, jwt.Claims{
+		Subject:   "user",
+		ExpiresAt: time.Now().Add(time.Minute).Unix(),
+		ID:        "session",
+		Actor:     &jwt.Actor{Subject: "admin"},
+		ReadOnly:  true,
+	})
+	do := func(method, path string) int {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(method, path, nil)
+		req.Header.Set("Authorization", BEARER_PREFIX+token)
+		r.ServeHTTP(w, req)
+		return w.Code
+	}
+
+	assert.Equal(t, http.StatusUnauthorized, do(http.MethodPost, "/c1/send"))
+	assert.Equal(t, http.StatusUnauthorized, do(http.MethodPut, "/notification"))
+	assert.Equal(t, 0, served)
+	assert.Equal(t, http.StatusOK, do(http.MethodGet, "/notification"))
+	assert.Equal(t, http.StatusOK, do(http.MethodPost, "/c1/fetch"))
+	assert.Equal(t, 2, served)
+
+	// every request is audited, refused or not
+	assert.Equal(t, []action{
+		{"session", "admin", "user", http.MethodPost, "/c1/send", http.StatusUnauthorized},
+		{"session", "admin", "user", http.MethodPut, "/notification", http.StatusUnauthorized},
+		{"session", "admin", "user", http.MethodGet, "/notification", http.StatusOK},
+		{"session", "admin", "user", http.MethodPost, "/c1/fetch", http.StatusOK},
+	}, auth.actions)
+}
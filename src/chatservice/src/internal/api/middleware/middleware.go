@@ -1,12 +1,13 @@
 package middleware
 
 import (
+	"github.com/aqaurius6666/chatservice/src/internal/lib/jwt"
 	"github.com/aqaurius6666/chatservice/src/services/authservice"
 	"github.com/google/wire"
 	"github.com/sirupsen/logrus"
 )
 
-var Set = wire.NewSet(wire.Bind(new(Middleware), new(*MiddlewareV1)), wire.Struct(new(MiddlewareV1), "*"))
+var Set = wire.NewSet(wire.Bind(new(Middleware), new(*MiddlewareV1)), wire.Struct(new(MiddlewareV1), "*"), NewKeySet)
 
 type Middleware interface {
 	Auth
@@ -18,4 +19,11 @@ type Middleware interface {
 type MiddlewareV1 struct {
 	Logger *logrus.Logger
 	Auth   authservice.Service
+	Keys   *jwt.KeySet
+}
+
+// NewKeySet returns the keys bearer tokens are verified with, fetched from
+// authservice.
+func NewKeySet(auth authservice.Service) *jwt.KeySet {
+	return jwt.NewKeySet(auth.GetJwks)
 }
@@ -7,4 +7,5 @@ type ChatRepo interface {
 	SelectChat(context.Context, *Search) (*Chat, error)
 	ListChats(context.Context, *Search) ([]*Chat, error)
 	UpdateChat(context.Context, *Search, *Chat) error
+	DeleteChats(context.Context, *Search) error
 }
@@ -45,6 +45,11 @@ func applySearchChat(db *gorm.DB, search *chat.Search) *gorm.DB {
 			Seen: search.Seen,
 		})
 	}
+	if search.SenderId != uuid.Nil {
+		db = db.Where(chat.Chat{
+			SenderId: search.SenderId,
+		})
+	}
 	db = db.Order("created_at ASC")
 
 	return db
@@ -109,3 +114,17 @@ func (s *ServerCDBRepo) UpdateChat(ctx context.Context, search *chat.Search, val
 	}
 	return nil
 }
+
+func (s *ServerCDBRepo) DeleteChats(ctx context.Context, search *chat.Search) error {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.DeleteChats))
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	if err := applySearchChat(s.Db, search).WithContext(ctx).Delete(&chat.Chat{}).Error; err != nil {
+		err = xerrors.Errorf("%w", chat.ErrDeleteFail)
+		lib.RecordError(span, err)
+		return err
+	}
+	return nil
+}
@@ -84,3 +84,17 @@ func (s *ServerCDBRepo) UpsertNotification(ctx context.Context, value *notificat
 	}
 	return nil
 }
+
+func (s *ServerCDBRepo) DeleteNotification(ctx context.Context, search *notification.Search) error {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.DeleteNotification))
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	if err := applySearchNotification(s.Db, search).WithContext(ctx).Delete(&notification.Notification{}).Error; err != nil {
+		err = xerrors.Errorf("%w", notification.ErrDeleteFail)
+		lib.RecordError(span, err)
+		return err
+	}
+	return nil
+}
@@ -6,5 +6,6 @@ var (
 	prefix        = "notification"
 	ErrNotFound   = xerrors.Errorf("%s: record not found", prefix)
 	ErrInsertFail = xerrors.Errorf("%s: insert failed", prefix)
+	ErrDeleteFail = xerrors.Errorf("%s: delete failed", prefix)
 	ErrFirstOrCreateFail = xerrors.Errorf("%s: first or create failed", prefix)
 )
@@ -7,4 +7,5 @@ type NotificationRepo interface {
 	SelectNotification(context.Context, *Search) (*Notification, error)
 	UpdateNotification(context.Context, *Search, *Notification) error
 	UpsertNotification(context.Context, *Notification) error
+	DeleteNotification(context.Context, *Search) error
 }
@@ -81,6 +81,15 @@ func MustGetRole(g *gin.Context) c.ROLE {
 	return ro
 }
 
+// GetActingId returns the id of the business the caller acts for, or its
+// own id when it acts for none.
+func GetActingId(g *gin.Context) string {
+	if id := g.GetString("businessId"); id != "" {
+		return id
+	}
+	return g.GetString("userId")
+}
+
 func RandomKey(name *string) *string {
 	ext := path.Ext(*name)
 	newKey := fmt.Sprintf("%s%s", uuid.New().String(), ext)
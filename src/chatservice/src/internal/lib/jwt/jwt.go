@@ -0,0 +1,143 @@
+// Package jwt verifies the ES256 access tokens issued by authservice.
+package jwt
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"strings"
+	"time"
+
+	"golang.org/x/xerrors"
+)
+
+const (
+	ALG  = "ES256"
+	size = 32
+)
+
+var (
+	prefix              = "jwt"
+	ErrMalformed        = xerrors.Errorf("%s: malformed token", prefix)
+	ErrUnsupportedAlg   = xerrors.Errorf("%s: unsupported algorithm", prefix)
+	ErrUnknownKey       = xerrors.Errorf("%s: unknown key", prefix)
+	ErrInvalidSignature = xerrors.Errorf("%s: invalid signature", prefix)
+	ErrExpired          = xerrors.Errorf("%s: token expired", prefix)
+	ErrInvalidKey       = xerrors.Errorf("%s: invalid key", prefix)
+)
+
+var encoding = base64.RawURLEncoding
+
+// Claims are the claims of an access token. Role is a c.ROLE code and
+// BusinessRole a c.BUSINESS_ROLE code, set when BusinessId is. A ReadOnly
+// token may only be used for requests that change nothing.
+type Claims struct {
+	Issuer             string   `json:"iss"`
+	Subject            string   `json:"sub"`
+	Role               int32    `json:"role"`
+	TotpEnrollRequired bool     `json:"ter,omitempty"`
+	Permissions        []string `json:"perms,omitempty"`
+	BusinessId         string   `json:"bid,omitempty"`
+	BusinessRole       int32    `json:"brole,omitempty"`
+	IssuedAt           int64    `json:"iat"`
+	ExpiresAt          int64    `json:"exp"`
+	ID                 string   `json:"jti"`
+	Actor              *Actor   `json:"act,omitempty"`
+	ReadOnly           bool     `json:"ro,omitempty"`
+}
+
+// Actor is who acts on behalf of the subject (RFC 8693). It is set on
+// impersonation tokens to the admin using them, and the ID of such a token
+// is the id of the impersonation session.
+type Actor struct {
+	Subject string `json:"sub"`
+}
+
+type header struct {
+	Alg string `json:"alg"`
+	Typ string `json:"typ"`
+	Kid string `json:"kid"`
+}
+
+// JWK is an EC public key in JSON Web Key format.
+type JWK struct {
+	Kty string `json:"kty"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+	Kid string `json:"kid"`
+	Alg string `json:"alg"`
+	Use string `json:"use"`
+}
+
+func (k JWK) PublicKey() (*ecdsa.PublicKey, error) {
+	if k.Kty != "EC" || k.Crv != "P-256" {
+		return nil, ErrInvalidKey
+	}
+	x, err := encoding.DecodeString(k.X)
+	if err != nil || len(x) != size {
+		return nil, ErrInvalidKey
+	}
+	y, err := encoding.DecodeString(k.Y)
+	if err != nil || len(y) != size {
+		return nil, ErrInvalidKey
+	}
+	pub := &ecdsa.PublicKey{
+		Curve: elliptic.P256(),
+		X:     new(big.Int).SetBytes(x),
+		Y:     new(big.Int).SetBytes(y),
+	}
+	if !pub.Curve.IsOnCurve(pub.X, pub.Y) {
+		return nil, ErrInvalidKey
+	}
+	return pub, nil
+}
+
+// Verify checks the signature of token against the key keyFn returns for its
+// kid and that the token has not expired at now.
+func Verify(token string, keyFn func(kid string) (*ecdsa.PublicKey, error), now time.Time) (*Claims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, ErrMalformed
+	}
+	bHeader, err := encoding.DecodeString(parts[0])
+	if err != nil {
+		return nil, ErrMalformed
+	}
+	h := header{}
+	if err := json.Unmarshal(bHeader, &h); err != nil {
+		return nil, ErrMalformed
+	}
+	if h.Alg != ALG {
+		return nil, ErrUnsupportedAlg
+	}
+	pub, err := keyFn(h.Kid)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := encoding.DecodeString(parts[2])
+	if err != nil || len(sig) != 2*size {
+		return nil, ErrInvalidSignature
+	}
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	r := new(big.Int).SetBytes(sig[:size])
+	s := new(big.Int).SetBytes(sig[size:])
+	if !ecdsa.Verify(pub, digest[:], r, s) {
+		return nil, ErrInvalidSignature
+	}
+	bClaims, err := encoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, ErrMalformed
+	}
+	claims := Claims{}
+	if err := json.Unmarshal(bClaims, &claims); err != nil {
+		return nil, ErrMalformed
+	}
+	if claims.ExpiresAt <= now.Unix() {
+		return nil, ErrExpired
+	}
+	return &claims, nil
+}
@@ -5,10 +5,13 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/aqaurius6666/chatservice/src/internal/db/chat"
+	"github.com/aqaurius6666/chatservice/src/internal/db/conversation"
 	"github.com/aqaurius6666/chatservice/src/internal/db/notification"
 	"github.com/aqaurius6666/chatservice/src/internal/lib"
 	"github.com/aqaurius6666/chatservice/src/internal/lib/unleash"
 	"github.com/aqaurius6666/chatservice/src/internal/var/c"
+	"github.com/aqaurius6666/go-utils/utils"
 	"github.com/google/uuid"
 	"go.opentelemetry.io/otel"
 	"golang.org/x/xerrors"
@@ -19,6 +22,47 @@ type Notification interface {
 	UpsertNotification(context.Context, *notification.Notification) error
 
 	SetUserInactiveTimeout(ctx context.Context, userId uuid.UUID) error
+	DeleteUserData(ctx context.Context, userId uuid.UUID) error
+}
+
+// DeleteUserData erases what chatservice keeps about a user whose account is
+// deleted. Conversations stay for the other members but are closed, and the
+// messages the user sent are removed from them.
+func (s *ServerModel) DeleteUserData(ctx context.Context, userId uuid.UUID) error {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.DeleteUserData))
+	defer span.End()
+	if err := s.Repo.UpdateConversation(ctx, &conversation.Search{
+		MemberId: userId,
+		Conversation: conversation.Conversation{
+			Status: utils.Int32Ptr(0),
+		},
+	}, &conversation.Conversation{
+		Status: utils.Int32Ptr(1),
+	}); err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return err
+	}
+	if err := s.Repo.DeleteChats(ctx, &chat.Search{
+		Chat: chat.Chat{SenderId: userId},
+	}); err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return err
+	}
+	if err := s.Repo.DeleteNotification(ctx, &notification.Search{
+		Notification: notification.Notification{UserId: userId},
+	}); err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return err
+	}
+	if err := s.Redis.RemoveMemberSortedSet(ctx, c.INACTIVE_SET_KEY, userId.String()); err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return err
+	}
+	return nil
 }
 
 // Imeplement SetUserInactiveTimeout
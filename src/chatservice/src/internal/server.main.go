@@ -14,6 +14,7 @@ import (
 
 	"github.com/aqaurius6666/chatservice/src/internal/db"
 	"github.com/aqaurius6666/chatservice/src/internal/lib"
+	"github.com/aqaurius6666/chatservice/src/internal/lib/mtls"
 	"github.com/aqaurius6666/chatservice/src/internal/lib/unleash"
 	"github.com/aqaurius6666/chatservice/src/internal/var/c"
 	"github.com/aqaurius6666/chatservice/src/pb/chatpb"
@@ -37,6 +38,7 @@ import (
 	"go.opentelemetry.io/otel/sdk/resource"
 	tracesdk "go.opentelemetry.io/otel/sdk/trace"
 	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+	"golang.org/x/xerrors"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	healthpb "google.golang.org/grpc/health/grpc_health_v1"
@@ -78,6 +80,11 @@ func runMain(appCtx *cli.Context) error {
 		logger.Fatal(err)
 		return err
 	}
+	creds, err := newTransportCredentials(appCtx)
+	if err != nil {
+		logger.Fatal(err)
+		return err
+	}
 	mainServer, err := InitMainServer(ctx, logger, ServerOptions{
 		DBDsn:             db.DBDsn(appCtx.String("db-uri")),
 		AuthserviceAddr:   authservice.AuthserviceAddr(appCtx.String("authservice-address")),
@@ -86,6 +93,7 @@ func runMain(appCtx *cli.Context) error {
 		RedisPass:         redis.REDIS_PASS(appCtx.String("redis-pass")),
 		MailserviceAddr:   mailservice.MailserviceAddr(appCtx.String("mailservice-address")),
 		TwilloCallbackUrl: twilloclient.TWILLO_SMS_CALLBACK_URL(appCtx.String("twillo-callback-url")),
+		Creds:             creds,
 	})
 	if err != nil {
 		logger.Fatal(err)
@@ -132,12 +140,12 @@ func runMain(appCtx *cli.Context) error {
 		// defer wg.Done()
 		// if appCtx.Bool("disable-stats") {
 		// logger.Info("Stats disabled.")
-		srv = grpc.NewServer(
+		srv = grpc.NewServer(append(creds.ServerOptions(grpcPolicy),
 			grpc.ChainUnaryInterceptor(
 				otelgrpc.UnaryServerInterceptor(),
 				grpc_recovery.UnaryServerInterceptor(grpc_recovery.WithRecoveryHandler(MyLogger)),
 				lib.UnaryServerLogRequestInterceptor,
-			))
+			))...)
 		// } else {
 		// 	logger.Info("Stats enabled.")
 		// 	// srv = grpc.NewServer(grpc.StatsHandler(&ocgrpc.ServerHandler{}))
@@ -313,3 +321,24 @@ func MyLogger(p interface{}) error {
 	logger.Errorf("%+v", err)
 	return status.Error(codes.Internal, err.Error())
 }
+
+// newTransportCredentials loads the certificate this service presents to its
+// callers and to the services it dials. Without one internal gRPC traffic is
+// plaintext and any pod can call any RPC.
+func newTransportCredentials(appCtx *cli.Context) (*mtls.Credentials, error) {
+	creds, err := mtls.New(mtls.Config{
+		Mode:     appCtx.String("tls-mode"),
+		CAFile:   appCtx.String("tls-ca-file"),
+		CertFile: appCtx.String("tls-cert-file"),
+		KeyFile:  appCtx.String("tls-key-file"),
+		DevDir:   appCtx.String("tls-dev-dir"),
+		Identity: c.SERVICE_NAME,
+	})
+	if err != nil {
+		return nil, xerrors.Errorf("%w", err)
+	}
+	if creds == nil {
+		logger.Warn("tls-mode disabled, internal gRPC traffic is not authenticated")
+	}
+	return creds, nil
+}
@@ -0,0 +1,22 @@
+package main
+
+import "github.com/aqaurius6666/chatservice/src/internal/lib/mtls"
+
+// Identities are the common names of the certificates services present to
+// each other.
+const (
+	apiService     = "api-service"
+	cronjobService = "cronjob-service"
+)
+
+// grpcPolicy lists which services may call each RPC when tls-mode is not
+// disabled. Chat is only served to users over websocket, never over gRPC.
+var grpcPolicy = mtls.Policy{
+	"/grpc.health.v1.Health/*":                   {mtls.Anyone},
+	"/common_grpc.Common/Echo":                   {mtls.Anyone},
+	"/chatservice.ChatService/NewConversation":   {apiService},
+	"/chatservice.ChatService/GetConversation":   {apiService},
+	"/chatservice.ChatService/CloseConversation": {apiService},
+	"/chatservice.ChatService/TriggerSendSMS":    {cronjobService},
+	"/chatservice.ChatService/DeleteUserData":    {cronjobService},
+}
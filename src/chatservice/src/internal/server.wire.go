@@ -8,6 +8,7 @@ import (
 
 	"github.com/aqaurius6666/chatservice/src/internal/api"
 	"github.com/aqaurius6666/chatservice/src/internal/db"
+	"github.com/aqaurius6666/chatservice/src/internal/lib/mtls"
 	"github.com/aqaurius6666/chatservice/src/internal/model"
 	"github.com/aqaurius6666/chatservice/src/services/authservice"
 	"github.com/aqaurius6666/chatservice/src/services/mailservice"
@@ -31,11 +32,12 @@ type ServerOptions struct {
 	RedisUser         redis.REDIS_USER
 	RedisPass         redis.REDIS_PASS
 	TwilloCallbackUrl twilloclient.TWILLO_SMS_CALLBACK_URL
+	Creds             *mtls.Credentials
 }
 
 func InitMainServer(ctx context.Context, logger *logrus.Logger, opts ServerOptions) (*Server, error) {
 	wire.Build(
-		wire.FieldsOf(&opts, "TwilloCallbackUrl", "DBDsn", "AuthserviceAddr", "RedisUser", "RedisPass", "RedisUri", "MailserviceAddr"),
+		wire.FieldsOf(&opts, "TwilloCallbackUrl", "DBDsn", "AuthserviceAddr", "RedisUser", "RedisPass", "RedisUri", "MailserviceAddr", "Creds"),
 		gin.New,
 		db.ServerRepoSet,
 		api.ApiServerSet,
@@ -23,9 +23,12 @@ const (
 type ROLE int32
 
 const (
-	ROLE_CUSTOMER ROLE = 0
-	ROLE_HANDYMAN ROLE = 1
-	ROLE_ADMIN    ROLE = 2
+	ROLE_CUSTOMER  ROLE = 0
+	ROLE_HANDYMAN  ROLE = 1
+	ROLE_ADMIN     ROLE = 2
+	ROLE_SUPPORT   ROLE = 3
+	ROLE_FINANCE   ROLE = 4
+	ROLE_MODERATOR ROLE = 5
 )
 
 // Enum value maps for ROLE.
@@ -34,11 +37,17 @@ var (
 		0: "CUSTOMER",
 		1: "HANDYMAN",
 		2: "ADMIN",
+		3: "SUPPORT",
+		4: "FINANCE",
+		5: "MODERATOR",
 	}
 	ROLE_value = map[string]int32{
-		"CUSTOMER": 0,
-		"HANDYMAN": 1,
-		"ADMIN":    2,
+		"CUSTOMER":  0,
+		"HANDYMAN":  1,
+		"ADMIN":     2,
+		"SUPPORT":   3,
+		"FINANCE":   4,
+		"MODERATOR": 5,
 	}
 )
 
@@ -122,6 +131,9 @@ const (
 	OTP_TYPE_FORGOT_PASSWORD      OTP_TYPE = 1
 	OTP_TYPE_CHANGE_MAIL          OTP_TYPE = 2
 	OTP_TYPE_CHANGE_MAIL_AND_PASS OTP_TYPE = 3
+	OTP_TYPE_VERIFY_PHONE         OTP_TYPE = 4
+	OTP_TYPE_DELETE_ACCOUNT       OTP_TYPE = 5
+	OTP_TYPE_BUSINESS_INVITE      OTP_TYPE = 6
 )
 
 // Enum value maps for OTP_TYPE.
@@ -131,12 +143,18 @@ var (
 		1: "FORGOT_PASSWORD",
 		2: "CHANGE_MAIL",
 		3: "CHANGE_MAIL_AND_PASS",
+		4: "VERIFY_PHONE",
+		5: "DELETE_ACCOUNT",
+		6: "BUSINESS_INVITE",
 	}
 	OTP_TYPE_value = map[string]int32{
 		"REGISTER":             0,
 		"FORGOT_PASSWORD":      1,
 		"CHANGE_MAIL":          2,
 		"CHANGE_MAIL_AND_PASS": 3,
+		"VERIFY_PHONE":         4,
+		"DELETE_ACCOUNT":       5,
+		"BUSINESS_INVITE":      6,
 	}
 )
 
@@ -167,14 +185,243 @@ func (OTP_TYPE) EnumDescriptor() ([]byte, []int) {
 	return file_const_proto_rawDescGZIP(), []int{2}
 }
 
+type OTP_CHANNEL int32
+
+const (
+	OTP_CHANNEL_MAIL OTP_CHANNEL = 0
+	OTP_CHANNEL_SMS  OTP_CHANNEL = 1
+)
+
+// Enum value maps for OTP_CHANNEL.
+var (
+	OTP_CHANNEL_name = map[int32]string{
+		0: "MAIL",
+		1: "SMS",
+	}
+	OTP_CHANNEL_value = map[string]int32{
+		"MAIL": 0,
+		"SMS":  1,
+	}
+)
+
+func (x OTP_CHANNEL) Enum() *OTP_CHANNEL {
+	p := new(OTP_CHANNEL)
+	*p = x
+	return p
+}
+
+func (x OTP_CHANNEL) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OTP_CHANNEL) Descriptor() protoreflect.EnumDescriptor {
+	return file_const_proto_enumTypes[3].Descriptor()
+}
+
+func (OTP_CHANNEL) Type() protoreflect.EnumType {
+	return &file_const_proto_enumTypes[3]
+}
+
+func (x OTP_CHANNEL) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OTP_CHANNEL.Descriptor instead.
+func (OTP_CHANNEL) EnumDescriptor() ([]byte, []int) {
+	return file_const_proto_rawDescGZIP(), []int{3}
+}
+
+type SECURITY_EVENT int32
+
+const (
+	SECURITY_EVENT_CREDENTIAL_FETCH     SECURITY_EVENT = 0
+	SECURITY_EVENT_OTP_VERIFY           SECURITY_EVENT = 1
+	SECURITY_EVENT_MAIL_AND_PASS_CHANGE SECURITY_EVENT = 2
+	SECURITY_EVENT_PASSWORD_RESET       SECURITY_EVENT = 3
+	SECURITY_EVENT_BAN                  SECURITY_EVENT = 4
+	SECURITY_EVENT_UNBAN                SECURITY_EVENT = 5
+	SECURITY_EVENT_DELETION_SCHEDULE    SECURITY_EVENT = 6
+	SECURITY_EVENT_DELETION_CANCEL      SECURITY_EVENT = 7
+	SECURITY_EVENT_API_KEY_CREATE       SECURITY_EVENT = 8
+	SECURITY_EVENT_API_KEY_REVOKE       SECURITY_EVENT = 9
+)
+
+// Enum value maps for SECURITY_EVENT.
+var (
+	SECURITY_EVENT_name = map[int32]string{
+		0: "CREDENTIAL_FETCH",
+		1: "OTP_VERIFY",
+		2: "MAIL_AND_PASS_CHANGE",
+		3: "PASSWORD_RESET",
+		4: "BAN",
+		5: "UNBAN",
+		6: "DELETION_SCHEDULE",
+		7: "DELETION_CANCEL",
+		8: "API_KEY_CREATE",
+		9: "API_KEY_REVOKE",
+	}
+	SECURITY_EVENT_value = map[string]int32{
+		"CREDENTIAL_FETCH":     0,
+		"OTP_VERIFY":           1,
+		"MAIL_AND_PASS_CHANGE": 2,
+		"PASSWORD_RESET":       3,
+		"BAN":                  4,
+		"UNBAN":                5,
+		"DELETION_SCHEDULE":    6,
+		"DELETION_CANCEL":      7,
+		"API_KEY_CREATE":       8,
+		"API_KEY_REVOKE":       9,
+	}
+)
+
+func (x SECURITY_EVENT) Enum() *SECURITY_EVENT {
+	p := new(SECURITY_EVENT)
+	*p = x
+	return p
+}
+
+func (x SECURITY_EVENT) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SECURITY_EVENT) Descriptor() protoreflect.EnumDescriptor {
+	return file_const_proto_enumTypes[4].Descriptor()
+}
+
+func (SECURITY_EVENT) Type() protoreflect.EnumType {
+	return &file_const_proto_enumTypes[4]
+}
+
+func (x SECURITY_EVENT) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SECURITY_EVENT.Descriptor instead.
+func (SECURITY_EVENT) EnumDescriptor() ([]byte, []int) {
+	return file_const_proto_rawDescGZIP(), []int{4}
+}
+
+type SUSPENSION_REASON int32
+
+const (
+	SUSPENSION_REASON_OTHER            SUSPENSION_REASON = 0
+	SUSPENSION_REASON_FRAUD            SUSPENSION_REASON = 1
+	SUSPENSION_REASON_ABUSE            SUSPENSION_REASON = 2
+	SUSPENSION_REASON_SPAM             SUSPENSION_REASON = 3
+	SUSPENSION_REASON_POLICY_VIOLATION SUSPENSION_REASON = 4
+	SUSPENSION_REASON_UNPAID_FEES      SUSPENSION_REASON = 5
+)
+
+// Enum value maps for SUSPENSION_REASON.
+var (
+	SUSPENSION_REASON_name = map[int32]string{
+		0: "OTHER",
+		1: "FRAUD",
+		2: "ABUSE",
+		3: "SPAM",
+		4: "POLICY_VIOLATION",
+		5: "UNPAID_FEES",
+	}
+	SUSPENSION_REASON_value = map[string]int32{
+		"OTHER":            0,
+		"FRAUD":            1,
+		"ABUSE":            2,
+		"SPAM":             3,
+		"POLICY_VIOLATION": 4,
+		"UNPAID_FEES":      5,
+	}
+)
+
+func (x SUSPENSION_REASON) Enum() *SUSPENSION_REASON {
+	p := new(SUSPENSION_REASON)
+	*p = x
+	return p
+}
+
+func (x SUSPENSION_REASON) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SUSPENSION_REASON) Descriptor() protoreflect.EnumDescriptor {
+	return file_const_proto_enumTypes[5].Descriptor()
+}
+
+func (SUSPENSION_REASON) Type() protoreflect.EnumType {
+	return &file_const_proto_enumTypes[5]
+}
+
+func (x SUSPENSION_REASON) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SUSPENSION_REASON.Descriptor instead.
+func (SUSPENSION_REASON) EnumDescriptor() ([]byte, []int) {
+	return file_const_proto_rawDescGZIP(), []int{5}
+}
+
+type BUSINESS_ROLE int32
+
+const (
+	BUSINESS_ROLE_OWNER   BUSINESS_ROLE = 0
+	BUSINESS_ROLE_MANAGER BUSINESS_ROLE = 1
+	BUSINESS_ROLE_STAFF   BUSINESS_ROLE = 2
+)
+
+// Enum value maps for BUSINESS_ROLE.
+var (
+	BUSINESS_ROLE_name = map[int32]string{
+		0: "OWNER",
+		1: "MANAGER",
+		2: "STAFF",
+	}
+	BUSINESS_ROLE_value = map[string]int32{
+		"OWNER":   0,
+		"MANAGER": 1,
+		"STAFF":   2,
+	}
+)
+
+func (x BUSINESS_ROLE) Enum() *BUSINESS_ROLE {
+	p := new(BUSINESS_ROLE)
+	*p = x
+	return p
+}
+
+func (x BUSINESS_ROLE) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BUSINESS_ROLE) Descriptor() protoreflect.EnumDescriptor {
+	return file_const_proto_enumTypes[6].Descriptor()
+}
+
+func (BUSINESS_ROLE) Type() protoreflect.EnumType {
+	return &file_const_proto_enumTypes[6]
+}
+
+func (x BUSINESS_ROLE) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BUSINESS_ROLE.Descriptor instead.
+func (BUSINESS_ROLE) EnumDescriptor() ([]byte, []int) {
+	return file_const_proto_rawDescGZIP(), []int{6}
+}
+
+// ORDER_STATUS is where an order stands. EXPIRED_STATUS orders were left
+// pending past their end date, EXPIRED is taken by ORDER_REASON.
+// AWAITING_CONFIRMATION orders were marked done by one side and wait for the
+// other to confirm or dispute it.
 type ORDER_STATUS int32
 
 const (
-	ORDER_STATUS_PENDING   ORDER_STATUS = 0
-	ORDER_STATUS_CONNECTED ORDER_STATUS = 1
-	ORDER_STATUS_REJECTED  ORDER_STATUS = 2
-	ORDER_STATUS_CANCELED  ORDER_STATUS = 3
-	ORDER_STATUS_COMPLETED ORDER_STATUS = 4
+	ORDER_STATUS_PENDING               ORDER_STATUS = 0
+	ORDER_STATUS_CONNECTED             ORDER_STATUS = 1
+	ORDER_STATUS_REJECTED              ORDER_STATUS = 2
+	ORDER_STATUS_CANCELED              ORDER_STATUS = 3
+	ORDER_STATUS_COMPLETED             ORDER_STATUS = 4
+	ORDER_STATUS_EXPIRED_STATUS        ORDER_STATUS = 5
+	ORDER_STATUS_AWAITING_CONFIRMATION ORDER_STATUS = 6
 )
 
 // Enum value maps for ORDER_STATUS.
@@ -185,13 +432,17 @@ var (
 		2: "REJECTED",
 		3: "CANCELED",
 		4: "COMPLETED",
+		5: "EXPIRED_STATUS",
+		6: "AWAITING_CONFIRMATION",
 	}
 	ORDER_STATUS_value = map[string]int32{
-		"PENDING":   0,
-		"CONNECTED": 1,
-		"REJECTED":  2,
-		"CANCELED":  3,
-		"COMPLETED": 4,
+		"PENDING":               0,
+		"CONNECTED":             1,
+		"REJECTED":              2,
+		"CANCELED":              3,
+		"COMPLETED":             4,
+		"EXPIRED_STATUS":        5,
+		"AWAITING_CONFIRMATION": 6,
 	}
 )
 
@@ -206,11 +457,11 @@ func (x ORDER_STATUS) String() string {
 }
 
 func (ORDER_STATUS) Descriptor() protoreflect.EnumDescriptor {
-	return file_const_proto_enumTypes[3].Descriptor()
+	return file_const_proto_enumTypes[7].Descriptor()
 }
 
 func (ORDER_STATUS) Type() protoreflect.EnumType {
-	return &file_const_proto_enumTypes[3]
+	return &file_const_proto_enumTypes[7]
 }
 
 func (x ORDER_STATUS) Number() protoreflect.EnumNumber {
@@ -219,7 +470,567 @@ func (x ORDER_STATUS) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ORDER_STATUS.Descriptor instead.
 func (ORDER_STATUS) EnumDescriptor() ([]byte, []int) {
-	return file_const_proto_rawDescGZIP(), []int{3}
+	return file_const_proto_rawDescGZIP(), []int{7}
+}
+
+// ORDER_URGENCY is when a customer needs the job done. ON_DATE orders carry
+// a preferred date or time windows.
+type ORDER_URGENCY int32
+
+const (
+	ORDER_URGENCY_FLEXIBLE    ORDER_URGENCY = 0
+	ORDER_URGENCY_ASAP        ORDER_URGENCY = 1
+	ORDER_URGENCY_WITHIN_WEEK ORDER_URGENCY = 2
+	ORDER_URGENCY_ON_DATE     ORDER_URGENCY = 3
+)
+
+// Enum value maps for ORDER_URGENCY.
+var (
+	ORDER_URGENCY_name = map[int32]string{
+		0: "FLEXIBLE",
+		1: "ASAP",
+		2: "WITHIN_WEEK",
+		3: "ON_DATE",
+	}
+	ORDER_URGENCY_value = map[string]int32{
+		"FLEXIBLE":    0,
+		"ASAP":        1,
+		"WITHIN_WEEK": 2,
+		"ON_DATE":     3,
+	}
+)
+
+func (x ORDER_URGENCY) Enum() *ORDER_URGENCY {
+	p := new(ORDER_URGENCY)
+	*p = x
+	return p
+}
+
+func (x ORDER_URGENCY) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ORDER_URGENCY) Descriptor() protoreflect.EnumDescriptor {
+	return file_const_proto_enumTypes[8].Descriptor()
+}
+
+func (ORDER_URGENCY) Type() protoreflect.EnumType {
+	return &file_const_proto_enumTypes[8]
+}
+
+func (x ORDER_URGENCY) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ORDER_URGENCY.Descriptor instead.
+func (ORDER_URGENCY) EnumDescriptor() ([]byte, []int) {
+	return file_const_proto_rawDescGZIP(), []int{8}
+}
+
+// SLOT_PROPOSAL_STATUS is the answer of a customer to the slot a handyman
+// proposed instead of the requested schedule.
+type SLOT_PROPOSAL_STATUS int32
+
+const (
+	SLOT_PROPOSAL_STATUS_PROPOSED SLOT_PROPOSAL_STATUS = 0
+	SLOT_PROPOSAL_STATUS_ACCEPTED SLOT_PROPOSAL_STATUS = 1
+	SLOT_PROPOSAL_STATUS_DECLINED SLOT_PROPOSAL_STATUS = 2
+)
+
+// Enum value maps for SLOT_PROPOSAL_STATUS.
+var (
+	SLOT_PROPOSAL_STATUS_name = map[int32]string{
+		0: "PROPOSED",
+		1: "ACCEPTED",
+		2: "DECLINED",
+	}
+	SLOT_PROPOSAL_STATUS_value = map[string]int32{
+		"PROPOSED": 0,
+		"ACCEPTED": 1,
+		"DECLINED": 2,
+	}
+)
+
+func (x SLOT_PROPOSAL_STATUS) Enum() *SLOT_PROPOSAL_STATUS {
+	p := new(SLOT_PROPOSAL_STATUS)
+	*p = x
+	return p
+}
+
+func (x SLOT_PROPOSAL_STATUS) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SLOT_PROPOSAL_STATUS) Descriptor() protoreflect.EnumDescriptor {
+	return file_const_proto_enumTypes[9].Descriptor()
+}
+
+func (SLOT_PROPOSAL_STATUS) Type() protoreflect.EnumType {
+	return &file_const_proto_enumTypes[9]
+}
+
+func (x SLOT_PROPOSAL_STATUS) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SLOT_PROPOSAL_STATUS.Descriptor instead.
+func (SLOT_PROPOSAL_STATUS) EnumDescriptor() ([]byte, []int) {
+	return file_const_proto_rawDescGZIP(), []int{9}
+}
+
+// QUESTION_TYPE is the kind of answer a category question takes. CHOICE
+// answers are one of the options of the question, PHOTOS answers are urls.
+type QUESTION_TYPE int32
+
+const (
+	QUESTION_TYPE_TEXT   QUESTION_TYPE = 0
+	QUESTION_TYPE_YES_NO QUESTION_TYPE = 1
+	QUESTION_TYPE_CHOICE QUESTION_TYPE = 2
+	QUESTION_TYPE_NUMBER QUESTION_TYPE = 3
+	QUESTION_TYPE_PHOTOS QUESTION_TYPE = 4
+)
+
+// Enum value maps for QUESTION_TYPE.
+var (
+	QUESTION_TYPE_name = map[int32]string{
+		0: "TEXT",
+		1: "YES_NO",
+		2: "CHOICE",
+		3: "NUMBER",
+		4: "PHOTOS",
+	}
+	QUESTION_TYPE_value = map[string]int32{
+		"TEXT":   0,
+		"YES_NO": 1,
+		"CHOICE": 2,
+		"NUMBER": 3,
+		"PHOTOS": 4,
+	}
+)
+
+func (x QUESTION_TYPE) Enum() *QUESTION_TYPE {
+	p := new(QUESTION_TYPE)
+	*p = x
+	return p
+}
+
+func (x QUESTION_TYPE) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (QUESTION_TYPE) Descriptor() protoreflect.EnumDescriptor {
+	return file_const_proto_enumTypes[10].Descriptor()
+}
+
+func (QUESTION_TYPE) Type() protoreflect.EnumType {
+	return &file_const_proto_enumTypes[10]
+}
+
+func (x QUESTION_TYPE) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use QUESTION_TYPE.Descriptor instead.
+func (QUESTION_TYPE) EnumDescriptor() ([]byte, []int) {
+	return file_const_proto_rawDescGZIP(), []int{10}
+}
+
+// QUOTE_STATUS is where the quote of a handyman stands. Open quotes lapse
+// when their validity ends and are lost when the customer accepts another one.
+type QUOTE_STATUS int32
+
+const (
+	QUOTE_STATUS_OPEN   QUOTE_STATUS = 0
+	QUOTE_STATUS_WON    QUOTE_STATUS = 1
+	QUOTE_STATUS_LOST   QUOTE_STATUS = 2
+	QUOTE_STATUS_LAPSED QUOTE_STATUS = 3
+)
+
+// Enum value maps for QUOTE_STATUS.
+var (
+	QUOTE_STATUS_name = map[int32]string{
+		0: "OPEN",
+		1: "WON",
+		2: "LOST",
+		3: "LAPSED",
+	}
+	QUOTE_STATUS_value = map[string]int32{
+		"OPEN":   0,
+		"WON":    1,
+		"LOST":   2,
+		"LAPSED": 3,
+	}
+)
+
+func (x QUOTE_STATUS) Enum() *QUOTE_STATUS {
+	p := new(QUOTE_STATUS)
+	*p = x
+	return p
+}
+
+func (x QUOTE_STATUS) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (QUOTE_STATUS) Descriptor() protoreflect.EnumDescriptor {
+	return file_const_proto_enumTypes[11].Descriptor()
+}
+
+func (QUOTE_STATUS) Type() protoreflect.EnumType {
+	return &file_const_proto_enumTypes[11]
+}
+
+func (x QUOTE_STATUS) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use QUOTE_STATUS.Descriptor instead.
+func (QUOTE_STATUS) EnumDescriptor() ([]byte, []int) {
+	return file_const_proto_rawDescGZIP(), []int{11}
+}
+
+// UPLOAD_PURPOSE is what a file is uploaded for. IMAGE files are public,
+// ORDER_ATTACHMENT files, attached to orders or to disputes, are only read
+// through short-lived links.
+type UPLOAD_PURPOSE int32
+
+const (
+	UPLOAD_PURPOSE_IMAGE            UPLOAD_PURPOSE = 0
+	UPLOAD_PURPOSE_ORDER_ATTACHMENT UPLOAD_PURPOSE = 1
+)
+
+// Enum value maps for UPLOAD_PURPOSE.
+var (
+	UPLOAD_PURPOSE_name = map[int32]string{
+		0: "IMAGE",
+		1: "ORDER_ATTACHMENT",
+	}
+	UPLOAD_PURPOSE_value = map[string]int32{
+		"IMAGE":            0,
+		"ORDER_ATTACHMENT": 1,
+	}
+)
+
+func (x UPLOAD_PURPOSE) Enum() *UPLOAD_PURPOSE {
+	p := new(UPLOAD_PURPOSE)
+	*p = x
+	return p
+}
+
+func (x UPLOAD_PURPOSE) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UPLOAD_PURPOSE) Descriptor() protoreflect.EnumDescriptor {
+	return file_const_proto_enumTypes[12].Descriptor()
+}
+
+func (UPLOAD_PURPOSE) Type() protoreflect.EnumType {
+	return &file_const_proto_enumTypes[12]
+}
+
+func (x UPLOAD_PURPOSE) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UPLOAD_PURPOSE.Descriptor instead.
+func (UPLOAD_PURPOSE) EnumDescriptor() ([]byte, []int) {
+	return file_const_proto_rawDescGZIP(), []int{12}
+}
+
+// DISPUTE_STATUS is where the dispute of a connection fee is in its review.
+type DISPUTE_STATUS int32
+
+const (
+	DISPUTE_STATUS_UNDER_REVIEW DISPUTE_STATUS = 0
+	DISPUTE_STATUS_APPROVED     DISPUTE_STATUS = 1
+	DISPUTE_STATUS_DENIED       DISPUTE_STATUS = 2
+)
+
+// Enum value maps for DISPUTE_STATUS.
+var (
+	DISPUTE_STATUS_name = map[int32]string{
+		0: "UNDER_REVIEW",
+		1: "APPROVED",
+		2: "DENIED",
+	}
+	DISPUTE_STATUS_value = map[string]int32{
+		"UNDER_REVIEW": 0,
+		"APPROVED":     1,
+		"DENIED":       2,
+	}
+)
+
+func (x DISPUTE_STATUS) Enum() *DISPUTE_STATUS {
+	p := new(DISPUTE_STATUS)
+	*p = x
+	return p
+}
+
+func (x DISPUTE_STATUS) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DISPUTE_STATUS) Descriptor() protoreflect.EnumDescriptor {
+	return file_const_proto_enumTypes[13].Descriptor()
+}
+
+func (DISPUTE_STATUS) Type() protoreflect.EnumType {
+	return &file_const_proto_enumTypes[13]
+}
+
+func (x DISPUTE_STATUS) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DISPUTE_STATUS.Descriptor instead.
+func (DISPUTE_STATUS) EnumDescriptor() ([]byte, []int) {
+	return file_const_proto_rawDescGZIP(), []int{13}
+}
+
+// DISPUTE_REASON is why a handyman disputes the fee of a connected order.
+type DISPUTE_REASON int32
+
+const (
+	DISPUTE_REASON_SPAM_REQUEST         DISPUTE_REASON = 0
+	DISPUTE_REASON_UNREACHABLE_CUSTOMER DISPUTE_REASON = 1
+	DISPUTE_REASON_OTHER_DISPUTE_REASON DISPUTE_REASON = 2
+)
+
+// Enum value maps for DISPUTE_REASON.
+var (
+	DISPUTE_REASON_name = map[int32]string{
+		0: "SPAM_REQUEST",
+		1: "UNREACHABLE_CUSTOMER",
+		2: "OTHER_DISPUTE_REASON",
+	}
+	DISPUTE_REASON_value = map[string]int32{
+		"SPAM_REQUEST":         0,
+		"UNREACHABLE_CUSTOMER": 1,
+		"OTHER_DISPUTE_REASON": 2,
+	}
+)
+
+func (x DISPUTE_REASON) Enum() *DISPUTE_REASON {
+	p := new(DISPUTE_REASON)
+	*p = x
+	return p
+}
+
+func (x DISPUTE_REASON) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DISPUTE_REASON) Descriptor() protoreflect.EnumDescriptor {
+	return file_const_proto_enumTypes[14].Descriptor()
+}
+
+func (DISPUTE_REASON) Type() protoreflect.EnumType {
+	return &file_const_proto_enumTypes[14]
+}
+
+func (x DISPUTE_REASON) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DISPUTE_REASON.Descriptor instead.
+func (DISPUTE_REASON) EnumDescriptor() ([]byte, []int) {
+	return file_const_proto_rawDescGZIP(), []int{14}
+}
+
+// DISPUTE_RESOLUTION is how an approved dispute gave the fee back. A fee not
+// billed yet is WAIVED, a billed one REFUNDED and a free contact CREDITED
+// back to the business.
+type DISPUTE_RESOLUTION int32
+
+const (
+	DISPUTE_RESOLUTION_NOT_RESOLVED DISPUTE_RESOLUTION = 0
+	DISPUTE_RESOLUTION_WAIVED       DISPUTE_RESOLUTION = 1
+	DISPUTE_RESOLUTION_REFUNDED     DISPUTE_RESOLUTION = 2
+	DISPUTE_RESOLUTION_CREDITED     DISPUTE_RESOLUTION = 3
+)
+
+// Enum value maps for DISPUTE_RESOLUTION.
+var (
+	DISPUTE_RESOLUTION_name = map[int32]string{
+		0: "NOT_RESOLVED",
+		1: "WAIVED",
+		2: "REFUNDED",
+		3: "CREDITED",
+	}
+	DISPUTE_RESOLUTION_value = map[string]int32{
+		"NOT_RESOLVED": 0,
+		"WAIVED":       1,
+		"REFUNDED":     2,
+		"CREDITED":     3,
+	}
+)
+
+func (x DISPUTE_RESOLUTION) Enum() *DISPUTE_RESOLUTION {
+	p := new(DISPUTE_RESOLUTION)
+	*p = x
+	return p
+}
+
+func (x DISPUTE_RESOLUTION) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DISPUTE_RESOLUTION) Descriptor() protoreflect.EnumDescriptor {
+	return file_const_proto_enumTypes[15].Descriptor()
+}
+
+func (DISPUTE_RESOLUTION) Type() protoreflect.EnumType {
+	return &file_const_proto_enumTypes[15]
+}
+
+func (x DISPUTE_RESOLUTION) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DISPUTE_RESOLUTION.Descriptor instead.
+func (DISPUTE_RESOLUTION) EnumDescriptor() ([]byte, []int) {
+	return file_const_proto_rawDescGZIP(), []int{15}
+}
+
+// ORDER_REASON is why an order changed status. Handymen must give one when
+// they reject an order. Reasons after OTHER_REASON are only recorded by the
+// platform.
+type ORDER_REASON int32
+
+const (
+	ORDER_REASON_NO_REASON             ORDER_REASON = 0
+	ORDER_REASON_OUTSIDE_SERVICE_AREA  ORDER_REASON = 1
+	ORDER_REASON_FULLY_BOOKED          ORDER_REASON = 2
+	ORDER_REASON_SERVICE_NOT_OFFERED   ORDER_REASON = 3
+	ORDER_REASON_CUSTOMER_UNRESPONSIVE ORDER_REASON = 4
+	ORDER_REASON_CHANGED_MIND          ORDER_REASON = 5
+	ORDER_REASON_HIRED_ELSEWHERE       ORDER_REASON = 6
+	ORDER_REASON_OTHER_REASON          ORDER_REASON = 7
+	ORDER_REASON_EXPIRED               ORDER_REASON = 8
+	ORDER_REASON_SUSPENDED             ORDER_REASON = 9
+	ORDER_REASON_ACCOUNT_DELETED       ORDER_REASON = 10
+	ORDER_REASON_QUOTE_ACCEPTED        ORDER_REASON = 11
+	ORDER_REASON_COMPLETION_DISPUTED   ORDER_REASON = 12
+	ORDER_REASON_CONFIRMATION_TIMEOUT  ORDER_REASON = 13
+)
+
+// Enum value maps for ORDER_REASON.
+var (
+	ORDER_REASON_name = map[int32]string{
+		0:  "NO_REASON",
+		1:  "OUTSIDE_SERVICE_AREA",
+		2:  "FULLY_BOOKED",
+		3:  "SERVICE_NOT_OFFERED",
+		4:  "CUSTOMER_UNRESPONSIVE",
+		5:  "CHANGED_MIND",
+		6:  "HIRED_ELSEWHERE",
+		7:  "OTHER_REASON",
+		8:  "EXPIRED",
+		9:  "SUSPENDED",
+		10: "ACCOUNT_DELETED",
+		11: "QUOTE_ACCEPTED",
+		12: "COMPLETION_DISPUTED",
+		13: "CONFIRMATION_TIMEOUT",
+	}
+	ORDER_REASON_value = map[string]int32{
+		"NO_REASON":             0,
+		"OUTSIDE_SERVICE_AREA":  1,
+		"FULLY_BOOKED":          2,
+		"SERVICE_NOT_OFFERED":   3,
+		"CUSTOMER_UNRESPONSIVE": 4,
+		"CHANGED_MIND":          5,
+		"HIRED_ELSEWHERE":       6,
+		"OTHER_REASON":          7,
+		"EXPIRED":               8,
+		"SUSPENDED":             9,
+		"ACCOUNT_DELETED":       10,
+		"QUOTE_ACCEPTED":        11,
+		"COMPLETION_DISPUTED":   12,
+		"CONFIRMATION_TIMEOUT":  13,
+	}
+)
+
+func (x ORDER_REASON) Enum() *ORDER_REASON {
+	p := new(ORDER_REASON)
+	*p = x
+	return p
+}
+
+func (x ORDER_REASON) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ORDER_REASON) Descriptor() protoreflect.EnumDescriptor {
+	return file_const_proto_enumTypes[16].Descriptor()
+}
+
+func (ORDER_REASON) Type() protoreflect.EnumType {
+	return &file_const_proto_enumTypes[16]
+}
+
+func (x ORDER_REASON) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ORDER_REASON.Descriptor instead.
+func (ORDER_REASON) EnumDescriptor() ([]byte, []int) {
+	return file_const_proto_rawDescGZIP(), []int{16}
+}
+
+// COMPLETION_CONFIRMATION is how the completion of a job was settled, by the
+// side that did not mark it done or AUTO_CONFIRMED when nobody answered in
+// time.
+type COMPLETION_CONFIRMATION int32
+
+const (
+	COMPLETION_CONFIRMATION_UNCONFIRMED           COMPLETION_CONFIRMATION = 0
+	COMPLETION_CONFIRMATION_CONFIRMED_BY_CUSTOMER COMPLETION_CONFIRMATION = 1
+	COMPLETION_CONFIRMATION_CONFIRMED_BY_HANDYMAN COMPLETION_CONFIRMATION = 2
+	COMPLETION_CONFIRMATION_AUTO_CONFIRMED        COMPLETION_CONFIRMATION = 3
+)
+
+// Enum value maps for COMPLETION_CONFIRMATION.
+var (
+	COMPLETION_CONFIRMATION_name = map[int32]string{
+		0: "UNCONFIRMED",
+		1: "CONFIRMED_BY_CUSTOMER",
+		2: "CONFIRMED_BY_HANDYMAN",
+		3: "AUTO_CONFIRMED",
+	}
+	COMPLETION_CONFIRMATION_value = map[string]int32{
+		"UNCONFIRMED":           0,
+		"CONFIRMED_BY_CUSTOMER": 1,
+		"CONFIRMED_BY_HANDYMAN": 2,
+		"AUTO_CONFIRMED":        3,
+	}
+)
+
+func (x COMPLETION_CONFIRMATION) Enum() *COMPLETION_CONFIRMATION {
+	p := new(COMPLETION_CONFIRMATION)
+	*p = x
+	return p
+}
+
+func (x COMPLETION_CONFIRMATION) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (COMPLETION_CONFIRMATION) Descriptor() protoreflect.EnumDescriptor {
+	return file_const_proto_enumTypes[17].Descriptor()
+}
+
+func (COMPLETION_CONFIRMATION) Type() protoreflect.EnumType {
+	return &file_const_proto_enumTypes[17]
+}
+
+func (x COMPLETION_CONFIRMATION) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use COMPLETION_CONFIRMATION.Descriptor instead.
+func (COMPLETION_CONFIRMATION) EnumDescriptor() ([]byte, []int) {
+	return file_const_proto_rawDescGZIP(), []int{17}
 }
 
 type ACCOUNT_STATUS int32
@@ -252,11 +1063,11 @@ func (x ACCOUNT_STATUS) String() string {
 }
 
 func (ACCOUNT_STATUS) Descriptor() protoreflect.EnumDescriptor {
-	return file_const_proto_enumTypes[4].Descriptor()
+	return file_const_proto_enumTypes[18].Descriptor()
 }
 
 func (ACCOUNT_STATUS) Type() protoreflect.EnumType {
-	return &file_const_proto_enumTypes[4]
+	return &file_const_proto_enumTypes[18]
 }
 
 func (x ACCOUNT_STATUS) Number() protoreflect.EnumNumber {
@@ -265,7 +1076,7 @@ func (x ACCOUNT_STATUS) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ACCOUNT_STATUS.Descriptor instead.
 func (ACCOUNT_STATUS) EnumDescriptor() ([]byte, []int) {
-	return file_const_proto_rawDescGZIP(), []int{4}
+	return file_const_proto_rawDescGZIP(), []int{18}
 }
 
 type SORT_QUERY int32
@@ -301,11 +1112,11 @@ func (x SORT_QUERY) String() string {
 }
 
 func (SORT_QUERY) Descriptor() protoreflect.EnumDescriptor {
-	return file_const_proto_enumTypes[5].Descriptor()
+	return file_const_proto_enumTypes[19].Descriptor()
 }
 
 func (SORT_QUERY) Type() protoreflect.EnumType {
-	return &file_const_proto_enumTypes[5]
+	return &file_const_proto_enumTypes[19]
 }
 
 func (x SORT_QUERY) Number() protoreflect.EnumNumber {
@@ -314,7 +1125,7 @@ func (x SORT_QUERY) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use SORT_QUERY.Descriptor instead.
 func (SORT_QUERY) EnumDescriptor() ([]byte, []int) {
-	return file_const_proto_rawDescGZIP(), []int{5}
+	return file_const_proto_rawDescGZIP(), []int{19}
 }
 
 type QUERY_CATEGORY_ADMIN int32
@@ -350,11 +1161,11 @@ func (x QUERY_CATEGORY_ADMIN) String() string {
 }
 
 func (QUERY_CATEGORY_ADMIN) Descriptor() protoreflect.EnumDescriptor {
-	return file_const_proto_enumTypes[6].Descriptor()
+	return file_const_proto_enumTypes[20].Descriptor()
 }
 
 func (QUERY_CATEGORY_ADMIN) Type() protoreflect.EnumType {
-	return &file_const_proto_enumTypes[6]
+	return &file_const_proto_enumTypes[20]
 }
 
 func (x QUERY_CATEGORY_ADMIN) Number() protoreflect.EnumNumber {
@@ -363,7 +1174,7 @@ func (x QUERY_CATEGORY_ADMIN) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use QUERY_CATEGORY_ADMIN.Descriptor instead.
 func (QUERY_CATEGORY_ADMIN) EnumDescriptor() ([]byte, []int) {
-	return file_const_proto_rawDescGZIP(), []int{6}
+	return file_const_proto_rawDescGZIP(), []int{20}
 }
 
 type REGISTRATION_PROCESS int32
@@ -402,11 +1213,11 @@ func (x REGISTRATION_PROCESS) String() string {
 }
 
 func (REGISTRATION_PROCESS) Descriptor() protoreflect.EnumDescriptor {
-	return file_const_proto_enumTypes[7].Descriptor()
+	return file_const_proto_enumTypes[21].Descriptor()
 }
 
 func (REGISTRATION_PROCESS) Type() protoreflect.EnumType {
-	return &file_const_proto_enumTypes[7]
+	return &file_const_proto_enumTypes[21]
 }
 
 func (x REGISTRATION_PROCESS) Number() protoreflect.EnumNumber {
@@ -415,7 +1226,7 @@ func (x REGISTRATION_PROCESS) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use REGISTRATION_PROCESS.Descriptor instead.
 func (REGISTRATION_PROCESS) EnumDescriptor() ([]byte, []int) {
-	return file_const_proto_rawDescGZIP(), []int{7}
+	return file_const_proto_rawDescGZIP(), []int{21}
 }
 
 type SORT_TRANSACTION int32
@@ -460,11 +1271,11 @@ func (x SORT_TRANSACTION) String() string {
 }
 
 func (SORT_TRANSACTION) Descriptor() protoreflect.EnumDescriptor {
-	return file_const_proto_enumTypes[8].Descriptor()
+	return file_const_proto_enumTypes[22].Descriptor()
 }
 
 func (SORT_TRANSACTION) Type() protoreflect.EnumType {
-	return &file_const_proto_enumTypes[8]
+	return &file_const_proto_enumTypes[22]
 }
 
 func (x SORT_TRANSACTION) Number() protoreflect.EnumNumber {
@@ -473,7 +1284,7 @@ func (x SORT_TRANSACTION) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use SORT_TRANSACTION.Descriptor instead.
 func (SORT_TRANSACTION) EnumDescriptor() ([]byte, []int) {
-	return file_const_proto_rawDescGZIP(), []int{8}
+	return file_const_proto_rawDescGZIP(), []int{22}
 }
 
 type STATUS_VERIFY_REFERRAL_CODE int32
@@ -512,11 +1323,11 @@ func (x STATUS_VERIFY_REFERRAL_CODE) String() string {
 }
 
 func (STATUS_VERIFY_REFERRAL_CODE) Descriptor() protoreflect.EnumDescriptor {
-	return file_const_proto_enumTypes[9].Descriptor()
+	return file_const_proto_enumTypes[23].Descriptor()
 }
 
 func (STATUS_VERIFY_REFERRAL_CODE) Type() protoreflect.EnumType {
-	return &file_const_proto_enumTypes[9]
+	return &file_const_proto_enumTypes[23]
 }
 
 func (x STATUS_VERIFY_REFERRAL_CODE) Number() protoreflect.EnumNumber {
@@ -525,59 +1336,154 @@ func (x STATUS_VERIFY_REFERRAL_CODE) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use STATUS_VERIFY_REFERRAL_CODE.Descriptor instead.
 func (STATUS_VERIFY_REFERRAL_CODE) EnumDescriptor() ([]byte, []int) {
-	return file_const_proto_rawDescGZIP(), []int{9}
+	return file_const_proto_rawDescGZIP(), []int{23}
 }
 
 var File_const_proto protoreflect.FileDescriptor
 
 var file_const_proto_rawDesc = []byte{
 	0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x63,
-	0x6f, 0x6e, 0x73, 0x74, 0x2a, 0x2d, 0x0a, 0x04, 0x52, 0x4f, 0x4c, 0x45, 0x12, 0x0c, 0x0a, 0x08,
+	0x6f, 0x6e, 0x73, 0x74, 0x2a, 0x56, 0x0a, 0x04, 0x52, 0x4f, 0x4c, 0x45, 0x12, 0x0c, 0x0a, 0x08,
 	0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x45, 0x52, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x48, 0x41,
 	0x4e, 0x44, 0x59, 0x4d, 0x41, 0x4e, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x41, 0x44, 0x4d, 0x49,
-	0x4e, 0x10, 0x02, 0x2a, 0x3a, 0x0a, 0x0e, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x53,
-	0x54, 0x41, 0x54, 0x55, 0x53, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45,
-	0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x53, 0x45, 0x52,
-	0x56, 0x49, 0x43, 0x45, 0x5f, 0x49, 0x4e, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x01, 0x2a,
-	0x58, 0x0a, 0x08, 0x4f, 0x54, 0x50, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x12, 0x0c, 0x0a, 0x08, 0x52,
-	0x45, 0x47, 0x49, 0x53, 0x54, 0x45, 0x52, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x46, 0x4f, 0x52,
-	0x47, 0x4f, 0x54, 0x5f, 0x50, 0x41, 0x53, 0x53, 0x57, 0x4f, 0x52, 0x44, 0x10, 0x01, 0x12, 0x0f,
-	0x0a, 0x0b, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x4d, 0x41, 0x49, 0x4c, 0x10, 0x02, 0x12,
-	0x18, 0x0a, 0x14, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x4d, 0x41, 0x49, 0x4c, 0x5f, 0x41,
-	0x4e, 0x44, 0x5f, 0x50, 0x41, 0x53, 0x53, 0x10, 0x03, 0x2a, 0x55, 0x0a, 0x0c, 0x4f, 0x52, 0x44,
-	0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x45, 0x4e,
-	0x44, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43,
-	0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x45,
-	0x44, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x45, 0x44, 0x10,
-	0x03, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x04,
-	0x2a, 0x2a, 0x0a, 0x0e, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54,
-	0x55, 0x53, 0x12, 0x0a, 0x0a, 0x06, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x00, 0x12, 0x0c,
-	0x0a, 0x08, 0x49, 0x4e, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x01, 0x2a, 0x32, 0x0a, 0x0a,
-	0x53, 0x4f, 0x52, 0x54, 0x5f, 0x51, 0x55, 0x45, 0x52, 0x59, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x45,
-	0x46, 0x41, 0x55, 0x4c, 0x54, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x45, 0x51, 0x55, 0x45,
-	0x53, 0x54, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x45, 0x56, 0x49, 0x45, 0x57, 0x10, 0x02,
-	0x2a, 0x38, 0x0a, 0x14, 0x51, 0x55, 0x45, 0x52, 0x59, 0x5f, 0x43, 0x41, 0x54, 0x45, 0x47, 0x4f,
-	0x52, 0x59, 0x5f, 0x41, 0x44, 0x4d, 0x49, 0x4e, 0x12, 0x06, 0x0a, 0x02, 0x4e, 0x4f, 0x10, 0x00,
-	0x12, 0x0d, 0x0a, 0x09, 0x41, 0x44, 0x56, 0x45, 0x52, 0x54, 0x49, 0x53, 0x45, 0x10, 0x01, 0x12,
-	0x09, 0x0a, 0x05, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x10, 0x02, 0x2a, 0x44, 0x0a, 0x14, 0x52, 0x45,
-	0x47, 0x49, 0x53, 0x54, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x43, 0x45,
-	0x53, 0x53, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06,
-	0x53, 0x54, 0x45, 0x50, 0x5f, 0x31, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x54, 0x45, 0x50,
-	0x5f, 0x32, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x54, 0x45, 0x50, 0x5f, 0x33, 0x10, 0x03,
-	0x2a, 0x74, 0x0a, 0x10, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41, 0x43,
-	0x54, 0x49, 0x4f, 0x4e, 0x12, 0x0d, 0x0a, 0x09, 0x54, 0x48, 0x49, 0x53, 0x5f, 0x57, 0x45, 0x45,
-	0x4b, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x4c, 0x41, 0x53, 0x54, 0x5f, 0x31, 0x5f, 0x57, 0x45,
-	0x45, 0x4b, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x4c, 0x41, 0x53, 0x54, 0x5f, 0x32, 0x5f, 0x57,
-	0x45, 0x45, 0x4b, 0x53, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x4c, 0x41, 0x53, 0x54, 0x5f, 0x33,
-	0x5f, 0x57, 0x45, 0x45, 0x4b, 0x53, 0x10, 0x03, 0x12, 0x0e, 0x0a, 0x0a, 0x4c, 0x41, 0x53, 0x54,
-	0x5f, 0x4d, 0x4f, 0x4e, 0x54, 0x48, 0x10, 0x04, 0x12, 0x0c, 0x0a, 0x08, 0x41, 0x4c, 0x4c, 0x5f,
-	0x54, 0x49, 0x4d, 0x45, 0x10, 0x05, 0x2a, 0x4c, 0x0a, 0x1b, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
-	0x5f, 0x56, 0x45, 0x52, 0x49, 0x46, 0x59, 0x5f, 0x52, 0x45, 0x46, 0x45, 0x52, 0x52, 0x41, 0x4c,
-	0x5f, 0x43, 0x4f, 0x44, 0x45, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12,
-	0x0d, 0x0a, 0x09, 0x56, 0x45, 0x52, 0x49, 0x46, 0x59, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x08,
-	0x0a, 0x04, 0x44, 0x45, 0x4e, 0x59, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x41, 0x43, 0x43, 0x45,
-	0x50, 0x54, 0x10, 0x03, 0x42, 0x05, 0x5a, 0x03, 0x2e, 0x2f, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x4e, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x55, 0x50, 0x50, 0x4f, 0x52, 0x54, 0x10, 0x03,
+	0x12, 0x0b, 0x0a, 0x07, 0x46, 0x49, 0x4e, 0x41, 0x4e, 0x43, 0x45, 0x10, 0x04, 0x12, 0x0d, 0x0a,
+	0x09, 0x4d, 0x4f, 0x44, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x10, 0x05, 0x2a, 0x3a, 0x0a, 0x0e,
+	0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x12, 0x12,
+	0x0a, 0x0e, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45,
+	0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x49, 0x4e,
+	0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x01, 0x2a, 0x93, 0x01, 0x0a, 0x08, 0x4f, 0x54, 0x50,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45, 0x47, 0x49, 0x53, 0x54, 0x45,
+	0x52, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x46, 0x4f, 0x52, 0x47, 0x4f, 0x54, 0x5f, 0x50, 0x41,
+	0x53, 0x53, 0x57, 0x4f, 0x52, 0x44, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x43, 0x48, 0x41, 0x4e,
+	0x47, 0x45, 0x5f, 0x4d, 0x41, 0x49, 0x4c, 0x10, 0x02, 0x12, 0x18, 0x0a, 0x14, 0x43, 0x48, 0x41,
+	0x4e, 0x47, 0x45, 0x5f, 0x4d, 0x41, 0x49, 0x4c, 0x5f, 0x41, 0x4e, 0x44, 0x5f, 0x50, 0x41, 0x53,
+	0x53, 0x10, 0x03, 0x12, 0x10, 0x0a, 0x0c, 0x56, 0x45, 0x52, 0x49, 0x46, 0x59, 0x5f, 0x50, 0x48,
+	0x4f, 0x4e, 0x45, 0x10, 0x04, 0x12, 0x12, 0x0a, 0x0e, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x5f,
+	0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0x05, 0x12, 0x13, 0x0a, 0x0f, 0x42, 0x55, 0x53,
+	0x49, 0x4e, 0x45, 0x53, 0x53, 0x5f, 0x49, 0x4e, 0x56, 0x49, 0x54, 0x45, 0x10, 0x06, 0x2a, 0x20,
+	0x0a, 0x0b, 0x4f, 0x54, 0x50, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x12, 0x08, 0x0a,
+	0x04, 0x4d, 0x41, 0x49, 0x4c, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x53, 0x4d, 0x53, 0x10, 0x01,
+	0x2a, 0xcc, 0x01, 0x0a, 0x0e, 0x53, 0x45, 0x43, 0x55, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x12, 0x14, 0x0a, 0x10, 0x43, 0x52, 0x45, 0x44, 0x45, 0x4e, 0x54, 0x49, 0x41,
+	0x4c, 0x5f, 0x46, 0x45, 0x54, 0x43, 0x48, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x4f, 0x54, 0x50,
+	0x5f, 0x56, 0x45, 0x52, 0x49, 0x46, 0x59, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x4d, 0x41, 0x49,
+	0x4c, 0x5f, 0x41, 0x4e, 0x44, 0x5f, 0x50, 0x41, 0x53, 0x53, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47,
+	0x45, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x41, 0x53, 0x53, 0x57, 0x4f, 0x52, 0x44, 0x5f,
+	0x52, 0x45, 0x53, 0x45, 0x54, 0x10, 0x03, 0x12, 0x07, 0x0a, 0x03, 0x42, 0x41, 0x4e, 0x10, 0x04,
+	0x12, 0x09, 0x0a, 0x05, 0x55, 0x4e, 0x42, 0x41, 0x4e, 0x10, 0x05, 0x12, 0x15, 0x0a, 0x11, 0x44,
+	0x45, 0x4c, 0x45, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45,
+	0x10, 0x06, 0x12, 0x13, 0x0a, 0x0f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43,
+	0x41, 0x4e, 0x43, 0x45, 0x4c, 0x10, 0x07, 0x12, 0x12, 0x0a, 0x0e, 0x41, 0x50, 0x49, 0x5f, 0x4b,
+	0x45, 0x59, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x10, 0x08, 0x12, 0x12, 0x0a, 0x0e, 0x41,
+	0x50, 0x49, 0x5f, 0x4b, 0x45, 0x59, 0x5f, 0x52, 0x45, 0x56, 0x4f, 0x4b, 0x45, 0x10, 0x09, 0x2a,
+	0x65, 0x0a, 0x11, 0x53, 0x55, 0x53, 0x50, 0x45, 0x4e, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45,
+	0x41, 0x53, 0x4f, 0x4e, 0x12, 0x09, 0x0a, 0x05, 0x4f, 0x54, 0x48, 0x45, 0x52, 0x10, 0x00, 0x12,
+	0x09, 0x0a, 0x05, 0x46, 0x52, 0x41, 0x55, 0x44, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x41, 0x42,
+	0x55, 0x53, 0x45, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x50, 0x41, 0x4d, 0x10, 0x03, 0x12,
+	0x14, 0x0a, 0x10, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x56, 0x49, 0x4f, 0x4c, 0x41, 0x54,
+	0x49, 0x4f, 0x4e, 0x10, 0x04, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x50, 0x41, 0x49, 0x44, 0x5f,
+	0x46, 0x45, 0x45, 0x53, 0x10, 0x05, 0x2a, 0x32, 0x0a, 0x0d, 0x42, 0x55, 0x53, 0x49, 0x4e, 0x45,
+	0x53, 0x53, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x12, 0x09, 0x0a, 0x05, 0x4f, 0x57, 0x4e, 0x45, 0x52,
+	0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x4d, 0x41, 0x4e, 0x41, 0x47, 0x45, 0x52, 0x10, 0x01, 0x12,
+	0x09, 0x0a, 0x05, 0x53, 0x54, 0x41, 0x46, 0x46, 0x10, 0x02, 0x2a, 0x84, 0x01, 0x0a, 0x0c, 0x4f,
+	0x52, 0x44, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x12, 0x0b, 0x0a, 0x07, 0x50,
+	0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x4f, 0x4e, 0x4e,
+	0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45, 0x4a, 0x45, 0x43,
+	0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x45,
+	0x44, 0x10, 0x03, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x44,
+	0x10, 0x04, 0x12, 0x12, 0x0a, 0x0e, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x10, 0x05, 0x12, 0x19, 0x0a, 0x15, 0x41, 0x57, 0x41, 0x49, 0x54, 0x49,
+	0x4e, 0x47, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x52, 0x4d, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10,
+	0x06, 0x2a, 0x45, 0x0a, 0x0d, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x55, 0x52, 0x47, 0x45, 0x4e,
+	0x43, 0x59, 0x12, 0x0c, 0x0a, 0x08, 0x46, 0x4c, 0x45, 0x58, 0x49, 0x42, 0x4c, 0x45, 0x10, 0x00,
+	0x12, 0x08, 0x0a, 0x04, 0x41, 0x53, 0x41, 0x50, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x57, 0x49,
+	0x54, 0x48, 0x49, 0x4e, 0x5f, 0x57, 0x45, 0x45, 0x4b, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x4f,
+	0x4e, 0x5f, 0x44, 0x41, 0x54, 0x45, 0x10, 0x03, 0x2a, 0x40, 0x0a, 0x14, 0x53, 0x4c, 0x4f, 0x54,
+	0x5f, 0x50, 0x52, 0x4f, 0x50, 0x4f, 0x53, 0x41, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x12, 0x0c, 0x0a, 0x08, 0x50, 0x52, 0x4f, 0x50, 0x4f, 0x53, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0c,
+	0x0a, 0x08, 0x41, 0x43, 0x43, 0x45, 0x50, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08,
+	0x44, 0x45, 0x43, 0x4c, 0x49, 0x4e, 0x45, 0x44, 0x10, 0x02, 0x2a, 0x49, 0x0a, 0x0d, 0x51, 0x55,
+	0x45, 0x53, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x12, 0x08, 0x0a, 0x04, 0x54,
+	0x45, 0x58, 0x54, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x59, 0x45, 0x53, 0x5f, 0x4e, 0x4f, 0x10,
+	0x01, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x48, 0x4f, 0x49, 0x43, 0x45, 0x10, 0x02, 0x12, 0x0a, 0x0a,
+	0x06, 0x4e, 0x55, 0x4d, 0x42, 0x45, 0x52, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x50, 0x48, 0x4f,
+	0x54, 0x4f, 0x53, 0x10, 0x04, 0x2a, 0x37, 0x0a, 0x0c, 0x51, 0x55, 0x4f, 0x54, 0x45, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x12, 0x08, 0x0a, 0x04, 0x4f, 0x50, 0x45, 0x4e, 0x10, 0x00, 0x12,
+	0x07, 0x0a, 0x03, 0x57, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x4c, 0x4f, 0x53, 0x54,
+	0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x4c, 0x41, 0x50, 0x53, 0x45, 0x44, 0x10, 0x03, 0x2a, 0x31,
+	0x0a, 0x0e, 0x55, 0x50, 0x4c, 0x4f, 0x41, 0x44, 0x5f, 0x50, 0x55, 0x52, 0x50, 0x4f, 0x53, 0x45,
+	0x12, 0x09, 0x0a, 0x05, 0x49, 0x4d, 0x41, 0x47, 0x45, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x4f,
+	0x52, 0x44, 0x45, 0x52, 0x5f, 0x41, 0x54, 0x54, 0x41, 0x43, 0x48, 0x4d, 0x45, 0x4e, 0x54, 0x10,
+	0x01, 0x2a, 0x3c, 0x0a, 0x0e, 0x44, 0x49, 0x53, 0x50, 0x55, 0x54, 0x45, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x12, 0x10, 0x0a, 0x0c, 0x55, 0x4e, 0x44, 0x45, 0x52, 0x5f, 0x52, 0x45, 0x56,
+	0x49, 0x45, 0x57, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x41, 0x50, 0x50, 0x52, 0x4f, 0x56, 0x45,
+	0x44, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x44, 0x45, 0x4e, 0x49, 0x45, 0x44, 0x10, 0x02, 0x2a,
+	0x56, 0x0a, 0x0e, 0x44, 0x49, 0x53, 0x50, 0x55, 0x54, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f,
+	0x4e, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x50, 0x41, 0x4d, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53,
+	0x54, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x55, 0x4e, 0x52, 0x45, 0x41, 0x43, 0x48, 0x41, 0x42,
+	0x4c, 0x45, 0x5f, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x45, 0x52, 0x10, 0x01, 0x12, 0x18, 0x0a,
+	0x14, 0x4f, 0x54, 0x48, 0x45, 0x52, 0x5f, 0x44, 0x49, 0x53, 0x50, 0x55, 0x54, 0x45, 0x5f, 0x52,
+	0x45, 0x41, 0x53, 0x4f, 0x4e, 0x10, 0x02, 0x2a, 0x4e, 0x0a, 0x12, 0x44, 0x49, 0x53, 0x50, 0x55,
+	0x54, 0x45, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x4c, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x12, 0x10, 0x0a,
+	0x0c, 0x4e, 0x4f, 0x54, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x4c, 0x56, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x0a, 0x0a, 0x06, 0x57, 0x41, 0x49, 0x56, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x52,
+	0x45, 0x46, 0x55, 0x4e, 0x44, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x52, 0x45,
+	0x44, 0x49, 0x54, 0x45, 0x44, 0x10, 0x03, 0x2a, 0xae, 0x02, 0x0a, 0x0c, 0x4f, 0x52, 0x44, 0x45,
+	0x52, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x4f, 0x5f, 0x52,
+	0x45, 0x41, 0x53, 0x4f, 0x4e, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x4f, 0x55, 0x54, 0x53, 0x49,
+	0x44, 0x45, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x41, 0x52, 0x45, 0x41, 0x10,
+	0x01, 0x12, 0x10, 0x0a, 0x0c, 0x46, 0x55, 0x4c, 0x4c, 0x59, 0x5f, 0x42, 0x4f, 0x4f, 0x4b, 0x45,
+	0x44, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x4e,
+	0x4f, 0x54, 0x5f, 0x4f, 0x46, 0x46, 0x45, 0x52, 0x45, 0x44, 0x10, 0x03, 0x12, 0x19, 0x0a, 0x15,
+	0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x45, 0x52, 0x5f, 0x55, 0x4e, 0x52, 0x45, 0x53, 0x50, 0x4f,
+	0x4e, 0x53, 0x49, 0x56, 0x45, 0x10, 0x04, 0x12, 0x10, 0x0a, 0x0c, 0x43, 0x48, 0x41, 0x4e, 0x47,
+	0x45, 0x44, 0x5f, 0x4d, 0x49, 0x4e, 0x44, 0x10, 0x05, 0x12, 0x13, 0x0a, 0x0f, 0x48, 0x49, 0x52,
+	0x45, 0x44, 0x5f, 0x45, 0x4c, 0x53, 0x45, 0x57, 0x48, 0x45, 0x52, 0x45, 0x10, 0x06, 0x12, 0x10,
+	0x0a, 0x0c, 0x4f, 0x54, 0x48, 0x45, 0x52, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x10, 0x07,
+	0x12, 0x0b, 0x0a, 0x07, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0x08, 0x12, 0x0d, 0x0a,
+	0x09, 0x53, 0x55, 0x53, 0x50, 0x45, 0x4e, 0x44, 0x45, 0x44, 0x10, 0x09, 0x12, 0x13, 0x0a, 0x0f,
+	0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10,
+	0x0a, 0x12, 0x12, 0x0a, 0x0e, 0x51, 0x55, 0x4f, 0x54, 0x45, 0x5f, 0x41, 0x43, 0x43, 0x45, 0x50,
+	0x54, 0x45, 0x44, 0x10, 0x0b, 0x12, 0x17, 0x0a, 0x13, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x49, 0x53, 0x50, 0x55, 0x54, 0x45, 0x44, 0x10, 0x0c, 0x12, 0x18,
+	0x0a, 0x14, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x52, 0x4d, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54,
+	0x49, 0x4d, 0x45, 0x4f, 0x55, 0x54, 0x10, 0x0d, 0x2a, 0x74, 0x0a, 0x17, 0x43, 0x4f, 0x4d, 0x50,
+	0x4c, 0x45, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x52, 0x4d, 0x41, 0x54,
+	0x49, 0x4f, 0x4e, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x52, 0x4d,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x52, 0x4d, 0x45,
+	0x44, 0x5f, 0x42, 0x59, 0x5f, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x45, 0x52, 0x10, 0x01, 0x12,
+	0x19, 0x0a, 0x15, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x52, 0x4d, 0x45, 0x44, 0x5f, 0x42, 0x59, 0x5f,
+	0x48, 0x41, 0x4e, 0x44, 0x59, 0x4d, 0x41, 0x4e, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x41, 0x55,
+	0x54, 0x4f, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x52, 0x4d, 0x45, 0x44, 0x10, 0x03, 0x2a, 0x2a,
+	0x0a, 0x0e, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x12, 0x0a, 0x0a, 0x06, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08,
+	0x49, 0x4e, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x01, 0x2a, 0x32, 0x0a, 0x0a, 0x53, 0x4f,
+	0x52, 0x54, 0x5f, 0x51, 0x55, 0x45, 0x52, 0x59, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x45, 0x46, 0x41,
+	0x55, 0x4c, 0x54, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54,
+	0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x45, 0x56, 0x49, 0x45, 0x57, 0x10, 0x02, 0x2a, 0x38,
+	0x0a, 0x14, 0x51, 0x55, 0x45, 0x52, 0x59, 0x5f, 0x43, 0x41, 0x54, 0x45, 0x47, 0x4f, 0x52, 0x59,
+	0x5f, 0x41, 0x44, 0x4d, 0x49, 0x4e, 0x12, 0x06, 0x0a, 0x02, 0x4e, 0x4f, 0x10, 0x00, 0x12, 0x0d,
+	0x0a, 0x09, 0x41, 0x44, 0x56, 0x45, 0x52, 0x54, 0x49, 0x53, 0x45, 0x10, 0x01, 0x12, 0x09, 0x0a,
+	0x05, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x10, 0x02, 0x2a, 0x44, 0x0a, 0x14, 0x52, 0x45, 0x47, 0x49,
+	0x53, 0x54, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53,
+	0x12, 0x08, 0x0a, 0x04, 0x44, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x54,
+	0x45, 0x50, 0x5f, 0x31, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x54, 0x45, 0x50, 0x5f, 0x32,
+	0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x54, 0x45, 0x50, 0x5f, 0x33, 0x10, 0x03, 0x2a, 0x74,
+	0x0a, 0x10, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41, 0x43, 0x54, 0x49,
+	0x4f, 0x4e, 0x12, 0x0d, 0x0a, 0x09, 0x54, 0x48, 0x49, 0x53, 0x5f, 0x57, 0x45, 0x45, 0x4b, 0x10,
+	0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x4c, 0x41, 0x53, 0x54, 0x5f, 0x31, 0x5f, 0x57, 0x45, 0x45, 0x4b,
+	0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x4c, 0x41, 0x53, 0x54, 0x5f, 0x32, 0x5f, 0x57, 0x45, 0x45,
+	0x4b, 0x53, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x4c, 0x41, 0x53, 0x54, 0x5f, 0x33, 0x5f, 0x57,
+	0x45, 0x45, 0x4b, 0x53, 0x10, 0x03, 0x12, 0x0e, 0x0a, 0x0a, 0x4c, 0x41, 0x53, 0x54, 0x5f, 0x4d,
+	0x4f, 0x4e, 0x54, 0x48, 0x10, 0x04, 0x12, 0x0c, 0x0a, 0x08, 0x41, 0x4c, 0x4c, 0x5f, 0x54, 0x49,
+	0x4d, 0x45, 0x10, 0x05, 0x2a, 0x4c, 0x0a, 0x1b, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x56,
+	0x45, 0x52, 0x49, 0x46, 0x59, 0x5f, 0x52, 0x45, 0x46, 0x45, 0x52, 0x52, 0x41, 0x4c, 0x5f, 0x43,
+	0x4f, 0x44, 0x45, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x0d, 0x0a,
+	0x09, 0x56, 0x45, 0x52, 0x49, 0x46, 0x59, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04,
+	0x44, 0x45, 0x4e, 0x59, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x41, 0x43, 0x43, 0x45, 0x50, 0x54,
+	0x10, 0x03, 0x42, 0x05, 0x5a, 0x03, 0x2e, 0x2f, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -592,18 +1498,32 @@ func file_const_proto_rawDescGZIP() []byte {
 	return file_const_proto_rawDescData
 }
 
-var file_const_proto_enumTypes = make([]protoimpl.EnumInfo, 10)
+var file_const_proto_enumTypes = make([]protoimpl.EnumInfo, 24)
 var file_const_proto_goTypes = []interface{}{
 	(ROLE)(0),                        // 0: const.ROLE
 	(SERVICE_STATUS)(0),              // 1: const.SERVICE_STATUS
 	(OTP_TYPE)(0),                    // 2: const.OTP_TYPE
-	(ORDER_STATUS)(0),                // 3: const.ORDER_STATUS
-	(ACCOUNT_STATUS)(0),              // 4: const.ACCOUNT_STATUS
-	(SORT_QUERY)(0),                  // 5: const.SORT_QUERY
-	(QUERY_CATEGORY_ADMIN)(0),        // 6: const.QUERY_CATEGORY_ADMIN
-	(REGISTRATION_PROCESS)(0),        // 7: const.REGISTRATION_PROCESS
-	(SORT_TRANSACTION)(0),            // 8: const.SORT_TRANSACTION
-	(STATUS_VERIFY_REFERRAL_CODE)(0), // 9: const.STATUS_VERIFY_REFERRAL_CODE
+	(OTP_CHANNEL)(0),                 // 3: const.OTP_CHANNEL
+	(SECURITY_EVENT)(0),              // 4: const.SECURITY_EVENT
+	(SUSPENSION_REASON)(0),           // 5: const.SUSPENSION_REASON
+	(BUSINESS_ROLE)(0),               // 6: const.BUSINESS_ROLE
+	(ORDER_STATUS)(0),                // 7: const.ORDER_STATUS
+	(ORDER_URGENCY)(0),               // 8: const.ORDER_URGENCY
+	(SLOT_PROPOSAL_STATUS)(0),        // 9: const.SLOT_PROPOSAL_STATUS
+	(QUESTION_TYPE)(0),               // 10: const.QUESTION_TYPE
+	(QUOTE_STATUS)(0),                // 11: const.QUOTE_STATUS
+	(UPLOAD_PURPOSE)(0),              // 12: const.UPLOAD_PURPOSE
+	(DISPUTE_STATUS)(0),              // 13: const.DISPUTE_STATUS
+	(DISPUTE_REASON)(0),              // 14: const.DISPUTE_REASON
+	(DISPUTE_RESOLUTION)(0),          // 15: const.DISPUTE_RESOLUTION
+	(ORDER_REASON)(0),                // 16: const.ORDER_REASON
+	(COMPLETION_CONFIRMATION)(0),     // 17: const.COMPLETION_CONFIRMATION
+	(ACCOUNT_STATUS)(0),              // 18: const.ACCOUNT_STATUS
+	(SORT_QUERY)(0),                  // 19: const.SORT_QUERY
+	(QUERY_CATEGORY_ADMIN)(0),        // 20: const.QUERY_CATEGORY_ADMIN
+	(REGISTRATION_PROCESS)(0),        // 21: const.REGISTRATION_PROCESS
+	(SORT_TRANSACTION)(0),            // 22: const.SORT_TRANSACTION
+	(STATUS_VERIFY_REFERRAL_CODE)(0), // 23: const.STATUS_VERIFY_REFERRAL_CODE
 }
 var file_const_proto_depIdxs = []int32{
 	0, // [0:0] is the sub-list for method output_type
@@ -623,7 +1543,7 @@ func file_const_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_const_proto_rawDesc,
-			NumEnums:      10,
+			NumEnums:      24,
 			NumMessages:   0,
 			NumExtensions: 0,
 			NumServices:   0,
@@ -18,4 +18,5 @@ var (
 	ErrOpenConversation        = xerrors.Errorf("%s: open conversation", prefix)
 	ErrBuyPhoneFail            = xerrors.Errorf("%s: unable to buy new phone number", prefix)
 	ErrMaxPhoneNumbers         = xerrors.Errorf("%s: unable to buy more phone number", prefix)
+	ErrReadOnlyToken           = xerrors.Errorf("%s: token is read only", prefix)
 )
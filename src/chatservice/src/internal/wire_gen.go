@@ -12,6 +12,7 @@ import (
 	"github.com/aqaurius6666/chatservice/src/internal/api/middleware"
 	"github.com/aqaurius6666/chatservice/src/internal/db"
 	"github.com/aqaurius6666/chatservice/src/internal/db/cockroach"
+	"github.com/aqaurius6666/chatservice/src/internal/lib/mtls"
 	"github.com/aqaurius6666/chatservice/src/internal/model"
 	"github.com/aqaurius6666/chatservice/src/services/authservice"
 	"github.com/aqaurius6666/chatservice/src/services/mailservice"
@@ -62,7 +63,8 @@ func InitMainServer(ctx context.Context, logger2 *logrus.Logger, opts ServerOpti
 		Client: client,
 	}
 	mailserviceAddr := opts.MailserviceAddr
-	mailServiceClient, err := mailservice.ConnectClient(ctx, mailserviceAddr)
+	credentials := opts.Creds
+	mailServiceClient, err := mailservice.ConnectClient(ctx, mailserviceAddr, credentials)
 	if err != nil {
 		return nil, err
 	}
@@ -88,7 +90,7 @@ func InitMainServer(ctx context.Context, logger2 *logrus.Logger, opts ServerOpti
 		Twillo: twilloClient,
 	}
 	authserviceAddr := opts.AuthserviceAddr
-	authServiceClient, err := authservice.ConnectClient(ctx, authserviceAddr)
+	authServiceClient, err := authservice.ConnectClient(ctx, authserviceAddr, credentials)
 	if err != nil {
 		return nil, err
 	}
@@ -96,9 +98,11 @@ func InitMainServer(ctx context.Context, logger2 *logrus.Logger, opts ServerOpti
 		Ctx:    ctx,
 		Client: authServiceClient,
 	}
+	keySet := middleware.NewKeySet(authserviceServiceGRPC)
 	middlewareV1 := &middleware.MiddlewareV1{
 		Logger: logger2,
 		Auth:   authserviceServiceGRPC,
+		Keys:   keySet,
 	}
 	indexController := &api.IndexController{}
 	apiServer := &api.ApiServer{
@@ -133,4 +137,5 @@ type ServerOptions struct {
 	RedisUser         redis.REDIS_USER
 	RedisPass         redis.REDIS_PASS
 	TwilloCallbackUrl twilloclient.TWILLO_SMS_CALLBACK_URL
+	Creds             *mtls.Credentials
 }
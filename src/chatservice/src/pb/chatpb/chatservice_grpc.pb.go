@@ -27,6 +27,7 @@ type ChatServiceClient interface {
 	GetConversation(ctx context.Context, in *ConversationPostRequest, opts ...grpc.CallOption) (*ConversationPostResponse, error)
 	TriggerSendSMS(ctx context.Context, in *TriggerSendSMSRequest, opts ...grpc.CallOption) (*TriggerSendSMSResponse, error)
 	CloseConversation(ctx context.Context, in *CloseConversationRequest, opts ...grpc.CallOption) (*CloseConversationResponse, error)
+	DeleteUserData(ctx context.Context, in *DeleteUserDataRequest, opts ...grpc.CallOption) (*DeleteUserDataResponse, error)
 }
 
 type chatServiceClient struct {
@@ -104,6 +105,15 @@ func (c *chatServiceClient) CloseConversation(ctx context.Context, in *CloseConv
 	return out, nil
 }
 
+func (c *chatServiceClient) DeleteUserData(ctx context.Context, in *DeleteUserDataRequest, opts ...grpc.CallOption) (*DeleteUserDataResponse, error) {
+	out := new(DeleteUserDataResponse)
+	err := c.cc.Invoke(ctx, "/chatservice.ChatService/DeleteUserData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ChatServiceServer is the server API for ChatService service.
 // All implementations must embed UnimplementedChatServiceServer
 // for forward compatibility
@@ -113,6 +123,7 @@ type ChatServiceServer interface {
 	GetConversation(context.Context, *ConversationPostRequest) (*ConversationPostResponse, error)
 	TriggerSendSMS(context.Context, *TriggerSendSMSRequest) (*TriggerSendSMSResponse, error)
 	CloseConversation(context.Context, *CloseConversationRequest) (*CloseConversationResponse, error)
+	DeleteUserData(context.Context, *DeleteUserDataRequest) (*DeleteUserDataResponse, error)
 	mustEmbedUnimplementedChatServiceServer()
 }
 
@@ -135,6 +146,9 @@ func (UnimplementedChatServiceServer) TriggerSendSMS(context.Context, *TriggerSe
 func (UnimplementedChatServiceServer) CloseConversation(context.Context, *CloseConversationRequest) (*CloseConversationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CloseConversation not implemented")
 }
+func (UnimplementedChatServiceServer) DeleteUserData(context.Context, *DeleteUserDataRequest) (*DeleteUserDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUserData not implemented")
+}
 func (UnimplementedChatServiceServer) mustEmbedUnimplementedChatServiceServer() {}
 
 // UnsafeChatServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -246,6 +260,24 @@ func _ChatService_CloseConversation_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ChatService_DeleteUserData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).DeleteUserData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chatservice.ChatService/DeleteUserData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).DeleteUserData(ctx, req.(*DeleteUserDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ChatService_ServiceDesc is the grpc.ServiceDesc for ChatService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -269,6 +301,10 @@ var ChatService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CloseConversation",
 			Handler:    _ChatService_CloseConversation_Handler,
 		},
+		{
+			MethodName: "DeleteUserData",
+			Handler:    _ChatService_DeleteUserData_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -3,6 +3,7 @@ package authservice
 import (
 	"context"
 
+	"github.com/aqaurius6666/chatservice/src/internal/lib/jwt"
 	"github.com/aqaurius6666/chatservice/src/internal/var/c"
 	"github.com/google/wire"
 )
@@ -10,7 +11,9 @@ import (
 var Set = wire.NewSet(wire.Bind(new(Service), new(ServiceGRPC)), wire.Struct(new(ServiceGRPC), "*"), ConnectClient)
 
 type Service interface {
-	CheckAuth(ctx context.Context, header, body []byte, method string) (string, c.ROLE, error)
+	CheckAuth(ctx context.Context, header, body []byte, method string) (id string, role c.ROLE, businessId string, err error)
+	GetJwks(ctx context.Context) ([]jwt.JWK, error)
+	RecordImpersonationAction(ctx context.Context, sessionId, adminId, userId, method, path string, code int32) error
 	// GetCredential(ctx context.Context, data string) (id, pub, priv string, err error)
 	// Register(ctx context.Context, mail, phone, pub, enpriv string, role c.ROLE) (otpId string, err error)
 	// RegisterOTP(ctx context.Context, otpId string) (id, mail, phone string, role c.ROLE, err error)
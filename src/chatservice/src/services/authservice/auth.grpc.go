@@ -5,13 +5,14 @@ import (
 	"time"
 
 	"github.com/aqaurius6666/chatservice/src/internal/lib"
+	"github.com/aqaurius6666/chatservice/src/internal/lib/jwt"
+	"github.com/aqaurius6666/chatservice/src/internal/lib/mtls"
 	"github.com/aqaurius6666/chatservice/src/internal/var/c"
 	"github.com/aqaurius6666/chatservice/src/pb/authpb"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"go.opentelemetry.io/otel"
 	"golang.org/x/xerrors"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/status"
 )
 
@@ -27,10 +28,10 @@ type ServiceGRPC struct {
 	Client authpb.AuthServiceClient
 }
 
-func ConnectClient(ctx context.Context, addr AuthserviceAddr) (authpb.AuthServiceClient, error) {
+func ConnectClient(ctx context.Context, addr AuthserviceAddr, creds *mtls.Credentials) (authpb.AuthServiceClient, error) {
 	nctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
-	conn, err := grpc.DialContext(nctx, string(addr), grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithChainUnaryInterceptor(
+	conn, err := grpc.DialContext(nctx, string(addr), creds.DialOption(), grpc.WithChainUnaryInterceptor(
 		otelgrpc.UnaryClientInterceptor(),
 	))
 	if err != nil {
@@ -229,7 +230,7 @@ func ConnectClient(ctx context.Context, addr AuthserviceAddr) (authpb.AuthServic
 // 	return res.Type, nil
 // }
 
-func (s ServiceGRPC) CheckAuth(ctx context.Context, header, body []byte, method string) (string, c.ROLE, error) {
+func (s ServiceGRPC) CheckAuth(ctx context.Context, header, body []byte, method string) (string, c.ROLE, string, error) {
 	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.CheckAuth))
 	defer span.End()
 	ctx, cancel := context.WithTimeout(ctx, timeout)
@@ -246,9 +247,64 @@ func (s ServiceGRPC) CheckAuth(ctx context.Context, header, body []byte, method
 		}
 		err = xerrors.Errorf("%w", err)
 		lib.RecordError(span, err)
-		return "", 0, err
+		return "", 0, "", err
 	}
-	return res.Id, res.Role, nil
+	return res.Id, res.Role, res.BusinessId, nil
+}
+
+func (s ServiceGRPC) GetJwks(ctx context.Context) ([]jwt.JWK, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.GetJwks))
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	res, err := s.Client.GetJwks(ctx, &authpb.GetJwksRequest{})
+	if err != nil {
+		stt, ok := status.FromError(err)
+		if ok {
+			err = xerrors.New(stt.Message())
+		}
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return nil, err
+	}
+	keys := make([]jwt.JWK, 0, len(res.Keys))
+	for _, k := range res.Keys {
+		keys = append(keys, jwt.JWK{
+			Kty: k.Kty,
+			Crv: k.Crv,
+			X:   k.X,
+			Y:   k.Y,
+			Kid: k.Kid,
+			Alg: k.Alg,
+			Use: k.Use,
+		})
+	}
+	return keys, nil
+}
+
+func (s ServiceGRPC) RecordImpersonationAction(ctx context.Context, sessionId, adminId, userId, method, path string, code int32) error {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.RecordImpersonationAction))
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	_, err := s.Client.RecordImpersonationAction(ctx, &authpb.RecordImpersonationActionRequest{
+		SessionId: sessionId,
+		AdminId:   adminId,
+		UserId:    userId,
+		Method:    method,
+		Path:      path,
+		Status:    code,
+	})
+	if err != nil {
+		stt, ok := status.FromError(err)
+		if ok {
+			err = xerrors.New(stt.Message())
+		}
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return err
+	}
+	return nil
 }
 
 // func (s ServiceGRPC) Register(ctx context.Context, mail, phone, pub, enpriv string, role c.ROLE) (otpId string, err error) {
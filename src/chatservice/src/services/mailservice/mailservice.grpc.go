@@ -5,13 +5,13 @@ import (
 	"time"
 
 	"github.com/aqaurius6666/chatservice/src/internal/lib"
+	"github.com/aqaurius6666/chatservice/src/internal/lib/mtls"
 	"github.com/aqaurius6666/chatservice/src/internal/var/c"
 	"github.com/aqaurius6666/chatservice/src/pb/mailpb"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"go.opentelemetry.io/otel"
 	"golang.org/x/xerrors"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/status"
 )
 
@@ -27,10 +27,10 @@ type ServiceGRPC struct {
 	Client mailpb.MailServiceClient
 }
 
-func ConnectClient(ctx context.Context, addr MailserviceAddr) (mailpb.MailServiceClient, error) {
+func ConnectClient(ctx context.Context, addr MailserviceAddr, creds *mtls.Credentials) (mailpb.MailServiceClient, error) {
 	nctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
-	conn, err := grpc.DialContext(nctx, string(addr), grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithChainUnaryInterceptor(
+	conn, err := grpc.DialContext(nctx, string(addr), creds.DialOption(), grpc.WithChainUnaryInterceptor(
 		otelgrpc.UnaryClientInterceptor(),
 	))
 	if err != nil {
@@ -11,14 +11,23 @@ type QUANTITY_INTERVAL int
 type UNIT_INTERVAL string
 type PAYMENT_DAY string
 type CHAT_QUANTITY_INTERVAL int
+type DELETION_QUANTITY_INTERVAL int
+type SUSPENSION_QUANTITY_INTERVAL int
+type ORDER_EXPIRY_QUANTITY_INTERVAL int
 
-var JobSet = wire.NewSet(SliceProvider, wire.Struct(new(PaymentCronjob), "*"), wire.Struct(new(ChatCronjob), "*"))
+// ORDER_EXPIRY_REMINDER is how many minutes before a pending order expires
+// its handyman is reminded.
+type ORDER_EXPIRY_REMINDER int
+type ORDER_COMPLETION_QUANTITY_INTERVAL int
+type QUOTE_EXPIRY_QUANTITY_INTERVAL int
+
+var JobSet = wire.NewSet(SliceProvider, wire.Struct(new(PaymentCronjob), "*"), wire.Struct(new(ChatCronjob), "*"), wire.Struct(new(DeletionCronjob), "*"), wire.Struct(new(SuspensionCronjob), "*"), wire.Struct(new(OrderExpiryCronjob), "*"), wire.Struct(new(OrderCompletionCronjob), "*"), wire.Struct(new(QuoteExpiryCronjob), "*"))
 
 var timeout = 20 * time.Second
 
-func SliceProvider(a *PaymentCronjob, b *ChatCronjob) []Cronjob {
+func SliceProvider(a *PaymentCronjob, b *ChatCronjob, d *DeletionCronjob, e *SuspensionCronjob, f *OrderExpiryCronjob, g *OrderCompletionCronjob, h *QuoteExpiryCronjob) []Cronjob {
 	return []Cronjob{
-		a, b,
+		a, b, d, e, f, g, h,
 	}
 }
 
@@ -0,0 +1,95 @@
+package cronjob
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aqaurius6666/cronjob/src/internal/lib"
+	"github.com/aqaurius6666/cronjob/src/internal/model"
+	"github.com/aqaurius6666/cronjob/src/internal/var/c"
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"golang.org/x/xerrors"
+)
+
+// deletionBatch bounds how many accounts one run erases, so a run fits in
+// the job timeout. The rest are left for the next runs.
+var deletionBatch int32 = 20
+
+type DeletionCronjob struct {
+	Logger           *logrus.Logger
+	Model            model.Server
+	QuantityInterval DELETION_QUANTITY_INTERVAL
+}
+
+func (s *DeletionCronjob) Run(ctx context.Context) {
+	defer s.tearDown()
+	timeCh := s.timer(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timeCh:
+			ctx2, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+			s.execute(ctx2)
+		}
+	}
+}
+
+func (s *DeletionCronjob) tearDown() {
+	fmt.Println("Job deletion says \"Bye bye ~\"")
+}
+
+func (s *DeletionCronjob) timer(ctx context.Context) <-chan bool {
+	ch := make(chan bool)
+	go func() {
+		ticker := time.NewTicker(time.Duration(s.QuantityInterval) * time.Minute)
+		for {
+			<-ticker.C
+			ch <- true
+		}
+	}()
+
+	return ch
+}
+
+func (s *DeletionCronjob) execute(ctx context.Context) error {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.execute))
+	defer span.End()
+	startTime := time.Now()
+
+	users, err := s.Model.ListDueDeletions(ctx, deletionBatch)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return err
+	}
+	if len(users) <= 0 {
+		return nil
+	}
+	defer func() {
+		endtime := time.Now()
+		s.Logger.Info("End execute deletion job at ", endtime)
+		span.SetAttributes(attribute.KeyValue{
+			Key:   attribute.Key("job.end_time"),
+			Value: attribute.StringValue(endtime.String()),
+		})
+	}()
+	s.Logger.Info("Start execute deletion job at ", startTime)
+	span.SetAttributes(attribute.KeyValue{
+		Key:   attribute.Key("job.start_time"),
+		Value: attribute.StringValue(startTime.String()),
+	})
+	for _, u := range users {
+		// One account failing must not hold back the others.
+		if err := s.Model.EraseAccount(ctx, u); err != nil {
+			err = xerrors.Errorf("%w", err)
+			lib.RecordError(span, err)
+			s.Logger.Error("Cannot erase account ", u.Id, ": ", err)
+		}
+	}
+	return nil
+}
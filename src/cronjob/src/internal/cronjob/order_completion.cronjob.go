@@ -0,0 +1,93 @@
+package cronjob
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aqaurius6666/cronjob/src/internal/lib"
+	"github.com/aqaurius6666/cronjob/src/internal/model"
+	"github.com/aqaurius6666/cronjob/src/internal/var/c"
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"golang.org/x/xerrors"
+)
+
+// orderCompletionBatch bounds how many orders one run confirms. The rest are
+// left for the next runs.
+var orderCompletionBatch int32 = 100
+
+type OrderCompletionCronjob struct {
+	Logger           *logrus.Logger
+	Model            model.Server
+	QuantityInterval ORDER_COMPLETION_QUANTITY_INTERVAL
+}
+
+func (s *OrderCompletionCronjob) Run(ctx context.Context) {
+	defer s.tearDown()
+	timeCh := s.timer(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timeCh:
+			ctx2, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+			s.execute(ctx2)
+		}
+	}
+}
+
+func (s *OrderCompletionCronjob) tearDown() {
+	fmt.Println("Job order completion says \"Bye bye ~\"")
+}
+
+func (s *OrderCompletionCronjob) timer(ctx context.Context) <-chan bool {
+	ch := make(chan bool)
+	go func() {
+		ticker := time.NewTicker(time.Duration(s.QuantityInterval) * time.Minute)
+		for {
+			<-ticker.C
+			ch <- true
+		}
+	}()
+
+	return ch
+}
+
+// execute confirms the jobs marked done nobody answered in time, then closes
+// their conversations and tells both sides.
+func (s *OrderCompletionCronjob) execute(ctx context.Context) error {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.execute))
+	defer span.End()
+	startTime := time.Now()
+	s.Logger.Info("Start execute order completion job at ", startTime)
+	span.SetAttributes(attribute.KeyValue{
+		Key:   attribute.Key("job.start_time"),
+		Value: attribute.StringValue(startTime.String()),
+	})
+	defer func() {
+		endtime := time.Now()
+		s.Logger.Info("End execute order completion job at ", endtime)
+		span.SetAttributes(attribute.KeyValue{
+			Key:   attribute.Key("job.end_time"),
+			Value: attribute.StringValue(endtime.String()),
+		})
+	}()
+
+	confirmed, err := s.Model.ConfirmOrders(ctx, orderCompletionBatch)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return err
+	}
+	for _, o := range confirmed {
+		if err := s.Model.FinishConfirmedOrder(ctx, o); err != nil {
+			err = xerrors.Errorf("%w", err)
+			lib.RecordError(span, err)
+			s.Logger.Error("Cannot finish confirmed order ", o.ID, ": ", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,108 @@
+package cronjob
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aqaurius6666/cronjob/src/internal/lib"
+	"github.com/aqaurius6666/cronjob/src/internal/model"
+	"github.com/aqaurius6666/cronjob/src/internal/var/c"
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"golang.org/x/xerrors"
+)
+
+// orderExpiryBatch bounds how many orders one run reminds and expires each.
+// The rest are left for the next runs.
+var orderExpiryBatch int32 = 100
+
+type OrderExpiryCronjob struct {
+	Logger           *logrus.Logger
+	Model            model.Server
+	QuantityInterval ORDER_EXPIRY_QUANTITY_INTERVAL
+	Reminder         ORDER_EXPIRY_REMINDER
+}
+
+func (s *OrderExpiryCronjob) Run(ctx context.Context) {
+	defer s.tearDown()
+	timeCh := s.timer(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timeCh:
+			ctx2, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+			s.execute(ctx2)
+		}
+	}
+}
+
+func (s *OrderExpiryCronjob) tearDown() {
+	fmt.Println("Job order expiry says \"Bye bye ~\"")
+}
+
+func (s *OrderExpiryCronjob) timer(ctx context.Context) <-chan bool {
+	ch := make(chan bool)
+	go func() {
+		ticker := time.NewTicker(time.Duration(s.QuantityInterval) * time.Minute)
+		for {
+			<-ticker.C
+			ch <- true
+		}
+	}()
+
+	return ch
+}
+
+// execute reminds the handymen of the orders about to expire, then expires
+// the orders past their end date and tells both sides.
+func (s *OrderExpiryCronjob) execute(ctx context.Context) error {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.execute))
+	defer span.End()
+	startTime := time.Now()
+	s.Logger.Info("Start execute order expiry job at ", startTime)
+	span.SetAttributes(attribute.KeyValue{
+		Key:   attribute.Key("job.start_time"),
+		Value: attribute.StringValue(startTime.String()),
+	})
+	defer func() {
+		endtime := time.Now()
+		s.Logger.Info("End execute order expiry job at ", endtime)
+		span.SetAttributes(attribute.KeyValue{
+			Key:   attribute.Key("job.end_time"),
+			Value: attribute.StringValue(endtime.String()),
+		})
+	}()
+
+	expiring, err := s.Model.ListExpiringOrders(ctx, time.Duration(s.Reminder)*time.Minute, orderExpiryBatch)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return err
+	}
+	for _, o := range expiring {
+		if err := s.Model.RemindExpiringOrder(ctx, o); err != nil {
+			err = xerrors.Errorf("%w", err)
+			lib.RecordError(span, err)
+			s.Logger.Error("Cannot remind expiring order ", o.ID, ": ", err)
+		}
+	}
+
+	expired, err := s.Model.ExpireOrders(ctx, orderExpiryBatch)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return err
+	}
+	for _, o := range expired {
+		if err := s.Model.NotifyOrderExpired(ctx, o); err != nil {
+			err = xerrors.Errorf("%w", err)
+			lib.RecordError(span, err)
+			s.Logger.Error("Cannot notify expired order ", o.ID, ": ", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+package cronjob
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aqaurius6666/cronjob/src/internal/lib"
+	"github.com/aqaurius6666/cronjob/src/internal/model"
+	"github.com/aqaurius6666/cronjob/src/internal/var/c"
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"golang.org/x/xerrors"
+)
+
+// quoteExpiryBatch bounds how many quotes one run lapses. The rest are left
+// for the next runs.
+var quoteExpiryBatch int32 = 100
+
+type QuoteExpiryCronjob struct {
+	Logger           *logrus.Logger
+	Model            model.Server
+	QuantityInterval QUOTE_EXPIRY_QUANTITY_INTERVAL
+}
+
+func (s *QuoteExpiryCronjob) Run(ctx context.Context) {
+	defer s.tearDown()
+	timeCh := s.timer(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timeCh:
+			ctx2, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+			s.execute(ctx2)
+		}
+	}
+}
+
+func (s *QuoteExpiryCronjob) tearDown() {
+	fmt.Println("Job quote expiry says \"Bye bye ~\"")
+}
+
+func (s *QuoteExpiryCronjob) timer(ctx context.Context) <-chan bool {
+	ch := make(chan bool)
+	go func() {
+		ticker := time.NewTicker(time.Duration(s.QuantityInterval) * time.Minute)
+		for {
+			<-ticker.C
+			ch <- true
+		}
+	}()
+
+	return ch
+}
+
+// execute lapses the open quotes past their validity and tells their
+// handymen.
+func (s *QuoteExpiryCronjob) execute(ctx context.Context) error {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.execute))
+	defer span.End()
+	startTime := time.Now()
+	s.Logger.Info("Start execute quote expiry job at ", startTime)
+	span.SetAttributes(attribute.KeyValue{
+		Key:   attribute.Key("job.start_time"),
+		Value: attribute.StringValue(startTime.String()),
+	})
+	defer func() {
+		endtime := time.Now()
+		s.Logger.Info("End execute quote expiry job at ", endtime)
+		span.SetAttributes(attribute.KeyValue{
+			Key:   attribute.Key("job.end_time"),
+			Value: attribute.StringValue(endtime.String()),
+		})
+	}()
+
+	lapsed, err := s.Model.LapseQuotes(ctx, quoteExpiryBatch)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return err
+	}
+	for _, q := range lapsed {
+		if err := s.Model.SendQuoteLapsedNotification(ctx, q); err != nil {
+			err = xerrors.Errorf("%w", err)
+			lib.RecordError(span, err)
+			s.Logger.Error("Cannot notify lapsed quote ", q.ID, ": ", err)
+		}
+	}
+	return nil
+}
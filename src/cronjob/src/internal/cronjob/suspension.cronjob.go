@@ -0,0 +1,94 @@
+package cronjob
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aqaurius6666/cronjob/src/internal/lib"
+	"github.com/aqaurius6666/cronjob/src/internal/model"
+	"github.com/aqaurius6666/cronjob/src/internal/var/c"
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"golang.org/x/xerrors"
+)
+
+// suspensionBatch bounds how many suspensions one run lifts. The rest are
+// left for the next runs.
+var suspensionBatch int32 = 50
+
+type SuspensionCronjob struct {
+	Logger           *logrus.Logger
+	Model            model.Server
+	QuantityInterval SUSPENSION_QUANTITY_INTERVAL
+}
+
+func (s *SuspensionCronjob) Run(ctx context.Context) {
+	defer s.tearDown()
+	timeCh := s.timer(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timeCh:
+			ctx2, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+			s.execute(ctx2)
+		}
+	}
+}
+
+func (s *SuspensionCronjob) tearDown() {
+	fmt.Println("Job suspension says \"Bye bye ~\"")
+}
+
+func (s *SuspensionCronjob) timer(ctx context.Context) <-chan bool {
+	ch := make(chan bool)
+	go func() {
+		ticker := time.NewTicker(time.Duration(s.QuantityInterval) * time.Minute)
+		for {
+			<-ticker.C
+			ch <- true
+		}
+	}()
+
+	return ch
+}
+
+func (s *SuspensionCronjob) execute(ctx context.Context) error {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.execute))
+	defer span.End()
+	startTime := time.Now()
+
+	users, err := s.Model.ListExpiredSuspensions(ctx, suspensionBatch)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return err
+	}
+	if len(users) <= 0 {
+		return nil
+	}
+	defer func() {
+		endtime := time.Now()
+		s.Logger.Info("End execute suspension job at ", endtime)
+		span.SetAttributes(attribute.KeyValue{
+			Key:   attribute.Key("job.end_time"),
+			Value: attribute.StringValue(endtime.String()),
+		})
+	}()
+	s.Logger.Info("Start execute suspension job at ", startTime)
+	span.SetAttributes(attribute.KeyValue{
+		Key:   attribute.Key("job.start_time"),
+		Value: attribute.StringValue(startTime.String()),
+	})
+	for _, u := range users {
+		if err := s.Model.LiftExpiredSuspension(ctx, u); err != nil {
+			err = xerrors.Errorf("%w", err)
+			lib.RecordError(span, err)
+			s.Logger.Error("Cannot lift suspension of ", u.Id, ": ", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,14 @@
+package business
+
+import (
+	"github.com/aqaurius6666/go-utils/database"
+)
+
+type Business struct {
+	database.BaseModel
+	Status *int32 `gorm:"type:int8;default:0"`
+}
+type Search struct {
+	database.DefaultSearchModel
+	Business
+}
@@ -0,0 +1,8 @@
+package business
+
+import "context"
+
+type BusinessRepo interface {
+	DeleteBusiness(ctx context.Context, search *Search) error
+	UpdateBusiness(ctx context.Context, search *Search, value *Business) error
+}
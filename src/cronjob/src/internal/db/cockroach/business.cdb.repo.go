@@ -0,0 +1,55 @@
+package cockroach
+
+import (
+	"context"
+
+	"github.com/aqaurius6666/cronjob/src/internal/db/business"
+	"github.com/aqaurius6666/cronjob/src/internal/lib"
+	"github.com/aqaurius6666/cronjob/src/internal/var/c"
+	"github.com/aqaurius6666/go-utils/database"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"golang.org/x/xerrors"
+	"gorm.io/gorm"
+)
+
+func applySearchBusiness(db *gorm.DB, search *business.Search) *gorm.DB {
+	if search.ID != uuid.Nil {
+		db = db.Where(business.Business{
+			BaseModel: database.BaseModel{
+				ID: search.ID,
+			},
+		})
+	}
+	return db
+}
+
+func (u *ServerCDBRepo) DeleteBusiness(ctx context.Context, search *business.Search) error {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(u.DeleteBusiness))
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	if err := applySearchBusiness(u.Db, search).WithContext(ctx).Delete(&business.Business{}).Error; err != nil {
+		err = xerrors.Errorf("%w", business.ErrDeleteFail)
+		lib.RecordError(span, err)
+		return err
+	}
+	return nil
+}
+
+func (u *ServerCDBRepo) UpdateBusiness(ctx context.Context, search *business.Search, value *business.Business) error {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(u.UpdateBusiness))
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	if err := applySearchBusiness(u.Db, search).WithContext(ctx).Updates(value).Error; err != nil {
+		err = xerrors.Errorf("%w", business.ErrUpdateFail)
+		lib.RecordError(span, err)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+package cockroach
+
+import (
+	"context"
+
+	"github.com/aqaurius6666/cronjob/src/internal/db/contact"
+	"github.com/aqaurius6666/cronjob/src/internal/lib"
+	"github.com/aqaurius6666/cronjob/src/internal/var/c"
+	"github.com/aqaurius6666/go-utils/database"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"golang.org/x/xerrors"
+	"gorm.io/gorm"
+)
+
+func applySearchContact(db *gorm.DB, search *contact.Search) *gorm.DB {
+	if search.ID != uuid.Nil {
+		db = db.Where(contact.Contact{
+			BaseModel: database.BaseModel{
+				ID: search.ID,
+			},
+		})
+	}
+	return db
+}
+
+func (u *ServerCDBRepo) DeleteContact(ctx context.Context, search *contact.Search) error {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(u.DeleteContact))
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	if err := applySearchContact(u.Db, search).WithContext(ctx).Delete(&contact.Contact{}).Error; err != nil {
+		err = xerrors.Errorf("%w", contact.ErrDeleteFail)
+		lib.RecordError(span, err)
+		return err
+	}
+	return nil
+}
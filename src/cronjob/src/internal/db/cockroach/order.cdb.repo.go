@@ -0,0 +1,123 @@
+package cockroach
+
+import (
+	"context"
+
+	"github.com/aqaurius6666/cronjob/src/internal/db/order"
+	"github.com/aqaurius6666/cronjob/src/internal/db/order_event"
+	"github.com/aqaurius6666/cronjob/src/internal/lib"
+	"github.com/aqaurius6666/cronjob/src/internal/var/c"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"golang.org/x/xerrors"
+	"gorm.io/gorm"
+)
+
+func applySearchOrder(db *gorm.DB, search *order.Search) *gorm.DB {
+	if search.CustomerId != uuid.Nil {
+		db = db.Where(order.Order{
+			CustomerId: search.CustomerId,
+		})
+	}
+	if search.ID != uuid.Nil {
+		db = db.Where(`"orders"."id" = ?`, search.ID)
+	}
+	if search.Status != nil {
+		db = db.Where(`"orders"."status" = ?`, *search.Status)
+	}
+	if search.ExpiryReminded != nil {
+		db = db.Where(`coalesce("orders"."expiry_reminded", false) = ?`, *search.ExpiryReminded)
+	}
+	if search.EndDate != nil {
+		db = db.Where(`"orders"."end_date" >= ?`, *search.EndDate)
+	}
+	if search.EndBefore != nil {
+		db = db.Where(`"orders"."end_date" < ?`, *search.EndBefore)
+	}
+	if search.ConfirmBefore != nil {
+		db = db.Where(`"orders"."confirm_by" < ?`, *search.ConfirmBefore)
+	}
+	if search.Limit > 0 {
+		db = db.Limit(search.Limit)
+	}
+	return db
+}
+
+func (u *ServerCDBRepo) UpdateOrders(ctx context.Context, search *order.Search, value *order.Order) error {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(u.UpdateOrders))
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	if err := applySearchOrder(u.Db, search).WithContext(ctx).Updates(value).Error; err != nil {
+		err = xerrors.Errorf("%w", order.ErrUpdateFail)
+		lib.RecordError(span, err)
+		return err
+	}
+	return nil
+}
+
+func (u *ServerCDBRepo) ListOrders(ctx context.Context, search *order.Search) ([]*order.Order, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(u.ListOrders))
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	r := make([]*order.Order, 0)
+	if err := withBusinessName(applySearchOrder(u.Db, search)).WithContext(ctx).
+		Order(`"orders"."end_date" asc`).Find(&r).Error; err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return nil, err
+	}
+	return r, nil
+}
+
+// TransitionOrders moves the orders found by search to value and records
+// event for each of them, all or none. It returns the orders as they were.
+func (u *ServerCDBRepo) TransitionOrders(ctx context.Context, search *order.Search, value *order.Order, event *order_event.OrderEvent) ([]*order.Order, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(u.TransitionOrders))
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	r := make([]*order.Order, 0)
+	err := u.Db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := withBusinessName(applySearchOrder(tx, search)).Find(&r).Error; err != nil {
+			return err
+		}
+		if len(r) == 0 {
+			return nil
+		}
+		ids := make([]uuid.UUID, 0, len(r))
+		events := make([]*order_event.OrderEvent, 0, len(r))
+		for _, o := range r {
+			ids = append(ids, o.ID)
+			ev := *event
+			ev.ID = uuid.New()
+			ev.OrderId = o.ID
+			ev.OldStatus = o.Status
+			ev.NewStatus = value.Status
+			events = append(events, &ev)
+		}
+		if err := tx.Model(&order.Order{}).Where(`"orders"."id" in ?`, ids).Updates(value).Error; err != nil {
+			return err
+		}
+		return tx.Create(&events).Error
+	})
+	if err != nil {
+		err = xerrors.Errorf("%w", order.ErrUpdateFail)
+		lib.RecordError(span, err)
+		return nil, err
+	}
+	return r, nil
+}
+
+func withBusinessName(db *gorm.DB) *gorm.DB {
+	return db.Model(&order.Order{}).
+		Select(`"orders"."id", "orders"."customer_id", "orders"."customer_name", "orders"."business_id", "orders"."conversation_id", "orders"."end_date", "orders"."status", "businesses"."name" as "business_name"`).
+		Joins(`left join businesses "businesses" on "orders"."business_id" = "businesses"."id"`)
+}
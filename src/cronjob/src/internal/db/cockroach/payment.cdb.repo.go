@@ -1 +1,56 @@
 package cockroach
+
+import (
+	"context"
+
+	"github.com/aqaurius6666/cronjob/src/internal/db/payment"
+	"github.com/aqaurius6666/cronjob/src/internal/lib"
+	"github.com/aqaurius6666/cronjob/src/internal/var/c"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"golang.org/x/xerrors"
+	"gorm.io/gorm"
+)
+
+func applySearchPayment(db *gorm.DB, search *payment.Search) *gorm.DB {
+	if search.BusinessId != uuid.Nil {
+		db = db.Where(payment.Payment{
+			BusinessId: search.BusinessId,
+		})
+	}
+	return db
+}
+
+func (u *ServerCDBRepo) SelectPayment(ctx context.Context, search *payment.Search) (*payment.Payment, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(u.SelectPayment))
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	r := payment.Payment{}
+	if err := applySearchPayment(u.Db, search).WithContext(ctx).First(&r).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			err = payment.ErrNotFound
+		}
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return nil, err
+	}
+	return &r, nil
+}
+
+func (u *ServerCDBRepo) DeletePayment(ctx context.Context, search *payment.Search) error {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(u.DeletePayment))
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	if err := applySearchPayment(u.Db, search).WithContext(ctx).Delete(&payment.Payment{}).Error; err != nil {
+		err = xerrors.Errorf("%w", payment.ErrDeleteFail)
+		lib.RecordError(span, err)
+		return err
+	}
+	return nil
+}
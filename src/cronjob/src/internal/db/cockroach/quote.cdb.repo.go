@@ -0,0 +1,63 @@
+package cockroach
+
+import (
+	"context"
+
+	"github.com/aqaurius6666/cronjob/src/internal/db/quote"
+	"github.com/aqaurius6666/cronjob/src/internal/lib"
+	"github.com/aqaurius6666/cronjob/src/internal/var/c"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"golang.org/x/xerrors"
+	"gorm.io/gorm"
+)
+
+func applySearchQuote(db *gorm.DB, search *quote.Search) *gorm.DB {
+	if search.ID != uuid.Nil {
+		db = db.Where(`"quotes"."id" = ?`, search.ID)
+	}
+	if search.Status != nil {
+		db = db.Where(`"quotes"."status" = ?`, *search.Status)
+	}
+	if search.ValidBefore != nil {
+		db = db.Where(`"quotes"."valid_until" < ?`, *search.ValidBefore)
+	}
+	if search.Limit > 0 {
+		db = db.Limit(search.Limit)
+	}
+	return db
+}
+
+// UpdateQuotes sets value on the quotes found by search, all or none. It
+// returns the quotes as they were, with the name of the customer.
+func (u *ServerCDBRepo) UpdateQuotes(ctx context.Context, search *quote.Search, value *quote.Quote) ([]*quote.Quote, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(u.UpdateQuotes))
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	r := make([]*quote.Quote, 0)
+	err := u.Db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := applySearchQuote(tx.Model(&quote.Quote{}), search).
+			Select(`"quotes"."id", "quotes"."order_id", "quotes"."business_id", "quotes"."customer_id", "quotes"."valid_until", "quotes"."status", "orders"."customer_name"`).
+			Joins(`left join orders "orders" on "quotes"."order_id" = "orders"."id"`).
+			Find(&r).Error; err != nil {
+			return err
+		}
+		if len(r) == 0 {
+			return nil
+		}
+		ids := make([]uuid.UUID, 0, len(r))
+		for _, q := range r {
+			ids = append(ids, q.ID)
+		}
+		return tx.Model(&quote.Quote{}).Where(`"quotes"."id" in ?`, ids).Updates(value).Error
+	})
+	if err != nil {
+		err = xerrors.Errorf("%w", quote.ErrUpdateFail)
+		lib.RecordError(span, err)
+		return nil, err
+	}
+	return r, nil
+}
@@ -3,8 +3,14 @@ package cockroach
 import (
 	"time"
 
+	"github.com/aqaurius6666/cronjob/src/internal/db/business"
+	"github.com/aqaurius6666/cronjob/src/internal/db/contact"
+	"github.com/aqaurius6666/cronjob/src/internal/db/order"
+	"github.com/aqaurius6666/cronjob/src/internal/db/order_event"
 	"github.com/aqaurius6666/cronjob/src/internal/db/payment"
+	"github.com/aqaurius6666/cronjob/src/internal/db/quote"
 	"github.com/aqaurius6666/cronjob/src/internal/db/transaction"
+	"github.com/aqaurius6666/cronjob/src/internal/db/user"
 	"github.com/aqaurius6666/go-utils/database/cockroach"
 	"github.com/google/wire"
 )
@@ -22,6 +28,12 @@ func InterfacesProvider() cockroach.DBInterfaces {
 	return cockroach.DBInterfaces{
 		payment.Payment{},
 		transaction.Transaction{},
+		user.User{},
+		business.Business{},
+		contact.Contact{},
+		order.Order{},
+		order_event.OrderEvent{},
+		quote.Quote{},
 	}
 }
 
@@ -13,6 +13,8 @@ import (
 )
 
 func applySearchTransaction(db *gorm.DB, search *transaction.Search) *gorm.DB {
+	// Fees waived after a dispute are never billed.
+	db = db.Where(`"transactions"."is_waived" is not true`)
 	if search.Now != nil {
 		db = db.Where(`"transactions"."created_at" < ?`, *search.Now)
 	}
@@ -0,0 +1,55 @@
+package cockroach
+
+import (
+	"context"
+
+	"github.com/aqaurius6666/cronjob/src/internal/db/user"
+	"github.com/aqaurius6666/cronjob/src/internal/lib"
+	"github.com/aqaurius6666/cronjob/src/internal/var/c"
+	"github.com/aqaurius6666/go-utils/database"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"golang.org/x/xerrors"
+	"gorm.io/gorm"
+)
+
+func applySearchUser(db *gorm.DB, search *user.Search) *gorm.DB {
+	if search.ID != uuid.Nil {
+		db = db.Where(user.User{
+			BaseModel: database.BaseModel{
+				ID: search.ID,
+			},
+		})
+	}
+	return db
+}
+
+func (u *ServerCDBRepo) DeleteUser(ctx context.Context, search *user.Search) error {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(u.DeleteUser))
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	if err := applySearchUser(u.Db, search).WithContext(ctx).Delete(&user.User{}).Error; err != nil {
+		err = xerrors.Errorf("%w", user.ErrDeleteFail)
+		lib.RecordError(span, err)
+		return err
+	}
+	return nil
+}
+
+func (u *ServerCDBRepo) UpdateUser(ctx context.Context, search *user.Search, value *user.User) error {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(u.UpdateUser))
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	if err := applySearchUser(u.Db, search).WithContext(ctx).Updates(value).Error; err != nil {
+		err = xerrors.Errorf("%w", user.ErrUpdateFail)
+		lib.RecordError(span, err)
+		return err
+	}
+	return nil
+}
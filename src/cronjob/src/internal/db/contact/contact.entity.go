@@ -0,0 +1,13 @@
+package contact
+
+import (
+	"github.com/aqaurius6666/go-utils/database"
+)
+
+type Contact struct {
+	database.BaseModel
+}
+type Search struct {
+	database.DefaultSearchModel
+	Contact
+}
@@ -0,0 +1,9 @@
+package contact
+
+import "golang.org/x/xerrors"
+
+var (
+	prefix        = "contact"
+	ErrNotFound   = xerrors.Errorf("%s: record not found", prefix)
+	ErrDeleteFail = xerrors.Errorf("%s: delete failed", prefix)
+)
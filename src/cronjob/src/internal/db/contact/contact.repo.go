@@ -0,0 +1,7 @@
+package contact
+
+import "context"
+
+type ContactRepo interface {
+	DeleteContact(ctx context.Context, search *Search) error
+}
@@ -0,0 +1,33 @@
+package order
+
+import (
+	"github.com/aqaurius6666/go-utils/database"
+	"github.com/google/uuid"
+)
+
+type Order struct {
+	database.BaseModel
+	CustomerId      uuid.UUID `gorm:"type:uuid"`
+	CustomerPhone   *string   `gorm:"type:varchar(16)"`
+	CustomerName    *string   `gorm:"type:varchar(128)"`
+	CustomerMessage *string   `gorm:"type:varchar(256)"`
+	BusinessId      uuid.UUID `gorm:"type:uuid"`
+	ConversationId  uuid.UUID `gorm:"type:uuid"`
+	EndDate         *int64    `gorm:"type:bigint"`
+	Status          *int32    `gorm:"type:int8;default:0"`
+	ExpiryReminded  *bool     `gorm:"type:bool;default:false"`
+	// ConfirmBy is when a job marked done auto-confirms.
+	ConfirmBy              *int64  `gorm:"type:bigint;index"`
+	CompletionConfirmation *int32  `gorm:"type:int8;default:0"`
+	CompletedAt            *int64  `gorm:"type:bigint"`
+	BusinessName           *string `gorm:"-:migration;->"`
+}
+type Search struct {
+	database.DefaultSearchModel
+	Order
+	// EndDate is the earliest end date of the orders, EndBefore is after
+	// their end date
+	EndBefore *int64
+	// ConfirmBefore is after the confirmation deadline of the orders
+	ConfirmBefore *int64
+}
@@ -0,0 +1,9 @@
+package order
+
+import "golang.org/x/xerrors"
+
+var (
+	prefix        = "order"
+	ErrNotFound   = xerrors.Errorf("%s: record not found", prefix)
+	ErrUpdateFail = xerrors.Errorf("%s: update failed", prefix)
+)
@@ -0,0 +1,13 @@
+package order
+
+import (
+	"context"
+
+	"github.com/aqaurius6666/cronjob/src/internal/db/order_event"
+)
+
+type OrderRepo interface {
+	UpdateOrders(ctx context.Context, search *Search, value *Order) error
+	ListOrders(ctx context.Context, search *Search) ([]*Order, error)
+	TransitionOrders(ctx context.Context, search *Search, value *Order, event *order_event.OrderEvent) ([]*Order, error)
+}
@@ -6,4 +6,5 @@ var (
 	prefix        = "payment"
 	ErrNotFound   = xerrors.Errorf("%s: record not found", prefix)
 	ErrInsertFail = xerrors.Errorf("%s: insert failed", prefix)
+	ErrDeleteFail = xerrors.Errorf("%s: delete failed", prefix)
 )
@@ -1,4 +1,8 @@
 package payment
 
+import "context"
+
 type PaymentRepo interface {
+	SelectPayment(ctx context.Context, search *Search) (*Payment, error)
+	DeletePayment(ctx context.Context, search *Search) error
 }
@@ -0,0 +1,24 @@
+package quote
+
+import (
+	"github.com/aqaurius6666/go-utils/database"
+	"github.com/google/uuid"
+)
+
+// Quote is the price a handyman offers for a pending order.
+type Quote struct {
+	database.BaseModel
+	OrderId      uuid.UUID `gorm:"type:uuid;index"`
+	BusinessId   uuid.UUID `gorm:"type:uuid"`
+	CustomerId   uuid.UUID `gorm:"type:uuid;index"`
+	ValidUntil   *int64    `gorm:"type:bigint"`
+	Status       *int32    `gorm:"type:int8;default:0"`
+	CustomerName *string   `gorm:"-:migration;->"`
+}
+
+type Search struct {
+	database.DefaultSearchModel
+	Quote
+	// ValidBefore only matches quotes whose validity ends before it.
+	ValidBefore *int64
+}
@@ -0,0 +1,8 @@
+package quote
+
+import "golang.org/x/xerrors"
+
+var (
+	prefix        = "quote"
+	ErrUpdateFail = xerrors.Errorf("%s: update failed", prefix)
+)
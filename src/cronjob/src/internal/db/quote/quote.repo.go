@@ -0,0 +1,7 @@
+package quote
+
+import "context"
+
+type QuoteRepo interface {
+	UpdateQuotes(ctx context.Context, search *Search, value *Quote) ([]*Quote, error)
+}
@@ -1,8 +1,13 @@
 package db
 
 import (
+	"github.com/aqaurius6666/cronjob/src/internal/db/business"
+	"github.com/aqaurius6666/cronjob/src/internal/db/contact"
+	"github.com/aqaurius6666/cronjob/src/internal/db/order"
 	"github.com/aqaurius6666/cronjob/src/internal/db/payment"
+	"github.com/aqaurius6666/cronjob/src/internal/db/quote"
 	"github.com/aqaurius6666/cronjob/src/internal/db/transaction"
+	"github.com/aqaurius6666/cronjob/src/internal/db/user"
 	"github.com/aqaurius6666/go-utils/database"
 )
 
@@ -12,4 +17,9 @@ type ServerRepo interface {
 	database.CommonRepository
 	payment.PaymentRepo
 	transaction.TransactionRepo
+	user.UserRepo
+	business.BusinessRepo
+	contact.ContactRepo
+	order.OrderRepo
+	quote.QuoteRepo
 }
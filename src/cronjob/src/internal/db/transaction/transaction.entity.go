@@ -13,6 +13,7 @@ type Transaction struct {
 	Fee              *int64    `gorm:"type:int8;default:0"`
 	IsFree           *bool     `gorm:"type:bool;default:false"`
 	IsPaid           *bool     `gorm:"type:bool;default:false"`
+	IsWaived         *bool     `gorm:"type:bool;default:false"`
 	PaymentIntentId  *string   `gorm:"type:varchar(128)"`
 	StartDate        *int64    `gorm:"-:migration;->"`
 	EndDate          *int64    `gorm:"-:migration;->"`
@@ -0,0 +1,14 @@
+package user
+
+import (
+	"github.com/aqaurius6666/go-utils/database"
+)
+
+type User struct {
+	database.BaseModel
+	Status *int32 `gorm:"type:int8;default:0"`
+}
+type Search struct {
+	database.DefaultSearchModel
+	User
+}
@@ -0,0 +1,10 @@
+package user
+
+import "golang.org/x/xerrors"
+
+var (
+	prefix        = "user"
+	ErrNotFound   = xerrors.Errorf("%s: record not found", prefix)
+	ErrDeleteFail = xerrors.Errorf("%s: delete failed", prefix)
+	ErrUpdateFail = xerrors.Errorf("%s: update failed", prefix)
+)
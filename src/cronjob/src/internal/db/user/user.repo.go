@@ -0,0 +1,8 @@
+package user
+
+import "context"
+
+type UserRepo interface {
+	DeleteUser(ctx context.Context, search *Search) error
+	UpdateUser(ctx context.Context, search *Search, value *User) error
+}
@@ -0,0 +1,135 @@
+package model
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aqaurius6666/cronjob/src/internal/db/business"
+	"github.com/aqaurius6666/cronjob/src/internal/db/contact"
+	"github.com/aqaurius6666/cronjob/src/internal/db/order"
+	"github.com/aqaurius6666/cronjob/src/internal/db/payment"
+	"github.com/aqaurius6666/cronjob/src/internal/db/user"
+	"github.com/aqaurius6666/cronjob/src/internal/lib"
+	"github.com/aqaurius6666/cronjob/src/internal/var/c"
+	"github.com/aqaurius6666/cronjob/src/services/authservice"
+	"github.com/aqaurius6666/go-utils/database"
+	"github.com/aqaurius6666/go-utils/utils"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"golang.org/x/xerrors"
+)
+
+type DeletionModel interface {
+	ListDueDeletions(ctx context.Context, limit int32) ([]*authservice.DueDeletion, error)
+	EraseAccount(ctx context.Context, u *authservice.DueDeletion) error
+}
+
+func (s *ServerModel) ListDueDeletions(ctx context.Context, limit int32) ([]*authservice.DueDeletion, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.ListDueDeletions))
+	defer span.End()
+
+	users, err := s.Auth.ListDueDeletions(ctx, limit)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return nil, err
+	}
+	return users, nil
+}
+
+// EraseAccount removes the data of u from every service. Every step can be
+// run again, and authservice forgets the account last, so an account that
+// fails halfway is picked up again by the next run.
+func (s *ServerModel) EraseAccount(ctx context.Context, u *authservice.DueDeletion) error {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.EraseAccount))
+	defer span.End()
+
+	uid, err := uuid.Parse(u.Id)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return err
+	}
+	if err := s.Chat.DeleteUserData(ctx, u.Id); err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return err
+	}
+	if err := s.Mail.DeleteUserDevices(ctx, u.Id); err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return err
+	}
+	switch u.Role {
+	case c.ROLE_CUSTOMER:
+		err = s.eraseCustomer(ctx, uid)
+	case c.ROLE_HANDYMAN:
+		err = s.eraseBusiness(ctx, uid)
+	}
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return err
+	}
+	if err := s.Repo.DeleteContact(ctx, &contact.Search{
+		Contact: contact.Contact{BaseModel: database.BaseModel{ID: uid}},
+	}); err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return err
+	}
+	if err := s.Auth.DeleteUser(ctx, u.Id); err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return err
+	}
+	return nil
+}
+
+// eraseCustomer keeps the orders of a customer for the businesses that
+// served them, without the details that identify the customer.
+func (s *ServerModel) eraseCustomer(ctx context.Context, id uuid.UUID) error {
+	if err := s.Repo.UpdateOrders(ctx, &order.Search{
+		Order: order.Order{CustomerId: id},
+	}, &order.Order{
+		CustomerPhone:   utils.StrPtr(""),
+		CustomerName:    utils.StrPtr(""),
+		CustomerMessage: utils.StrPtr(""),
+	}); err != nil {
+		return xerrors.Errorf("%w", err)
+	}
+	if err := s.Repo.DeleteUser(ctx, &user.Search{
+		User: user.User{BaseModel: database.BaseModel{ID: id}},
+	}); err != nil {
+		return xerrors.Errorf("%w", err)
+	}
+	return nil
+}
+
+// eraseBusiness deletes the stripe customer of a business before its row.
+func (s *ServerModel) eraseBusiness(ctx context.Context, id uuid.UUID) error {
+	p, err := s.Repo.SelectPayment(ctx, &payment.Search{
+		Payment: payment.Payment{BusinessId: id},
+	})
+	if err != nil && !errors.Is(err, payment.ErrNotFound) {
+		return xerrors.Errorf("%w", err)
+	}
+	if p != nil {
+		if utils.StrVal(p.CustomerId) != "" {
+			if err := s.Payment.DeleteCustomer(ctx, p.CustomerId); err != nil {
+				return xerrors.Errorf("%w", err)
+			}
+		}
+		if err := s.Repo.DeletePayment(ctx, &payment.Search{
+			Payment: payment.Payment{BusinessId: id},
+		}); err != nil {
+			return xerrors.Errorf("%w", err)
+		}
+	}
+	if err := s.Repo.DeleteBusiness(ctx, &business.Search{
+		Business: business.Business{BaseModel: database.BaseModel{ID: id}},
+	}); err != nil {
+		return xerrors.Errorf("%w", err)
+	}
+	return nil
+}
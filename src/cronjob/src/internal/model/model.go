@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/aqaurius6666/cronjob/src/internal/db"
+	"github.com/aqaurius6666/cronjob/src/services/authservice"
 	"github.com/aqaurius6666/cronjob/src/services/chatservice"
 	"github.com/aqaurius6666/cronjob/src/services/mailservice"
 	"github.com/aqaurius6666/cronjob/src/services/payment"
@@ -16,6 +17,11 @@ type Server interface {
 	TransactionModel
 	NotificationModel
 	ConversationModel
+	DeletionModel
+	SuspensionModel
+	OrderExpiryModel
+	OrderCompletionModel
+	QuoteExpiryModel
 }
 
 type ServerModel struct {
@@ -26,6 +32,7 @@ type ServerModel struct {
 	Mail    mailservice.Service
 	Redis   redis.Redis
 	Chat    chatservice.Service
+	Auth    authservice.Service
 }
 
 var ServerModelSet = wire.NewSet(wire.Bind(new(Server), new(*ServerModel)), wire.Struct(new(ServerModel), "*"))
@@ -4,9 +4,13 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
+	"github.com/aqaurius6666/cronjob/src/internal/db/order"
+	"github.com/aqaurius6666/cronjob/src/internal/db/quote"
 	"github.com/aqaurius6666/cronjob/src/internal/lib"
 	"github.com/aqaurius6666/cronjob/src/internal/var/c"
+	"github.com/aqaurius6666/go-utils/utils"
 	"github.com/google/uuid"
 	"go.opentelemetry.io/otel"
 	"golang.org/x/xerrors"
@@ -14,6 +18,10 @@ import (
 
 type NotificationModel interface {
 	SendFeeNotification(ctx context.Context, handymanId string, fee float32) error
+	SendOrderExpiryReminderNotification(ctx context.Context, o *order.Order) error
+	SendOrderExpiredNotification(ctx context.Context, o *order.Order, to c.ROLE) error
+	SendOrderAutoConfirmedNotification(ctx context.Context, o *order.Order, to c.ROLE) error
+	SendQuoteLapsedNotification(ctx context.Context, q *quote.Quote) error
 }
 
 func (s *ServerModel) SendFeeNotification(ctx context.Context, handymanId string, fee float32) error {
@@ -42,3 +50,90 @@ func (s *ServerModel) SendFeeNotification(ctx context.Context, handymanId string
 	}
 	return nil
 }
+
+func (s *ServerModel) SendOrderExpiryReminderNotification(ctx context.Context, o *order.Order) error {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.SendOrderExpiryReminderNotification))
+	defer span.End()
+	left := time.Until(time.UnixMilli(utils.Int64Val(o.EndDate))).Round(time.Hour)
+	body := fmt.Sprintf("The request of %s expires in about %d hour(s). Answer it before it does", utils.StrVal(o.CustomerName), int(left.Hours()))
+
+	if err := s.sendOrderNotification(ctx, o.BusinessId.String(), body, c.ORDER_EXPIRY_REMINDER_NOTIFICATION, o.ID); err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return err
+	}
+	return nil
+}
+
+// SendOrderExpiredNotification tells the customer or the handyman of o that
+// it expired without an answer.
+func (s *ServerModel) SendOrderExpiredNotification(ctx context.Context, o *order.Order, to c.ROLE) error {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.SendOrderExpiredNotification))
+	defer span.End()
+	userId := o.CustomerId.String()
+	body := fmt.Sprintf("%s did not answer your request in time. You can request another business", utils.StrVal(o.BusinessName))
+	if to == c.ROLE_HANDYMAN {
+		userId = o.BusinessId.String()
+		body = fmt.Sprintf("The request of %s expired without your answer", utils.StrVal(o.CustomerName))
+	}
+
+	if err := s.sendOrderNotification(ctx, userId, body, c.ORDER_EXPIRED_NOTIFICATION, o.ID); err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return err
+	}
+	return nil
+}
+
+// SendOrderAutoConfirmedNotification tells the customer or the handyman of o
+// that its job was confirmed done because nobody answered in time.
+func (s *ServerModel) SendOrderAutoConfirmedNotification(ctx context.Context, o *order.Order, to c.ROLE) error {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.SendOrderAutoConfirmedNotification))
+	defer span.End()
+	userId := o.CustomerId.String()
+	body := fmt.Sprintf("Your job with %s is confirmed as done. You can now review it", utils.StrVal(o.BusinessName))
+	if to == c.ROLE_HANDYMAN {
+		userId = o.BusinessId.String()
+		body = fmt.Sprintf("The job for %s is confirmed as done", utils.StrVal(o.CustomerName))
+	}
+
+	if err := s.sendOrderNotification(ctx, userId, body, c.ORDER_AUTO_CONFIRMED_NOTIFICATION, o.ID); err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return err
+	}
+	return nil
+}
+
+// SendQuoteLapsedNotification tells the handyman of q that it lapsed before
+// the customer accepted it.
+func (s *ServerModel) SendQuoteLapsedNotification(ctx context.Context, q *quote.Quote) error {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.SendQuoteLapsedNotification))
+	defer span.End()
+	body := fmt.Sprintf("Your quote for %s lapsed before it was accepted. You can send a new one while the request is pending", utils.StrVal(q.CustomerName))
+
+	if err := s.sendOrderNotification(ctx, q.BusinessId.String(), body, c.QUOTE_LAPSED_NOTIFICATION, q.OrderId); err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return err
+	}
+	return nil
+}
+
+func (s *ServerModel) sendOrderNotification(ctx context.Context, userId string, body string, typ string, orderId uuid.UUID) error {
+	nid := uuid.New()
+	message := map[string]string{
+		"id":      nid.String(),
+		"seq":     fmt.Sprint(nid.ClockSequence()),
+		"type":    typ,
+		"orderId": orderId.String(),
+	}
+	messageByte, err := json.Marshal(message)
+	if err != nil {
+		return xerrors.Errorf("%w", err)
+	}
+	if err := s.Mail.SendNotification(ctx, userId, "AnyGoNow", body, string(messageByte)); err != nil {
+		return xerrors.Errorf("%w", err)
+	}
+	return nil
+}
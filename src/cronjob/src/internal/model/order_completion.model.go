@@ -0,0 +1,68 @@
+package model
+
+import (
+	"context"
+	"time"
+
+	"github.com/aqaurius6666/cronjob/src/internal/db/order"
+	"github.com/aqaurius6666/cronjob/src/internal/db/order_event"
+	"github.com/aqaurius6666/cronjob/src/internal/lib"
+	"github.com/aqaurius6666/cronjob/src/internal/var/c"
+	"github.com/aqaurius6666/go-utils/database"
+	"github.com/aqaurius6666/go-utils/utils"
+	"go.opentelemetry.io/otel"
+	"golang.org/x/xerrors"
+)
+
+type OrderCompletionModel interface {
+	ConfirmOrders(ctx context.Context, limit int32) ([]*order.Order, error)
+	FinishConfirmedOrder(ctx context.Context, o *order.Order) error
+}
+
+// ConfirmOrders completes at most limit jobs marked done whose other side did
+// not answer before their confirmation deadline, and records it as a system
+// change. It returns the completed orders.
+func (s *ServerModel) ConfirmOrders(ctx context.Context, limit int32) ([]*order.Order, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.ConfirmOrders))
+	defer span.End()
+
+	now := time.Now().UnixMilli()
+	orders, err := s.Repo.TransitionOrders(ctx, &order.Search{
+		Order: order.Order{
+			Status: utils.Int32Ptr(int32(c.ORDER_STATUS_AWAITING_CONFIRMATION)),
+		},
+		ConfirmBefore:      utils.Int64Ptr(now),
+		DefaultSearchModel: database.DefaultSearchModel{Limit: int(limit)},
+	}, &order.Order{
+		Status:                 utils.Int32Ptr(int32(c.ORDER_STATUS_COMPLETED)),
+		CompletionConfirmation: utils.Int32Ptr(int32(c.COMPLETION_CONFIRMATION_AUTO_CONFIRMED)),
+		CompletedAt:            utils.Int64Ptr(now),
+	}, &order_event.OrderEvent{
+		Reason: utils.Int32Ptr(int32(c.ORDER_REASON_CONFIRMATION_TIMEOUT)),
+	})
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return nil, err
+	}
+	return orders, nil
+}
+
+// FinishConfirmedOrder closes the conversation of o, completed by
+// ConfirmOrders, and tells both sides. Both are told even if closing fails.
+func (s *ServerModel) FinishConfirmedOrder(ctx context.Context, o *order.Order) error {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.FinishConfirmedOrder))
+	defer span.End()
+
+	errConv := s.Chat.CloseConversation(ctx, o.ConversationId.String())
+	errC := s.SendOrderAutoConfirmedNotification(ctx, o, c.ROLE_CUSTOMER)
+	errH := s.SendOrderAutoConfirmedNotification(ctx, o, c.ROLE_HANDYMAN)
+	for _, err := range []error{errConv, errC, errH} {
+		if err != nil {
+			err = xerrors.Errorf("%w", err)
+			lib.RecordError(span, err)
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+package model
+
+import (
+	"context"
+	"time"
+
+	"github.com/aqaurius6666/cronjob/src/internal/db/order"
+	"github.com/aqaurius6666/cronjob/src/internal/db/order_event"
+	"github.com/aqaurius6666/cronjob/src/internal/lib"
+	"github.com/aqaurius6666/cronjob/src/internal/var/c"
+	"github.com/aqaurius6666/go-utils/database"
+	"github.com/aqaurius6666/go-utils/utils"
+	"go.opentelemetry.io/otel"
+	"golang.org/x/xerrors"
+)
+
+type OrderExpiryModel interface {
+	ListExpiringOrders(ctx context.Context, within time.Duration, limit int32) ([]*order.Order, error)
+	RemindExpiringOrder(ctx context.Context, o *order.Order) error
+	ExpireOrders(ctx context.Context, limit int32) ([]*order.Order, error)
+	NotifyOrderExpired(ctx context.Context, o *order.Order) error
+}
+
+// ListExpiringOrders lists the pending orders expiring within the given time
+// whose handyman was not reminded yet.
+func (s *ServerModel) ListExpiringOrders(ctx context.Context, within time.Duration, limit int32) ([]*order.Order, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.ListExpiringOrders))
+	defer span.End()
+
+	now := time.Now()
+	orders, err := s.Repo.ListOrders(ctx, &order.Search{
+		Order: order.Order{
+			Status:         utils.Int32Ptr(int32(c.ORDER_STATUS_PENDING)),
+			ExpiryReminded: utils.BoolPtr(false),
+			EndDate:        utils.Int64Ptr(now.UnixMilli()),
+		},
+		EndBefore:          utils.Int64Ptr(now.Add(within).UnixMilli()),
+		DefaultSearchModel: database.DefaultSearchModel{Limit: int(limit)},
+	})
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return nil, err
+	}
+	return orders, nil
+}
+
+// RemindExpiringOrder tells the handyman of o to answer before it expires.
+// The order is marked reminded only once the notification is sent, so a
+// failed one is retried by the next run.
+func (s *ServerModel) RemindExpiringOrder(ctx context.Context, o *order.Order) error {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.RemindExpiringOrder))
+	defer span.End()
+
+	if err := s.SendOrderExpiryReminderNotification(ctx, o); err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return err
+	}
+	err := s.Repo.UpdateOrders(ctx, &order.Search{
+		Order: order.Order{BaseModel: database.BaseModel{ID: o.ID}},
+	}, &order.Order{ExpiryReminded: utils.BoolPtr(true)})
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return err
+	}
+	return nil
+}
+
+// ExpireOrders moves at most limit pending orders past their end date to
+// expired and records it as a system change. It returns the expired orders.
+func (s *ServerModel) ExpireOrders(ctx context.Context, limit int32) ([]*order.Order, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.ExpireOrders))
+	defer span.End()
+
+	orders, err := s.Repo.TransitionOrders(ctx, &order.Search{
+		Order: order.Order{
+			Status: utils.Int32Ptr(int32(c.ORDER_STATUS_PENDING)),
+		},
+		EndBefore:          utils.Int64Ptr(time.Now().UnixMilli()),
+		DefaultSearchModel: database.DefaultSearchModel{Limit: int(limit)},
+	}, &order.Order{
+		Status: utils.Int32Ptr(int32(c.ORDER_STATUS_EXPIRED_STATUS)),
+	}, &order_event.OrderEvent{
+		Reason: utils.Int32Ptr(int32(c.ORDER_REASON_EXPIRED)),
+	})
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return nil, err
+	}
+	return orders, nil
+}
+
+// NotifyOrderExpired tells both the customer and the handyman of o that it
+// expired. Both are tried even if one fails.
+func (s *ServerModel) NotifyOrderExpired(ctx context.Context, o *order.Order) error {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.NotifyOrderExpired))
+	defer span.End()
+
+	errC := s.SendOrderExpiredNotification(ctx, o, c.ROLE_CUSTOMER)
+	errH := s.SendOrderExpiredNotification(ctx, o, c.ROLE_HANDYMAN)
+	for _, err := range []error{errC, errH} {
+		if err != nil {
+			err = xerrors.Errorf("%w", err)
+			lib.RecordError(span, err)
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+package model
+
+import (
+	"context"
+	"time"
+
+	"github.com/aqaurius6666/cronjob/src/internal/db/quote"
+	"github.com/aqaurius6666/cronjob/src/internal/lib"
+	"github.com/aqaurius6666/cronjob/src/internal/var/c"
+	"github.com/aqaurius6666/go-utils/database"
+	"github.com/aqaurius6666/go-utils/utils"
+	"go.opentelemetry.io/otel"
+	"golang.org/x/xerrors"
+)
+
+type QuoteExpiryModel interface {
+	LapseQuotes(ctx context.Context, limit int32) ([]*quote.Quote, error)
+}
+
+// LapseQuotes moves at most limit open quotes past their validity to lapsed.
+// It returns the lapsed quotes.
+func (s *ServerModel) LapseQuotes(ctx context.Context, limit int32) ([]*quote.Quote, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.LapseQuotes))
+	defer span.End()
+
+	quotes, err := s.Repo.UpdateQuotes(ctx, &quote.Search{
+		Quote: quote.Quote{
+			Status: utils.Int32Ptr(int32(c.QUOTE_STATUS_OPEN)),
+		},
+		ValidBefore:        utils.Int64Ptr(time.Now().UnixMilli()),
+		DefaultSearchModel: database.DefaultSearchModel{Limit: int(limit)},
+	}, &quote.Quote{
+		Status: utils.Int32Ptr(int32(c.QUOTE_STATUS_LAPSED)),
+	})
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return nil, err
+	}
+	return quotes, nil
+}
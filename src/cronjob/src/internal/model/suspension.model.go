@@ -0,0 +1,71 @@
+package model
+
+import (
+	"context"
+
+	"github.com/aqaurius6666/cronjob/src/internal/db/business"
+	"github.com/aqaurius6666/cronjob/src/internal/db/user"
+	"github.com/aqaurius6666/cronjob/src/internal/lib"
+	"github.com/aqaurius6666/cronjob/src/internal/var/c"
+	"github.com/aqaurius6666/cronjob/src/services/authservice"
+	"github.com/aqaurius6666/go-utils/database"
+	"github.com/aqaurius6666/go-utils/utils"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"golang.org/x/xerrors"
+)
+
+type SuspensionModel interface {
+	ListExpiredSuspensions(ctx context.Context, limit int32) ([]*authservice.ExpiredSuspension, error)
+	LiftExpiredSuspension(ctx context.Context, u *authservice.ExpiredSuspension) error
+}
+
+func (s *ServerModel) ListExpiredSuspensions(ctx context.Context, limit int32) ([]*authservice.ExpiredSuspension, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.ListExpiredSuspensions))
+	defer span.End()
+
+	users, err := s.Auth.ListExpiredSuspensions(ctx, limit)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return nil, err
+	}
+	return users, nil
+}
+
+// LiftExpiredSuspension marks the account of u active and lets it sign in
+// again. authservice lifts the suspension last, so an account that fails
+// halfway is picked up again by the next run.
+func (s *ServerModel) LiftExpiredSuspension(ctx context.Context, u *authservice.ExpiredSuspension) error {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.LiftExpiredSuspension))
+	defer span.End()
+
+	uid, err := uuid.Parse(u.Id)
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return err
+	}
+	active := utils.Int32Ptr(int32(c.ACCOUNT_STATUS_ACTIVE))
+	switch u.Role {
+	case c.ROLE_CUSTOMER:
+		err = s.Repo.UpdateUser(ctx, &user.Search{
+			User: user.User{BaseModel: database.BaseModel{ID: uid}},
+		}, &user.User{Status: active})
+	case c.ROLE_HANDYMAN:
+		err = s.Repo.UpdateBusiness(ctx, &business.Search{
+			Business: business.Business{BaseModel: database.BaseModel{ID: uid}},
+		}, &business.Business{Status: active})
+	}
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return err
+	}
+	if err := s.Auth.LiftSuspension(ctx, u.Id); err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return err
+	}
+	return nil
+}
@@ -2,8 +2,10 @@ package main
 
 import (
 	"os"
+	"path/filepath"
 	"time"
 
+	"github.com/aqaurius6666/cronjob/src/internal/lib/mtls"
 	"github.com/aqaurius6666/cronjob/src/internal/var/c"
 	cli2 "github.com/aqaurius6666/go-utils/cli"
 	"github.com/sirupsen/logrus"
@@ -34,14 +36,14 @@ func makeApp() *cli.App {
 			},
 		},
 		Action: runMain,
-		Flags:  makeFlags(cli2.GormFlag, cli2.CommonServerFlag, cli2.LoggerFlag, CustomFlag, cli2.FeatureToggleFlag, cli2.PrometheusFlag, RedisFlag),
+		Flags:  makeFlags(cli2.GormFlag, cli2.CommonServerFlag, cli2.LoggerFlag, CustomFlag, cli2.FeatureToggleFlag, cli2.PrometheusFlag, RedisFlag, TLSFlag),
 		Commands: []*cli.Command{
 			{
 				Name:    "serve",
 				Aliases: []string{"s"},
 				Usage:   "run server",
 				Action:  runMain,
-				Flags:   makeFlags(cli2.GormFlag, cli2.CommonServerFlag, cli2.LoggerFlag, CustomFlag, cli2.FeatureToggleFlag, cli2.PrometheusFlag, RedisFlag),
+				Flags:   makeFlags(cli2.GormFlag, cli2.CommonServerFlag, cli2.LoggerFlag, CustomFlag, cli2.FeatureToggleFlag, cli2.PrometheusFlag, RedisFlag, TLSFlag),
 			},
 		},
 	}
@@ -76,6 +78,11 @@ var (
 			EnvVars:  []string{"CONFIG_CHATSERVICE_ADDRESS"},
 			Required: true,
 		},
+		&cli.StringFlag{
+			Name:     "authservice-address",
+			EnvVars:  []string{"CONFIG_AUTHSERVICE_ADDRESS"},
+			Required: true,
+		},
 		// &cli.StringFlag{
 		// 	Name:     "frontend-url",
 		// 	EnvVars:  []string{"CONFIG_FRONTEND_URL"},
@@ -109,6 +116,74 @@ var (
 			EnvVars: []string{"CONFIG_CHAT_QUANTITY_INTERVAL"},
 			Value:   1,
 		},
+		&cli.IntFlag{
+			Name:    "deletion-quantity-interval",
+			Usage:   "minutes between two runs of the account deletion job",
+			EnvVars: []string{"CONFIG_DELETION_QUANTITY_INTERVAL"},
+			Value:   60,
+		},
+		&cli.IntFlag{
+			Name:    "suspension-quantity-interval",
+			Usage:   "minutes between two runs of the expired suspension job",
+			EnvVars: []string{"CONFIG_SUSPENSION_QUANTITY_INTERVAL"},
+			Value:   5,
+		},
+		&cli.IntFlag{
+			Name:    "order-expiry-quantity-interval",
+			Usage:   "minutes between two runs of the pending order expiry job",
+			EnvVars: []string{"CONFIG_ORDER_EXPIRY_QUANTITY_INTERVAL"},
+			Value:   5,
+		},
+		&cli.IntFlag{
+			Name:    "order-expiry-reminder",
+			Usage:   "minutes before a pending order expires its handyman is reminded",
+			EnvVars: []string{"CONFIG_ORDER_EXPIRY_REMINDER"},
+			Value:   720,
+		},
+		&cli.IntFlag{
+			Name:    "order-completion-quantity-interval",
+			Usage:   "minutes between two runs of the job confirming jobs marked done",
+			EnvVars: []string{"CONFIG_ORDER_COMPLETION_QUANTITY_INTERVAL"},
+			Value:   5,
+		},
+		&cli.IntFlag{
+			Name:    "quote-expiry-quantity-interval",
+			Usage:   "minutes between two runs of the job lapsing quotes past their validity",
+			EnvVars: []string{"CONFIG_QUOTE_EXPIRY_QUANTITY_INTERVAL"},
+			Value:   5,
+		},
+	}
+)
+
+var (
+	TLSFlag = []cli.Flag{
+		&cli.StringFlag{
+			Name:    "tls-mode",
+			Usage:   "transport security of internal gRPC servers and clients: disabled, dev or mtls",
+			EnvVars: []string{"CONFIG_TLS_MODE"},
+			Value:   mtls.ModeDisabled,
+		},
+		&cli.StringFlag{
+			Name:    "tls-ca-file",
+			Usage:   "PEM encoded CA that signs the certificates of every service",
+			EnvVars: []string{"CONFIG_TLS_CA_FILE"},
+		},
+		&cli.StringFlag{
+			Name:    "tls-cert-file",
+			Usage:   "PEM encoded certificate of this service, its common name is the service identity",
+			EnvVars: []string{"CONFIG_TLS_CERT_FILE"},
+		},
+		&cli.StringFlag{
+			Name:    "tls-key-file",
+			Usage:   "PEM encoded private key of tls-cert-file",
+			EnvVars: []string{"CONFIG_TLS_KEY_FILE"},
+		},
+		&cli.StringFlag{
+			Name:    "tls-dev-dir",
+			Usage:   "where dev mode keeps the local CA shared by services on this machine",
+			EnvVars: []string{"CONFIG_TLS_DEV_DIR"},
+			Value:   filepath.Join(os.TempDir(), "grpc-dev-ca"),
+		},
 	}
 )
 
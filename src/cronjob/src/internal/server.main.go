@@ -13,8 +13,10 @@ import (
 
 	"github.com/aqaurius6666/cronjob/src/internal/cronjob"
 	"github.com/aqaurius6666/cronjob/src/internal/db"
+	"github.com/aqaurius6666/cronjob/src/internal/lib/mtls"
 	"github.com/aqaurius6666/cronjob/src/internal/lib/unleash"
 	"github.com/aqaurius6666/cronjob/src/internal/var/c"
+	"github.com/aqaurius6666/cronjob/src/services/authservice"
 	"github.com/aqaurius6666/cronjob/src/services/chatservice"
 	"github.com/aqaurius6666/cronjob/src/services/mailservice"
 	"github.com/aqaurius6666/cronjob/src/services/payment"
@@ -32,6 +34,7 @@ import (
 	"go.opentelemetry.io/otel/sdk/resource"
 	tracesdk "go.opentelemetry.io/otel/sdk/trace"
 	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+	"golang.org/x/xerrors"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	healthpb "google.golang.org/grpc/health/grpc_health_v1"
@@ -72,6 +75,11 @@ func runMain(appCtx *cli.Context) error {
 		logger.Fatal(err)
 		return err
 	}
+	creds, err := newTransportCredentials(appCtx)
+	if err != nil {
+		logger.Fatal(err)
+		return err
+	}
 	mainServer, err := InitMainServer(ctx, logger, ServerOptions{
 		DBDsn:                db.DBDsn(appCtx.String("db-uri")),
 		Key:                  payment.STRIPE_API_KEY(appCtx.String("stripe-key")),
@@ -84,6 +92,14 @@ func runMain(appCtx *cli.Context) error {
 		RedisUser:            redis.REDIS_USER(appCtx.String("redis-user")),
 		RedisPass:            redis.REDIS_PASS(appCtx.String("redis-pass")),
 		ChatserviceAddr:      chatservice.ChatserviceAddr(appCtx.String("chatservice-address")),
+		AuthserviceAddr:      authservice.AuthserviceAddr(appCtx.String("authservice-address")),
+		DeletionInterval:     cronjob.DELETION_QUANTITY_INTERVAL(appCtx.Int("deletion-quantity-interval")),
+		SuspensionInterval:   cronjob.SUSPENSION_QUANTITY_INTERVAL(appCtx.Int("suspension-quantity-interval")),
+		OrderExpiryInterval:  cronjob.ORDER_EXPIRY_QUANTITY_INTERVAL(appCtx.Int("order-expiry-quantity-interval")),
+		OrderExpiryReminder:  cronjob.ORDER_EXPIRY_REMINDER(appCtx.Int("order-expiry-reminder")),
+		CompletionInterval:   cronjob.ORDER_COMPLETION_QUANTITY_INTERVAL(appCtx.Int("order-completion-quantity-interval")),
+		QuoteExpiryInterval:  cronjob.QUOTE_EXPIRY_QUANTITY_INTERVAL(appCtx.Int("quote-expiry-quantity-interval")),
+		Creds:                creds,
 	})
 	if err != nil {
 		logger.Fatal(err)
@@ -107,11 +123,11 @@ func runMain(appCtx *cli.Context) error {
 		defer wg.Done()
 		if appCtx.Bool("disable-stats") {
 			logger.Info("Stats disabled.")
-			srv = grpc.NewServer(
+			srv = grpc.NewServer(append(creds.ServerOptions(grpcPolicy),
 				grpc.ChainUnaryInterceptor(
 				// otelgrpc.UnaryServerInterceptor(),
 				// grpc_recovery.UnaryServerInterceptor(grpc_recovery.WithRecoveryHandler(MyLogger)),
-				))
+				))...)
 		} else {
 			logger.Info("Stats enabled.")
 			// srv = grpc.NewServer(grpc.StatsHandler(&ocgrpc.ServerHandler{}))
@@ -290,3 +306,24 @@ func MyLogger(p interface{}) error {
 	err = utils.Unwrap(err)
 	return status.Error(codes.Internal, err.Error())
 }
+
+// newTransportCredentials loads the certificate this service presents to its
+// callers and to the services it dials. Without one internal gRPC traffic is
+// plaintext and any pod can call any RPC.
+func newTransportCredentials(appCtx *cli.Context) (*mtls.Credentials, error) {
+	creds, err := mtls.New(mtls.Config{
+		Mode:     appCtx.String("tls-mode"),
+		CAFile:   appCtx.String("tls-ca-file"),
+		CertFile: appCtx.String("tls-cert-file"),
+		KeyFile:  appCtx.String("tls-key-file"),
+		DevDir:   appCtx.String("tls-dev-dir"),
+		Identity: c.SERVICE_NAME,
+	})
+	if err != nil {
+		return nil, xerrors.Errorf("%w", err)
+	}
+	if creds == nil {
+		logger.Warn("tls-mode disabled, internal gRPC traffic is not authenticated")
+	}
+	return creds, nil
+}
@@ -0,0 +1,10 @@
+package main
+
+import "github.com/aqaurius6666/cronjob/src/internal/lib/mtls"
+
+// grpcPolicy lists which services may call each RPC when tls-mode is not
+// disabled. Only health checks are served over gRPC.
+var grpcPolicy = mtls.Policy{
+	"/grpc.health.v1.Health/*": {mtls.Anyone},
+	"/common_grpc.Common/Echo": {mtls.Anyone},
+}
@@ -8,7 +8,9 @@ import (
 
 	"github.com/aqaurius6666/cronjob/src/internal/cronjob"
 	"github.com/aqaurius6666/cronjob/src/internal/db"
+	"github.com/aqaurius6666/cronjob/src/internal/lib/mtls"
 	"github.com/aqaurius6666/cronjob/src/internal/model"
+	"github.com/aqaurius6666/cronjob/src/services/authservice"
 	"github.com/aqaurius6666/cronjob/src/services/chatservice"
 	"github.com/aqaurius6666/cronjob/src/services/mailservice"
 	"github.com/aqaurius6666/cronjob/src/services/payment"
@@ -34,12 +36,20 @@ type ServerOptions struct {
 	RedisUser            redis.REDIS_USER
 	RedisPass            redis.REDIS_PASS
 	ChatserviceAddr      chatservice.ChatserviceAddr
+	AuthserviceAddr      authservice.AuthserviceAddr
+	DeletionInterval     cronjob.DELETION_QUANTITY_INTERVAL
+	SuspensionInterval   cronjob.SUSPENSION_QUANTITY_INTERVAL
+	OrderExpiryInterval  cronjob.ORDER_EXPIRY_QUANTITY_INTERVAL
+	OrderExpiryReminder  cronjob.ORDER_EXPIRY_REMINDER
+	CompletionInterval   cronjob.ORDER_COMPLETION_QUANTITY_INTERVAL
+	QuoteExpiryInterval  cronjob.QUOTE_EXPIRY_QUANTITY_INTERVAL
+	Creds                *mtls.Credentials
 }
 
 func InitMainServer(ctx context.Context, logger *logrus.Logger, opts ServerOptions) (*Server, error) {
 
 	wire.Build(
-		wire.FieldsOf(&opts, "DBDsn", "Key", "QuantityInterval", "UnitInterval", "PaymentDay", "MailserviceAddr", "ChatQuantityInterval", "RedisUri", "RedisUser", "RedisPass", "ChatserviceAddr"),
+		wire.FieldsOf(&opts, "DBDsn", "Key", "QuantityInterval", "UnitInterval", "PaymentDay", "MailserviceAddr", "ChatQuantityInterval", "RedisUri", "RedisUser", "RedisPass", "ChatserviceAddr", "AuthserviceAddr", "DeletionInterval", "SuspensionInterval", "OrderExpiryInterval", "OrderExpiryReminder", "CompletionInterval", "QuoteExpiryInterval", "Creds"),
 		db.ServerRepoSet,
 		model.ServerModelSet,
 		cronjob.JobSet,
@@ -47,6 +57,7 @@ func InitMainServer(ctx context.Context, logger *logrus.Logger, opts ServerOptio
 		mailservice.Set,
 		redis.Set,
 		chatservice.Set,
+		authservice.Set,
 		wire.Struct(new(Server), "*"),
 	)
 	return &Server{}, nil
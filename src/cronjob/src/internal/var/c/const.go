@@ -10,6 +10,10 @@ var (
 )
 
 var (
-	FEE_HANDYMAN_NOTIFICATION = "fee-notification"
-	INACTIVE_SET_KEY          = "inactive-set"
+	FEE_HANDYMAN_NOTIFICATION          = "fee-notification"
+	ORDER_EXPIRY_REMINDER_NOTIFICATION = "order-expiry-reminder-notification"
+	ORDER_EXPIRED_NOTIFICATION         = "order-expired-notification"
+	ORDER_AUTO_CONFIRMED_NOTIFICATION  = "order-auto-confirmed-notification"
+	QUOTE_LAPSED_NOTIFICATION          = "quote-lapsed-notification"
+	INACTIVE_SET_KEY                   = "inactive-set"
 )
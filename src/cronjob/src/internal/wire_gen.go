@@ -11,7 +11,9 @@ import (
 	"github.com/aqaurius6666/cronjob/src/internal/cronjob"
 	"github.com/aqaurius6666/cronjob/src/internal/db"
 	"github.com/aqaurius6666/cronjob/src/internal/db/cockroach"
+	"github.com/aqaurius6666/cronjob/src/internal/lib/mtls"
 	"github.com/aqaurius6666/cronjob/src/internal/model"
+	"github.com/aqaurius6666/cronjob/src/services/authservice"
 	"github.com/aqaurius6666/cronjob/src/services/chatservice"
 	"github.com/aqaurius6666/cronjob/src/services/mailservice"
 	"github.com/aqaurius6666/cronjob/src/services/payment"
@@ -44,7 +46,8 @@ func InitMainServer(ctx context.Context, logger2 *logrus.Logger, opts ServerOpti
 		return nil, err
 	}
 	mailserviceAddr := opts.MailserviceAddr
-	mailServiceClient, err := mailservice.ConnectClient(ctx, mailserviceAddr)
+	credentials := opts.Creds
+	mailServiceClient, err := mailservice.ConnectClient(ctx, mailserviceAddr, credentials)
 	if err != nil {
 		return nil, err
 	}
@@ -64,7 +67,7 @@ func InitMainServer(ctx context.Context, logger2 *logrus.Logger, opts ServerOpti
 		Client: client,
 	}
 	chatserviceAddr := opts.ChatserviceAddr
-	chatServiceClient, err := chatservice.ConnectClient(ctx, chatserviceAddr)
+	chatServiceClient, err := chatservice.ConnectClient(ctx, chatserviceAddr, credentials)
 	if err != nil {
 		return nil, err
 	}
@@ -72,6 +75,15 @@ func InitMainServer(ctx context.Context, logger2 *logrus.Logger, opts ServerOpti
 		Ctx:    ctx,
 		Client: chatServiceClient,
 	}
+	authserviceAddr := opts.AuthserviceAddr
+	authServiceClient, err := authservice.ConnectClient(ctx, authserviceAddr, credentials)
+	if err != nil {
+		return nil, err
+	}
+	authserviceServiceGRPC := &authservice.ServiceGRPC{
+		Ctx:    ctx,
+		Client: authServiceClient,
+	}
 	serverModel := &model.ServerModel{
 		Ctx:     ctx,
 		Logger:  logger2,
@@ -80,6 +92,7 @@ func InitMainServer(ctx context.Context, logger2 *logrus.Logger, opts ServerOpti
 		Mail:    serviceGRPC,
 		Redis:   redisImpl,
 		Chat:    chatserviceServiceGRPC,
+		Auth:    authserviceServiceGRPC,
 	}
 	quantity_INTERVAL := opts.QuantityInterval
 	unit_INTERVAL := opts.UnitInterval
@@ -97,7 +110,39 @@ func InitMainServer(ctx context.Context, logger2 *logrus.Logger, opts ServerOpti
 		Model:            serverModel,
 		QuantityInterval: chat_QUANTITY_INTERVAL,
 	}
-	v := cronjob.SliceProvider(paymentCronjob, chatCronjob)
+	deletion_QUANTITY_INTERVAL := opts.DeletionInterval
+	deletionCronjob := &cronjob.DeletionCronjob{
+		Logger:           logger2,
+		Model:            serverModel,
+		QuantityInterval: deletion_QUANTITY_INTERVAL,
+	}
+	suspension_QUANTITY_INTERVAL := opts.SuspensionInterval
+	suspensionCronjob := &cronjob.SuspensionCronjob{
+		Logger:           logger2,
+		Model:            serverModel,
+		QuantityInterval: suspension_QUANTITY_INTERVAL,
+	}
+	order_EXPIRY_QUANTITY_INTERVAL := opts.OrderExpiryInterval
+	order_EXPIRY_REMINDER := opts.OrderExpiryReminder
+	orderExpiryCronjob := &cronjob.OrderExpiryCronjob{
+		Logger:           logger2,
+		Model:            serverModel,
+		QuantityInterval: order_EXPIRY_QUANTITY_INTERVAL,
+		Reminder:         order_EXPIRY_REMINDER,
+	}
+	order_COMPLETION_QUANTITY_INTERVAL := opts.CompletionInterval
+	orderCompletionCronjob := &cronjob.OrderCompletionCronjob{
+		Logger:           logger2,
+		Model:            serverModel,
+		QuantityInterval: order_COMPLETION_QUANTITY_INTERVAL,
+	}
+	quote_EXPIRY_QUANTITY_INTERVAL := opts.QuoteExpiryInterval
+	quoteExpiryCronjob := &cronjob.QuoteExpiryCronjob{
+		Logger:           logger2,
+		Model:            serverModel,
+		QuantityInterval: quote_EXPIRY_QUANTITY_INTERVAL,
+	}
+	v := cronjob.SliceProvider(paymentCronjob, chatCronjob, deletionCronjob, suspensionCronjob, orderExpiryCronjob, orderCompletionCronjob, quoteExpiryCronjob)
 	server := &Server{
 		Jobs:     v,
 		MainRepo: serverCDBRepo,
@@ -124,4 +169,12 @@ type ServerOptions struct {
 	RedisUser            redis.REDIS_USER
 	RedisPass            redis.REDIS_PASS
 	ChatserviceAddr      chatservice.ChatserviceAddr
+	AuthserviceAddr      authservice.AuthserviceAddr
+	DeletionInterval     cronjob.DELETION_QUANTITY_INTERVAL
+	SuspensionInterval   cronjob.SUSPENSION_QUANTITY_INTERVAL
+	OrderExpiryInterval  cronjob.ORDER_EXPIRY_QUANTITY_INTERVAL
+	OrderExpiryReminder  cronjob.ORDER_EXPIRY_REMINDER
+	CompletionInterval   cronjob.ORDER_COMPLETION_QUANTITY_INTERVAL
+	QuoteExpiryInterval  cronjob.QUOTE_EXPIRY_QUANTITY_INTERVAL
+	Creds                *mtls.Credentials
 }
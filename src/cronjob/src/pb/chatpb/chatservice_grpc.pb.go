@@ -26,6 +26,8 @@ type ChatServiceClient interface {
 	NewConversation(ctx context.Context, in *NewConversationRequest, opts ...grpc.CallOption) (*NewConversationResponse, error)
 	GetConversation(ctx context.Context, in *ConversationPostRequest, opts ...grpc.CallOption) (*ConversationPostResponse, error)
 	TriggerSendSMS(ctx context.Context, in *TriggerSendSMSRequest, opts ...grpc.CallOption) (*TriggerSendSMSResponse, error)
+	CloseConversation(ctx context.Context, in *CloseConversationRequest, opts ...grpc.CallOption) (*CloseConversationResponse, error)
+	DeleteUserData(ctx context.Context, in *DeleteUserDataRequest, opts ...grpc.CallOption) (*DeleteUserDataResponse, error)
 }
 
 type chatServiceClient struct {
@@ -94,6 +96,24 @@ func (c *chatServiceClient) TriggerSendSMS(ctx context.Context, in *TriggerSendS
 	return out, nil
 }
 
+func (c *chatServiceClient) CloseConversation(ctx context.Context, in *CloseConversationRequest, opts ...grpc.CallOption) (*CloseConversationResponse, error) {
+	out := new(CloseConversationResponse)
+	err := c.cc.Invoke(ctx, "/chatservice.ChatService/CloseConversation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) DeleteUserData(ctx context.Context, in *DeleteUserDataRequest, opts ...grpc.CallOption) (*DeleteUserDataResponse, error) {
+	out := new(DeleteUserDataResponse)
+	err := c.cc.Invoke(ctx, "/chatservice.ChatService/DeleteUserData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ChatServiceServer is the server API for ChatService service.
 // All implementations must embed UnimplementedChatServiceServer
 // for forward compatibility
@@ -102,6 +122,8 @@ type ChatServiceServer interface {
 	NewConversation(context.Context, *NewConversationRequest) (*NewConversationResponse, error)
 	GetConversation(context.Context, *ConversationPostRequest) (*ConversationPostResponse, error)
 	TriggerSendSMS(context.Context, *TriggerSendSMSRequest) (*TriggerSendSMSResponse, error)
+	CloseConversation(context.Context, *CloseConversationRequest) (*CloseConversationResponse, error)
+	DeleteUserData(context.Context, *DeleteUserDataRequest) (*DeleteUserDataResponse, error)
 	mustEmbedUnimplementedChatServiceServer()
 }
 
@@ -121,6 +143,12 @@ func (UnimplementedChatServiceServer) GetConversation(context.Context, *Conversa
 func (UnimplementedChatServiceServer) TriggerSendSMS(context.Context, *TriggerSendSMSRequest) (*TriggerSendSMSResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TriggerSendSMS not implemented")
 }
+func (UnimplementedChatServiceServer) CloseConversation(context.Context, *CloseConversationRequest) (*CloseConversationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseConversation not implemented")
+}
+func (UnimplementedChatServiceServer) DeleteUserData(context.Context, *DeleteUserDataRequest) (*DeleteUserDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUserData not implemented")
+}
 func (UnimplementedChatServiceServer) mustEmbedUnimplementedChatServiceServer() {}
 
 // UnsafeChatServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -214,6 +242,42 @@ func _ChatService_TriggerSendSMS_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ChatService_CloseConversation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseConversationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).CloseConversation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chatservice.ChatService/CloseConversation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).CloseConversation(ctx, req.(*CloseConversationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_DeleteUserData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).DeleteUserData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chatservice.ChatService/DeleteUserData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).DeleteUserData(ctx, req.(*DeleteUserDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ChatService_ServiceDesc is the grpc.ServiceDesc for ChatService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -233,6 +297,14 @@ var ChatService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TriggerSendSMS",
 			Handler:    _ChatService_TriggerSendSMS_Handler,
 		},
+		{
+			MethodName: "CloseConversation",
+			Handler:    _ChatService_CloseConversation_Handler,
+		},
+		{
+			MethodName: "DeleteUserData",
+			Handler:    _ChatService_DeleteUserData_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -0,0 +1,29 @@
+package authservice
+
+import (
+	"context"
+
+	"github.com/aqaurius6666/cronjob/src/internal/var/c"
+	"github.com/google/wire"
+)
+
+type Service interface {
+	ListDueDeletions(ctx context.Context, limit int32) ([]*DueDeletion, error)
+	DeleteUser(ctx context.Context, id string) error
+	ListExpiredSuspensions(ctx context.Context, limit int32) ([]*ExpiredSuspension, error)
+	LiftSuspension(ctx context.Context, id string) error
+}
+
+// DueDeletion is an account whose deletion grace period is over.
+type DueDeletion struct {
+	Id   string
+	Role c.ROLE
+}
+
+// ExpiredSuspension is a suspended account whose suspension has run out.
+type ExpiredSuspension struct {
+	Id   string
+	Role c.ROLE
+}
+
+var Set = wire.NewSet(wire.Bind(new(Service), new(*ServiceGRPC)), wire.Struct(new(ServiceGRPC), "*"), ConnectClient)
@@ -0,0 +1,140 @@
+package authservice
+
+import (
+	"context"
+	"time"
+
+	"github.com/aqaurius6666/cronjob/src/internal/lib"
+	"github.com/aqaurius6666/cronjob/src/internal/lib/mtls"
+	"github.com/aqaurius6666/cronjob/src/internal/var/c"
+	"github.com/aqaurius6666/cronjob/src/pb/authpb"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/otel"
+	"golang.org/x/xerrors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+type AuthserviceAddr string
+
+var (
+	_       Service = (*ServiceGRPC)(nil)
+	timeout         = 5 * time.Second
+)
+
+type ServiceGRPC struct {
+	Ctx    context.Context
+	Client authpb.AuthServiceClient
+}
+
+func ConnectClient(ctx context.Context, addr AuthserviceAddr, creds *mtls.Credentials) (authpb.AuthServiceClient, error) {
+	nctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	conn, err := grpc.DialContext(nctx, string(addr), creds.DialOption(), grpc.WithChainUnaryInterceptor(
+		otelgrpc.UnaryClientInterceptor(),
+	))
+	if err != nil {
+		return nil, xerrors.Errorf("%w", err)
+	}
+	return authpb.NewAuthServiceClient(conn), nil
+}
+
+func (s *ServiceGRPC) ListDueDeletions(ctx context.Context, limit int32) ([]*DueDeletion, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.ListDueDeletions))
+	defer span.End()
+
+	nctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	res, err := s.Client.ListDueDeletions(nctx, &authpb.ListDueDeletionsRequest{
+		Limit: limit,
+	})
+	if err != nil {
+		stt, ok := status.FromError(err)
+		if ok {
+			err = xerrors.New(stt.Message())
+		}
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return nil, err
+	}
+	users := make([]*DueDeletion, 0, len(res.Users))
+	for _, u := range res.Users {
+		users = append(users, &DueDeletion{
+			Id:   u.Id,
+			Role: u.Role,
+		})
+	}
+	return users, nil
+}
+
+func (s *ServiceGRPC) DeleteUser(ctx context.Context, id string) error {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.DeleteUser))
+	defer span.End()
+
+	nctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	_, err := s.Client.DeleteUser(nctx, &authpb.DeleteUserRequest{
+		Id: id,
+	})
+	if err != nil {
+		stt, ok := status.FromError(err)
+		if ok {
+			err = xerrors.New(stt.Message())
+		}
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return err
+	}
+	return nil
+}
+
+func (s *ServiceGRPC) ListExpiredSuspensions(ctx context.Context, limit int32) ([]*ExpiredSuspension, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.ListExpiredSuspensions))
+	defer span.End()
+
+	nctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	res, err := s.Client.ListExpiredSuspensions(nctx, &authpb.ListExpiredSuspensionsRequest{
+		Limit: limit,
+	})
+	if err != nil {
+		stt, ok := status.FromError(err)
+		if ok {
+			err = xerrors.New(stt.Message())
+		}
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return nil, err
+	}
+	users := make([]*ExpiredSuspension, 0, len(res.Users))
+	for _, u := range res.Users {
+		users = append(users, &ExpiredSuspension{
+			Id:   u.Id,
+			Role: u.Role,
+		})
+	}
+	return users, nil
+}
+
+// LiftSuspension lifts the suspension of a user on behalf of the system,
+// so no admin is recorded for it.
+func (s *ServiceGRPC) LiftSuspension(ctx context.Context, id string) error {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.LiftSuspension))
+	defer span.End()
+
+	nctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	_, err := s.Client.LiftSuspension(nctx, &authpb.LiftSuspensionRequest{
+		Id: id,
+	})
+	if err != nil {
+		stt, ok := status.FromError(err)
+		if ok {
+			err = xerrors.New(stt.Message())
+		}
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return err
+	}
+	return nil
+}
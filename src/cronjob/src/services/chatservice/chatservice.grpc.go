@@ -4,11 +4,11 @@ import (
 	"context"
 	"time"
 
+	"github.com/aqaurius6666/cronjob/src/internal/lib/mtls"
 	"github.com/aqaurius6666/cronjob/src/pb/chatpb"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"golang.org/x/xerrors"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 )
 
 type ChatserviceAddr string
@@ -23,10 +23,10 @@ type ServiceGRPC struct {
 	Client chatpb.ChatServiceClient
 }
 
-func ConnectClient(ctx context.Context, addr ChatserviceAddr) (chatpb.ChatServiceClient, error) {
+func ConnectClient(ctx context.Context, addr ChatserviceAddr, creds *mtls.Credentials) (chatpb.ChatServiceClient, error) {
 	nctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
-	conn, err := grpc.DialContext(nctx, string(addr), grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithChainUnaryInterceptor(
+	conn, err := grpc.DialContext(nctx, string(addr), creds.DialOption(), grpc.WithChainUnaryInterceptor(
 		otelgrpc.UnaryClientInterceptor(),
 	))
 	if err != nil {
@@ -48,6 +48,30 @@ func (s *ServiceGRPC) TriggerSendSMS(ctx context.Context, userId string) error {
 	return nil
 }
 
+func (s *ServiceGRPC) DeleteUserData(ctx context.Context, userId string) error {
+	nctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	_, err := s.Client.DeleteUserData(nctx, &chatpb.DeleteUserDataRequest{
+		UserId: userId,
+	})
+	if err != nil {
+		return xerrors.Errorf("%w", err)
+	}
+	return nil
+}
+
+func (s *ServiceGRPC) CloseConversation(ctx context.Context, conversationId string) error {
+	nctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	_, err := s.Client.CloseConversation(nctx, &chatpb.CloseConversationRequest{
+		OrderId: conversationId,
+	})
+	if err != nil {
+		return xerrors.Errorf("%w", err)
+	}
+	return nil
+}
+
 // func (s *ServiceGRPC) NewConversation(ctx context.Context, order string, member []string, phones []string) (string, error) {
 // 	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.NewConversation))
 // 	defer span.End()
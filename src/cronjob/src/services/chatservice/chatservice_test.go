@@ -10,7 +10,7 @@ import (
 
 func TestChatservice(t *testing.T) {
 	ctx := context.Background()
-	chat, err := ConnectClient(ctx, ChatserviceAddr("localhost:50050"))
+	chat, err := ConnectClient(ctx, ChatserviceAddr("localhost:50050"), nil)
 	assert.Nil(t, err, err)
 	conv, err := chat.NewConversation(ctx, &chatpb.NewConversationRequest{
 		MemberIds: []string{"5c5db1ba-a7a1-4dc5-b657-93ca90331126", "2472d3cb-68bc-4ef8-9d1c-6bf02f87dadc"},
@@ -5,13 +5,13 @@ import (
 	"time"
 
 	"github.com/aqaurius6666/cronjob/src/internal/lib"
+	"github.com/aqaurius6666/cronjob/src/internal/lib/mtls"
 	"github.com/aqaurius6666/cronjob/src/internal/var/c"
 	"github.com/aqaurius6666/cronjob/src/pb/mailpb"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"go.opentelemetry.io/otel"
 	"golang.org/x/xerrors"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/status"
 )
 
@@ -27,10 +27,10 @@ type ServiceGRPC struct {
 	Client mailpb.MailServiceClient
 }
 
-func ConnectClient(ctx context.Context, addr MailserviceAddr) (mailpb.MailServiceClient, error) {
+func ConnectClient(ctx context.Context, addr MailserviceAddr, creds *mtls.Credentials) (mailpb.MailServiceClient, error) {
 	nctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
-	conn, err := grpc.DialContext(nctx, string(addr), grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithChainUnaryInterceptor(
+	conn, err := grpc.DialContext(nctx, string(addr), creds.DialOption(), grpc.WithChainUnaryInterceptor(
 		otelgrpc.UnaryClientInterceptor(),
 	))
 	if err != nil {
@@ -106,3 +106,24 @@ func (s *ServiceGRPC) SendNotification(ctx context.Context, to string, title, bo
 	}
 	return nil
 }
+
+func (s *ServiceGRPC) DeleteUserDevices(ctx context.Context, userId string) error {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.DeleteUserDevices))
+	defer span.End()
+
+	nctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	_, err := s.Client.DeleteUserDevices(nctx, &mailpb.DeleteUserDevicesRequest{
+		UserId: userId,
+	})
+	if err != nil {
+		stt, ok := status.FromError(err)
+		if ok {
+			err = xerrors.New(stt.Message())
+		}
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return err
+	}
+	return nil
+}
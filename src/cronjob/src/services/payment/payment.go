@@ -12,4 +12,5 @@ var (
 
 type Interface interface {
 	PaymentIntent(ctx context.Context, customerId *string, amount *int64, paymentMethodId *string) (*string, error)
+	DeleteCustomer(ctx context.Context, customerId *string) error
 }
@@ -65,3 +65,20 @@ func (s *Stripe) PaymentIntent(ctx context.Context, customerId *string, amount *
 	}
 	return &pi.ID, nil
 }
+
+func (s *Stripe) DeleteCustomer(ctx context.Context, customerId *string) error {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.DeleteCustomer))
+	defer span.End()
+
+	_, err := s.client.Customers.Del(*customerId, &stripe.CustomerParams{
+		Params: stripe.Params{
+			Context: ctx,
+		},
+	})
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return err
+	}
+	return nil
+}
@@ -3,6 +3,7 @@ package api
 import (
 	"github.com/aqaurius6666/mailservice/src/internal/mail"
 	"github.com/aqaurius6666/mailservice/src/internal/model"
+	"github.com/aqaurius6666/mailservice/src/internal/sms"
 	"github.com/aqaurius6666/mailservice/src/pb/mailpb"
 	"github.com/google/wire"
 	"github.com/sirupsen/logrus"
@@ -17,6 +18,7 @@ var (
 type ApiServer struct {
 	mailpb.UnimplementedMailServiceServer `wire:"-"`
 	MailService                           mail.Service
+	Sms                                   sms.Service
 	Logger                                *logrus.Logger
 	Model                                 model.Server
 }
@@ -0,0 +1,23 @@
+package api
+
+import (
+	"context"
+
+	"github.com/aqaurius6666/mailservice/src/internal/lib"
+	"github.com/aqaurius6666/mailservice/src/internal/var/c"
+	"github.com/aqaurius6666/mailservice/src/internal/var/e"
+	"github.com/aqaurius6666/mailservice/src/pb/mailpb"
+	"go.opentelemetry.io/otel"
+	"golang.org/x/xerrors"
+)
+
+func (s *ApiServer) DeleteUserDevices(ctx context.Context, req *mailpb.DeleteUserDevicesRequest) (*mailpb.DeleteUserDevicesResponse, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.DeleteUserDevices))
+	defer span.End()
+	if req.UserId == "" {
+		err := xerrors.Errorf("%w", e.ErrInvalidRequest)
+		lib.RecordError(span, err)
+		panic(err)
+	}
+	return s.Model.DeleteUserDevices(ctx, req)
+}
@@ -0,0 +1,17 @@
+package api
+
+import (
+	"context"
+
+	"github.com/aqaurius6666/mailservice/src/pb/mailpb"
+	"golang.org/x/xerrors"
+)
+
+func (s *ApiServer) SendSMS(ctx context.Context, req *mailpb.SendSMSRequest) (*mailpb.SendSMSResponse, error) {
+	s.Logger.Infof("Send sms to %s", req.To)
+	err := s.Sms.SendSMS(ctx, req.To, req.Msg)
+	if err != nil {
+		panic(xerrors.Errorf("%w", err))
+	}
+	return &mailpb.SendSMSResponse{}, nil
+}
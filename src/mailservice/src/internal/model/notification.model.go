@@ -20,6 +20,7 @@ type NotificationModel interface {
 	SubscribeNotification(ctx context.Context, req *mailpb.SubscribeNotificationRequest) (*mailpb.SubscribeNotificationResponse, error)
 	UnsubscribeNotification(ctx context.Context, req *mailpb.UnsubscribeNotificationRequest) (*mailpb.UnsubscribeNotificationResponse, error)
 	SendNotification(ctx context.Context, req *mailpb.SendNotificationRequest) (*mailpb.SendNotificationResponse, error)
+	DeleteUserDevices(ctx context.Context, req *mailpb.DeleteUserDevicesRequest) (*mailpb.DeleteUserDevicesResponse, error)
 }
 
 func (s *ServerModel) SubscribeNotification(ctx context.Context, req *mailpb.SubscribeNotificationRequest) (*mailpb.SubscribeNotificationResponse, error) {
@@ -98,6 +99,24 @@ func (s *ServerModel) UnsubscribeNotification(ctx context.Context, req *mailpb.U
 	return &mailpb.UnsubscribeNotificationResponse{}, nil
 }
 
+// DeleteUserDevices forgets every device of a user whose account is erased.
+func (s *ServerModel) DeleteUserDevices(ctx context.Context, req *mailpb.DeleteUserDevicesRequest) (*mailpb.DeleteUserDevicesResponse, error) {
+	ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(ctx, lib.GetFunctionName(s.DeleteUserDevices))
+	defer span.End()
+
+	err := s.Repo.DeleteDevice(ctx, &device.Search{
+		Device: device.Device{
+			UserId: &req.UserId,
+		},
+	}, &device.Device{})
+	if err != nil {
+		err = xerrors.Errorf("%w", err)
+		lib.RecordError(span, err)
+		return nil, err
+	}
+	return &mailpb.DeleteUserDevicesResponse{}, nil
+}
+
 func (s *ServerModel) SendNotification(ctx context.Context, req *mailpb.SendNotificationRequest) (*mailpb.SendNotificationResponse, error) {
 	go func() {
 		ctx, span := otel.GetTracerProvider().Tracer(c.SERVICE_NAME).Start(context.Background(), lib.GetFunctionName(s.SendNotification))
@@ -20,6 +20,7 @@ import (
 	"github.com/aqaurius6666/go-utils/utils"
 	"github.com/aqaurius6666/mailservice/src/internal/db"
 	"github.com/aqaurius6666/mailservice/src/internal/lib"
+	"github.com/aqaurius6666/mailservice/src/internal/lib/mtls"
 	"github.com/aqaurius6666/mailservice/src/internal/lib/unleash"
 	"github.com/aqaurius6666/mailservice/src/internal/mail"
 	"github.com/aqaurius6666/mailservice/src/pb/mailpb"
@@ -31,6 +32,7 @@ import (
 	"go.opencensus.io/stats/view"
 	"go.opencensus.io/trace"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"golang.org/x/xerrors"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	healthpb "google.golang.org/grpc/health/grpc_health_v1"
@@ -68,6 +70,11 @@ func runMain(appCtx *cli.Context) error {
 		logger.Fatal(err)
 		return err
 	}
+	creds, err := newTransportCredentials(appCtx)
+	if err != nil {
+		logger.Fatal(err)
+		return err
+	}
 	mainServer, err := InitMainServer(ctx, logger, ServerOptions{
 		Username:    mail.MailUsername(appCtx.String("mail-username")),
 		Password:    mail.MailPassword(appCtx.String("mail-password")),
@@ -116,14 +123,14 @@ func runMain(appCtx *cli.Context) error {
 		defer wg.Done()
 		if appCtx.Bool("disable-stats") {
 			logger.Info("Stats disabled.")
-			srv = grpc.NewServer(grpc.ChainUnaryInterceptor(
+			srv = grpc.NewServer(append(creds.ServerOptions(grpcPolicy), grpc.ChainUnaryInterceptor(
 				otelgrpc.UnaryServerInterceptor(),
 				grpc_recovery.UnaryServerInterceptor(grpc_recovery.WithRecoveryHandler(MyLogger)),
 				lib.UnaryServerLogRequestInterceptor,
-			))
+			))...)
 		} else {
 			logger.Info("Stats enabled.")
-			srv = grpc.NewServer(grpc.StatsHandler(&ocgrpc.ServerHandler{}))
+			srv = grpc.NewServer(append(creds.ServerOptions(grpcPolicy), grpc.StatsHandler(&ocgrpc.ServerHandler{}))...)
 		}
 		healthpb.RegisterHealthServer(srv, commonServer)
 		commonpb.RegisterCommonServer(srv, commonServer)
@@ -285,3 +292,24 @@ func MyLogger(p interface{}) error {
 	logger.Errorf("%+v", err)
 	return status.Error(codes.Internal, err.Error())
 }
+
+// newTransportCredentials loads the certificate this service presents to its
+// callers. Without one internal gRPC traffic is plaintext and any pod can
+// call any RPC.
+func newTransportCredentials(appCtx *cli.Context) (*mtls.Credentials, error) {
+	creds, err := mtls.New(mtls.Config{
+		Mode:     appCtx.String("tls-mode"),
+		CAFile:   appCtx.String("tls-ca-file"),
+		CertFile: appCtx.String("tls-cert-file"),
+		KeyFile:  appCtx.String("tls-key-file"),
+		DevDir:   appCtx.String("tls-dev-dir"),
+		Identity: serviceName,
+	})
+	if err != nil {
+		return nil, xerrors.Errorf("%w", err)
+	}
+	if creds == nil {
+		logger.Warn("tls-mode disabled, internal gRPC traffic is not authenticated")
+	}
+	return creds, nil
+}
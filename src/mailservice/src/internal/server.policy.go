@@ -0,0 +1,26 @@
+package main
+
+import "github.com/aqaurius6666/mailservice/src/internal/lib/mtls"
+
+// Identities are the common names of the certificates services present to
+// each other.
+const (
+	apiService     = "api-service"
+	chatService    = "chat-service"
+	cronjobService = "cronjob-service"
+	authService    = "auth-service"
+)
+
+// grpcPolicy lists which services may call each RPC when tls-mode is not
+// disabled.
+var grpcPolicy = mtls.Policy{
+	"/grpc.health.v1.Health/*":                         {mtls.Anyone},
+	"/common_grpc.Common/Echo":                         {mtls.Anyone},
+	"/mailservice.MailService/SendMail":                {authService, chatService},
+	"/mailservice.MailService/SendMails":               {authService},
+	"/mailservice.MailService/SendSMS":                 {authService},
+	"/mailservice.MailService/SendNotification":        {apiService, cronjobService},
+	"/mailservice.MailService/SubscribeNotification":   {apiService},
+	"/mailservice.MailService/UnsubscribeNotification": {apiService},
+	"/mailservice.MailService/DeleteUserDevices":       {cronjobService},
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/aqaurius6666/mailservice/src/internal/db"
 	"github.com/aqaurius6666/mailservice/src/internal/mail"
 	"github.com/aqaurius6666/mailservice/src/internal/model"
+	"github.com/aqaurius6666/mailservice/src/internal/sms"
 	"github.com/aqaurius6666/mailservice/src/services/fcm"
 	"github.com/google/wire"
 	"github.com/sirupsen/logrus"
@@ -38,6 +39,7 @@ func InitMainServer(ctx context.Context, logger *logrus.Logger, opts ServerOptio
 		mail.ServiceSet,
 		model.ServerModelSet,
 		fcm.Set,
+		sms.NewService,
 		db.ServerRepoSet,
 		wire.Struct(new(Server), "*"),
 	)
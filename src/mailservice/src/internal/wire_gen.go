@@ -14,6 +14,7 @@ import (
 	"github.com/aqaurius6666/mailservice/src/internal/db/cockroach"
 	"github.com/aqaurius6666/mailservice/src/internal/mail"
 	"github.com/aqaurius6666/mailservice/src/internal/model"
+	"github.com/aqaurius6666/mailservice/src/internal/sms"
 	"github.com/aqaurius6666/mailservice/src/services/fcm"
 	"github.com/sirupsen/logrus"
 )
@@ -27,6 +28,10 @@ func InitMainServer(ctx context.Context, logger2 *logrus.Logger, opts ServerOpti
 	smtpPort := opts.Port
 	smtpSender := opts.Sender
 	serviceV2 := mail.NewServiceV2(mailUsername, mailPassword, smtpHost, smtpPort, smtpSender)
+	service, err := sms.NewService()
+	if err != nil {
+		return nil, err
+	}
 	dbDsn := opts.DBDsn
 	gormDB, err := db.ConnectGorm(dbDsn)
 	if err != nil {
@@ -43,7 +48,7 @@ func InitMainServer(ctx context.Context, logger2 *logrus.Logger, opts ServerOpti
 		CDBRepository: cdbRepository,
 	}
 	fb_PRIVATE_KEY := opts.FirebaseKey
-	service, err := fcm.NewService(logger2, fb_PRIVATE_KEY)
+	fcmService, err := fcm.NewService(logger2, fb_PRIVATE_KEY)
 	if err != nil {
 		return nil, err
 	}
@@ -51,10 +56,11 @@ func InitMainServer(ctx context.Context, logger2 *logrus.Logger, opts ServerOpti
 		Ctx:    ctx,
 		Logger: logger2,
 		Repo:   serverCDBRepo,
-		Fcm:    service,
+		Fcm:    fcmService,
 	}
 	apiServer := &api.ApiServer{
 		MailService: serviceV2,
+		Sms:         service,
 		Logger:      logger2,
 		Model:       serverModel,
 	}
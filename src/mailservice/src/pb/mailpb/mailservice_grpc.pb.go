@@ -27,6 +27,8 @@ type MailServiceClient interface {
 	SubscribeNotification(ctx context.Context, in *SubscribeNotificationRequest, opts ...grpc.CallOption) (*SubscribeNotificationResponse, error)
 	UnsubscribeNotification(ctx context.Context, in *UnsubscribeNotificationRequest, opts ...grpc.CallOption) (*UnsubscribeNotificationResponse, error)
 	SendNotification(ctx context.Context, in *SendNotificationRequest, opts ...grpc.CallOption) (*SendNotificationResponse, error)
+	SendSMS(ctx context.Context, in *SendSMSRequest, opts ...grpc.CallOption) (*SendSMSResponse, error)
+	DeleteUserDevices(ctx context.Context, in *DeleteUserDevicesRequest, opts ...grpc.CallOption) (*DeleteUserDevicesResponse, error)
 }
 
 type mailServiceClient struct {
@@ -82,6 +84,24 @@ func (c *mailServiceClient) SendNotification(ctx context.Context, in *SendNotifi
 	return out, nil
 }
 
+func (c *mailServiceClient) SendSMS(ctx context.Context, in *SendSMSRequest, opts ...grpc.CallOption) (*SendSMSResponse, error) {
+	out := new(SendSMSResponse)
+	err := c.cc.Invoke(ctx, "/mailservice.MailService/SendSMS", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mailServiceClient) DeleteUserDevices(ctx context.Context, in *DeleteUserDevicesRequest, opts ...grpc.CallOption) (*DeleteUserDevicesResponse, error) {
+	out := new(DeleteUserDevicesResponse)
+	err := c.cc.Invoke(ctx, "/mailservice.MailService/DeleteUserDevices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MailServiceServer is the server API for MailService service.
 // All implementations must embed UnimplementedMailServiceServer
 // for forward compatibility
@@ -91,6 +111,8 @@ type MailServiceServer interface {
 	SubscribeNotification(context.Context, *SubscribeNotificationRequest) (*SubscribeNotificationResponse, error)
 	UnsubscribeNotification(context.Context, *UnsubscribeNotificationRequest) (*UnsubscribeNotificationResponse, error)
 	SendNotification(context.Context, *SendNotificationRequest) (*SendNotificationResponse, error)
+	SendSMS(context.Context, *SendSMSRequest) (*SendSMSResponse, error)
+	DeleteUserDevices(context.Context, *DeleteUserDevicesRequest) (*DeleteUserDevicesResponse, error)
 	mustEmbedUnimplementedMailServiceServer()
 }
 
@@ -113,6 +135,12 @@ func (UnimplementedMailServiceServer) UnsubscribeNotification(context.Context, *
 func (UnimplementedMailServiceServer) SendNotification(context.Context, *SendNotificationRequest) (*SendNotificationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendNotification not implemented")
 }
+func (UnimplementedMailServiceServer) SendSMS(context.Context, *SendSMSRequest) (*SendSMSResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendSMS not implemented")
+}
+func (UnimplementedMailServiceServer) DeleteUserDevices(context.Context, *DeleteUserDevicesRequest) (*DeleteUserDevicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUserDevices not implemented")
+}
 func (UnimplementedMailServiceServer) mustEmbedUnimplementedMailServiceServer() {}
 
 // UnsafeMailServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -216,6 +244,42 @@ func _MailService_SendNotification_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MailService_SendSMS_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendSMSRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MailServiceServer).SendSMS(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mailservice.MailService/SendSMS",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MailServiceServer).SendSMS(ctx, req.(*SendSMSRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MailService_DeleteUserDevices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserDevicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MailServiceServer).DeleteUserDevices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mailservice.MailService/DeleteUserDevices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MailServiceServer).DeleteUserDevices(ctx, req.(*DeleteUserDevicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MailService_ServiceDesc is the grpc.ServiceDesc for MailService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -243,6 +307,14 @@ var MailService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SendNotification",
 			Handler:    _MailService_SendNotification_Handler,
 		},
+		{
+			MethodName: "SendSMS",
+			Handler:    _MailService_SendSMS_Handler,
+		},
+		{
+			MethodName: "DeleteUserDevices",
+			Handler:    _MailService_DeleteUserDevices_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "mailservice.proto",